@@ -14,6 +14,8 @@ import { describe, expect, it, vi } from 'vitest';
 
 vi.mock('@cleocode/core/internal', () => ({
   getProjectRoot: vi.fn(() => '/mock/project'),
+  resolveProjectRoot: vi.fn(() => '/mock/project'),
+  exportDependencyGraph: vi.fn(),
   getLogger: vi.fn(() => ({ error: vi.fn(), warn: vi.fn(), info: vi.fn(), debug: vi.fn() })),
   getBrainNativeDb: vi.fn(() => null),
   getNexusNativeDb: vi.fn(() => null),
@@ -120,6 +122,7 @@ describe('graphCommand (T9147 W3)', () => {
       'init',
       'sync',
       'reconcile',
+      'export',
     ];
 
     for (const op of requiredOps) {
@@ -44,17 +44,22 @@ vi.mock('../../../../../core/src/config.js', () => ({
 }));
 
 vi.mock('../../../../../core/src/orchestration/index.js', () => ({
+  computeReadyTasks: vi.fn(),
   getReadyTasks: vi.fn(),
   analyzeEpic: vi.fn(),
   getNextTask: vi.fn(),
 }));
 
+vi.mock('../../../../../core/src/tasks/task-index.js', () => ({
+  loadTaskIndex: vi.fn(),
+}));
+
 // ---------------------------------------------------------------------------
 // Imports AFTER mocks are set up
 // ---------------------------------------------------------------------------
 
 import { loadConfig } from '../../../../../core/src/config.js';
-import { getReadyTasks } from '../../../../../core/src/orchestration/index.js';
+import { computeReadyTasks } from '../../../../../core/src/orchestration/index.js';
 import { type getAccessor, getTaskAccessor } from '../../../../../core/src/store/data-accessor.js';
 
 // ---------------------------------------------------------------------------
@@ -103,9 +108,9 @@ function invalidTaskSet(epicId = 'E001'): Task[] {
   ];
 }
 
-/** Stub `getReadyTasks` to return one ready task. */
+/** Stub `computeReadyTasks` to return one ready task. */
 function stubReadyTasks(epicId: string): void {
-  vi.mocked(getReadyTasks).mockResolvedValue([
+  vi.mocked(computeReadyTasks).mockReturnValue([
     {
       taskId: 'T001',
       title: 'Child 1',
@@ -113,7 +118,7 @@ function stubReadyTasks(epicId: string): void {
       blockers: [],
       epicId,
     },
-  ] as ReturnType<typeof getReadyTasks> extends Promise<infer R> ? R : never);
+  ] as ReturnType<typeof computeReadyTasks>);
 }
 
 /** Stub accessor to return a minimal no-op stub (T9054: both shim + canonical). */
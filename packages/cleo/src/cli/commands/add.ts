@@ -86,7 +86,7 @@ export const addCommand = defineCommand({
     priority: {
       type: 'string',
       alias: 'p',
-      description: 'Task priority (low | medium | high | critical)',
+      description: 'Task priority (low | medium | high | critical; normal = medium)',
     },
     type: {
       type: 'string',
@@ -200,6 +200,23 @@ export const addCommand = defineCommand({
       description:
         'Severity level (P0|P1|P2|P3) — valid for any --kind (T9073). Orthogonal to priority. Appends signed attestation.',
     },
+    due: {
+      type: 'string',
+      description: 'Due date (RFC 3339, e.g. 2026-07-01 or 2026-07-01T17:00:00Z) — see `cleo overdue`',
+    },
+    recurrence: {
+      type: 'string',
+      description:
+        "Recur on completion: interval like 7d (m|h|d|w), '@weekly', or JSON {\"every\":\"7d\"}",
+    },
+    estimate: {
+      type: 'string',
+      description: 'Effort estimate (story points or hours) — rolled up by `cleo saga show`',
+    },
+    track: {
+      type: 'string',
+      description: 'Orchestration track (e.g. infra) — balanced by `cleo orchestrate ready`',
+    },
     /**
      * Bypass the E_DUPLICATE_TASK_LIKELY rejection guard.
      *
@@ -382,6 +399,10 @@ export const addCommand = defineCommand({
     if (args.kind !== undefined) params['kind'] = args.kind;
     if (args.scope !== undefined) params['scope'] = args.scope;
     if (args.severity !== undefined) params['severity'] = args.severity;
+    if (args.due !== undefined) params['due'] = args.due;
+    if (args.recurrence !== undefined) params['recurrence'] = args.recurrence;
+    if (args.estimate !== undefined) params['estimate'] = Number(args.estimate);
+    if (args.track !== undefined) params['track'] = args.track;
     // T1633: BRAIN duplicate-bypass flag
     if (args['force-duplicate'] !== undefined) params['forceDuplicate'] = args['force-duplicate'];
 
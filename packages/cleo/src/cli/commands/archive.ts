@@ -10,6 +10,10 @@
  *   cleo archive --tasks T001,T002      -- archive specific tasks
  *   cleo archive --no-cancelled         -- exclude cancelled tasks
  *   cleo archive --dry-run              -- preview without modifying
+ *   cleo archive list [--saga <id>] [--since <date>] -- archived tasks, newest first
+ *   cleo archive restore <id> [--to-saga <id>]       -- return a task to its prior status
+ *
+ * Archived tasks stay searchable read-only via `cleo find --include-archived`.
  *
  * @task T4461
  * @epic T4454
@@ -17,6 +21,49 @@
 
 import { defineCommand } from 'citty';
 import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { isSubCommandDispatch } from '../lib/subcommand-guard.js';
+
+/** cleo archive list — routes to `tasks.archive.list`. */
+const listCommand = defineCommand({
+  meta: { name: 'list', description: 'List archived tasks, most recent first' },
+  args: {
+    saga: { type: 'string', description: 'Only tasks archived from under this saga' },
+    since: { type: 'string', description: 'Only tasks archived at or after this date (ISO)' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'query',
+      'tasks',
+      'archive.list',
+      { sagaId: args.saga, since: args.since },
+      { command: 'archive' },
+    );
+  },
+});
+
+/** cleo archive restore <id> — routes to `tasks.archive.restore`. */
+const restoreCommand = defineCommand({
+  meta: {
+    name: 'restore',
+    description: 'Return an archived task to the status it held when archived',
+  },
+  args: {
+    taskId: { type: 'positional', description: 'Archived task ID', required: true },
+    'to-saga': {
+      type: 'string',
+      description: 'Restore under this saga (required if the original parent is gone)',
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'archive.restore',
+      { taskId: args.taskId, toSaga: args['to-saga'] },
+      { command: 'archive' },
+    );
+  },
+});
 
 /**
  * Native citty command for `cleo archive`.
@@ -24,7 +71,7 @@ import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
  * Dispatches to `tasks.archive` via dispatchFromCli.
  */
 export const archiveCommand = defineCommand({
-  meta: { name: 'archive', description: 'Archive completed tasks' },
+  meta: { name: 'archive', description: 'Archive completed tasks (list / restore archived ones)' },
   args: {
     before: {
       type: 'string',
@@ -45,7 +92,12 @@ export const archiveCommand = defineCommand({
       default: false,
     },
   },
-  async run({ args }) {
+  subCommands: {
+    list: listCommand,
+    restore: restoreCommand,
+  },
+  async run({ args, cmd, rawArgs }) {
+    if (isSubCommandDispatch(rawArgs, cmd.subCommands)) return;
     const params: Record<string, unknown> = {};
 
     if (args.before !== undefined) params['before'] = args.before;
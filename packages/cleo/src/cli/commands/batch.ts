@@ -0,0 +1,92 @@
+/**
+ * CLI batch command — thin adapter for the CORE `tasks.batch` op.
+ *
+ * Reads `{ "operations": [...] }` (or a bare array of steps) from `--params`,
+ * `--file`, or stdin via the shared {@link collectMutateInput} adapter, then
+ * makes ONE `tasks.batch` dispatch. CORE applies every step in a single
+ * transaction: if any step fails nothing is written and the error names the
+ * failing step index.
+ *
+ * @example
+ * ```sh
+ * cleo batch --params '{"operations":[
+ *   {"op":"create","title":"Spike","description":"Investigate","acceptance":["done"]},
+ *   {"op":"update","taskId":"T101","addDepends":["T100"]},
+ *   {"op":"complete","taskId":"T099"}
+ * ]}'
+ * ```
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { collectMutateInput } from '../lib/collect-input.js';
+import { defineCommand } from '../lib/define-cli-command.js';
+import { cliError } from '../renderers/index.js';
+
+/**
+ * Native citty command — `cleo batch`. All step validation and atomicity
+ * live in CORE (`tasks.batch`).
+ */
+export const batchCommand = defineCommand({
+  meta: {
+    name: 'batch',
+    description: 'Apply several create/update/complete steps atomically (all or nothing) from JSON',
+  },
+  args: {
+    params: {
+      type: 'string',
+      description: 'Inline JSON: { "operations": [{ "op": "create"|"update"|"complete", ... }] }',
+    },
+    file: {
+      type: 'string',
+      description: 'Path to a JSON file (operations array or full payload). Use - for stdin.',
+    },
+    'dry-run': {
+      type: 'boolean',
+      description: 'Apply every step, report the outcome, then roll everything back',
+    },
+  },
+  async run({ args }) {
+    const fileArg = args.file as string | undefined;
+    let raw: unknown;
+    try {
+      raw = await collectMutateInput(
+        {
+          params: args.params as string | undefined,
+          file: fileArg !== undefined && fileArg !== '-' ? fileArg : undefined,
+        },
+        process.stdin as NodeJS.ReadableStream & { isTTY?: boolean },
+      );
+    } catch (err) {
+      cliError((err as Error).message, ExitCode.VALIDATION_ERROR, {
+        name: 'E_VALIDATION_FAILED',
+        fix: 'Verify the JSON syntax of your --params / --file / stdin input',
+      });
+      process.exitCode = ExitCode.VALIDATION_ERROR;
+      return;
+    }
+
+    if (raw === undefined) {
+      cliError(
+        'No input provided. Pass --params <json>, --file <path>, or pipe JSON to stdin.',
+        ExitCode.VALIDATION_ERROR,
+        { name: 'E_VALIDATION_FAILED', fix: 'cleo batch --file steps.json' },
+      );
+      process.exitCode = ExitCode.VALIDATION_ERROR;
+      return;
+    }
+
+    // Accept a bare array of steps as shorthand for { operations: [...] }.
+    const payload: Record<string, unknown> = Array.isArray(raw)
+      ? { operations: raw }
+      : { ...(raw as Record<string, unknown>) };
+    if (args['dry-run'] === true && payload['dryRun'] === undefined) {
+      payload['dryRun'] = true;
+    }
+
+    await dispatchFromCli('mutate', 'tasks', 'batch', payload, {
+      command: 'batch',
+      operation: 'tasks.batch',
+    });
+  },
+});
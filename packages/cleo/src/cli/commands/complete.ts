@@ -15,7 +15,9 @@
  *
  * As of T1632, `cleo complete <epicId>` is REJECTED with E_EPIC_HAS_PENDING_CHILDREN
  * when the epic has pending or active children. Pass `--override-reason "<reason>"`
- * to bypass (audited to `.cleo/audit/premature-close.jsonl`).
+ * to bypass (audited to `.cleo/audit/premature-close.jsonl`). The same guard
+ * refuses any other parent with open children (E_HAS_CHILDREN); the error
+ * details carry `{ error: 'incomplete_children', children: [...] }`.
  *
  * @task T4461
  * @task T832
@@ -63,7 +65,7 @@ export const completeCommand = defineCommand({
     'override-reason': {
       type: 'string',
       description:
-        'Reason for bypassing E_EPIC_HAS_PENDING_CHILDREN guard (audited to .cleo/audit/premature-close.jsonl)',
+        'Reason for completing a parent whose children are still open (audited to .cleo/audit/premature-close.jsonl)',
     },
     // T10509 — AC-coverage gate (load-bearing IVTR closure)
     'waive-ac': {
@@ -1,7 +1,8 @@
 /**
- * CLI delete command — soft-delete a task to archive.
+ * CLI delete command — soft-delete a task to the trash.
  *
- * Dispatches to the `tasks.delete` registry operation.
+ * Dispatches to the `tasks.delete` registry operation. Trashed tasks are
+ * managed with `cleo trash`; `--purge` removes the task for good.
  *
  * @task T4461
  * @epic T4454
@@ -12,14 +13,14 @@ import { dispatchRaw, handleRawError } from '../../dispatch/adapters/cli.js';
 import { cliOutput } from '../renderers/index.js';
 
 /**
- * Delete command — soft-deletes the given task (archives it).
+ * Delete command — moves the given task to the trash (or purges it).
  *
  * Root alias `rm` is wired in index.ts.
  */
 export const deleteCommand = defineCommand({
   meta: {
     name: 'delete',
-    description: 'Delete a task (soft delete to archive)',
+    description: 'Delete a task (moves it to the trash; --purge removes it for good)',
   },
   args: {
     taskId: {
@@ -35,12 +36,17 @@ export const deleteCommand = defineCommand({
       type: 'boolean',
       description: 'Delete children recursively',
     },
+    purge: {
+      type: 'boolean',
+      description: 'Remove permanently instead of moving to the trash',
+    },
   },
   async run({ args }) {
     const response = await dispatchRaw('mutate', 'tasks', 'delete', {
       taskId: args.taskId,
       force: args.force as boolean | undefined,
       cascade: args.cascade as boolean | undefined,
+      purge: args.purge as boolean | undefined,
     });
 
     if (!response.success) {
@@ -49,6 +55,7 @@ export const deleteCommand = defineCommand({
 
     const data = response.data as Record<string, unknown> | undefined;
     const output: Record<string, unknown> = { deletedTask: data?.deletedTask };
+    if (data?.purged) output['purged'] = true;
     const cascadeDeleted = data?.cascadeDeleted;
     if (Array.isArray(cascadeDeleted) && cascadeDeleted.length > 0) {
       output['cascadeDeleted'] = cascadeDeleted;
@@ -5,6 +5,7 @@
  * --hooks flag shows the cross-provider hook support matrix via CAAMP.
  * --scan-rogue-cleo-dirs scans for rogue .cleo/ dirs inside sub-packages.
  * --quarantine-rogue-cleo-dirs moves rogue .cleo/ dirs to quarantine.
+ * --integrity reports orphaned task references; with --fix it repairs them.
  * @task T4454
  * @task T4795
  * @task T4903
@@ -258,12 +259,24 @@ export const doctorCommand = defineCommand({
     },
     fix: {
       type: 'boolean',
-      description: 'Auto-fix failed checks',
+      description:
+        'Auto-fix failed checks (with --integrity: remove dangling dependencies and ' +
+        'reparent orphans under the __unassigned saga)',
     },
     coherence: {
       type: 'boolean',
       description: 'Run coherence check across task data',
     },
+    integrity: {
+      type: 'boolean',
+      description:
+        'Report dangling dependencies, missing parents, duplicate IDs, labels only trashed ' +
+        'tasks carry, and done tasks with open children',
+    },
+    file: {
+      type: 'string',
+      description: 'With --integrity: scan a snapshot file (cleo snapshot export) instead',
+    },
     hooks: {
       type: 'boolean',
       description: 'Show cross-provider hook support matrix (CAAMP canonical taxonomy)',
@@ -405,8 +418,8 @@ export const doctorCommand = defineCommand({
     'dry-run': {
       type: 'boolean',
       description:
-        'With --quarantine-rogue-cleo-dirs, --scan-stray-nexus-dbs, or --check-worktree-config: ' +
-        'print what would be done without acting',
+        'With --quarantine-rogue-cleo-dirs, --scan-stray-nexus-dbs, --check-worktree-config, ' +
+        'or --integrity --fix: print what would be done without acting',
     },
     /**
      * Show brain.db health dashboard (T1908 / BBTT-W2-4).
@@ -622,6 +635,41 @@ export const doctorCommand = defineCommand({
           { command: 'doctor', operation: 'check.coherence' },
         );
         progress.complete('Coherence check complete');
+      } else if (args.integrity) {
+        const isDryRun = args['dry-run'] === true;
+        progress.step(0, `${isDryRun ? '[DRY RUN] ' : ''}Checking task references`);
+        const { auditTaskIntegrity } = await import('@cleocode/core/doctor/task-integrity.js');
+        const result = await auditTaskIntegrity(getProjectRoot(), {
+          fix: args.fix === true,
+          dryRun: isDryRun,
+          file: args.file as string | undefined,
+        });
+        progress.complete(
+          `Integrity check complete — ${result.count} issue(s) in ${result.taskCount} task(s)`,
+        );
+
+        if (isHuman && args.json !== true) {
+          for (const issue of result.issues) humanLine(`  [${issue.kind}] ${issue.message}`);
+          const verb = result.dryRun ? 'Would remove' : 'Removed';
+          for (const edge of result.removedEdges) {
+            humanLine(`  ${verb} ${edge.taskId} -> ${edge.dependsOn}`);
+          }
+          for (const move of result.reparented) {
+            const where = move.to ? `under ${move.to}` : 'to the top level';
+            humanLine(`  ${result.dryRun ? 'Would move' : 'Moved'} ${move.taskId} ${where}`);
+          }
+          if (!result.fix && result.issues.some((i) => i.fixable)) {
+            humanLine('\nRun cleo doctor --integrity --fix to repair the fixable issues.');
+          }
+        }
+
+        cliOutput(result, { command: 'doctor', operation: 'doctor.integrity' });
+        // After a real --fix only the report-only kinds are still outstanding.
+        const repaired = result.fix && !result.dryRun;
+        const unrepaired = repaired ? result.issues.filter((i) => !i.fixable) : result.issues;
+        if (unrepaired.length > 0 && (process.exitCode === undefined || process.exitCode === 0)) {
+          process.exitCode = 2;
+        }
       } else if (args.fix) {
         progress.step(4, 'Applying fixes');
         await dispatchFromCli(
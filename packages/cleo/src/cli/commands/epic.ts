@@ -0,0 +1,55 @@
+/**
+ * CLI epic command group — Epic views.
+ *
+ * Commands:
+ *   cleo epic show <epicId>
+ *
+ * Epics are created and edited through `cleo add --type epic` and
+ * `cleo update`; this group only adds the Epic-level rollups.
+ */
+
+import { dispatchRaw, handleRawError } from '../../dispatch/adapters/cli.js';
+import { defineCommand, showUsage } from '../lib/define-cli-command.js';
+import { cliOutput } from '../renderers/index.js';
+
+/**
+ * cleo epic show <epicId> — the Epic plus its effort-estimate rollup
+ * (estimate total/done/remaining and the unestimated member count).
+ */
+const showCommand = defineCommand({
+  meta: {
+    name: 'show',
+    description: 'Show an Epic with estimate_total/done/remaining summed over its member tasks',
+  },
+  args: {
+    epicId: {
+      type: 'positional',
+      description: 'Epic task ID',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    const response = await dispatchRaw('query', 'tasks', 'estimate.rollup', {
+      taskId: args.epicId,
+      type: 'epic',
+    });
+    handleRawError(response, { command: 'epic', operation: 'tasks.estimate.rollup' });
+    cliOutput(response.data ?? {}, { command: 'epic', operation: 'tasks.estimate.rollup' });
+  },
+});
+
+/** Root epic command group. */
+export const epicCommand = defineCommand({
+  meta: {
+    name: 'epic',
+    description: 'Epic views — estimate rollup across member tasks',
+  },
+  subCommands: {
+    show: showCommand,
+  },
+  async run({ cmd, rawArgs }) {
+    const firstArg = rawArgs?.find((a) => !a.startsWith('-'));
+    if (firstArg && cmd.subCommands && firstArg in cmd.subCommands) return;
+    await showUsage(cmd);
+  },
+});
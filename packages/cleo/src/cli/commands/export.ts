@@ -2,13 +2,19 @@
  * CLI export command — export tasks to various formats.
  *
  * Thin dispatch wrapper routing to admin.export.  When no --output file is
- * given the raw content is written directly to stdout for piping.
+ * given the raw content is written to stdout for piping; an explicit --json
+ * wraps it in the usual envelope instead.
+ *
+ * Usage:
+ *   cleo export csv [--saga <id>] [--fields id,title,status,priority,assignee,due,estimate]
+ *   cleo export --export-format markdown --output tasks.md
  *
  * @task T4454, T5323, T5328
  */
 
-import { defineCommand } from 'citty';
-import { dispatchFromCli, dispatchRaw, handleRawError } from '../../dispatch/adapters/cli.js';
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { getFormatContext, setFormatContext } from '../format-context.js';
+import { defineCommand } from '../lib/define-cli-command.js';
 
 /**
  * `cleo export` — export tasks to CSV, TSV, JSON, or markdown format.
@@ -16,6 +22,11 @@ import { dispatchFromCli, dispatchRaw, handleRawError } from '../../dispatch/ada
 export const exportCommand = defineCommand({
   meta: { name: 'export', description: 'Export tasks to CSV, TSV, JSON, or markdown format' },
   args: {
+    format: {
+      type: 'positional',
+      description: 'Export format: json, csv, tsv, markdown (overrides --export-format)',
+      required: false,
+    },
     'export-format': {
       type: 'string',
       description: 'Export format: json, csv, tsv, markdown',
@@ -37,9 +48,19 @@ export const exportCommand = defineCommand({
       type: 'string',
       description: 'Filter by phase',
     },
+    saga: {
+      type: 'string',
+      description: 'Only export tasks within this saga',
+    },
+    fields: {
+      type: 'string',
+      description:
+        'CSV/TSV columns, comma-separated (default: id,title,status,priority,type,parentId,phase,depends,createdAt,labels,assignee,due,estimate)',
+    },
   },
   async run({ args }) {
     const hasOutput = !!args.output;
+    const format = (args.format as string | undefined) ?? args['export-format'];
 
     if (hasOutput) {
       await dispatchFromCli(
@@ -47,28 +68,34 @@ export const exportCommand = defineCommand({
         'admin',
         'export',
         {
-          format: args['export-format'],
+          format,
           output: args.output,
           status: args.status as string | undefined,
           parent: args.parent as string | undefined,
           phase: args.phase as string | undefined,
+          saga: args.saga as string | undefined,
+          fields: args.fields as string | undefined,
         },
         { command: 'export' },
       );
     } else {
-      // No output file — write content directly to stdout for piping.
-      const response = await dispatchRaw('query', 'admin', 'export', {
-        format: args['export-format'],
-        status: args.status as string | undefined,
-        parent: args.parent as string | undefined,
-        phase: args.phase as string | undefined,
-      });
-      handleRawError(response, { command: 'export', operation: 'admin.export' });
-      const data = response.data as { content?: string } | undefined;
-      if (data?.content) {
-        process.stdout.write(data.content);
-        if (!data.content.endsWith('\n')) process.stdout.write('\n');
-      }
+      // No output file — the content is the output, unless --json asks for the envelope.
+      const ctx = getFormatContext();
+      if (ctx.source !== 'flag') setFormatContext({ ...ctx, format: 'human' });
+      await dispatchFromCli(
+        'query',
+        'admin',
+        'export',
+        {
+          format,
+          status: args.status as string | undefined,
+          parent: args.parent as string | undefined,
+          phase: args.phase as string | undefined,
+          saga: args.saga as string | undefined,
+          fields: args.fields as string | undefined,
+        },
+        { command: 'export-content', operation: 'admin.export' },
+      );
     }
   },
 });
@@ -0,0 +1,78 @@
+/**
+ * CLI fields command group — declare project-level custom task fields.
+ *
+ * Commands:
+ *   cleo fields add --name <n> --type string|number|enum [--values a,b] — declare a field
+ *   cleo fields list                                                     — show declarations
+ *
+ * Values are set with `cleo update <id> --set name=value` and filtered with
+ * `cleo find --where name=value`; both reject undeclared fields.
+ */
+
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { defineCommand, showUsage } from '../lib/define-cli-command.js';
+
+/** cleo fields add — routes to `tasks.fields.add`. */
+const addCommand = defineCommand({
+  meta: { name: 'add', description: 'Declare a custom field tasks can carry' },
+  args: {
+    name: {
+      type: 'string',
+      description: 'Field name (lowercase letters, digits, _ or -)',
+      required: true,
+    },
+    type: {
+      type: 'string',
+      description: 'Value type: string, number, or enum',
+      required: true,
+    },
+    values: {
+      type: 'string',
+      description: 'Comma-separated allowed values (enum only), e.g. low,med,high',
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'fields.add',
+      {
+        name: args.name,
+        type: args.type,
+        values: args.values?.split(',').map((s) => s.trim()),
+      },
+      { command: 'fields', operation: 'tasks.fields.add' },
+    );
+  },
+});
+
+/** cleo fields list — routes to `tasks.fields.list`. */
+const listCommand = defineCommand({
+  meta: { name: 'list', description: 'List declared custom fields' },
+  async run() {
+    await dispatchFromCli(
+      'query',
+      'tasks',
+      'fields.list',
+      {},
+      { command: 'fields', operation: 'tasks.fields.list' },
+    );
+  },
+});
+
+/** Root fields command group. */
+export const fieldsCommand = defineCommand({
+  meta: {
+    name: 'fields',
+    description: 'Project custom fields: declare them, then use update --set and find --where',
+  },
+  subCommands: {
+    add: addCommand,
+    list: listCommand,
+  },
+  async run({ cmd, rawArgs }) {
+    const firstArg = rawArgs?.find((a) => !a.startsWith('-'));
+    if (firstArg && cmd.subCommands && firstArg in cmd.subCommands) return;
+    await showUsage(cmd);
+  },
+});
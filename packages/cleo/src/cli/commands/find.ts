@@ -9,9 +9,10 @@ import { createPage } from '@cleocode/core';
 import { defineCommand } from 'citty';
 import { dispatchRaw, handleRawError, maybeEmitDescribe } from '../../dispatch/adapters/cli.js';
 import { cliOutput } from '../renderers/index.js';
+import { streamNdjson } from '../renderers/ndjson.js';
 /** Native citty command for `cleo find [query]`. */
 export const findCommand = defineCommand({
-  meta: { name: 'find', description: 'Fuzzy search tasks by title/description' },
+  meta: { name: 'find', description: 'Fuzzy or regex search tasks by title/description/notes' },
   args: {
     query: {
       type: 'positional',
@@ -24,8 +25,22 @@ export const findCommand = defineCommand({
       type: 'string',
       description: 'Filter by status (pending|active|blocked|done|cancelled)',
     },
-    in: { type: 'string', description: 'Field to search in (title|description|notes|id)' },
-    'include-archive': { type: 'boolean', description: 'Include archived tasks' },
+    field: {
+      type: 'string',
+      description:
+        'Field to match (title|description|notes|id|all). Default: title + description fuzzy, title for --regex',
+      alias: 'in',
+    },
+    regex: {
+      type: 'boolean',
+      description: 'Treat the query as a case-insensitive regular expression',
+    },
+    type: { type: 'string', description: 'Filter by type (saga|epic|task|subtask)' },
+    'include-archive': {
+      type: 'boolean',
+      description: 'Include archived tasks (read-only; restore with cleo archive restore)',
+      alias: 'include-archived',
+    },
     limit: { type: 'string', description: 'Max results (default: 20)' },
     offset: { type: 'string', description: 'Skip first N results' },
     fields: { type: 'string', description: 'Comma-separated additional fields to include' },
@@ -91,6 +106,20 @@ export const findCommand = defineCommand({
       description:
         'Filter by parent task ID — Saga-aware via task_relations groups (ADR-073 §1) (T10108)',
     },
+    sort: {
+      type: 'string',
+      description: "Result ordering: 'priority' (critical → low, ties by task ID)",
+    },
+    where: {
+      type: 'string',
+      description:
+        "Filter expression: comparisons joined by and/or/not with parentheses, e.g. 'priority >= high and (label:saga or label:wave-1)'. Custom fields (see cleo fields) work too",
+    },
+    ndjson: {
+      type: 'boolean',
+      description:
+        'Stream one match JSON object per line, then a {"_summary":true,...} line. Streams every match unless --limit is given.',
+    },
   },
   async run({ args }) {
     // T11692 (DHQ-057) — `cleo find --describe` prints the op's I/O schema.
@@ -104,7 +133,9 @@ export const findCommand = defineCommand({
     if (args.id !== undefined) params['id'] = args.id;
     if (args.exact !== undefined) params['exact'] = args.exact;
     if (args.status !== undefined) params['status'] = args.status;
-    if (args.in !== undefined) params['field'] = args.in;
+    if (args.field !== undefined) params['field'] = args.field;
+    if (args.regex !== undefined) params['regex'] = args.regex;
+    if (args.type !== undefined) params['type'] = args.type;
     if (args['include-archive'] !== undefined) params['includeArchive'] = args['include-archive'];
     if (limit !== undefined) params['limit'] = limit;
     if (offset !== undefined) params['offset'] = offset;
@@ -118,6 +149,23 @@ export const findCommand = defineCommand({
     if (args.label !== undefined) params['label'] = args.label;
     // T10108: parent filter — forward when set
     if (args.parent !== undefined) params['parent'] = args.parent;
+    if (args.sort !== undefined) params['sort'] = args.sort;
+    if (args.where !== undefined) params['where'] = [args.where];
+    if (args.ndjson) {
+      // One query for the whole stream; `limit: 0` returns every match.
+      const streamed = await dispatchRaw('query', 'tasks', 'find', {
+        ...params,
+        limit: limit ?? 0,
+      });
+      if (!streamed.success) handleRawError(streamed, { command: 'find', operation: 'tasks.find' });
+      const pageData = (streamed.data ?? {}) as Record<string, unknown>;
+      const count = await streamNdjson({
+        items: Array.isArray(pageData.results) ? pageData.results : [],
+        summary: { total: pageData.total },
+      });
+      if (count === 0) process.exit(ExitCode.NO_DATA);
+      return;
+    }
     const response = await dispatchRaw('query', 'tasks', 'find', params);
     if (!response.success) {
       handleRawError(response, { command: 'find', operation: 'tasks.find' });
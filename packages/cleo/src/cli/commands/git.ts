@@ -0,0 +1,51 @@
+/**
+ * CLI git command group — connect tasks to the commits that implemented them.
+ *
+ * Commands:
+ *   cleo git scan [--since <ref>] — link commits whose messages mention a task ID
+ *
+ * Single links are made with `cleo tasks link-commit <id> --sha <sha>`; a
+ * task's links are listed by `cleo tasks commits <id>`.
+ */
+
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { defineCommand, showUsage } from '../lib/define-cli-command.js';
+
+/** cleo git scan — routes to `tasks.commits.scan`. */
+const scanCommand = defineCommand({
+  meta: {
+    name: 'scan',
+    description: 'Scan git log for task IDs (e.g. T1234) and link the matching commits',
+  },
+  args: {
+    since: {
+      type: 'string',
+      description: 'Only scan commits after this ref (<since>..HEAD); whole history by default',
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'commits.scan',
+      { since: args.since },
+      { command: 'git', operation: 'tasks.commits.scan' },
+    );
+  },
+});
+
+/** Root git command group. */
+export const gitCommand = defineCommand({
+  meta: {
+    name: 'git',
+    description: 'Git integration: scan commit messages for task IDs and link the commits',
+  },
+  subCommands: {
+    scan: scanCommand,
+  },
+  async run({ cmd, rawArgs }) {
+    const firstArg = rawArgs?.find((a) => !a.startsWith('-'));
+    if (firstArg && cmd.subCommands && firstArg in cmd.subCommands) return;
+    await showUsage(cmd);
+  },
+});
@@ -8,6 +8,7 @@
  * Sub-namespaces:
  *   `cleo graph <op>`         — project-scoped code graph queries and mutations
  *   `cleo graph living <op>`  — ops that bridge the code graph + BRAIN (living-brain)
+ *   `cleo graph export`       — task dependency graph as DOT / Mermaid (task store, not code graph)
  *
  * The dispatch layer is unchanged — all ops still route through the `nexus`
  * dispatch domain. The split is purely at the CLI level.
@@ -20,6 +21,9 @@
  */
 
 import path from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '@cleocode/core';
+import { exportDependencyGraph, resolveProjectRoot } from '@cleocode/core/internal';
 import { defineCommand, showUsage } from 'citty';
 import { dispatchFromCli, dispatchRaw } from '../../dispatch/adapters/cli.js';
 import { getFormatContext, setFormatContext } from '../format-context.js';
@@ -693,6 +697,55 @@ const reconcileCommand = defineCommand({
   },
 });
 
+/**
+ * cleo graph export — render the task dependency graph as DOT or Mermaid.
+ *
+ * Unlike the other `cleo graph` verbs this reads the task store, not the code
+ * graph. The rendered source goes to stdout so it can be piped straight into
+ * `dot -Tsvg` or pasted into Markdown; `--json` wraps nodes, edges, and the
+ * rendered text in the usual LAFS envelope.
+ */
+const exportCommand = defineCommand({
+  meta: {
+    name: 'export',
+    description: 'Export the task dependency graph as Graphviz DOT or Mermaid',
+  },
+  args: {
+    format: { type: 'string', description: 'Output format: dot|mermaid (default: dot)' },
+    saga: { type: 'string', description: 'Restrict to a saga and everything beneath it' },
+    epic: { type: 'string', description: 'Restrict to an epic and everything beneath it' },
+    json: { type: 'boolean', description: 'Output as JSON (LAFS envelope format)' },
+  },
+  async run({ args }) {
+    applyJsonFlag(args.json as boolean | undefined);
+    // The rendered source is the output, unless --json asks for the envelope.
+    const ctx = getFormatContext();
+    if (ctx.source !== 'flag') setFormatContext({ ...ctx, format: 'human' });
+    const format = (args.format as string | undefined) ?? 'dot';
+    if (format !== 'dot' && format !== 'mermaid') {
+      cliError(`Unknown graph export format: ${format}`, ExitCode.VALIDATION_ERROR, {
+        name: 'E_VALIDATION',
+        fix: 'Use --format dot or --format mermaid',
+      });
+      process.exit(ExitCode.VALIDATION_ERROR);
+    }
+    try {
+      const result = await exportDependencyGraph(resolveProjectRoot(), {
+        format,
+        sagaId: args.saga as string | undefined,
+        epicId: args.epic as string | undefined,
+      });
+      cliOutput(result, { command: 'graph-export', operation: 'graph.export' });
+    } catch (err) {
+      if (err instanceof CleoError) {
+        cliError(err.message, err.code, { name: 'CleoError', fix: err.fix });
+        process.exit(err.code);
+      }
+      throw err;
+    }
+  },
+});
+
 // ── Living Brain subcommands ───────────────────────────────────────────────────
 
 /** cleo graph living full-context — show 5-substrate context for a symbol */
@@ -948,6 +1001,7 @@ export const graphCommand = defineCommand({
     init: initCommand,
     sync: syncCommand,
     reconcile: reconcileCommand,
+    export: exportCommand,
     living: livingCommand,
   },
   async run({ cmd, rawArgs }) {
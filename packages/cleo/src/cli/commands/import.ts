@@ -1,14 +1,47 @@
 /**
  * CLI command for importing tasks from an export package.
  *
- * Dispatches to `admin.import` via dispatchFromCli.
+ * Dispatches to `admin.import` via dispatchFromCli. `cleo import markdown
+ * <file>` turns a Markdown checklist into tasks via `tasks.import.markdown`.
  *
  * @task T4454, T5323, T5328
  */
 
-import { defineCommand, showUsage } from 'citty';
+import { defineCommand, runCommand, showUsage } from 'citty';
 import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
 
+/** cleo import markdown <file> — routes to `tasks.import.markdown`. */
+const markdownCommand = defineCommand({
+  meta: {
+    name: 'markdown',
+    description: 'Create tasks from a Markdown checklist (- [ ] pending, - [x] done)',
+  },
+  args: {
+    file: {
+      type: 'positional',
+      description: 'Markdown file to import',
+      required: true,
+    },
+    saga: {
+      type: 'string',
+      description: 'Saga to file the top-level items under (they become epics)',
+    },
+    'dry-run': {
+      type: 'boolean',
+      description: 'Preview the line → task mapping without writing',
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'import.markdown',
+      { file: args.file, saga: args.saga, dryRun: args['dry-run'] },
+      { command: 'import', operation: 'tasks.import.markdown' },
+    );
+  },
+});
+
 /**
  * cleo import <file> — import tasks from an export package.
  *
@@ -18,7 +51,7 @@ import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
 export const importCommand = defineCommand({
   meta: {
     name: 'import',
-    description: 'Import tasks from export package',
+    description: 'Import tasks from export package, or a Markdown checklist (import markdown)',
   },
   args: {
     file: {
@@ -48,11 +81,19 @@ export const importCommand = defineCommand({
       description: 'Preview import without changes',
     },
   },
-  async run({ args, cmd }) {
+  async run({ args, cmd, rawArgs }) {
     if (!args.file) {
       await showUsage(cmd);
       return;
     }
+    // A sub-verb rather than citty subCommands, so `cleo import <package>`
+    // keeps taking the package path as its first positional.
+    if (args.file === 'markdown') {
+      await runCommand(markdownCommand, {
+        rawArgs: rawArgs.slice(rawArgs.indexOf('markdown') + 1),
+      });
+      return;
+    }
     await dispatchFromCli(
       'mutate',
       'admin',
@@ -1,7 +1,8 @@
 /**
  * CLI command group for label management.
  *
- * Routes through dispatch layer to tasks.label.list.
+ * Routes through dispatch layer to tasks.label.list, tasks.label.rename and
+ * tasks.label.merge.
  *
  * Note: `tasks.label.show` was removed in T5615 rationalization.
  * Use `tasks.label.list` with a `{label}` filter param instead.
@@ -12,8 +13,10 @@
  * @epic T4454
  */
 
+import { ExitCode } from '@cleocode/contracts';
 import { defineCommand } from 'citty';
 import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { cliError } from '../renderers/index.js';
 
 /** cleo labels list — list all labels with task counts (default) */
 const listCommand = defineCommand({
@@ -44,6 +47,60 @@ const statsCommand = defineCommand({
   },
 });
 
+/** cleo labels rename <old> <new> — rewrite a label on every task bearing it */
+const renameCommand = defineCommand({
+  meta: {
+    name: 'rename',
+    description: 'Rename a label on every task (merges if <new> already exists)',
+  },
+  args: {
+    old: { type: 'positional', description: 'Existing label', required: true },
+    new: { type: 'positional', description: 'New label', required: true },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'label.rename',
+      { from: args.old, to: args.new },
+      { command: 'labels' },
+    );
+  },
+});
+
+/** cleo labels merge <src...> <dest> — fold several labels into one */
+const mergeCommand = defineCommand({
+  meta: { name: 'merge', description: 'Merge one or more labels into <dest> (last argument)' },
+  args: {
+    labels: {
+      type: 'positional',
+      description: 'Source labels followed by the destination label',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    // citty has no variadic positionals — read them all from `_`.
+    const labels = (args._ ?? []).map(String);
+    if (labels.length < 2) {
+      cliError(
+        'merge needs at least one source label and a destination',
+        ExitCode.INVALID_INPUT,
+        { name: 'E_INVALID_INPUT', fix: 'cleo labels merge <src...> <dest>' },
+        { operation: 'tasks.label.merge' },
+      );
+      process.exitCode = ExitCode.INVALID_INPUT;
+      return;
+    }
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'label.merge',
+      { sources: labels.slice(0, -1), dest: labels[labels.length - 1] },
+      { command: 'labels' },
+    );
+  },
+});
+
 /**
  * Root labels command group — list and filter tasks by label.
  *
@@ -53,6 +110,8 @@ const statsCommand = defineCommand({
  *   - `cleo labels list`        → explicit alias for label.list
  *   - `cleo labels show <name>` → explicit alias for the positional form
  *   - `cleo labels stats`       → label statistics
+ *   - `cleo labels rename <old> <new>` → rewrite a label (label.rename)
+ *   - `cleo labels merge <src...> <dest>` → fold labels together (label.merge)
  *
  * The positional `name` arg closes GH#393 (T9904) — `cleo labels <name>`
  * used to be rejected because the root command had no positional arg.
@@ -82,11 +141,13 @@ export const labelsCommand = defineCommand({
     list: listCommand,
     show: showCommand,
     stats: statsCommand,
+    rename: renameCommand,
+    merge: mergeCommand,
   },
   async run(ctx) {
     const rawArgs = ctx.rawArgs ?? [];
     // If a subcommand was invoked, citty will dispatch to it — bail out.
-    if (rawArgs.some((a) => ['list', 'show', 'stats'].includes(a))) {
+    if (rawArgs.some((a) => ['list', 'show', 'stats', 'rename', 'merge'].includes(a))) {
       return;
     }
     // T9904 — positional `name`: route to tasks.list with label filter.
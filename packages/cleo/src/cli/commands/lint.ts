@@ -0,0 +1,59 @@
+/**
+ * CLI command: cleo lint — structural invariant check for task data.
+ *
+ * Reports every violation found, one per line:
+ *
+ *   T42 valid-parent: Parent task 'T99' does not exist
+ *   -   schema-version: Schema version 1.9.0 does not match this binary
+ *
+ * Exits with VALIDATION_ERROR when any violation is found, so it can gate CI.
+ * `--file` lints a committed snapshot JSON instead of the task store.
+ *
+ * Usage:
+ *   cleo lint [--file <snapshot.json>] [--json]
+ *
+ * Core logic lives in packages/core/src/tasks/lint.ts.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '@cleocode/core';
+import { lintTaskData, resolveProjectRoot, type TaskLintResult } from '@cleocode/core/internal';
+import { setFormatContext } from '../format-context.js';
+import { defineCommand } from '../lib/define-cli-command.js';
+import { cliError, cliOutput } from '../renderers/index.js';
+
+/**
+ * Native citty command for `cleo lint`.
+ */
+export const lintCommand = defineCommand({
+  meta: {
+    name: 'lint',
+    description: 'Check task data for structural invariant violations (exits non-zero on any)',
+  },
+  args: {
+    file: {
+      type: 'string',
+      description: 'Lint this snapshot JSON file instead of the task store',
+    },
+    json: { type: 'boolean', description: 'Emit findings as JSON' },
+  },
+  async run({ args }) {
+    if (args.json) setFormatContext({ format: 'json', source: 'flag', quiet: false });
+    let result: TaskLintResult;
+    try {
+      result = await lintTaskData({ file: args.file as string | undefined }, resolveProjectRoot());
+    } catch (err) {
+      if (err instanceof CleoError) {
+        cliError(err.message, err.code, { name: 'CleoError', fix: err.fix });
+        process.exit(err.code);
+      }
+      throw err;
+    }
+
+    cliOutput(
+      { ...result, valid: result.findings.length === 0 },
+      { command: 'lint', operation: 'tasks.lint' },
+    );
+    if (result.findings.length > 0) process.exitCode = ExitCode.VALIDATION_ERROR;
+  },
+});
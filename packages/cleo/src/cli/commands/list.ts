@@ -9,6 +9,9 @@
  * CLI-only compatibility aliases are layered locally after registry arg
  * derivation so dispatch still receives canonical task params.
  *
+ * `--ndjson` streams one task per line (plus a `_summary` line) instead of
+ * a single envelope — see {@link streamNdjson}.
+ *
  * @task T4460
  * @task T4668
  * @task T864
@@ -21,6 +24,7 @@ import { defineCommand } from 'citty';
 import { dispatchRaw, handleRawError, maybeEmitDescribe } from '../../dispatch/adapters/cli.js';
 import { getOperationParams, paramsToCittyArgs } from '../lib/registry-args.js';
 import { cliOutput } from '../renderers/index.js';
+import { streamNdjson } from '../renderers/ndjson.js';
 
 const listArgs = {
   ...paramsToCittyArgs(getOperationParams('query', 'tasks', 'list')),
@@ -44,6 +48,11 @@ const listArgs = {
     description:
       'Render each task as a single line "<id> [<status>] <title-truncated-60>". Composes with --output: --output {id|table|count|silent} wins. T9932.',
   },
+  ndjson: {
+    type: 'boolean',
+    description:
+      'Stream one task JSON object per line, then a {"_summary":true,...} line. Streams every match unless --limit is given.',
+  },
 } as const;
 
 /**
@@ -74,6 +83,23 @@ export const listCommand = defineCommand({
     if (args['children'] !== undefined) params['children'] = args['children'];
     if (limit !== undefined) params['limit'] = limit;
     if (offset !== undefined) params['offset'] = offset;
+    if (args['sort'] !== undefined) params['sort'] = args['sort'];
+
+    if (args.ndjson) {
+      // One query for the whole stream; `limit: 0` lifts the default page cap.
+      const streamed = await dispatchRaw('query', 'tasks', 'list', {
+        ...params,
+        limit: limit ?? 0,
+      });
+      if (!streamed.success) handleRawError(streamed, { command: 'list', operation: 'tasks.list' });
+      const pageData = (streamed.data ?? {}) as Record<string, unknown>;
+      const count = await streamNdjson({
+        items: Array.isArray(pageData.tasks) ? pageData.tasks : [],
+        summary: { total: pageData.total, filtered: pageData.filtered },
+      });
+      if (count === 0) process.exit(ExitCode.NO_DATA);
+      return;
+    }
 
     const response = await dispatchRaw('query', 'tasks', 'list', params);
 
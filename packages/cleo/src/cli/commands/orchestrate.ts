@@ -7,9 +7,11 @@
  *   cleo orchestrate status               — epic/project status
  *   cleo orchestrate analyze <epicId>     — dependency structure analysis
  *   cleo orchestrate ready <epicId>       — parallel-safe ready tasks
+ *   cleo orchestrate tracks <epicId>      — ready-task count per track
  *   cleo orchestrate next <epicId>        — next task to spawn
  *   cleo orchestrate waves <epicId>       — dependency wave computation
  *   cleo orchestrate spawn <taskId>       — prepare subagent spawn context
+ *   cleo orchestrate claim <taskId> --assignee <name> — claim an unassigned task
  *   cleo orchestrate validate <taskId>    — validate subagent output
  *   cleo orchestrate context <epicId>     — orchestrator context summary
  *   cleo orchestrate ivtr <taskId>        — IVTR phased loop
@@ -287,6 +289,19 @@ const readyCommand = defineCommand({
       description:
         "Traversal mode (gh-390/ADR-073): 'parent' walks parentId only, 'saga' walks task_relations.type='groups' only, 'both' (default) auto-detects saga-labeled epics.",
     },
+    sort: {
+      type: 'string',
+      description: "Ordering: 'priority' (critical → low, ties by task ID)",
+    },
+    assignee: {
+      type: 'string',
+      description: 'Only return tasks that are unassigned or already assigned to this agent',
+    },
+    'track-balance': {
+      type: 'boolean',
+      description:
+        'Tag each ready task with its track (task, then saga, then trackRules label globs) and alternate between tracks',
+    },
   },
   async run({ args }) {
     await dispatchFromCli(
@@ -297,12 +312,40 @@ const readyCommand = defineCommand({
         epicId: args.epicId,
         ignoreDepsValidate: args['ignore-deps-validate'] === true,
         ...(args.via !== undefined && { via: args.via }),
+        ...(args.sort !== undefined && { sort: args.sort }),
+        ...(args.assignee !== undefined && { assignee: args.assignee }),
+        ...(args['track-balance'] === true && { trackBalance: true }),
       },
       { command: 'orchestrate' },
     );
   },
 });
 
+/** cleo orchestrate tracks — ready-task count per track */
+const tracksCommand = defineCommand({
+  meta: {
+    name: 'tracks',
+    description:
+      'Show each track with its ready-task count. A task takes its own track, then its saga track, then the first matching trackRules label glob; the rest are untracked.',
+  },
+  args: {
+    epicId: {
+      type: 'positional',
+      description: 'Epic or saga ID to query',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'query',
+      'orchestrate',
+      'tracks',
+      { epicId: args.epicId },
+      { command: 'orchestrate' },
+    );
+  },
+});
+
 /** cleo orchestrate report — grouped readiness report */
 const reportCommand = defineCommand({
   meta: {
@@ -394,17 +437,26 @@ const wavesCommand = defineCommand({
   },
 });
 
-/** cleo orchestrate plan — emit a deterministic wave+worker plan for an epic (T889 / W3-6) */
+/**
+ * cleo orchestrate plan — with an epic ID, emit a deterministic wave+worker
+ * plan for it (T889 / W3-6); without one, sequence all open work (or one
+ * saga's, via `--saga`) into topological waves.
+ */
 const planCommand = defineCommand({
   meta: {
     name: 'plan',
-    description: 'Emit a deterministic wave+worker plan for an epic (machine-readable)',
+    description:
+      'Emit a wave+worker plan for an epic, or (no epic) sequence open work into dependency waves [--saga <id>]',
   },
   args: {
     epicId: {
       type: 'positional',
-      description: 'Epic ID to plan',
-      required: true,
+      description: 'Epic ID to plan (omit to sequence the project or --saga)',
+      required: false,
+    },
+    saga: {
+      type: 'string',
+      description: 'Sequence only this saga (no epic ID)',
     },
     tier: {
       type: 'string',
@@ -412,6 +464,16 @@ const planCommand = defineCommand({
     },
   },
   async run({ args }) {
+    if (!args.epicId) {
+      await dispatchFromCli(
+        'query',
+        'orchestrate',
+        'sequence',
+        { ...(args.saga !== undefined && { sagaId: args.saga }) },
+        { command: 'orchestrate', operation: 'orchestrate.sequence' },
+      );
+      return;
+    }
     const preferTier =
       args.tier !== undefined ? (Number.parseInt(args.tier, 10) as 0 | 1 | 2) : undefined;
     await dispatchFromCli(
@@ -468,6 +530,12 @@ const spawnCommand = defineCommand({
         'canonical XDG path. If the worktree does not exist, returns E_RESUME_WORKTREE_MISSING. ' +
         'Use after a prior aborted spawn left a worktree behind.',
     },
+    assignee: {
+      type: 'string',
+      description:
+        'Claim the task for this assignee before spawning. Fails with already_claimed when ' +
+        'another assignee holds it. Defaults to the spawned agent when the task is unassigned.',
+    },
   },
   async run({ args }) {
     // T892: --tier accepts auto|0|1|2. 'auto' (and undefined) are forwarded
@@ -499,12 +567,42 @@ const spawnCommand = defineCommand({
         ...(args.scope ? { spawnScope: args.scope } : {}),
         ...(atomicityScope ? { atomicityScope } : {}),
         resume: args.resume === true,
+        ...(args.assignee ? { assignee: args.assignee } : {}),
       },
       { command: 'orchestrate' },
     );
   },
 });
 
+/** cleo orchestrate claim — atomically assign an unassigned task */
+const claimCommand = defineCommand({
+  meta: {
+    name: 'claim',
+    description: 'Assign a task to --assignee only if it is unassigned (fails with already_claimed)',
+  },
+  args: {
+    taskId: {
+      type: 'positional',
+      description: 'Task ID to claim',
+      required: true,
+    },
+    assignee: {
+      type: 'string',
+      description: 'Agent (or person) taking the task',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'orchestrate',
+      'claim',
+      { taskId: args.taskId, assignee: args.assignee },
+      { command: 'orchestrate' },
+    );
+  },
+});
+
 /** cleo orchestrate validate — validate subagent output */
 const validateCommand = defineCommand({
   meta: { name: 'validate', description: 'Validate subagent output' },
@@ -1121,11 +1219,13 @@ export const orchestrateCommand = defineCommand({
     'roll-up': rollupCommand,
     analyze: analyzeCommand,
     ready: readyCommand,
+    tracks: tracksCommand,
     report: reportCommand,
     next: nextCommand,
     waves: wavesCommand,
     plan: planCommand,
     spawn: spawnCommand,
+    claim: claimCommand,
     validate: validateCommand,
     context: contextCommand,
     ivtr: ivtrCommand,
@@ -0,0 +1,35 @@
+/**
+ * CLI overdue command — tasks past their due date that are not done.
+ *
+ * Routes through dispatch to `tasks.overdue`; the `{ results, total }`
+ * envelope matches `cleo find`, most overdue first.
+ */
+import { dispatchRaw, handleRawError } from '../../dispatch/adapters/cli.js';
+import { defineCommand } from '../lib/define-cli-command.js';
+import { cliOutput } from '../renderers/index.js';
+
+/** Native citty command for `cleo overdue [--as-of <date>]`. */
+export const overdueCommand = defineCommand({
+  meta: { name: 'overdue', description: 'List tasks past their due date that are not done' },
+  args: {
+    'as-of': {
+      type: 'string',
+      description: 'Reference date (RFC 3339, e.g. 2026-07-01); defaults to now',
+    },
+  },
+  async run({ args }) {
+    const params: Record<string, unknown> = {};
+    if (args['as-of'] !== undefined) params['asOf'] = args['as-of'];
+    const response = await dispatchRaw('query', 'tasks', 'overdue', params);
+    if (!response.success) {
+      handleRawError(response, { command: 'overdue', operation: 'tasks.overdue' });
+    }
+    const data = (response.data as Record<string, unknown>) ?? {};
+    const results = Array.isArray(data.results) ? data.results : [];
+    cliOutput(data, {
+      command: 'overdue',
+      operation: 'tasks.overdue',
+      ...(results.length === 0 ? { message: 'No overdue tasks' } : {}),
+    });
+  },
+});
@@ -0,0 +1,71 @@
+/**
+ * CLI report command group — progress reports over time.
+ *
+ * Commands:
+ *   cleo report burndown --saga <id> --from <date> [--to <date>] [--bucket day|week]
+ *
+ * Output is JSON so the series can be fed straight into a charting tool.
+ */
+
+import { dispatchRaw, handleRawError } from '../../dispatch/adapters/cli.js';
+import { defineCommand, showUsage } from '../lib/define-cli-command.js';
+import { cliOutput } from '../renderers/index.js';
+
+/**
+ * cleo report burndown — completed vs remaining estimate per bucket for a
+ * Saga. Routes through dispatch to `tasks.burndown`.
+ */
+const burndownCommand = defineCommand({
+  meta: {
+    name: 'burndown',
+    description: 'Completed vs remaining estimate per day/week for a Saga',
+  },
+  args: {
+    saga: {
+      type: 'string',
+      description: 'Saga task ID',
+      required: true,
+    },
+    from: {
+      type: 'string',
+      description: 'Range start (RFC 3339, e.g. 2026-06-01)',
+      required: true,
+    },
+    to: {
+      type: 'string',
+      description: 'Range end (RFC 3339); defaults to now',
+    },
+    bucket: {
+      type: 'string',
+      description: 'Bucket width: day|week (weeks start Monday, UTC)',
+      default: 'day',
+    },
+  },
+  async run({ args }) {
+    const params: Record<string, unknown> = {
+      sagaId: args.saga,
+      from: args.from,
+      bucket: args.bucket,
+    };
+    if (args.to !== undefined) params['to'] = args.to;
+    const response = await dispatchRaw('query', 'tasks', 'burndown', params);
+    handleRawError(response, { command: 'report', operation: 'tasks.burndown' });
+    cliOutput(response.data ?? {}, { command: 'report', operation: 'tasks.burndown' });
+  },
+});
+
+/** Root report command group. */
+export const reportCommand = defineCommand({
+  meta: {
+    name: 'report',
+    description: 'Progress reports over time (burndown)',
+  },
+  subCommands: {
+    burndown: burndownCommand,
+  },
+  async run({ cmd, rawArgs }) {
+    const firstArg = rawArgs?.find((a) => !a.startsWith('-'));
+    if (firstArg && cmd.subCommands && firstArg in cmd.subCommands) return;
+    await showUsage(cmd);
+  },
+});
@@ -21,6 +21,7 @@ import {
   CleoError,
   getProjectRoot,
   getTaskAccessor,
+  isPrefixedTaskId,
   type ParsedResolution,
   parseConflictReport,
   setAtPath,
@@ -255,7 +256,7 @@ const taskSubCommand = defineCommand({
     try {
       const taskId = args.taskId;
       const idPattern = /^T\d{3,}$/;
-      if (!idPattern.test(taskId)) {
+      if (!idPattern.test(taskId) && !isPrefixedTaskId(taskId)) {
         throw new CleoError(ExitCode.INVALID_INPUT, `Invalid task ID: ${taskId}`);
       }
 
@@ -6,12 +6,20 @@
  * `tasks.add`, `tasks.relates.add`, and `tasks.list` dispatch operations.
  *
  * Commands:
- *   cleo saga create --title <t> [--description <d>] [--acceptance <a>]
+ *   cleo saga create --title <t> [--description <d>] [--acceptance <a>] [--due <date>]
+ *                    [--prefix <PREFIX>] [--track <TRACK>]
  *   cleo saga add <sagaId> <epicId>
  *   cleo saga detach <sagaId> <memberId> [--reason "..."]
  *   cleo saga list
  *   cleo saga members <sagaId>
+ *   cleo saga show <sagaId>
+ *   cleo saga update <sagaId> --require commit,note
  *   cleo saga rollup <sagaId>
+ *   cleo saga critical-path <sagaId>
+ *   cleo saga deps <sagaId>
+ *   cleo saga schedule <sagaId> [--hours-per-unit <n>] [--as-of <date>]
+ *   cleo saga export <sagaId> > bundle.json
+ *   cleo saga import <bundle.json>
  *   cleo saga repair <sagaId>
  *   cleo saga reconcile [<sagaId>] [--dry-run]
  *
@@ -25,10 +33,12 @@
  * @epic T10210 — E-SAGA-AUTO-CLOSE
  */
 
+import { ExitCode } from '@cleocode/contracts';
 import { parseAcceptanceCriteria } from '@cleocode/core';
 import { defineCommand, showUsage } from 'citty';
 import { dispatchFromCli, dispatchRaw, handleRawError } from '../../dispatch/adapters/cli.js';
-import { cliOutput } from '../renderers/index.js';
+import { getFormatContext, setFormatContext } from '../format-context.js';
+import { cliError, cliOutput } from '../renderers/index.js';
 
 /** cleo saga create — create a new Saga (type='saga') */
 const createCommand = defineCommand({
@@ -52,6 +62,21 @@ const createCommand = defineCommand({
       description: 'Pipe-separated acceptance criteria (e.g. "AC1|AC2")',
       required: false,
     },
+    due: {
+      type: 'string',
+      description: 'Due date (RFC 3339, e.g. 2026-07-01); saga schedule works back from it',
+      required: false,
+    },
+    prefix: {
+      type: 'string',
+      description: 'ID prefix for the saga tasks, e.g. --prefix AUTH numbers them AUTH-1, AUTH-2',
+      required: false,
+    },
+    track: {
+      type: 'string',
+      description: 'Orchestration track for member tasks that have none of their own',
+      required: false,
+    },
     'dry-run': {
       type: 'boolean',
       description: 'Validate and preview the Saga without writing task, relation, or doc rows',
@@ -69,6 +94,9 @@ const createCommand = defineCommand({
         // T9839/gh-409: route through bracket+quote-aware parser so criteria
         // containing `ENUM (a|b|c)` or quoted unions aren't shredded.
         acceptance: args.acceptance ? parseAcceptanceCriteria(args.acceptance) : undefined,
+        due: args.due,
+        prefix: args.prefix,
+        track: args.track,
         dryRun: args['dry-run'] === true,
       },
       { command: 'saga', operation: 'tasks.saga.create' },
@@ -150,7 +178,7 @@ const detachCommand = defineCommand({
 const listCommand = defineCommand({
   meta: {
     name: 'list',
-    description: 'List all Sagas',
+    description: 'List all Sagas with their progress',
   },
   async run() {
     await dispatchFromCli('query', 'tasks', 'saga.list', {}, { command: 'saga' });
@@ -181,6 +209,80 @@ const membersCommand = defineCommand({
   },
 });
 
+/**
+ * cleo saga show <sagaId> — the Saga plus its effort-estimate rollup
+ * (estimate total/done/remaining and the unestimated member count).
+ */
+const showCommand = defineCommand({
+  meta: {
+    name: 'show',
+    description: 'Show a Saga with its estimate rollup, progress and status counts',
+  },
+  args: {
+    sagaId: {
+      type: 'positional',
+      description: 'Saga task ID',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    const response = await dispatchRaw('query', 'tasks', 'estimate.rollup', {
+      taskId: args.sagaId,
+      type: 'saga',
+    });
+    handleRawError(response, { command: 'saga', operation: 'tasks.estimate.rollup' });
+    cliOutput(response.data ?? {}, { command: 'saga', operation: 'tasks.estimate.rollup' });
+  },
+});
+
+/**
+ * cleo saga update <sagaId> --require <list> — set the completion
+ * requirements every task under the Saga inherits, e.g. `commit,note`.
+ * A task's own `cleo update --require` overrides them.
+ */
+const updateCommand = defineCommand({
+  meta: {
+    name: 'update',
+    description: 'Set the completion requirements tasks under a Saga inherit',
+  },
+  args: {
+    sagaId: {
+      type: 'positional',
+      description: 'Saga task ID',
+      required: true,
+    },
+    require: {
+      type: 'string',
+      description:
+        'Comma-separated requirements to complete member tasks: commit, note, estimate, assignee; --require none clears them',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    const shown = await dispatchRaw('query', 'tasks', 'show', { taskId: args.sagaId });
+    handleRawError(shown, { command: 'saga', operation: 'tasks.show' });
+    const type = (shown.data as { task?: { type?: string } } | undefined)?.task?.type;
+    if (type !== 'saga') {
+      cliError(`${args.sagaId} is a ${type ?? 'task'}, not a saga`, ExitCode.INVALID_INPUT, {
+        name: 'E_INVALID_INPUT',
+        fix: `Set a task's own requirements with: cleo update ${args.sagaId} --require <list>`,
+      });
+      process.exit(ExitCode.INVALID_INPUT);
+    }
+    const raw = args.require.trim().toLowerCase();
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'update',
+      {
+        taskId: args.sagaId,
+        completionRequirements: raw === '' || raw === 'none' ? null : raw.split(','),
+      },
+      { command: 'saga', operation: 'tasks.update' },
+    );
+  },
+});
+
 /**
  * cleo saga repair <sagaId> — detach an I5-violating `parentId` from a Saga
  * by clearing the invalid Saga parent edge.
@@ -280,6 +382,141 @@ const rollupCommand = defineCommand({
   },
 });
 
+/** cleo saga critical-path <sagaId> — longest dependency chain weighted by estimate */
+const criticalPathCommand = defineCommand({
+  meta: {
+    name: 'critical-path',
+    description:
+      'Longest dependency chain through open Saga work, weighted by estimate (unestimated tasks count 1 and are flagged)',
+  },
+  args: {
+    sagaId: {
+      type: 'positional',
+      description: 'Saga task ID',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    const response = await dispatchRaw('query', 'tasks', 'saga.critical-path', {
+      sagaId: args.sagaId,
+    });
+    handleRawError(response, { command: 'saga', operation: 'tasks.saga.critical-path' });
+    cliOutput(response.data ?? {}, { command: 'saga', operation: 'tasks.saga.critical-path' });
+  },
+});
+
+/** cleo saga deps <sagaId> — other Sagas this Saga depends on */
+const depsCommand = defineCommand({
+  meta: {
+    name: 'deps',
+    description:
+      'List the other Sagas this Saga depends on, with the task edges into each and how many are still open',
+  },
+  args: {
+    sagaId: {
+      type: 'positional',
+      description: 'Saga task ID',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    const response = await dispatchRaw('query', 'tasks', 'saga.deps', {
+      sagaId: args.sagaId,
+    });
+    handleRawError(response, { command: 'saga', operation: 'tasks.saga.deps' });
+    cliOutput(response.data ?? {}, { command: 'saga', operation: 'tasks.saga.deps' });
+  },
+});
+
+/** cleo saga schedule <sagaId> — latest start/finish per task from the Saga due date */
+const scheduleCommand = defineCommand({
+  meta: {
+    name: 'schedule',
+    description:
+      'Work back from the Saga due date along dependencies to the latest start and finish of each open task, flagging tasks already at risk',
+  },
+  args: {
+    sagaId: {
+      type: 'positional',
+      description: 'Saga task ID',
+      required: true,
+    },
+    'hours-per-unit': {
+      type: 'string',
+      description: 'Hours one estimate point stands for (default 1)',
+      required: false,
+    },
+    'as-of': {
+      type: 'string',
+      description: 'Judge at-risk tasks against this date instead of now (RFC 3339)',
+      required: false,
+    },
+  },
+  async run({ args }) {
+    const hoursPerUnit = args['hours-per-unit'];
+    const response = await dispatchRaw('query', 'tasks', 'saga.schedule', {
+      sagaId: args.sagaId,
+      hoursPerUnit: hoursPerUnit !== undefined ? Number(hoursPerUnit) : undefined,
+      asOf: args['as-of'],
+    });
+    handleRawError(response, { command: 'saga', operation: 'tasks.saga.schedule' });
+    cliOutput(response.data ?? {}, { command: 'saga', operation: 'tasks.saga.schedule' });
+  },
+});
+
+/** cleo saga export <sagaId> — write a portable bundle to stdout for piping */
+const exportCommand = defineCommand({
+  meta: {
+    name: 'export',
+    description: 'Print the Saga, its tasks, dependencies, and labels as a portable JSON bundle',
+  },
+  args: {
+    sagaId: {
+      type: 'positional',
+      description: 'Saga task ID',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    // The bundle is the output, unless --json asks for the envelope; `cleo saga
+    // import` reads either.
+    const ctx = getFormatContext();
+    if (ctx.source !== 'flag') setFormatContext({ ...ctx, format: 'human' });
+    await dispatchFromCli(
+      'query',
+      'tasks',
+      'saga.export',
+      { sagaId: args.sagaId },
+      { command: 'saga-export', operation: 'tasks.saga.export' },
+    );
+  },
+});
+
+/** cleo saga import <file> — re-create a bundle under fresh task IDs */
+const importCommand = defineCommand({
+  meta: {
+    name: 'import',
+    description:
+      'Import a Saga bundle under new task IDs; reports the old→new ID map and dropped depends',
+  },
+  args: {
+    file: {
+      type: 'positional',
+      description: 'Bundle JSON written by cleo saga export',
+      required: true,
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'saga.import',
+      { file: args.file },
+      { command: 'saga', operation: 'tasks.saga.import' },
+    );
+  },
+});
+
 /**
  * cleo saga next [<sagaId>] — return the next actionable Saga and its ready frontier.
  *
@@ -333,7 +570,14 @@ export const sagaCommand = defineCommand({
     detach: detachCommand,
     list: listCommand,
     members: membersCommand,
+    show: showCommand,
+    update: updateCommand,
     rollup: rollupCommand,
+    'critical-path': criticalPathCommand,
+    deps: depsCommand,
+    schedule: scheduleCommand,
+    export: exportCommand,
+    import: importCommand,
     repair: repairCommand,
     reconcile: reconcileCommand,
     next: nextCommand,
@@ -0,0 +1,42 @@
+/**
+ * CLI stale command — tasks stuck in one status with no recent updates.
+ *
+ *   cleo stale [--status active] [--older-than 7d]   — routes to `tasks.stale`
+ *   cleo stale ... --reset                           — routes to `tasks.stale.reset`
+ *
+ * `--reset` flips every stale task back to `pending` and clears its
+ * assignee, so an orchestrator can hand abandoned work to a new agent.
+ */
+
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { defineCommand } from '../lib/define-cli-command.js';
+
+/** Native citty command for `cleo stale`. */
+export const staleCommand = defineCommand({
+  meta: {
+    name: 'stale',
+    description: 'List tasks left in one status without updates (--reset returns them to pending)',
+  },
+  args: {
+    status: {
+      type: 'string',
+      description: 'Non-terminal status to scan (in_progress = active); defaults to active',
+    },
+    'older-than': {
+      type: 'string',
+      description: 'Staleness threshold since the last update (e.g. 7d, 12h); defaults to 7d',
+    },
+    reset: {
+      type: 'boolean',
+      description: 'Flip stale tasks back to pending and clear their assignee',
+    },
+  },
+  async run({ args }) {
+    const params = { status: args.status, olderThan: args['older-than'] };
+    if (args.reset) {
+      await dispatchFromCli('mutate', 'tasks', 'stale.reset', params, { command: 'stale' });
+    } else {
+      await dispatchFromCli('query', 'tasks', 'stale', params, { command: 'stale' });
+    }
+  },
+});
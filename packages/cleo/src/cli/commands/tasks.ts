@@ -6,17 +6,35 @@
  * extraction and dispatch routing (T1467 thin-wrapper migration).
  *
  * Subcommands:
- *   cleo tasks show <id>       — show full task details
- *   cleo tasks find <query>    — search tasks by keyword
- *   cleo tasks next            — auto-select highest-priority task
- *   cleo tasks current         — show currently active task
- *   cleo tasks plan            — composite planning view
- *   cleo tasks analyze         — leverage-sorted discovery
- *   cleo tasks slice <id>      — localized WorkGraph slice around a task
+ *   cleo tasks show <id>        — show full task details
+ *   cleo tasks find <query>     — search tasks by keyword
+ *   cleo tasks next             — auto-select highest-priority task
+ *   cleo tasks current          — show currently active task
+ *   cleo tasks plan             — composite planning view
+ *   cleo tasks analyze          — leverage-sorted discovery
+ *   cleo tasks slice <id>       — localized WorkGraph slice around a task
+ *   cleo tasks tree <id>        — transitive blockers and dependents as a tree
+ *   cleo tasks move <id>        — move a task (or subtree) to another saga/epic
+ *   cleo tasks merge <id> <dup...> — fold duplicate tasks into one
+ *   cleo tasks split <id>       — break a task into child tasks
+ *   cleo tasks block <id>       — block a task on an external cause
+ *   cleo tasks unblock <id>     — restore a blocked task's prior status
+ *   cleo tasks note <id>        — append an authored note to the task history
+ *   cleo tasks commits <id>     — list the git commit SHAs linked to a task
+ *   cleo tasks link-commit <id> — link a git commit SHA to a task
+ *   cleo tasks renumber         — compact task IDs into a contiguous range
+ *   cleo tasks set-status <status> — move every task matching a filter to a status
+ *   cleo tasks start <id>       — start the time tracker on a task
+ *   cleo tasks stop <id>        — stop the running time tracker on a task
+ *   cleo tasks time <id>        — tracked time intervals and total time spent
  *
  * Note: Mutation commands (add, update, complete, delete, etc.) retain their
  * top-level flat names (`cleo add`, `cleo complete`, etc.) per the original
- * CLI design. This module provides the `cleo tasks` namespace for query ops.
+ * CLI design. This module provides the `cleo tasks` namespace for query ops,
+ * plus `move`, `merge`, `split`, `block`, `unblock`, `note`, `commits`,
+ * `link-commit`, `renumber`, `set-status`, and the `start` / `stop` / `time`
+ * time tracker, which have no flat equivalent. (`cleo start` / `cleo stop`
+ * are the session's current-task commands, not time tracking.)
  *
  * @see packages/cleo/src/dispatch/domains/tasks.ts
  * @task T1467
@@ -148,6 +166,354 @@ const sliceSub = defineCommand({
   },
 });
 
+const treeSub = defineCommand({
+  meta: {
+    name: 'tree',
+    description: 'Show the transitive depends_on blockers and dependents of a task as a tree',
+  },
+  args: {
+    id: { type: 'positional', description: 'Task ID (e.g. T1234)', required: true },
+    depth: { type: 'string', description: 'Levels to walk from the task (default: all)' },
+    direction: {
+      type: 'string',
+      description: 'up (what must finish first), down (what this unblocks), or both (default)',
+    },
+    json: { type: 'boolean', description: 'Emit the trace as nested JSON' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'query',
+      'tasks',
+      'deps.trace',
+      {
+        taskId: args.id,
+        depth: args.depth ? Number(args.depth) : undefined,
+        direction: args.direction,
+      },
+      // `deps` routes to the tree renderer, which prints the `rendered` text.
+      { command: 'deps', operation: 'tasks.deps.trace' },
+    );
+  },
+});
+
+// ---------------------------------------------------------------------------
+// Mutate subcommands
+// ---------------------------------------------------------------------------
+
+const moveSub = defineCommand({
+  meta: {
+    name: 'move',
+    description: 'Move a task (or, with --recursive, its subtree) to another saga or epic',
+  },
+  args: {
+    id: { type: 'positional', description: 'Task ID to move', required: true },
+    'to-saga': { type: 'string', description: 'Target saga ID' },
+    'to-epic': { type: 'string', description: 'Target epic ID' },
+    recursive: { type: 'boolean', description: 'Move all descendant subtasks too' },
+    force: {
+      type: 'boolean',
+      description: 'Allow dependency edges to cross saga boundaries after the move',
+    },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'move',
+      {
+        taskId: args.id,
+        toSaga: args['to-saga'],
+        toEpic: args['to-epic'],
+        recursive: args.recursive === true,
+        force: args.force === true,
+      },
+      { command: 'tasks move', operation: 'tasks.move' },
+    );
+  },
+});
+
+const mergeSub = defineCommand({
+  meta: {
+    name: 'merge',
+    description:
+      'Fold duplicate tasks into <id> (labels, notes, dependents, subtasks), then trash them',
+  },
+  args: {
+    id: { type: 'positional', description: 'Task ID to keep', required: true },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    // citty has no variadic positionals — the duplicates follow <id> in `_`.
+    const duplicates = (args._ ?? []).map(String).slice(1);
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'merge',
+      { taskId: args.id, duplicates },
+      { command: 'tasks merge', operation: 'tasks.merge' },
+    );
+  },
+});
+
+/** Normalise a repeatable string flag (citty yields a string, or an array when repeated). */
+function repeated(value: unknown): string[] {
+  return value === undefined ? [] : [value].flat().map(String);
+}
+
+const splitSub = defineCommand({
+  meta: {
+    name: 'split',
+    description:
+      'Break a task into child tasks; dependents wait on every child and the parent becomes a container',
+  },
+  args: {
+    id: { type: 'positional', description: 'Task ID to split', required: true },
+    into: { type: 'string', description: 'Child task title (repeat once per child)' },
+    estimate: {
+      type: 'string',
+      description: 'Child estimate, matched to --into by position (default: even split)',
+    },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    const estimates = repeated(args.estimate);
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'split',
+      {
+        taskId: args.id,
+        into: repeated(args.into),
+        ...(estimates.length > 0 ? { estimates: estimates.map(Number) } : {}),
+      },
+      { command: 'tasks split', operation: 'tasks.split' },
+    );
+  },
+});
+
+const blockSub = defineCommand({
+  meta: {
+    name: 'block',
+    description: 'Block a task on an external cause (remembers its status for unblock)',
+  },
+  args: {
+    id: { type: 'positional', description: 'Task ID to block', required: true },
+    reason: { type: 'string', description: 'Why the task is blocked', required: true },
+    until: {
+      type: 'string',
+      description: 'RFC 3339 date after which orchestrate ready offers the task again',
+    },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'block',
+      { taskId: args.id, reason: args.reason, until: args.until },
+      { command: 'tasks block', operation: 'tasks.block' },
+    );
+  },
+});
+
+const unblockSub = defineCommand({
+  meta: { name: 'unblock', description: 'Unblock a task, restoring the status it had before' },
+  args: {
+    id: { type: 'positional', description: 'Task ID to unblock', required: true },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'unblock',
+      { taskId: args.id },
+      { command: 'tasks unblock', operation: 'tasks.unblock' },
+    );
+  },
+});
+
+const noteSub = defineCommand({
+  meta: { name: 'note', description: 'Append an authored note to the task note history' },
+  args: {
+    id: { type: 'positional', description: 'Task ID to annotate', required: true },
+    text: { type: 'string', description: 'Note text', required: true },
+    author: { type: 'string', description: 'Author (default $CLEO_AGENT_ID, else cleo)' },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'note',
+      { taskId: args.id, text: args.text, author: args.author },
+      { command: 'tasks note', operation: 'tasks.note' },
+    );
+  },
+});
+
+const commitsSub = defineCommand({
+  meta: { name: 'commits', description: 'List the git commit SHAs linked to a task' },
+  args: {
+    id: { type: 'positional', description: 'Task ID', required: true },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'query',
+      'tasks',
+      'commits',
+      { taskId: args.id },
+      { command: 'tasks commits', operation: 'tasks.commits' },
+    );
+  },
+});
+
+const linkCommitSub = defineCommand({
+  meta: { name: 'link-commit', description: 'Link a git commit SHA to a task' },
+  args: {
+    id: { type: 'positional', description: 'Task ID the commit implements', required: true },
+    sha: { type: 'string', description: 'Commit SHA (7-40 hex characters)', required: true },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'link-commit',
+      { taskId: args.id, sha: args.sha },
+      { command: 'tasks link-commit', operation: 'tasks.link-commit' },
+    );
+  },
+});
+
+const renumberSub = defineCommand({
+  meta: {
+    name: 'renumber',
+    description: 'Reassign contiguous task IDs and rewrite every reference (prints old → new)',
+  },
+  args: {
+    saga: { type: 'string', description: 'Only renumber this saga and its descendants' },
+    start: { type: 'string', description: 'First number to assign (default 1)' },
+    'dry-run': { type: 'boolean', description: 'Print the mapping without writing' },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'renumber',
+      {
+        saga: args.saga,
+        start: args.start !== undefined ? Number(args.start) : undefined,
+        dryRun: args['dry-run'],
+      },
+      { command: 'tasks renumber', operation: 'tasks.renumber' },
+    );
+  },
+});
+
+const setStatusSub = defineCommand({
+  meta: {
+    name: 'set-status',
+    description:
+      'Move every task matching --where/--label/--saga to a status in one atomic save (reports skipped tasks and why)',
+  },
+  args: {
+    status: {
+      type: 'positional',
+      description: 'Target status (pending, active, blocked, done, cancelled)',
+      required: true,
+    },
+    where: {
+      type: 'string',
+      description: "Filter expression, e.g. 'label:wave-1 and status = active' (see cleo find)",
+    },
+    label: { type: 'string', description: 'Only tasks carrying this label' },
+    saga: { type: 'string', description: 'Only tasks within this saga' },
+    force: {
+      type: 'boolean',
+      description: 'Close tasks that still have open children or dependencies',
+    },
+    'dry-run': { type: 'boolean', description: 'Report what would change without writing' },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'set-status',
+      {
+        status: args.status,
+        where: args.where !== undefined ? [args.where] : undefined,
+        label: args.label,
+        saga: args.saga,
+        force: args.force,
+        dryRun: args['dry-run'],
+      },
+      { command: 'tasks set-status', operation: 'tasks.set-status' },
+    );
+  },
+});
+
+const startSub = defineCommand({
+  meta: {
+    name: 'start',
+    description: 'Start the time tracker on a task (fails if already running)',
+  },
+  args: {
+    id: { type: 'positional', description: 'Task ID', required: true },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'time.start',
+      { taskId: args.id },
+      { command: 'tasks start', operation: 'tasks.time.start' },
+    );
+  },
+});
+
+const stopSub = defineCommand({
+  meta: { name: 'stop', description: 'Stop the running time tracker on a task' },
+  args: {
+    id: { type: 'positional', description: 'Task ID', required: true },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'time.stop',
+      { taskId: args.id },
+      { command: 'tasks stop', operation: 'tasks.time.stop' },
+    );
+  },
+});
+
+const timeSub = defineCommand({
+  meta: {
+    name: 'time',
+    description: 'Show tracked time intervals and total time spent vs estimate',
+  },
+  args: {
+    id: { type: 'positional', description: 'Task ID', required: true },
+    json: { type: 'boolean', description: 'Emit JSON output' },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'query',
+      'tasks',
+      'time',
+      { taskId: args.id },
+      { command: 'tasks time', operation: 'tasks.time' },
+    );
+  },
+});
+
 // ---------------------------------------------------------------------------
 // Root command
 // ---------------------------------------------------------------------------
@@ -160,7 +526,8 @@ const sliceSub = defineCommand({
 export const tasksCommand = defineCommand({
   meta: {
     name: 'tasks',
-    description: 'Task query namespace: show, find, next, current, plan, analyze, slice',
+    description:
+      'Task namespace: show, find, next, current, plan, analyze, slice, tree, move, merge, split, block, unblock, note, commits, link-commit, renumber, set-status, start, stop, time',
   },
   subCommands: {
     show: showSub,
@@ -170,14 +537,52 @@ export const tasksCommand = defineCommand({
     plan: planSub,
     analyze: analyzeSub,
     slice: sliceSub,
+    tree: treeSub,
+    move: moveSub,
+    merge: mergeSub,
+    split: splitSub,
+    block: blockSub,
+    unblock: unblockSub,
+    note: noteSub,
+    commits: commitsSub,
+    'link-commit': linkCommitSub,
+    renumber: renumberSub,
+    'set-status': setStatusSub,
+    start: startSub,
+    stop: stopSub,
+    time: timeSub,
   },
   async run({ cmd, rawArgs }) {
     if (isSubCommandDispatch(rawArgs, cmd.subCommands)) return;
     cliOutput(
-      { subCommands: ['show', 'find', 'next', 'current', 'plan', 'analyze'] },
+      {
+        subCommands: [
+          'show',
+          'find',
+          'next',
+          'current',
+          'plan',
+          'analyze',
+          'tree',
+          'move',
+          'merge',
+          'split',
+          'block',
+          'unblock',
+          'note',
+          'commits',
+          'link-commit',
+          'renumber',
+          'set-status',
+          'start',
+          'stop',
+          'time',
+        ],
+      },
       {
         command: 'tasks',
-        message: 'Usage: cleo tasks show|find|next|current|plan|analyze',
+        message:
+          'Usage: cleo tasks show|find|next|current|plan|analyze|tree|move|merge|split|block|unblock|note|commits|link-commit|renumber|set-status|start|stop|time',
         operation: 'tasks',
       },
     );
@@ -0,0 +1,83 @@
+/**
+ * CLI trash command group — deleted tasks awaiting restore or purge.
+ *
+ * Commands:
+ *   cleo trash list                          — trashed tasks, newest first
+ *   cleo trash restore <id> [--to-saga <id>] — bring a task back
+ *   cleo trash empty [--older-than 30d]      — remove trashed tasks for good
+ *
+ * `cleo delete <id>` puts tasks here; `cleo delete <id> --purge` skips it.
+ */
+
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { defineCommand, showUsage } from '../lib/define-cli-command.js';
+
+/** cleo trash list — routes to `tasks.trash.list`. */
+const listCommand = defineCommand({
+  meta: { name: 'list', description: 'List deleted tasks, most recent first' },
+  async run() {
+    await dispatchFromCli('query', 'tasks', 'trash.list', {}, { command: 'trash' });
+  },
+});
+
+/** cleo trash restore <id> — routes to `tasks.trash.restore`. */
+const restoreCommand = defineCommand({
+  meta: {
+    name: 'restore',
+    description: 'Restore a deleted task (and children deleted with it)',
+  },
+  args: {
+    taskId: { type: 'positional', description: 'Trashed task ID', required: true },
+    'to-saga': {
+      type: 'string',
+      description: 'Restore under this saga (required if the original parent is gone)',
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'trash.restore',
+      { taskId: args.taskId, toSaga: args['to-saga'] },
+      { command: 'trash' },
+    );
+  },
+});
+
+/** cleo trash empty — routes to `tasks.trash.empty`. */
+const emptyCommand = defineCommand({
+  meta: { name: 'empty', description: 'Permanently remove deleted tasks' },
+  args: {
+    'older-than': {
+      type: 'string',
+      description: 'Only remove tasks deleted longer ago than this (e.g. 30d, 12h)',
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'tasks',
+      'trash.empty',
+      { olderThan: args['older-than'] },
+      { command: 'trash' },
+    );
+  },
+});
+
+/** Root trash command group. */
+export const trashCommand = defineCommand({
+  meta: {
+    name: 'trash',
+    description: 'Deleted tasks: list, restore, empty',
+  },
+  subCommands: {
+    list: listCommand,
+    restore: restoreCommand,
+    empty: emptyCommand,
+  },
+  async run({ cmd, rawArgs }) {
+    const firstArg = rawArgs?.find((a) => !a.startsWith('-'));
+    if (firstArg && cmd.subCommands && firstArg in cmd.subCommands) return;
+    await showUsage(cmd);
+  },
+});
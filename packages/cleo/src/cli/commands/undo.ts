@@ -0,0 +1,55 @@
+/**
+ * CLI commands: cleo undo / cleo redo — step back and forward through the
+ * operation journal.
+ *
+ * `cleo undo` restores the task state from before the newest journaled
+ * mutate; `cleo redo` re-applies the most recently undone one:
+ *
+ *   Undid: tasks.update (op-1760428800-a1b2c3) from 2026-10-14T08:00:00.000Z
+ *     restored: T42
+ *
+ * Both refuse when tasks were changed outside cleo since the last journal
+ * entry. Only the last 100 mutates are kept.
+ *
+ * Usage:
+ *   cleo undo [--json]
+ *   cleo redo [--json]
+ *
+ * Routes through dispatch as `tasks.undo` / `tasks.redo`; core logic lives in
+ * packages/core/src/store/mutation-journal.ts.
+ */
+
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { defineCommand } from '../lib/define-cli-command.js';
+
+/** Build the command for one replay direction. */
+function replayCommand(kind: 'undo' | 'redo', description: string) {
+  return defineCommand({
+    meta: { name: kind, description },
+    async run() {
+      await dispatchFromCli(
+        'mutate',
+        'tasks',
+        kind,
+        {},
+        { command: kind, operation: `tasks.${kind}` },
+      );
+    },
+  });
+}
+
+/**
+ * Native citty command for `cleo undo`.
+ */
+export const undoCommand = replayCommand(
+  'undo',
+  'Undo the last task mutation (restores the prior state from the operation journal)',
+);
+
+/**
+ * Native citty command for `cleo redo`.
+ */
+export const redoCommand = replayCommand(
+  'redo',
+  'Re-apply the last mutation undone with cleo undo',
+);
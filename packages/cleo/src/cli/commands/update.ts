@@ -84,7 +84,7 @@ export const updateCommand = defineCommand({
     priority: {
       type: 'string',
       description:
-        'New priority (critical|high|medium|low). Orthogonal to --severity — see `cleo find --urgent` for the unified surface (T9905).',
+        'New priority (critical|high|medium|low; normal = medium). Orthogonal to --severity — see `cleo find --urgent` for the unified surface (T9905).',
       alias: 'p',
     },
     type: {
@@ -178,6 +178,10 @@ export const updateCommand = defineCommand({
       type: 'boolean',
       description: 'Disable auto-complete for epic',
     },
+    'auto-complete-parent': {
+      type: 'boolean',
+      description: 'Complete this task automatically once its last open child completes',
+    },
     'pipeline-stage': {
       type: 'string',
       description:
@@ -216,6 +220,35 @@ export const updateCommand = defineCommand({
       description:
         'Severity level (P0|P1|P2|P3) — valid for any --kind (T9073). Orthogonal to --priority — does NOT auto-map (a P0 with priority=medium stays medium). Use `cleo find --urgent` for the unified surface (T9905). Appends signed attestation.',
     },
+    due: {
+      type: 'string',
+      description: 'Due date (RFC 3339, e.g. 2026-07-01 or 2026-07-01T17:00:00Z); --due "" clears it',
+    },
+    recurrence: {
+      type: 'string',
+      description: "Recurrence rule: interval like 7d (m|h|d|w) or '@weekly'; --recurrence none stops it",
+    },
+    estimate: {
+      type: 'string',
+      description: 'Effort estimate (story points or hours); --estimate none clears it',
+    },
+    track: {
+      type: 'string',
+      description: 'Orchestration track (e.g. infra); --track none clears it',
+    },
+    assignee: {
+      type: 'string',
+      description: 'Agent or person to pin the task to; --assignee none clears it',
+    },
+    require: {
+      type: 'string',
+      description:
+        'Comma-separated completion requirements (commit, note, estimate, assignee), overriding the saga; --require none requires nothing, --require inherit restores the saga list',
+    },
+    set: {
+      type: 'string',
+      description: 'Set custom fields: name=value, comma-separated for several; name= clears one',
+    },
     /**
      * Operator-supplied justification required to override the
      * acceptance-criteria immutability guard once a task has entered the
@@ -504,12 +537,35 @@ export const updateCommand = defineCommand({
     if (args.parent !== undefined) params['parent'] = args.parent;
     if (args['parent-id'] !== undefined) params['parent'] = params['parent'] ?? args['parent-id'];
     if (args['no-auto-complete'] === true) params['noAutoComplete'] = true;
+    if (args['auto-complete-parent'] !== undefined)
+      params['autoCompleteParent'] = args['auto-complete-parent'];
     if (args['pipeline-stage'] !== undefined) params['pipelineStage'] = args['pipeline-stage'];
     // T944/T9072: --kind is canonical
     if (args.kind !== undefined) params['kind'] = args.kind;
     if (args.scope !== undefined) params['scope'] = args.scope;
     // T9073: severity — orthogonal to priority, valid for any role
     if (args.severity !== undefined) params['severity'] = args.severity;
+    // Due date — forwarded as-is so `--due ""` reaches core and clears the field
+    if (args.due !== undefined) params['due'] = args.due;
+    if (args.recurrence !== undefined) params['recurrence'] = args.recurrence;
+    // `none` / "" clear the estimate; anything else must parse as a number in core
+    if (args.estimate !== undefined) {
+      const raw = args.estimate.trim().toLowerCase();
+      params['estimate'] = raw === '' || raw === 'none' ? null : Number(raw);
+    }
+    if (args.track !== undefined) {
+      params['track'] = args.track.trim().toLowerCase() === 'none' ? null : args.track;
+    }
+    if (args.assignee !== undefined) {
+      params['assignee'] = args.assignee.trim().toLowerCase() === 'none' ? null : args.assignee;
+    }
+    // `none` is an explicit empty list; `inherit` / "" fall back to the saga's list
+    if (args.require !== undefined) {
+      const raw = args.require.trim().toLowerCase();
+      params['completionRequirements'] =
+        raw === 'none' ? [] : raw === '' || raw === 'inherit' ? null : raw.split(',');
+    }
+    if (args.set !== undefined) params['set'] = [args.set];
     // T1590: AC-immutability override reason — forwarded as `reason`.
     if (args.reason !== undefined) params['reason'] = args.reason;
 
@@ -27,6 +27,11 @@
  * evidence-atom Pre-Complete Gate Ritual. Use `cleo verify <id> --evidence`
  * to attest gates and `cleo verify <id> --explain` to re-validate atoms.
  *
+ * Without a task ID, `cleo verify` checks the task-store checksum seal
+ * instead: it recomputes the checksum, compares it to the stored value, and
+ * reports dangling `depends` targets. `--repair` reseals the store once task
+ * IDs are confirmed unique.
+ *
  * @task T4454
  * @task T832
  * @task T1006
@@ -37,8 +42,31 @@
  * @adr ADR-059
  */
 
-import { defineCommand, showUsage } from 'citty';
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '@cleocode/core';
+import { getProjectRoot, verifyTaskChecksum } from '@cleocode/core/internal';
+import { defineCommand } from 'citty';
 import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { cliError, cliOutput } from '../renderers/index.js';
+
+/**
+ * cleo verify (no task ID) — check, and optionally repair, the task-store
+ * checksum seal. Exits {@link ExitCode.CHECKSUM_MISMATCH} when the report is
+ * not clean.
+ */
+async function runChecksumVerify(repair: boolean): Promise<void> {
+  try {
+    const report = await verifyTaskChecksum(getProjectRoot(), { repair });
+    cliOutput(report, { command: 'verify', operation: 'verify.checksum' });
+    if (!report.ok) process.exitCode = ExitCode.CHECKSUM_MISMATCH;
+  } catch (err) {
+    if (err instanceof CleoError) {
+      cliError(err.message, err.code, { name: 'CleoError', fix: err.fix });
+      process.exit(err.code);
+    }
+    throw err;
+  }
+}
 
 /**
  * cleo verify <task-id> — view or modify verification gates.
@@ -56,7 +84,7 @@ export const verifyCommand = defineCommand({
   args: {
     taskId: {
       type: 'positional',
-      description: 'Task ID to inspect or update',
+      description: 'Task ID to inspect or update (omit to verify the task-store checksum)',
       required: false,
     },
     gate: {
@@ -95,10 +123,15 @@ export const verifyCommand = defineCommand({
       description:
         'Acknowledge that the same evidence atom is applied to >3 distinct tasks in this session (T1502 / ADR-059). Without this flag, such reuse triggers a warning; in strict mode (CLEO_STRICT_EVIDENCE=1) it is a hard reject.',
     },
+    repair: {
+      type: 'boolean',
+      description:
+        'Without a task ID: rewrite the stored checksum after confirming task IDs are unique',
+    },
   },
-  async run({ args, cmd }) {
+  async run({ args }) {
     if (!args.taskId) {
-      await showUsage(cmd);
+      await runChecksumVerify(args.repair === true);
       return;
     }
 
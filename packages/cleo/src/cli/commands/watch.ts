@@ -0,0 +1,119 @@
+/**
+ * CLI command: cleo watch — live feed of task changes.
+ *
+ * Watches the task store and prints each change as it lands:
+ *
+ *   task T42 pending→active
+ *   task T50 created
+ *   task T50 updated (labels, title)
+ *
+ * With `--json` each change is one event object per line (NDJSON), ready to
+ * pipe into a dashboard; a failed read of the store is reported as
+ * `W_WATCH_READ_FAILED`, inline as a `"_warning": true` line in NDJSON mode.
+ * Runs until interrupted.
+ *
+ * Usage:
+ *   cleo watch [--saga <id>] [--debounce <ms>] [--json]
+ *
+ * Core logic lives in packages/core/src/tasks/watch.ts.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError, drainWarnings, pushWarning } from '@cleocode/core';
+import { resolveProjectRoot, type TaskWatchEvent, watchTasks } from '@cleocode/core/internal';
+import { isJsonFormat, setFormatContext } from '../format-context.js';
+import { defineCommand } from '../lib/define-cli-command.js';
+import { cliError, humanInfo, humanLine, humanWarn } from '../renderers/index.js';
+import { writeNdjsonLine } from '../renderers/ndjson.js';
+
+/** One human-readable line for a watch event. */
+function formatEvent(e: TaskWatchEvent): string {
+  switch (e.event) {
+    case 'task.created':
+      return `task ${e.taskId} created`;
+    case 'task.status':
+      return `task ${e.taskId} ${e.from}→${e.to}`;
+    case 'task.updated':
+      return `task ${e.taskId} updated (${(e.fields ?? []).join(', ')})`;
+    case 'task.removed':
+      return `task ${e.taskId} removed`;
+  }
+}
+
+/**
+ * Native citty command for `cleo watch`.
+ */
+export const watchCommand = defineCommand({
+  meta: {
+    name: 'watch',
+    description: 'Live feed of task changes (created, status transitions, edits)',
+  },
+  args: {
+    saga: { type: 'string', description: 'Only report tasks within this saga' },
+    debounce: {
+      type: 'string',
+      description: 'Quiet period in ms before a burst of writes is reported (default: 200)',
+    },
+    json: { type: 'boolean', description: 'Emit one JSON event per line (NDJSON)' },
+  },
+  async run({ args }) {
+    if (args.json) setFormatContext({ format: 'json', source: 'flag', quiet: false });
+    let debounceMs: number | undefined;
+    if (args.debounce !== undefined) {
+      debounceMs = Number.parseInt(args.debounce as string, 10);
+      if (!Number.isFinite(debounceMs) || debounceMs < 0) {
+        cliError(`Invalid --debounce: ${args.debounce}`, ExitCode.VALIDATION_ERROR, {
+          name: 'E_VALIDATION',
+          fix: 'Pass a non-negative number of milliseconds',
+        });
+        process.exit(ExitCode.VALIDATION_ERROR);
+      }
+    }
+
+    const json = isJsonFormat();
+    let stop: () => void;
+    try {
+      stop = await watchTasks(
+        {
+          sagaId: args.saga as string | undefined,
+          debounceMs,
+          onEvent: (e) => {
+            if (json) void writeNdjsonLine(e);
+            else humanLine(formatEvent(e));
+          },
+          onError: (err) => {
+            pushWarning({
+              code: 'W_WATCH_READ_FAILED',
+              message: `watch: ${err instanceof Error ? err.message : String(err)}`,
+            });
+            // No envelope ever closes the feed, so flush warnings as they arrive.
+            for (const w of drainWarnings() ?? []) {
+              if (json) void writeNdjsonLine({ _warning: true, ...w });
+              else humanWarn(w.message);
+            }
+          },
+        },
+        resolveProjectRoot(),
+      );
+    } catch (err) {
+      if (err instanceof CleoError) {
+        cliError(err.message, err.code, { name: 'CleoError', fix: err.fix });
+        process.exit(err.code);
+      }
+      throw err;
+    }
+
+    if (!json) {
+      const scope = args.saga ? ` in ${args.saga}` : '';
+      humanInfo(`Watching tasks${scope} (Ctrl-C to stop)`);
+    }
+    await new Promise<void>((resolve) => {
+      const finish = (): void => {
+        stop();
+        resolve();
+      };
+      process.once('SIGINT', finish);
+      process.once('SIGTERM', finish);
+    });
+  },
+});
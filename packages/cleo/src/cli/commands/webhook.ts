@@ -0,0 +1,68 @@
+/**
+ * CLI webhook command group — outbound task lifecycle event webhooks.
+ *
+ * Thin CLI wrapper delegating to dispatch layer (admin domain).
+ * Core logic lives in packages/core/src/tasks/webhook.ts.
+ *
+ * The receiver is configured with `cleo config set webhook.url <url>`; every
+ * task create / update / complete / delete / archive is then POSTed to it.
+ *
+ * Commands:
+ *   cleo webhook test [--url <url>] [--event <name>] — send a synthetic event
+ *
+ * Dispatch equivalents:
+ *   mutate({domain:'admin', operation:'webhook.test', params:{url?, event?}})
+ */
+
+import { dispatchFromCli } from '../../dispatch/adapters/cli.js';
+import { defineCommand, showUsage } from '../lib/define-cli-command.js';
+
+/** cleo webhook test — POST a synthetic event and report the receiver's answer */
+const testCommand = defineCommand({
+  meta: {
+    name: 'test',
+    description: 'Send a synthetic task event to the webhook receiver and report its response',
+  },
+  args: {
+    url: {
+      type: 'string',
+      description: 'Receiver URL (default: webhook.url from config)',
+    },
+    event: {
+      type: 'string',
+      description: 'Event name to send (default: task.completed)',
+    },
+  },
+  async run({ args }) {
+    await dispatchFromCli(
+      'mutate',
+      'admin',
+      'webhook.test',
+      {
+        url: args.url as string | undefined,
+        event: args.event as string | undefined,
+      },
+      { command: 'webhook test', operation: 'admin.webhook.test' },
+    );
+  },
+});
+
+/**
+ * Root webhook command group.
+ *
+ * Dispatches to `admin.webhook.*` registry operations.
+ */
+export const webhookCommand = defineCommand({
+  meta: {
+    name: 'webhook',
+    description: 'Task lifecycle webhooks (configure with: cleo config set webhook.url <url>)',
+  },
+  subCommands: {
+    test: testCommand,
+  },
+  async run({ cmd, rawArgs }) {
+    const firstArg = rawArgs?.find((a) => !a.startsWith('-'));
+    if (firstArg && cmd.subCommands && firstArg in cmd.subCommands) return;
+    await showUsage(cmd);
+  },
+});
@@ -0,0 +1,138 @@
+/**
+ * CLI workspace command group — read tasks across several projects at once.
+ *
+ * Registered projects live in `~/.cleo/workspace.json`. `cleo workspace tasks`
+ * runs a `--where` query against each one and prefixes every task ID with
+ * the project name:
+ *
+ *   api:T42  active    high     Rate-limit the export endpoint
+ *   web:T7   pending   medium   Empty state for the board
+ *
+ * Only reads aggregate; to change a task, run the usual command inside its
+ * project.
+ *
+ * Subcommands:
+ *   cleo workspace add <path> [--name <name>]
+ *   cleo workspace remove <name>
+ *   cleo workspace list
+ *   cleo workspace tasks [--where <expr>]
+ *
+ * Projects that cannot be read are listed under `skipped` and raised as
+ * `W_WORKSPACE_PROJECT_SKIPPED` warnings rather than failing the query.
+ *
+ * Core logic lives in packages/core/src/tasks/workspace.ts.
+ */
+
+import { CleoError, pushWarning } from '@cleocode/core';
+import {
+  addWorkspaceProject,
+  queryWorkspaceTasks,
+  readWorkspace,
+  removeWorkspaceProject,
+} from '@cleocode/core/internal';
+import { defineCommand, showUsage } from '../lib/define-cli-command.js';
+import { cliError, cliOutput } from '../renderers/index.js';
+
+/** Run `fn`, reporting a CleoError the way the other direct-core commands do. */
+async function runOrExit<T>(fn: () => T | Promise<T>): Promise<T> {
+  try {
+    return await fn();
+  } catch (err) {
+    if (err instanceof CleoError) {
+      cliError(err.message, err.code, { name: 'CleoError', fix: err.fix });
+      process.exit(err.code);
+    }
+    throw err;
+  }
+}
+
+const addCommand = defineCommand({
+  meta: {
+    name: 'add',
+    description: 'Register the CLEO project containing <path> in ~/.cleo/workspace.json',
+  },
+  args: {
+    path: { type: 'positional', description: 'Any path inside the project', required: true },
+    name: {
+      type: 'string',
+      description: 'Prefix for its task IDs, e.g. api for api:T42 (default: directory name)',
+    },
+  },
+  async run({ args }) {
+    const result = await runOrExit(() =>
+      addWorkspaceProject(String(args.path), { name: args.name as string | undefined }),
+    );
+    cliOutput(result, { command: 'workspace-add', operation: 'workspace.add' });
+  },
+});
+
+const removeCommand = defineCommand({
+  meta: {
+    name: 'remove',
+    description: 'Unregister a project from the workspace (its tasks are untouched)',
+  },
+  args: {
+    name: { type: 'positional', description: 'Workspace project name', required: true },
+  },
+  async run({ args }) {
+    const name = String(args.name);
+    const removed = await runOrExit(() => removeWorkspaceProject(name));
+    cliOutput({ name, removed }, { command: 'workspace-remove', operation: 'workspace.remove' });
+  },
+});
+
+const listCommand = defineCommand({
+  meta: { name: 'list', description: 'List the projects registered in the workspace' },
+  async run() {
+    const { projects } = await runOrExit(() => readWorkspace());
+    cliOutput(
+      { count: projects.length, projects },
+      { command: 'workspace-list', operation: 'workspace.list' },
+    );
+  },
+});
+
+const tasksCommand = defineCommand({
+  meta: {
+    name: 'tasks',
+    description: 'Query tasks across every workspace project (IDs are shown as project:T42)',
+  },
+  args: {
+    where: {
+      type: 'string',
+      description: "Filter expression, e.g. 'status != done and due < 2026-11-01' (see cleo find)",
+    },
+  },
+  async run({ args }) {
+    const where = args.where !== undefined ? [String(args.where)] : undefined;
+    const result = await runOrExit(() => queryWorkspaceTasks({ where }));
+    for (const s of result.skipped) {
+      pushWarning({
+        code: 'W_WORKSPACE_PROJECT_SKIPPED',
+        message: `Skipped ${s.project} (${s.root}): ${s.reason}`,
+      });
+    }
+    cliOutput(result, { command: 'workspace-tasks', operation: 'workspace.tasks' });
+  },
+});
+
+/**
+ * Native citty command for `cleo workspace`.
+ */
+export const workspaceCommand = defineCommand({
+  meta: {
+    name: 'workspace',
+    description: 'Read tasks across several projects: add, remove, list, tasks',
+  },
+  subCommands: {
+    add: addCommand,
+    remove: removeCommand,
+    list: listCommand,
+    tasks: tasksCommand,
+  },
+  async run({ cmd, rawArgs }) {
+    const firstArg = rawArgs?.find((a) => !a.startsWith('-'));
+    if (firstArg && cmd.subCommands && firstArg in cmd.subCommands) return;
+    await showUsage(cmd);
+  },
+});
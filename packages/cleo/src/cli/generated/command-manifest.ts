@@ -90,7 +90,7 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
   {
     exportName: 'archiveCommand',
     name: 'archive',
-    description: 'Archive completed tasks',
+    description: 'Archive completed tasks (list / restore archived ones)',
     load: async () => (await import('../commands/archive.js')).archiveCommand as CommandDef,
   },
   {
@@ -140,6 +140,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'Add backup of todo files or list available backups',
     load: async () => (await import('../commands/backup.js')).backupCommand as CommandDef,
   },
+  {
+    exportName: 'batchCommand',
+    name: 'batch',
+    description: 'Apply several create/update/complete steps atomically (all or nothing) from JSON',
+    load: async () => (await import('../commands/batch.js')).batchCommand as CommandDef,
+  },
   {
     exportName: 'blockersCommand',
     name: 'blockers',
@@ -305,7 +311,7 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
   {
     exportName: 'deleteCommand',
     name: 'delete',
-    description: 'Delete a task (soft delete to archive)',
+    description: 'Delete a task (moves it to the trash; --purge removes it for good)',
     load: async () => (await import('../commands/delete.js')).deleteCommand as CommandDef,
   },
   {
@@ -421,6 +427,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'STUB — auto-generated commands (T4897)',
     load: async () => (await import('../commands/dynamic.js')).dynamicCommand as CommandDef,
   },
+  {
+    exportName: 'epicCommand',
+    name: 'epic',
+    description: 'Epic views — estimate rollup across member tasks',
+    load: async () => (await import('../commands/epic.js')).epicCommand as CommandDef,
+  },
   {
     exportName: 'orchestratorCommand',
     name: 'orchestrator',
@@ -465,10 +477,16 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'Federation peer management: add, remove, list trusted peers',
     load: async () => (await import('../commands/federation.js')).federationCommand as CommandDef,
   },
+  {
+    exportName: 'fieldsCommand',
+    name: 'fields',
+    description: 'Project custom fields: declare them, then use update --set and find --where',
+    load: async () => (await import('../commands/fields.js')).fieldsCommand as CommandDef,
+  },
   {
     exportName: 'findCommand',
     name: 'find',
-    description: 'Fuzzy search tasks by title/description',
+    description: 'Fuzzy or regex search tasks by title/description/notes',
     load: async () => (await import('../commands/find.js')).findCommand as CommandDef,
   },
   {
@@ -497,6 +515,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'Transcript garbage collection: manual trigger and status',
     load: async () => (await import('../commands/gc.js')).gcCommand as CommandDef,
   },
+  {
+    exportName: 'gitCommand',
+    name: 'git',
+    description: 'Git integration: scan commit messages for task IDs and link the commits',
+    load: async () => (await import('../commands/git.js')).gitCommand as CommandDef,
+  },
   {
     exportName: 'goCommand',
     name: 'go',
@@ -544,7 +568,7 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
   {
     exportName: 'importCommand',
     name: 'import',
-    description: 'Import tasks from export package',
+    description: 'Import tasks from export package, or a Markdown checklist (import markdown)',
     load: async () => (await import('../commands/import.js')).importCommand as CommandDef,
   },
   {
@@ -598,6 +622,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'RCASD-IVTR+C lifecycle pipeline management',
     load: async () => (await import('../commands/lifecycle.js')).lifecycleCommand as CommandDef,
   },
+  {
+    exportName: 'lintCommand',
+    name: 'lint',
+    description: 'Check task data for structural invariant violations (exits non-zero on any)',
+    load: async () => (await import('../commands/lint.js')).lintCommand as CommandDef,
+  },
   {
     exportName: 'listCommand',
     name: 'list',
@@ -701,6 +731,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
       'Lightweight token metrics from .cleo/metrics/TOKEN_USAGE.jsonl (session-level, spawn-level events)',
     load: async () => (await import('../commands/otel.js')).otelCommand as CommandDef,
   },
+  {
+    exportName: 'overdueCommand',
+    name: 'overdue',
+    description: 'List tasks past their due date that are not done',
+    load: async () => (await import('../commands/overdue.js')).overdueCommand as CommandDef,
+  },
   {
     exportName: 'phaseCommand',
     name: 'phase',
@@ -813,6 +849,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'Move task to a different parent in hierarchy',
     load: async () => (await import('../commands/reparent.js')).reparentCommand as CommandDef,
   },
+  {
+    exportName: 'reportCommand',
+    name: 'report',
+    description: 'Progress reports over time (burndown)',
+    load: async () => (await import('../commands/report.js')).reportCommand as CommandDef,
+  },
   {
     exportName: 'reqCommand',
     name: 'req',
@@ -933,6 +975,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'Export/import task state snapshots for multi-contributor sharing',
     load: async () => (await import('../commands/snapshot.js')).snapshotCommand as CommandDef,
   },
+  {
+    exportName: 'staleCommand',
+    name: 'stale',
+    description: 'List tasks left in one status without updates (--reset returns them to pending)',
+    load: async () => (await import('../commands/stale.js')).staleCommand as CommandDef,
+  },
   {
     exportName: 'startCommand',
     name: 'start',
@@ -972,7 +1020,7 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
   {
     exportName: 'tasksCommand',
     name: 'tasks',
-    description: 'Task query namespace: show, find, next, current, plan, analyze, slice',
+    description: 'Task namespace: show, find, next, current, plan, analyze, slice, tree, move, merge, split, block, unblock, note, commits, link-commit, renumber, set-status, start, stop, time',
     load: async () => (await import('../commands/tasks.js')).tasksCommand as CommandDef,
   },
   {
@@ -1007,6 +1055,12 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'Transcript lifecycle management: scan, extract, and prune session transcripts',
     load: async () => (await import('../commands/transcript.js')).transcriptCommand as CommandDef,
   },
+  {
+    exportName: 'trashCommand',
+    name: 'trash',
+    description: 'Deleted tasks: list, restore, empty',
+    load: async () => (await import('../commands/trash.js')).trashCommand as CommandDef,
+  },
   {
     exportName: 'tuiCommand',
     name: 'tui',
@@ -1014,6 +1068,19 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
       'Launch the Pi-powered terminal cockpit (keyboard-first Kanban over the daemon /v1 gateway)',
     load: async () => (await import('../commands/tui.js')).tuiCommand as CommandDef,
   },
+  {
+    exportName: 'undoCommand',
+    name: 'undo',
+    description:
+      'Undo the last task mutation (restores the prior state from the operation journal)',
+    load: async () => (await import('../commands/undo.js')).undoCommand as CommandDef,
+  },
+  {
+    exportName: 'redoCommand',
+    name: 'redo',
+    description: 'Re-apply the last mutation undone with cleo undo',
+    load: async () => (await import('../commands/undo.js')).redoCommand as CommandDef,
+  },
   {
     exportName: 'updateCommand',
     name: 'update',
@@ -1034,18 +1101,36 @@ export const COMMAND_MANIFEST: readonly CommandManifestEntry[] = [
     description: 'View or modify verification gates for a task',
     load: async () => (await import('../commands/verify.js')).verifyCommand as CommandDef,
   },
+  {
+    exportName: 'watchCommand',
+    name: 'watch',
+    description: 'Live feed of task changes (created, status transitions, edits)',
+    load: async () => (await import('../commands/watch.js')).watchCommand as CommandDef,
+  },
   {
     exportName: 'webCommand',
     name: 'web',
     description: 'Open CLEO Studio in the browser (starts gateway on demand)',
     load: async () => (await import('../commands/web.js')).webCommand as CommandDef,
   },
+  {
+    exportName: 'webhookCommand',
+    name: 'webhook',
+    description: 'Task lifecycle webhooks (configure with: cleo config set webhook.url <url>)',
+    load: async () => (await import('../commands/webhook.js')).webhookCommand as CommandDef,
+  },
   {
     exportName: 'workgraphCommand',
     name: 'workgraph',
     description: 'PM-Core V2 WorkGraph operations — validate, apply, plan, structure, status',
     load: async () => (await import('../commands/workgraph.js')).workgraphCommand as CommandDef,
   },
+  {
+    exportName: 'workspaceCommand',
+    name: 'workspace',
+    description: 'Read tasks across several projects: add, remove, list, tasks',
+    load: async () => (await import('../commands/workspace.js')).workspaceCommand as CommandDef,
+  },
   {
     exportName: 'worktreeCommand',
     name: 'worktree',
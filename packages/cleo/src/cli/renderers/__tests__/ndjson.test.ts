@@ -0,0 +1,67 @@
+/**
+ * Tests for the NDJSON streaming renderer behind `cleo list --ndjson` and
+ * `cleo find --ndjson`.
+ */
+
+import { PassThrough } from 'node:stream';
+import { describe, expect, it } from 'vitest';
+import { streamNdjson } from '../ndjson.js';
+
+/** Collect everything written to a PassThrough as parsed NDJSON lines. */
+function capture(): { out: PassThrough; lines: () => Record<string, unknown>[] } {
+  const out = new PassThrough();
+  const chunks: string[] = [];
+  out.on('data', (chunk: Buffer) => chunks.push(chunk.toString('utf8')));
+  return {
+    out,
+    lines: () =>
+      chunks
+        .join('')
+        .split('\n')
+        .filter((l) => l.length > 0)
+        .map((l) => JSON.parse(l) as Record<string, unknown>),
+  };
+}
+
+/** `n` task-shaped records. */
+function records(n: number): Array<{ id: string }> {
+  return Array.from({ length: n }, (_, i) => ({ id: `T${String(i + 1).padStart(3, '0')}` }));
+}
+
+describe('streamNdjson', () => {
+  it('writes one record per line, then a summary line', async () => {
+    const { out, lines } = capture();
+
+    const page = { items: records(5), summary: { total: 9, filtered: 5 } };
+    const count = await streamNdjson(page, out);
+
+    expect(count).toBe(5);
+    const written = lines();
+    expect(written.slice(0, 5).map((l) => l.id)).toEqual(['T001', 'T002', 'T003', 'T004', 'T005']);
+    expect(written[5]).toEqual({ _summary: true, total: 9, filtered: 5, count: 5 });
+  });
+
+  it('waits for drain when the destination is full', async () => {
+    const out = new PassThrough({ highWaterMark: 16 });
+    const done = streamNdjson({ items: records(50), summary: {} }, out);
+    let settled = false;
+    void done.then(() => {
+      settled = true;
+    });
+    await new Promise((resolve) => setImmediate(resolve));
+    expect(settled).toBe(false);
+
+    const chunks: string[] = [];
+    out.on('data', (chunk: Buffer) => chunks.push(chunk.toString('utf8')));
+    expect(await done).toBe(50);
+    expect(chunks.join('').trim().split('\n')).toHaveLength(51);
+  });
+
+  it('emits only the summary line when nothing matches', async () => {
+    const { out, lines } = capture();
+    const count = await streamNdjson({ items: [], summary: { total: 0, filtered: 0 } }, out);
+
+    expect(count).toBe(0);
+    expect(lines()).toEqual([{ _summary: true, total: 0, filtered: 0, count: 0 }]);
+  });
+});
@@ -127,11 +127,20 @@ import {
   renderArchive,
   renderComplete,
   renderDelete,
+  renderExportContent,
   renderFind,
+  renderGraphExport,
+  renderLint,
   renderList,
   renderRestore,
+  renderSagaExport,
   renderShow,
+  renderUndo,
   renderUpdate,
+  renderWorkspaceAdd,
+  renderWorkspaceList,
+  renderWorkspaceRemove,
+  renderWorkspaceTasks,
 } from '@cleocode/core';
 
 // ---------------------------------------------------------------------------
@@ -155,6 +164,16 @@ const renderers: Record<string, HumanRenderer> = {
   rm: renderDelete,
   archive: renderArchive,
   restore: renderRestore,
+  undo: renderUndo,
+  redo: renderUndo,
+  'saga-export': renderSagaExport,
+  lint: renderLint,
+  'export-content': renderExportContent,
+  'graph-export': renderGraphExport,
+  'workspace-add': renderWorkspaceAdd,
+  'workspace-remove': renderWorkspaceRemove,
+  'workspace-list': renderWorkspaceList,
+  'workspace-tasks': renderWorkspaceTasks,
 
   // Task work
   start: renderStart,
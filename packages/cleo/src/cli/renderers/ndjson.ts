@@ -0,0 +1,58 @@
+/**
+ * NDJSON streaming renderer for large list surfaces (`cleo list --ndjson`,
+ * `cleo find --ndjson`).
+ *
+ * Instead of one envelope holding every record, the result of a single
+ * dispatch is written as one JSON object per line, honouring stdout
+ * backpressure so a slow consumer never makes this process buffer the whole
+ * serialized output. The query runs once, so every line comes from the same
+ * snapshot of the task store. The envelope counts follow as a final line
+ * marked `"_summary": true`:
+ *
+ *   {"id":"T001","title":"…","status":"pending",…}
+ *   {"id":"T002","title":"…","status":"active",…}
+ *   {"_summary":true,"total":3000,"filtered":2,"count":2}
+ */
+
+/** The records of one dispatch plus the envelope counts reported alongside them. */
+export interface NdjsonPage {
+  /** Records in result order. */
+  items: unknown[];
+  /** Envelope fields for the summary line (e.g. `total`, `filtered`). */
+  summary: Record<string, unknown>;
+}
+
+/**
+ * Write `value` as a single NDJSON line, waiting for `drain` when the
+ * destination's buffer is full.
+ */
+export async function writeNdjsonLine(
+  value: unknown,
+  out: NodeJS.WritableStream = process.stdout,
+): Promise<void> {
+  if (!out.write(`${JSON.stringify(value)}\n`)) {
+    await new Promise<void>((resolve) => out.once('drain', () => resolve()));
+  }
+}
+
+/**
+ * Stream records as NDJSON, then the `_summary` line.
+ *
+ * The summary line merges `page.summary` with `count` — the number of
+ * records emitted.
+ *
+ * @param page - Records and envelope counts from one dispatch.
+ * @param out - Destination stream (default `process.stdout`).
+ * @returns The number of records emitted.
+ */
+export async function streamNdjson(
+  page: NdjsonPage,
+  out: NodeJS.WritableStream = process.stdout,
+): Promise<number> {
+  for (const item of page.items) {
+    await writeNdjsonLine(item, out);
+  }
+  const count = page.items.length;
+  await writeNdjsonLine({ _summary: true, ...page.summary, count }, out);
+  return count;
+}
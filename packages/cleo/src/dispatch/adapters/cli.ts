@@ -20,6 +20,7 @@ import {
   describeOperation,
   getProjectRoot,
   hooks,
+  installWebhookEmitter,
 } from '@cleocode/core/internal';
 import type { GatewayHandler } from '@cleocode/runtime/gateway';
 import { createDispatchSpinner } from '../../cli/animation-bridge.js';
@@ -32,6 +33,9 @@ import { createAudit } from '../middleware/audit.js';
 import { createBudgetEnforcement } from '../middleware/budget-enforcement.js';
 import { createFieldFilter } from '../middleware/field-filter.js';
 import { createIdempotency } from '../middleware/idempotency.js';
+import { createMutateHooks } from '../middleware/mutate-hooks.js';
+import { createMutateJournal } from '../middleware/mutate-journal.js';
+import { createMutateLock } from '../middleware/mutate-lock.js';
 import { createMutateMinimalEnvelope } from '../middleware/mutate-minimal-envelope.js';
 import { createMviRecordProjection } from '../middleware/mvi-record-projection.js';
 import { createSanitizer } from '../middleware/sanitizer.js';
@@ -205,6 +209,8 @@ async function lookupCliSession(): Promise<string | null> {
 export function createCliDispatcher(): Dispatcher {
   // Ensure the CAAMP skill catalog is available for tools domain operations
   ensureCaampLibrary();
+  // POST task lifecycle events to `webhook.url` when one is configured.
+  installWebhookEmitter();
 
   const handlers = createDomainHandlers();
   return new Dispatcher({
@@ -212,6 +218,15 @@ export function createCliDispatcher(): Dispatcher {
     middlewares: [
       createSessionResolver(lookupCliSession), // T4959: session identity first
       createSanitizer(() => getProjectRoot()),
+      // One writer per project: every mutate (including audit + idempotency
+      // bookkeeping below) runs under the project's exclusive mutate lock.
+      // Queries pass through unlocked.
+      createMutateLock(() => getProjectRoot()),
+      // Project `.cleo/hooks/pre-mutate` / `post-mutate` scripts, inside the
+      // lock so their before/after snapshots match the write.
+      createMutateHooks(() => getProjectRoot()),
+      // `.cleo/journal.ndjson` entry per task-changing mutate, for `cleo undo`.
+      createMutateJournal(() => getProjectRoot()),
       createFieldFilter(),
       // T9922 (Saga T9855 / E8.3): MVI record projection default for read ops.
       // Runs AFTER the domain handler returns so it can trim the data payload
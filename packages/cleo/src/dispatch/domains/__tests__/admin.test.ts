@@ -247,6 +247,7 @@ describe('AdminHandler', () => {
         'import',
         'detect',
         'map',
+        'webhook.test',
       ]);
     });
   });
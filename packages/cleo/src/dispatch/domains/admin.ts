@@ -68,6 +68,7 @@ import {
   syncAdrsToDb,
   systemCreateBackup,
   validateAllAdrs,
+  webhookTest,
   writeSnapshot,
 } from '@cleocode/core/internal';
 import {
@@ -86,6 +87,7 @@ import {
   lafsSuccess,
   type OpsFromCore,
   typedDispatch,
+  wrapCoreResult,
 } from '../adapters/typed.js';
 import { OPERATIONS } from '../registry.js';
 import type { DispatchResponse, DomainHandler } from '../types.js';
@@ -1242,6 +1244,15 @@ const _adminTypedHandler = defineTypedHandler<AdminOps>('admin', {
     const templateResult = await ensureGlobalTemplates();
     return lafsSuccess({ scaffold: scaffoldResult, templates: templateResult }, 'install.global');
   },
+
+  // POST a synthetic task event to the configured (or given) webhook receiver.
+  'webhook.test': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await webhookTest(projectRoot, { url: params.url, event: params.event }),
+      'webhook.test',
+    );
+  },
 });
 
 // ---------------------------------------------------------------------------
@@ -1298,6 +1309,7 @@ const MUTATE_OPS = new Set<string>([
   'context.inject',
   'map',
   'install.global',
+  'webhook.test',
 ]);
 
 // ---------------------------------------------------------------------------
@@ -1517,6 +1529,7 @@ export class AdminHandler implements DomainHandler {
         'import',
         'detect',
         'map',
+        'webhook.test',
       ],
     };
   }
@@ -54,7 +54,7 @@ import type {
   LlmOutputMode,
 } from '@cleocode/contracts/operations/docs';
 import { LLM_OUTPUT_MODES } from '@cleocode/contracts/operations/docs';
-import { pushWarning } from '@cleocode/core';
+import { isPrefixedTaskId, pushWarning } from '@cleocode/core';
 import type {
   AttachmentRef,
   ExportDocumentOptions,
@@ -229,7 +229,7 @@ type DocsTypedOps = {
  * @param ownerId - Raw owner entity ID string
  */
 function inferOwnerType(ownerId: string): AttachmentRef['ownerType'] {
-  if (/^T\d+$/i.test(ownerId)) return 'task';
+  if (/^T\d+$/i.test(ownerId) || isPrefixedTaskId(ownerId)) return 'task';
   if (ownerId.startsWith('ses_')) return 'session';
   if (ownerId.startsWith('O-')) return 'observation';
   // Broader prefixes for other BRAIN entity types
@@ -716,7 +716,7 @@ const _docsTypedHandler = defineTypedHandler<DocsTypedOps>('docs', {
       }
       mode = raw as LlmOutputMode;
     } else {
-      mode = /^T\d+$/i.test(forId) ? 'task-export' : 'attachment-bundle';
+      mode = /^T\d+$/i.test(forId) || isPrefixedTaskId(forId) ? 'task-export' : 'attachment-bundle';
     }
     const cwd = getProjectRoot();
     if (mode === 'task-export') {
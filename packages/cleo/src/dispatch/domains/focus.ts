@@ -65,8 +65,8 @@ function roughTokenCount(value: unknown): number {
   }
 }
 
-/** Pattern for a valid CLEO task ID (`T####`). */
-const TASK_ID_RE = /^T\d+$/i;
+/** Pattern for a valid CLEO task ID (`T####`, or `PREFIX-#` under a prefixed saga). */
+const TASK_ID_RE = /^(?:T\d+|[A-Z]{2,10}-\d+)$/i;
 
 /**
  * Fetch up to 5 recent git commits mentioning `taskId` via `git log --grep`.
@@ -25,6 +25,7 @@ import {
   CLEO_DIR_NAME,
   orchestrateAnalyze,
   orchestrateBootstrap,
+  orchestrateClaim,
   orchestrateContext,
   orchestrateHandoff,
   orchestrateNext,
@@ -33,10 +34,12 @@ import {
   orchestratePlan,
   orchestrateReady,
   orchestrateReport,
+  orchestrateSequence,
   orchestrateSpawn,
   orchestrateSpawnExecute,
   orchestrateStartup,
   orchestrateStatus,
+  orchestrateTracks,
   orchestrateUnblockOpportunities,
   orchestrateValidate,
   orchestrateWaves,
@@ -72,6 +75,10 @@ interface OrchestrateNextParams {
   epicId: string;
 }
 
+interface OrchestrateTracksParams {
+  epicId: string;
+}
+
 interface OrchestrateReadyParams {
   epicId: string;
   /** CLI-only bypass flag. When true, skips dep-graph validation and audit-logs the bypass. */
@@ -82,6 +89,12 @@ interface OrchestrateReadyParams {
    * auto-detects saga-labeled epics.
    */
   via?: 'parent' | 'saga' | 'both';
+  /** Ready-set ordering; `'priority'` orders critical → low, ties by task ID. */
+  sort?: 'priority';
+  /** When set, only tasks that are unassigned or assigned to this agent are ready. */
+  assignee?: string;
+  /** Tag ready tasks with their track and interleave the tracks. */
+  trackBalance?: boolean;
 }
 
 interface OrchestrateAnalyzeParams {
@@ -128,6 +141,10 @@ interface OrchestratePlanParams {
   preferTier?: 0 | 1 | 2;
 }
 
+interface OrchestrateSequenceParams {
+  sagaId?: string;
+}
+
 interface OrchestrateBootstrapParams {
   speed?: 'fast' | 'full' | 'complete';
 }
@@ -145,6 +162,11 @@ interface OrchestrateStartParams {
   epicId: string;
 }
 
+interface OrchestrateClaimParams {
+  taskId: string;
+  assignee: string;
+}
+
 interface OrchestrateSpawnParams {
   taskId: string;
   protocolType?: string;
@@ -165,6 +187,8 @@ interface OrchestrateSpawnParams {
    * locked worktree at the canonical XDG path.
    */
   resume?: boolean;
+  /** Claim the task for this assignee before spawning; fails when claimed by another. */
+  assignee?: string;
 }
 
 interface OrchestrateHandoffParams {
@@ -310,9 +334,16 @@ async function orchestrateReadyOp(params: OrchestrateReadyParams) {
   return orchestrateReady(params.epicId, getProjectRoot(), {
     ignoreDepsValidate: params.ignoreDepsValidate,
     via: params.via,
+    sort: params.sort,
+    assignee: params.assignee,
+    trackBalance: params.trackBalance,
   });
 }
 
+async function orchestrateTracksOp(params: OrchestrateTracksParams) {
+  return orchestrateTracks(params.epicId, getProjectRoot());
+}
+
 async function orchestrateReportOp(params: OrchestrateReportParams) {
   return orchestrateReport(params.epicId, getProjectRoot(), params);
 }
@@ -375,6 +406,10 @@ async function orchestratePlanOp(params: OrchestratePlanParams) {
   });
 }
 
+async function orchestrateSequenceOp(params: OrchestrateSequenceParams) {
+  return orchestrateSequence({ projectRoot: getProjectRoot(), sagaId: params.sagaId });
+}
+
 async function orchestrateBootstrapOp(params: OrchestrateBootstrapParams) {
   return orchestrateBootstrap(getProjectRoot(), { speed: params.speed });
 }
@@ -405,9 +440,14 @@ async function orchestrateSpawnOp(params: OrchestrateSpawnParams) {
     params.spawnScope,
     params.atomicityScope,
     params.resume,
+    params.assignee,
   );
 }
 
+async function orchestrateClaimOp(params: OrchestrateClaimParams) {
+  return orchestrateClaim(params.taskId, params.assignee, getProjectRoot());
+}
+
 async function orchestrateHandoffOp(params: OrchestrateHandoffParams) {
   return orchestrateHandoff(
     {
@@ -504,6 +544,7 @@ const coreOps = {
   status: orchestrateStatusOp,
   next: orchestrateNextOp,
   ready: orchestrateReadyOp,
+  tracks: orchestrateTracksOp,
   report: orchestrateReportOp,
   analyze: orchestrateAnalyzeOp,
   classify: orchestrateClassifyOp,
@@ -511,12 +552,14 @@ const coreOps = {
   context: orchestrateContextOp,
   waves: orchestrateWavesOp,
   plan: orchestratePlanOp,
+  sequence: orchestrateSequenceOp,
   bootstrap: orchestrateBootstrapOp,
   'unblock.opportunities': orchestrateUnblockOp,
   'ivtr.status': orchestrateIvtrStatusOp,
   pending: orchestratePendingOp,
   start: orchestrateStartOp,
   spawn: orchestrateSpawnOp,
+  claim: orchestrateClaimOp,
   handoff: orchestrateHandoffOp,
   'spawn.execute': orchestrateSpawnExecuteOp,
   validate: orchestrateValidateOp,
@@ -587,10 +630,27 @@ export class OrchestrateHandler implements DomainHandler {
             epicId: params.epicId as string,
             ignoreDepsValidate: params.ignoreDepsValidate as boolean | undefined,
             ...(via !== undefined && { via }),
+            ...(params.sort === 'priority' && { sort: 'priority' as const }),
+            ...(typeof params.assignee === 'string' && { assignee: params.assignee }),
+            ...(params.trackBalance === true && { trackBalance: true }),
           };
           return wrapResult(await coreOps.ready(p), 'query', 'orchestrate', operation, startTime);
         }
 
+        case 'tracks': {
+          if (!params?.epicId)
+            return errorResult(
+              'query',
+              'orchestrate',
+              operation,
+              'E_INVALID_INPUT',
+              'epicId is required',
+              startTime,
+            );
+          const p: OrchestrateTracksParams = { epicId: params.epicId as string };
+          return wrapResult(await coreOps.tracks(p), 'query', 'orchestrate', operation, startTime);
+        }
+
         case 'report': {
           if (!params?.epicId)
             return errorResult(
@@ -714,6 +774,17 @@ export class OrchestrateHandler implements DomainHandler {
           return wrapResult(await coreOps.plan(p), 'query', 'orchestrate', operation, startTime);
         }
 
+        case 'sequence': {
+          const p: OrchestrateSequenceParams = { sagaId: params?.sagaId as string | undefined };
+          return wrapResult(
+            await coreOps.sequence(p),
+            'query',
+            'orchestrate',
+            operation,
+            startTime,
+          );
+        }
+
         case 'bootstrap': {
           const p: OrchestrateBootstrapParams = {
             speed: params?.speed as 'fast' | 'full' | 'complete' | undefined,
@@ -807,10 +878,28 @@ export class OrchestrateHandler implements DomainHandler {
             noWorktree: params.noWorktree as boolean | undefined,
             spawnScope: params.spawnScope as string | undefined,
             ...(atomicityScope ? { atomicityScope } : {}),
+            ...(typeof params.assignee === 'string' ? { assignee: params.assignee } : {}),
           };
           return wrapResult(await coreOps.spawn(p), 'mutate', 'orchestrate', operation, startTime);
         }
 
+        case 'claim': {
+          if (!params?.taskId || !params.assignee)
+            return errorResult(
+              'mutate',
+              'orchestrate',
+              operation,
+              'E_INVALID_INPUT',
+              'taskId and assignee are required',
+              startTime,
+            );
+          const p: OrchestrateClaimParams = {
+            taskId: params.taskId as string,
+            assignee: params.assignee as string,
+          };
+          return wrapResult(await coreOps.claim(p), 'mutate', 'orchestrate', operation, startTime);
+        }
+
         case 'handoff': {
           if (!params?.taskId)
             return errorResult(
@@ -1121,10 +1210,12 @@ export class OrchestrateHandler implements DomainHandler {
         'status',
         'next',
         'ready',
+        'tracks',
         'analyze',
         'context',
         'waves',
         'plan',
+        'sequence',
         'bootstrap',
         'unblock.opportunities',
         'classify',
@@ -1135,6 +1226,7 @@ export class OrchestrateHandler implements DomainHandler {
       mutate: [
         'start',
         'spawn',
+        'claim',
         'handoff',
         'spawn.execute',
         'validate',
@@ -7,7 +7,8 @@
  * promote, reorder, relates.add, relates.remove, start, stop,
  * sync.reconcile, sync.links, sync.links.remove,
  * saga.create, saga.add, saga.detach, saga.list, saga.members, saga.rollup,
- * saga.repair, saga.reconcile.
+ * saga.critical-path, saga.deps, saga.schedule, saga.export, saga.import, saga.repair,
+ * saga.reconcile.
  *
  * Query operations delegate to task-engine; start/stop/current delegate
  * to session-engine (which hosts task-work functions).
@@ -32,60 +33,100 @@ import { taskContext } from '@cleocode/core/internal';
 import {
   sagaAdd as coreSagaAdd,
   sagaCreate as coreSagaCreate,
+  sagaCriticalPath as coreSagaCriticalPath,
+  sagaDeps as coreSagaDeps,
   detachSagaMember as coreSagaDetach,
+  sagaExport as coreSagaExport,
+  sagaImport as coreSagaImport,
   sagaList as coreSagaList,
   sagaMembers as coreSagaMembers,
   reconcileSaga as coreSagaReconcile,
   repairSaga as coreSagaRepair,
   sagaRollup as coreSagaRollup,
+  sagaSchedule as coreSagaSchedule,
 } from '@cleocode/core/sagas';
 import {
   addTaskWithSessionScope,
   completeTaskStrict,
   taskAnalyze,
   taskArchive,
+  taskArchiveList,
+  taskArchiveRestore,
   // T11786 (epic T11556) — bulk task mutate ops Studio's Kanban binds to.
   taskAssignee,
+  taskBlock,
   taskBlockers,
   taskBulkMove,
+  taskBurndown,
   taskCancel,
   taskClaim,
+  taskCommits,
+  taskCommitsScan,
   taskComplexityEstimate,
   taskCurrentGet,
   taskDelete,
   taskDepends,
   taskDepsCycles,
   taskDepsOverview,
+  taskDepsTrace,
   taskDepsTree,
   taskDepsValidate,
+  taskEstimateRollup,
+  taskFieldsAdd,
+  taskFieldsList,
   taskFind,
+  taskGraph,
   taskHistory,
   taskImpact,
+  taskImportMarkdown,
   taskLabelList,
+  taskLabelMerge,
+  taskLabelRename,
+  taskLinkCommit,
   taskList,
+  taskMerge,
+  taskMove,
   taskNext,
+  taskNote,
+  taskOverdue,
   taskPlan,
+  taskPurge,
+  taskRedo,
   taskRelates,
   taskRelatesAdd,
   taskRelatesAddBatch,
   taskRelatesFind,
   taskRelatesRemove,
+  taskRenumber,
   taskReopen,
   taskReorder,
   taskReorderRank,
   taskReparent,
   taskRestore,
+  taskSetStatus,
   taskShowOperation,
   taskSlice,
+  taskSplit,
+  taskStale,
+  taskStaleReset,
   taskStart,
   taskStop,
   taskSyncLinks,
   taskSyncLinksRemove,
   taskSyncReconcile,
   tasksAddBatchOp,
+  tasksBatchOp,
+  taskTime,
+  taskTimeStart,
+  taskTimeStop,
+  taskTrashEmpty,
+  taskTrashList,
+  taskTrashRestore,
   taskTree,
   taskUnarchive,
+  taskUnblock,
   taskUnclaim,
+  taskUndo,
   taskUpdate,
   taskWorkHistory,
 } from '@cleocode/runtime/gateway';
@@ -148,6 +189,7 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
       limit: params.limit,
       offset: params.offset,
       compact: params.compact,
+      sort: params.sort,
     });
     if (!result.success) {
       return lafsError(
@@ -184,6 +226,11 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
         // resolveSagaMemberIds (ADR-073 §1) so saga members surface through
         // the same routing as `cleo list --parent`.
         parent: params.parent,
+        sort: params.sort,
+        field: params.field,
+        regex: params.regex,
+        type: params.type,
+        where: params.where,
       }),
       'find',
     );
@@ -258,6 +305,14 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
     );
   },
 
+  'deps.trace': async (params) => {
+    const projectRoot = getProjectRoot();
+    if (!params.taskId) {
+      return lafsError('E_INVALID_INPUT', 'taskId is required for deps.trace', 'deps.trace');
+    }
+    return wrapCoreResult(await taskDepsTrace(projectRoot, params), 'deps.trace');
+  },
+
   analyze: async (params) => {
     const projectRoot = getProjectRoot();
     return wrapCoreResult(
@@ -331,6 +386,80 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
     return wrapCoreResult(await taskLabelList(projectRoot), 'label.list');
   },
 
+  'trash.list': async (_params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskTrashList(projectRoot), 'trash.list');
+  },
+
+  'archive.list': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskArchiveList(projectRoot, { sagaId: params.sagaId, since: params.since }),
+      'archive.list',
+    );
+  },
+
+  overdue: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskOverdue(projectRoot, { asOf: params.asOf }), 'overdue');
+  },
+
+  stale: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskStale(projectRoot, { status: params.status, olderThan: params.olderThan }),
+      'stale',
+    );
+  },
+
+  commits: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskCommits(projectRoot, { taskId: params.taskId }), 'commits');
+  },
+
+  time: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskTime(projectRoot, { taskId: params.taskId }), 'time');
+  },
+
+  'fields.list': async () => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskFieldsList(projectRoot), 'fields.list');
+  },
+
+  'estimate.rollup': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskEstimateRollup(projectRoot, { taskId: params.taskId, type: params.type }),
+      'estimate.rollup',
+    );
+  },
+
+  burndown: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskBurndown(projectRoot, {
+        sagaId: params.sagaId,
+        from: params.from,
+        to: params.to,
+        bucket: params.bucket,
+      }),
+      'burndown',
+    );
+  },
+
+  graph: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskGraph(projectRoot, {
+        sagaId: params.sagaId,
+        epicId: params.epicId,
+        format: params.format,
+      }),
+      'graph',
+    );
+  },
+
   'sync.links': async (params) => {
     const projectRoot = getProjectRoot();
     return wrapCoreResult(await taskSyncLinks(projectRoot, params), 'sync.links');
@@ -352,6 +481,17 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
     );
   },
 
+  batch: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await tasksBatchOp(projectRoot, {
+        operations: params.operations ?? [],
+        dryRun: typeof params.dryRun === 'boolean' ? params.dryRun : undefined,
+      }),
+      'batch',
+    );
+  },
+
   add: async (params) => {
     const projectRoot = getProjectRoot();
     return wrapCoreResult(
@@ -374,6 +514,10 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
         kind: params.kind,
         scope: params.scope,
         severity: params.severity,
+        due: params.due,
+        recurrence: params.recurrence,
+        estimate: params.estimate,
+        track: params.track,
         // T1633: BRAIN duplicate-bypass flag
         forceDuplicate: params.forceDuplicate,
       }),
@@ -412,6 +556,14 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
         scope: params.scope,
         // T9073: severity — orthogonal to priority, valid for any kind
         severity: params.severity,
+        // Due date — an empty string clears it
+        due: params.due,
+        recurrence: params.recurrence,
+        estimate: params.estimate,
+        track: params.track,
+        assignee: params.assignee,
+        // Custom field assignments — validated against `cleo fields`
+        set: params.set,
         // T1590: AC-immutability override reason
         reason: params.reason,
         // T9241 / gh#1106: set/clear the free-text blockedBy reason. The set
@@ -424,6 +576,8 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
         relates: params.relates,
         addRelates: params.addRelates,
         removeRelates: params.removeRelates,
+        autoCompleteParent: params.autoCompleteParent,
+        completionRequirements: params.completionRequirements,
       }),
       'update',
     );
@@ -482,6 +636,10 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
 
   delete: async (params) => {
     const projectRoot = getProjectRoot();
+    // SSoT-EXEMPT: purge routes to the hard-delete engine fn instead of the trash
+    if (params.purge) {
+      return wrapCoreResult(await taskPurge(projectRoot, params.taskId, params.force), 'delete');
+    }
     return wrapCoreResult(await taskDelete(projectRoot, params.taskId, params.force), 'delete');
   },
 
@@ -535,6 +693,210 @@ const _tasksTypedHandler = defineTypedHandler<TasksOps>('tasks', {
     );
   },
 
+  move: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskMove(projectRoot, {
+        taskId: params.taskId,
+        toSaga: params.toSaga,
+        toEpic: params.toEpic,
+        recursive: params.recursive,
+        force: params.force,
+      }),
+      'move',
+    );
+  },
+
+  merge: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskMerge(projectRoot, {
+        taskId: params.taskId,
+        duplicates: params.duplicates,
+      }),
+      'merge',
+    );
+  },
+
+  split: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskSplit(projectRoot, {
+        taskId: params.taskId,
+        into: params.into,
+        estimates: params.estimates,
+      }),
+      'split',
+    );
+  },
+
+  'trash.restore': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskTrashRestore(projectRoot, { taskId: params.taskId, toSaga: params.toSaga }),
+      'trash.restore',
+    );
+  },
+
+  'archive.restore': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskArchiveRestore(projectRoot, { taskId: params.taskId, toSaga: params.toSaga }),
+      'archive.restore',
+    );
+  },
+
+  'trash.empty': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskTrashEmpty(projectRoot, { olderThan: params.olderThan }),
+      'trash.empty',
+    );
+  },
+
+  'stale.reset': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskStaleReset(projectRoot, { status: params.status, olderThan: params.olderThan }),
+      'stale.reset',
+    );
+  },
+
+  block: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskBlock(projectRoot, {
+        taskId: params.taskId,
+        reason: params.reason,
+        until: params.until,
+      }),
+      'block',
+    );
+  },
+
+  unblock: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskUnblock(projectRoot, { taskId: params.taskId }), 'unblock');
+  },
+
+  note: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskNote(projectRoot, {
+        taskId: params.taskId,
+        text: params.text,
+        author: params.author,
+      }),
+      'note',
+    );
+  },
+
+  'link-commit': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskLinkCommit(projectRoot, { taskId: params.taskId, sha: params.sha }),
+      'link-commit',
+    );
+  },
+
+  'commits.scan': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskCommitsScan(projectRoot, { since: params.since }),
+      'commits.scan',
+    );
+  },
+
+  'fields.add': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskFieldsAdd(projectRoot, {
+        name: params.name,
+        type: params.type,
+        values: params.values,
+      }),
+      'fields.add',
+    );
+  },
+
+  'import.markdown': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskImportMarkdown(projectRoot, {
+        file: params.file,
+        saga: params.saga,
+        dryRun: params.dryRun,
+      }),
+      'import.markdown',
+    );
+  },
+
+  renumber: async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskRenumber(projectRoot, {
+        saga: params.saga,
+        start: params.start,
+        dryRun: params.dryRun,
+      }),
+      'renumber',
+    );
+  },
+
+  undo: async () => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskUndo(projectRoot), 'undo');
+  },
+
+  redo: async () => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskRedo(projectRoot), 'redo');
+  },
+
+  'set-status': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskSetStatus(projectRoot, {
+        status: params.status,
+        where: params.where,
+        label: params.label,
+        saga: params.saga,
+        force: params.force,
+        dryRun: params.dryRun,
+      }),
+      'set-status',
+    );
+  },
+
+  'time.start': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskTimeStart(projectRoot, { taskId: params.taskId }),
+      'time.start',
+    );
+  },
+
+  'time.stop': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(await taskTimeStop(projectRoot, { taskId: params.taskId }), 'time.stop');
+  },
+
+  'label.rename': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskLabelRename(projectRoot, { from: params.from, to: params.to }),
+      'label.rename',
+    );
+  },
+
+  'label.merge': async (params) => {
+    const projectRoot = getProjectRoot();
+    return wrapCoreResult(
+      await taskLabelMerge(projectRoot, { sources: params.sources, dest: params.dest }),
+      'label.merge',
+    );
+  },
+
   reorder: async (params) => {
     const projectRoot = getProjectRoot();
     return wrapCoreResult(
@@ -665,6 +1027,7 @@ const QUERY_OPS = new Set<string>([
   'context',
   'deps.validate',
   'deps.tree',
+  'deps.trace',
   'analyze',
   'impact',
   'next',
@@ -674,16 +1037,31 @@ const QUERY_OPS = new Set<string>([
   'history',
   'current',
   'label.list',
+  'trash.list',
+  'archive.list',
+  'overdue',
+  'stale',
+  'commits',
+  'time',
+  'fields.list',
+  'estimate.rollup',
+  'burndown',
+  'graph',
   'sync.links',
   // Saga sub-domain (ADR-073)
   'saga.list',
   'saga.members',
   'saga.rollup',
+  'saga.critical-path',
+  'saga.deps',
+  'saga.schedule',
+  'saga.export',
 ]);
 
 const MUTATE_OPS = new Set<string>([
   'add',
   'add-batch',
+  'batch',
   'update',
   'complete',
   'cancel',
@@ -691,6 +1069,28 @@ const MUTATE_OPS = new Set<string>([
   'archive',
   'restore',
   'reparent',
+  'move',
+  'merge',
+  'split',
+  'trash.restore',
+  'trash.empty',
+  'archive.restore',
+  'stale.reset',
+  'block',
+  'unblock',
+  'note',
+  'link-commit',
+  'commits.scan',
+  'fields.add',
+  'import.markdown',
+  'renumber',
+  'undo',
+  'redo',
+  'set-status',
+  'time.start',
+  'time.stop',
+  'label.rename',
+  'label.merge',
   'reorder',
   // T11786 (epic T11556) — bulk task mutate ops Studio's interactive Kanban binds to.
   'reorder-rank',
@@ -717,6 +1117,7 @@ const MUTATE_OPS = new Set<string>([
   'saga.detach',
   // T10121 — idempotent cron-safe auto-close repair (supersedes T10098 scope).
   'saga.reconcile',
+  'saga.import',
 ]);
 
 // ---------------------------------------------------------------------------
@@ -734,9 +1135,20 @@ async function sagaCreate(params: Record<string, unknown>): Promise<LafsEnvelope
   const title = typeof params.title === 'string' ? params.title : '';
   const description = typeof params.description === 'string' ? params.description : undefined;
   const acceptance = Array.isArray(params.acceptance) ? (params.acceptance as string[]) : undefined;
+  const due = typeof params.due === 'string' ? params.due : undefined;
+  const prefix = typeof params.prefix === 'string' ? params.prefix : undefined;
+  const track = typeof params.track === 'string' ? params.track : undefined;
   const dryRun = params.dryRun === true;
   return wrapCoreResult(
-    await coreSagaCreate(getProjectRoot(), { title, description, acceptance, dryRun }),
+    await coreSagaCreate(getProjectRoot(), {
+      title,
+      description,
+      acceptance,
+      due,
+      prefix,
+      track,
+      dryRun,
+    }),
     'saga.create',
   );
 }
@@ -781,6 +1193,45 @@ async function sagaRollup(params: Record<string, unknown>): Promise<LafsEnvelope
   return wrapCoreResult(await coreSagaRollup(getProjectRoot(), { sagaId }), 'saga.rollup');
 }
 
+/** saga.critical-path — estimate-weighted longest chain. See `core/sagas/critical-path.ts`. */
+async function sagaCriticalPath(params: Record<string, unknown>): Promise<LafsEnvelope<unknown>> {
+  const sagaId = typeof params.sagaId === 'string' ? params.sagaId : '';
+  return wrapCoreResult(
+    await coreSagaCriticalPath(getProjectRoot(), { sagaId }),
+    'saga.critical-path',
+  );
+}
+
+/** saga.deps — Sagas this Saga depends on. See `core/sagas/deps.ts`. */
+async function sagaDeps(params: Record<string, unknown>): Promise<LafsEnvelope<unknown>> {
+  const sagaId = typeof params.sagaId === 'string' ? params.sagaId : '';
+  return wrapCoreResult(await coreSagaDeps(getProjectRoot(), { sagaId }), 'saga.deps');
+}
+
+/** saga.schedule — latest start/finish from the Saga due date. See `core/sagas/schedule.ts`. */
+async function sagaSchedule(params: Record<string, unknown>): Promise<LafsEnvelope<unknown>> {
+  const sagaId = typeof params.sagaId === 'string' ? params.sagaId : '';
+  const hoursPerUnit =
+    params.hoursPerUnit === undefined ? undefined : Number(params.hoursPerUnit as number | string);
+  const asOf = typeof params.asOf === 'string' ? params.asOf : undefined;
+  return wrapCoreResult(
+    await coreSagaSchedule(getProjectRoot(), { sagaId, hoursPerUnit, asOf }),
+    'saga.schedule',
+  );
+}
+
+/** saga.export — portable Saga bundle. See `core/sagas/bundle.ts`. */
+async function sagaExport(params: Record<string, unknown>): Promise<LafsEnvelope<unknown>> {
+  const sagaId = typeof params.sagaId === 'string' ? params.sagaId : '';
+  return wrapCoreResult(await coreSagaExport(getProjectRoot(), { sagaId }), 'saga.export');
+}
+
+/** saga.import — re-create a Saga bundle under fresh IDs. See `core/sagas/bundle.ts`. */
+async function sagaImport(params: Record<string, unknown>): Promise<LafsEnvelope<unknown>> {
+  const file = typeof params.file === 'string' ? params.file : '';
+  return wrapCoreResult(await coreSagaImport(getProjectRoot(), { file }), 'saga.import');
+}
+
 /**
  * saga.repair — detach an I5-violating `parentId` from a saga and re-attach
  * the former parent via `task_relations.type='groups'`. Idempotent.
@@ -943,6 +1394,22 @@ export class TasksHandler implements DomainHandler {
         const envelope = await sagaRollup(params ?? {});
         return wrapResult(envelopeToEngineResult(envelope), 'query', 'tasks', operation, startTime);
       }
+      if (operation === 'saga.critical-path') {
+        const envelope = await sagaCriticalPath(params ?? {});
+        return wrapResult(envelopeToEngineResult(envelope), 'query', 'tasks', operation, startTime);
+      }
+      if (operation === 'saga.deps') {
+        const envelope = await sagaDeps(params ?? {});
+        return wrapResult(envelopeToEngineResult(envelope), 'query', 'tasks', operation, startTime);
+      }
+      if (operation === 'saga.schedule') {
+        const envelope = await sagaSchedule(params ?? {});
+        return wrapResult(envelopeToEngineResult(envelope), 'query', 'tasks', operation, startTime);
+      }
+      if (operation === 'saga.export') {
+        const envelope = await sagaExport(params ?? {});
+        return wrapResult(envelopeToEngineResult(envelope), 'query', 'tasks', operation, startTime);
+      }
     } catch (error) {
       getLogger('domain:tasks').error(
         { gateway: 'query', domain: 'tasks', operation, err: error },
@@ -1046,6 +1513,16 @@ export class TasksHandler implements DomainHandler {
           startTime,
         );
       }
+      if (operation === 'saga.import') {
+        const envelope = await sagaImport(params ?? {});
+        return wrapResult(
+          envelopeToEngineResult(envelope),
+          'mutate',
+          'tasks',
+          operation,
+          startTime,
+        );
+      }
     } catch (error) {
       getLogger('domain:tasks').error(
         { gateway: 'mutate', domain: 'tasks', operation, err: error },
@@ -1099,6 +1576,7 @@ export class TasksHandler implements DomainHandler {
         'context',
         'deps.validate',
         'deps.tree',
+        'deps.trace',
         'analyze',
         'impact',
         'next',
@@ -1108,14 +1586,29 @@ export class TasksHandler implements DomainHandler {
         'history',
         'current',
         'label.list',
+        'trash.list',
+        'archive.list',
+        'overdue',
+        'stale',
+        'commits',
+        'time',
+        'fields.list',
+        'estimate.rollup',
+        'burndown',
+        'graph',
         'sync.links',
         // Saga sub-domain (ADR-073)
         'saga.list',
         'saga.members',
         'saga.rollup',
+        'saga.critical-path',
+        'saga.deps',
+        'saga.schedule',
+        'saga.export',
       ],
       mutate: [
         'add',
+        'batch',
         'update',
         'complete',
         'cancel',
@@ -1123,6 +1616,28 @@ export class TasksHandler implements DomainHandler {
         'archive',
         'restore',
         'reparent',
+        'move',
+        'merge',
+        'split',
+        'trash.restore',
+        'trash.empty',
+        'archive.restore',
+        'stale.reset',
+        'block',
+        'unblock',
+        'note',
+        'link-commit',
+        'commits.scan',
+        'fields.add',
+        'import.markdown',
+        'renumber',
+        'undo',
+        'redo',
+        'set-status',
+        'time.start',
+        'time.stop',
+        'label.rename',
+        'label.merge',
         'reorder',
         // T11786 (epic T11556) — bulk task mutate ops Studio's Kanban binds to.
         'reorder-rank',
@@ -1145,6 +1660,7 @@ export class TasksHandler implements DomainHandler {
         'saga.detach',
         // T10121 — idempotent cron-safe auto-close repair.
         'saga.reconcile',
+        'saga.import',
       ],
     };
   }
@@ -11,6 +11,9 @@ export { Dispatcher, type DispatcherConfig } from './dispatcher.js';
 export { createDomainHandlers } from './domains/index.js';
 export { createDispatchMeta } from './lib/meta.js';
 export { createAudit } from './middleware/audit.js';
+export { createMutateHooks } from './middleware/mutate-hooks.js';
+export { createMutateJournal } from './middleware/mutate-journal.js';
+export { createMutateLock } from './middleware/mutate-lock.js';
 export { compose } from './middleware/pipeline.js';
 export { createProtocolEnforcement } from './middleware/protocol-enforcement.js';
 export { createRateLimiter } from './middleware/rate-limiter.js';
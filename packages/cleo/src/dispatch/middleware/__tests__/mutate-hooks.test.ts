@@ -0,0 +1,116 @@
+/**
+ * Tests for the mutate-hooks dispatch middleware.
+ *
+ * Verifies that a failing `pre-mutate` hook rejects the request without
+ * running the handler, and that `post-mutate` sees the after-state of a
+ * successful mutate only.
+ */
+
+import { beforeEach, describe, expect, it, vi } from 'vitest';
+import type { DispatchRequest, DispatchResponse } from '../../types.js';
+
+const { mockFindMutateHook, mockRunMutateHook, mockLoadSingleTask } = vi.hoisted(() => ({
+  mockFindMutateHook: vi.fn(),
+  mockRunMutateHook: vi.fn(),
+  mockLoadSingleTask: vi.fn(),
+}));
+
+vi.mock('../../../../../core/src/internal.js', () => ({
+  findMutateHook: mockFindMutateHook,
+  runMutateHook: mockRunMutateHook,
+  getTaskAccessor: vi.fn(async () => ({ loadSingleTask: mockLoadSingleTask })),
+  getLogger: () => ({ warn: vi.fn() }),
+}));
+
+import { createMutateHooks } from '../mutate-hooks.js';
+
+function makeRequest(overrides: Partial<DispatchRequest> = {}): DispatchRequest {
+  return {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'complete',
+    params: { taskId: 'T1' },
+    source: 'cli',
+    requestId: 'req-1',
+    ...overrides,
+  };
+}
+
+const ok: DispatchResponse = {
+  meta: {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'complete',
+    timestamp: '2026-05-25T00:00:00.000Z',
+    duration_ms: 1,
+    source: 'cli',
+    requestId: 'req-1',
+  },
+  success: true,
+  data: { completed: true },
+};
+
+function hookResult(exitCode: number | null, stderr = '') {
+  return {
+    hook: 'pre-mutate',
+    path: '/h',
+    exitCode,
+    timedOut: false,
+    stdout: '',
+    stderr,
+    durationMs: 3,
+  };
+}
+
+describe('createMutateHooks middleware', () => {
+  beforeEach(() => {
+    vi.clearAllMocks();
+    mockFindMutateHook.mockImplementation(
+      (_root: string, hook: string) => `/project/.cleo/hooks/${hook}`,
+    );
+    mockLoadSingleTask
+      .mockReset()
+      .mockResolvedValueOnce({ id: 'T1', status: 'active' })
+      .mockResolvedValueOnce({ id: 'T1', status: 'done' });
+  });
+
+  it('rejects the mutate when pre-mutate exits non-zero', async () => {
+    mockRunMutateHook.mockResolvedValueOnce(hookResult(1, 'T1 has open subtasks'));
+    const next = vi.fn().mockResolvedValue(ok);
+    const result = await createMutateHooks(() => '/project')(makeRequest(), next);
+
+    expect(next).not.toHaveBeenCalled();
+    expect(result.success).toBe(false);
+    expect(result.error).toMatchObject({
+      code: 'E_HOOK_REJECTED',
+      details: { error: 'hook_rejected', stderr: 'T1 has open subtasks' },
+    });
+  });
+
+  it('hands post-mutate the before and after task state', async () => {
+    mockRunMutateHook.mockResolvedValue(hookResult(0));
+    const next = vi.fn().mockResolvedValue(ok);
+    const result = await createMutateHooks(() => '/project')(makeRequest(), next);
+
+    expect(result).toBe(ok);
+    expect(mockRunMutateHook).toHaveBeenCalledTimes(2);
+    expect(mockRunMutateHook.mock.calls[1]?.[1]).toMatchObject({
+      hook: 'post-mutate',
+      operation: 'complete',
+      taskId: 'T1',
+      before: { status: 'active' },
+      after: { status: 'done' },
+    });
+  });
+
+  it('skips post-mutate after a failed mutate, and ignores queries', async () => {
+    mockFindMutateHook.mockImplementation((_root: string, hook: string) =>
+      hook === 'post-mutate' ? '/project/.cleo/hooks/post-mutate' : null,
+    );
+    const failed = vi.fn().mockResolvedValue({ ...ok, success: false, data: undefined });
+    await createMutateHooks(() => '/project')(makeRequest(), failed);
+    await createMutateHooks(() => '/project')(makeRequest({ gateway: 'query' }), vi.fn());
+
+    expect(mockRunMutateHook).not.toHaveBeenCalled();
+  });
+});
@@ -0,0 +1,109 @@
+/**
+ * Tests for the mutate-journal dispatch middleware.
+ *
+ * Verifies that a successful mutate is journaled against the task rows
+ * captured while the handler ran, and that failed mutates and queries are
+ * not journaled.
+ */
+
+import { tmpdir } from 'node:os';
+import { beforeEach, describe, expect, it, vi } from 'vitest';
+import type { DispatchRequest, DispatchResponse } from '../../types.js';
+
+const { mockCaptureTaskWrites, mockRecordMutation } = vi.hoisted(() => ({
+  mockCaptureTaskWrites: vi.fn(),
+  mockRecordMutation: vi.fn(),
+}));
+
+vi.mock('../../../../../core/src/internal.js', () => ({
+  captureTaskWrites: mockCaptureTaskWrites,
+  recordMutation: mockRecordMutation,
+  getCleoDirAbsolute: () => tmpdir(),
+  getLogger: () => ({ warn: vi.fn() }),
+}));
+
+import { createMutateJournal } from '../mutate-journal.js';
+
+function makeRequest(overrides: Partial<DispatchRequest> = {}): DispatchRequest {
+  return {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'update',
+    params: { taskId: 'T1' },
+    source: 'cli',
+    requestId: 'req-1',
+    ...overrides,
+  };
+}
+
+const ok: DispatchResponse = {
+  meta: {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'update',
+    timestamp: '2026-05-25T00:00:00.000Z',
+    duration_ms: 1,
+    source: 'cli',
+    requestId: 'req-1',
+  },
+  success: true,
+  data: { updated: true },
+};
+
+describe('createMutateJournal middleware', () => {
+  const before = new Map([['T1', { id: 'T1', title: 'Before' }]]);
+
+  beforeEach(() => {
+    vi.clearAllMocks();
+    mockCaptureTaskWrites.mockImplementation(async (fn: () => Promise<unknown>) => ({
+      result: await fn(),
+      before,
+    }));
+    mockRecordMutation.mockResolvedValue(null);
+  });
+
+  it('records a successful mutate against the rows captured while it ran', async () => {
+    const next = vi.fn(async () => ok);
+    const result = await createMutateJournal(() => '/project')(makeRequest(), next);
+
+    expect(result).toBe(ok);
+    expect(mockCaptureTaskWrites).toHaveBeenCalledWith(next);
+    expect(mockRecordMutation).toHaveBeenCalledWith('/project', 'tasks.update', before);
+  });
+
+  it('skips the journal when the capture failed', async () => {
+    mockCaptureTaskWrites.mockImplementationOnce(async (fn: () => Promise<unknown>) => ({
+      result: await fn(),
+      before: null,
+    }));
+    const result = await createMutateJournal(() => '/project')(makeRequest(), async () => ok);
+
+    expect(result).toBe(ok);
+    expect(mockRecordMutation).not.toHaveBeenCalled();
+  });
+
+  it('keeps the response when the journal write fails', async () => {
+    mockRecordMutation.mockRejectedValueOnce(new Error('disk full'));
+    const result = await createMutateJournal(() => '/project')(makeRequest(), async () => ok);
+
+    expect(result).toBe(ok);
+  });
+
+  it('skips failed mutates and queries', async () => {
+    const failed = vi.fn().mockResolvedValue({ ...ok, success: false, data: undefined });
+    await createMutateJournal(() => '/project')(makeRequest(), failed);
+    await createMutateJournal(() => '/project')(makeRequest({ gateway: 'query' }), vi.fn());
+
+    expect(mockRecordMutation).not.toHaveBeenCalled();
+  });
+
+  it('passes undo and redo through without journaling them', async () => {
+    await createMutateJournal(() => '/project')(
+      makeRequest({ operation: 'undo', params: {} }),
+      async () => ok,
+    );
+
+    expect(mockCaptureTaskWrites).not.toHaveBeenCalled();
+    expect(mockRecordMutation).not.toHaveBeenCalled();
+  });
+});
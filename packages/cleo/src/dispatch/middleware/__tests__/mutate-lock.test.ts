@@ -0,0 +1,105 @@
+/**
+ * Tests for the mutate-lock dispatch middleware.
+ *
+ * Verifies that only mutate requests take the project lock and that a lock
+ * timeout becomes an `E_LOCK_TIMEOUT` response without running the handler.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '@cleocode/core';
+import { beforeEach, describe, expect, it, vi } from 'vitest';
+import type { DispatchRequest, DispatchResponse } from '../../types.js';
+
+const { mockWithMutateLock, mockRefreshTaskChecksum } = vi.hoisted(() => ({
+  mockWithMutateLock: vi.fn(async (_root: string, fn: () => Promise<unknown>) => fn()),
+  mockRefreshTaskChecksum: vi.fn().mockResolvedValue(false),
+}));
+
+vi.mock('../../../../../core/src/internal.js', () => ({
+  withMutateLock: mockWithMutateLock,
+  refreshTaskChecksum: mockRefreshTaskChecksum,
+}));
+
+import { createMutateLock } from '../mutate-lock.js';
+
+function makeRequest(overrides: Partial<DispatchRequest> = {}): DispatchRequest {
+  return {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'update',
+    params: { taskId: 'T1' },
+    source: 'cli',
+    requestId: 'req-1',
+    ...overrides,
+  };
+}
+
+const ok: DispatchResponse = {
+  meta: {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'update',
+    timestamp: '2026-05-25T00:00:00.000Z',
+    duration_ms: 1,
+    source: 'cli',
+    requestId: 'req-1',
+  },
+  success: true,
+  data: { updated: true },
+};
+
+describe('createMutateLock middleware', () => {
+  beforeEach(() => {
+    vi.clearAllMocks();
+  });
+
+  it('runs mutate handlers under the project lock', async () => {
+    const next = vi.fn().mockResolvedValue(ok);
+    const result = await createMutateLock(() => '/project')(makeRequest(), next);
+
+    expect(result).toBe(ok);
+    expect(mockWithMutateLock).toHaveBeenCalledWith('/project', expect.any(Function));
+    expect(next).toHaveBeenCalledTimes(1);
+    expect(mockRefreshTaskChecksum).toHaveBeenCalledWith('/project');
+  });
+
+  it('leaves the checksum seal alone when the mutate fails', async () => {
+    const next = vi.fn().mockResolvedValue({ ...ok, success: false, data: undefined });
+    await createMutateLock(() => '/project')(makeRequest(), next);
+
+    expect(mockRefreshTaskChecksum).not.toHaveBeenCalled();
+  });
+
+  it('lets queries through without locking', async () => {
+    const next = vi.fn().mockResolvedValue(ok);
+    await createMutateLock(() => '/project')(makeRequest({ gateway: 'query' }), next);
+
+    expect(mockWithMutateLock).not.toHaveBeenCalled();
+    expect(next).toHaveBeenCalledTimes(1);
+  });
+
+  it('returns E_LOCK_TIMEOUT when the lock cannot be acquired', async () => {
+    mockWithMutateLock.mockRejectedValueOnce(
+      new CleoError(ExitCode.LOCK_TIMEOUT, 'Timed out', {
+        details: { field: 'lock', error: 'lock_timeout', timeoutMs: 10 },
+      }),
+    );
+    const next = vi.fn().mockResolvedValue(ok);
+    const result = await createMutateLock(() => '/project')(makeRequest(), next);
+
+    expect(next).not.toHaveBeenCalled();
+    expect(result.success).toBe(false);
+    expect(result.error).toMatchObject({
+      code: 'E_LOCK_TIMEOUT',
+      exitCode: ExitCode.LOCK_TIMEOUT,
+      details: { error: 'lock_timeout' },
+    });
+  });
+
+  it('rethrows unrelated errors', async () => {
+    mockWithMutateLock.mockRejectedValueOnce(new Error('disk full'));
+    await expect(
+      createMutateLock(() => '/project')(makeRequest(), vi.fn()),
+    ).rejects.toThrow('disk full');
+  });
+});
@@ -0,0 +1,114 @@
+/**
+ * Mutate-hooks middleware — runs the project's `.cleo/hooks/pre-mutate` and
+ * `post-mutate` scripts around every mutating dispatch operation.
+ *
+ * `pre-mutate` can veto: a non-zero exit or timeout fails the request with
+ * `E_HOOK_REJECTED` and `details.error === 'hook_rejected'`, carrying the
+ * hook's stderr, without running the handler. `post-mutate` runs only after
+ * a successful mutate and cannot change its result. Projects without hook
+ * scripts pay one `stat` per hook and nothing else.
+ *
+ * Sits inside {@link createMutateLock} so the before/after task snapshots
+ * handed to the hooks match what the handler saw and wrote.
+ */
+
+import { ExitCode, type Task } from '@cleocode/contracts';
+import {
+  findMutateHook,
+  getLogger,
+  getTaskAccessor,
+  type MutateHookName,
+  type MutateHookPayload,
+  runMutateHook,
+} from '@cleocode/core/internal';
+import type { DispatchNext, DispatchRequest, DispatchResponse, Middleware } from '../types.js';
+
+const log = getLogger('mutate-hooks');
+
+/** Load the task a request targets, or `null` when there is none. */
+async function snapshot(projectRoot: string, taskId: string | null): Promise<Task | null> {
+  if (!taskId) return null;
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return await accessor.loadSingleTask(taskId);
+  } catch {
+    return null;
+  }
+}
+
+/**
+ * Create middleware that runs the project's mutate hook scripts.
+ *
+ * @param getProjectRoot - Resolves the project whose `.cleo/hooks/` is used.
+ * @returns Dispatch middleware wrapping `mutate` requests with the hooks.
+ */
+export function createMutateHooks(getProjectRoot: () => string): Middleware {
+  return async (req: DispatchRequest, next: DispatchNext): Promise<DispatchResponse> => {
+    if (req.gateway !== 'mutate') return next();
+
+    const projectRoot = getProjectRoot();
+    const pre = findMutateHook(projectRoot, 'pre-mutate');
+    const post = findMutateHook(projectRoot, 'post-mutate');
+    if (!pre && !post) return next();
+
+    const params = req.params ?? {};
+    const taskId = typeof params['taskId'] === 'string' ? params['taskId'] : null;
+    const before = await snapshot(projectRoot, taskId);
+    const payload = (hook: MutateHookName, after: Task | null): MutateHookPayload => ({
+      hook,
+      domain: req.domain,
+      operation: req.operation,
+      taskId,
+      params,
+      before,
+      after,
+      timestamp: new Date().toISOString(),
+      projectRoot,
+    });
+
+    if (pre) {
+      const result = await runMutateHook(pre, payload('pre-mutate', null));
+      if (result.exitCode !== 0) {
+        const why = result.timedOut ? 'timed out' : `exited with ${result.exitCode ?? 'an error'}`;
+        return {
+          meta: {
+            gateway: req.gateway,
+            domain: req.domain,
+            operation: req.operation,
+            timestamp: new Date().toISOString(),
+            duration_ms: result.durationMs,
+            source: req.source,
+            requestId: req.requestId,
+            ...(req.sessionId ? { sessionId: req.sessionId } : {}),
+          },
+          success: false,
+          error: {
+            code: 'E_HOOK_REJECTED',
+            exitCode: ExitCode.VALIDATION_ERROR,
+            message: `pre-mutate hook rejected ${req.domain}.${req.operation} (${why})`,
+            fix: `Check the hook's stderr, or move ${pre} aside to bypass it`,
+            details: {
+              error: 'hook_rejected',
+              stderr: result.stderr,
+              exitCode: result.exitCode,
+              timedOut: result.timedOut,
+            },
+          },
+        };
+      }
+    }
+
+    const response = await next();
+    if (post && response.success) {
+      const after = await snapshot(projectRoot, taskId);
+      const result = await runMutateHook(post, payload('post-mutate', after));
+      if (result.exitCode !== 0) {
+        log.warn(
+          { hook: post, exitCode: result.exitCode, timedOut: result.timedOut },
+          `post-mutate hook failed for ${req.domain}.${req.operation}`,
+        );
+      }
+    }
+    return response;
+  };
+}
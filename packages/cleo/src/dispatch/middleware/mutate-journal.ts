@@ -0,0 +1,61 @@
+/**
+ * Mutate-journal middleware — records every task-changing mutate in the
+ * project operation journal (`.cleo/journal.ndjson`) so `cleo undo` can
+ * reverse it.
+ *
+ * The handler runs inside a task write capture, so the before-image of each
+ * task it writes is kept; after a successful mutate those rows are diffed
+ * against the store, only tasks that changed are journaled, and mutates that
+ * touched no task write nothing. Journaling is best-effort: a failure is
+ * logged and never fails the request, since the write has already happened.
+ *
+ * `tasks.undo` / `tasks.redo` are passed straight through: they append their
+ * own journal entries, and journaling them as mutates would discard the
+ * redo stack they walk.
+ *
+ * Sits inside {@link createMutateLock} so no other writer can land between
+ * the before-snapshot and the journal entry.
+ */
+
+import { existsSync } from 'node:fs';
+import {
+  captureTaskWrites,
+  getCleoDirAbsolute,
+  getLogger,
+  recordMutation,
+} from '@cleocode/core/internal';
+import type { DispatchNext, DispatchRequest, DispatchResponse, Middleware } from '../types.js';
+
+const log = getLogger('mutate-journal');
+
+/** Operations that replay the journal themselves. */
+const JOURNAL_REPLAY_OPS = new Set(['undo', 'redo']);
+
+/**
+ * Create middleware that journals mutates for `cleo undo` / `cleo redo`.
+ *
+ * @param getProjectRoot - Resolves the project whose journal is written.
+ * @returns Dispatch middleware recording `mutate` requests.
+ */
+export function createMutateJournal(getProjectRoot: () => string): Middleware {
+  return async (req: DispatchRequest, next: DispatchNext): Promise<DispatchResponse> => {
+    if (req.gateway !== 'mutate') return next();
+    if (req.domain === 'tasks' && JOURNAL_REPLAY_OPS.has(req.operation)) return next();
+
+    const projectRoot = getProjectRoot();
+    // Before `cleo init` there is no task store to journal.
+    if (!existsSync(getCleoDirAbsolute(projectRoot))) return next();
+
+    const { result: response, before } = await captureTaskWrites(next);
+    if (!before) {
+      log.warn(`journal snapshot failed for ${req.domain}.${req.operation}`);
+    } else if (response.success) {
+      try {
+        await recordMutation(projectRoot, `${req.domain}.${req.operation}`, before);
+      } catch (err) {
+        log.warn({ err }, `journal write failed for ${req.domain}.${req.operation}`);
+      }
+    }
+    return response;
+  };
+}
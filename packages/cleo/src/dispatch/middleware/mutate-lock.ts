@@ -0,0 +1,67 @@
+/**
+ * Mutate-lock middleware — serialises mutating dispatch operations per project.
+ *
+ * Every `mutate` request runs inside {@link withMutateLock}, so the handler's
+ * reads and writes happen under one exclusive project lock and concurrent
+ * `cleo` processes can no longer interleave their read → decide → write
+ * sequences. `query` requests pass straight through and never wait.
+ *
+ * When the lock cannot be acquired before the timeout the request fails with
+ * `E_LOCK_TIMEOUT` and `details.error === 'lock_timeout'` without running the
+ * handler.
+ *
+ * After a successful mutate, and before the lock is released, the task-store
+ * checksum seal (`cleo verify`) is brought up to date so only writes made
+ * outside cleo show up as drift.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '@cleocode/core';
+import { refreshTaskChecksum, withMutateLock } from '@cleocode/core/internal';
+import type { DispatchNext, DispatchRequest, DispatchResponse, Middleware } from '../types.js';
+
+/**
+ * Create middleware that holds the project mutate lock around mutate handlers.
+ *
+ * @param getProjectRoot - Resolves the project whose lock is taken.
+ * @returns Dispatch middleware serialising `mutate` requests.
+ */
+export function createMutateLock(getProjectRoot: () => string): Middleware {
+  return async (req: DispatchRequest, next: DispatchNext): Promise<DispatchResponse> => {
+    if (req.gateway !== 'mutate') return next();
+
+    const projectRoot = getProjectRoot();
+    try {
+      return await withMutateLock(projectRoot, async () => {
+        const response = await next();
+        if (response.success) {
+          // Best-effort: a stale seal is reported by `cleo verify`, never fatal here.
+          await refreshTaskChecksum(projectRoot).catch(() => false);
+        }
+        return response;
+      });
+    } catch (err) {
+      if (!(err instanceof CleoError) || err.code !== ExitCode.LOCK_TIMEOUT) throw err;
+      return {
+        meta: {
+          gateway: req.gateway,
+          domain: req.domain,
+          operation: req.operation,
+          timestamp: new Date().toISOString(),
+          duration_ms: 0,
+          source: req.source,
+          requestId: req.requestId,
+          ...(req.sessionId ? { sessionId: req.sessionId } : {}),
+        },
+        success: false,
+        error: {
+          code: 'E_LOCK_TIMEOUT',
+          exitCode: ExitCode.LOCK_TIMEOUT,
+          message: err.message,
+          fix: err.fix,
+          details: err.details,
+        },
+      };
+    }
+  };
+}
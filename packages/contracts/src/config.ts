@@ -707,6 +707,42 @@ export interface DecisionsConfig {
   validatorConfidenceThreshold?: number;
 }
 
+/**
+ * Outbound webhook configuration (`cleo config set webhook.url <url>`).
+ *
+ * Delivery is best-effort: events are queued in memory, retried briefly on
+ * network errors and 5xx / 429 responses, and dropped (oldest first) when
+ * the receiver falls behind. A slow receiver never blocks a command.
+ */
+export interface WebhookConfig {
+  /**
+   * Receiver URL. Events are POSTed as `application/json`.
+   *
+   * @defaultValue undefined
+   */
+  url?: string;
+  /**
+   * Per-attempt request timeout in milliseconds.
+   *
+   * @defaultValue 2000
+   */
+  timeoutMs?: number;
+}
+
+/**
+ * Maps task labels to an orchestration track.
+ *
+ * A task with no track of its own or from its saga takes the track of the
+ * first rule whose `label` glob (`*` for any run of characters, `?` for one)
+ * matches one of its labels, so rule order decides between overlapping globs.
+ */
+export interface TrackRule {
+  /** Label glob, e.g. `infra*`. */
+  label: string;
+  /** Track a matching task is classified under. */
+  track: string;
+}
+
 /**
  * Operating mode for the Lead-tier wave roll-up
  * (`packages/core/src/orchestration/lead-rollup.ts`).
@@ -952,6 +988,23 @@ export interface CleoConfig {
    * @task T11994
    */
   resources?: ResourcesConfig;
+  /**
+   * Outbound webhook for task lifecycle events.
+   *
+   * When `webhook.url` is set, every task create / update / complete /
+   * delete / archive is POSTed to it as JSON, best-effort.
+   *
+   * @defaultValue undefined (no events are sent)
+   */
+  webhook?: WebhookConfig;
+  /**
+   * Label → track rules used by `cleo orchestrate ready --track-balance` and
+   * `cleo orchestrate tracks` to classify tasks that have no track of their
+   * own or from their saga. Tasks no rule matches are `untracked`.
+   *
+   * @defaultValue undefined (every task is untracked)
+   */
+  trackRules?: TrackRule[];
 }
 
 /**
@@ -64,6 +64,8 @@ export interface TaskQueryFilters {
   label?: string;
   search?: string; // SQL LIKE on title+description
   excludeStatus?: TaskStatus | TaskStatus[];
+  /** `true` = only trashed tasks; otherwise trashed tasks are always excluded. */
+  trashed?: boolean;
   limit?: number;
   offset?: number;
   orderBy?: 'position' | 'createdAt' | 'updatedAt' | 'priority';
@@ -105,6 +107,22 @@ export interface TaskFieldUpdates {
   updatedAt?: string | null;
   assignee?: string | null;
   pipelineStage?: string | null;
+  due?: string | null;
+  recurrenceJson?: string | null;
+  recurredTo?: string | null;
+  estimate?: number | null;
+  track?: string | null;
+  deletedAt?: string | null;
+  deletedParentId?: string | null;
+  blockedUntil?: string | null;
+  blockedPriorStatus?: TaskStatus | null;
+  noteHistoryJson?: string;
+  commitsJson?: string;
+  timeEntriesJson?: string;
+  completionRequirementsJson?: string | null;
+  idPrefix?: string | null;
+  customJson?: string | null;
+  autoCompleteParent?: boolean | null;
 }
 
 /**
@@ -519,7 +537,9 @@ export interface DataAccessor {
    *
    * @param taskId - ID of the task to claim.
    * @param agentId - Agent identifier claiming the task.
-   * @throws {Error} When the task is not found or is already claimed by another agent.
+   * @throws {Error} When the task is not found.
+   * @throws CleoError `TASK_CLAIMED` with `details.error = 'already_claimed'` when
+   *   another agent holds the task.
    */
   claimTask(taskId: string, agentId: string): Promise<void>;
 
@@ -318,6 +318,12 @@ export const OPERATIONS: OperationDef[] = [
         required: false,
         description: 'Deprecated: request compact task rows for compatibility',
       },
+      {
+        name: 'sort',
+        type: 'string',
+        required: false,
+        description: "Result ordering: 'priority' (critical → low, ties by task ID)",
+      },
     ],
   },
   {
@@ -414,6 +420,39 @@ export const OPERATIONS: OperationDef[] = [
       },
     ],
   },
+  {
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'deps.trace',
+    description:
+      'tasks.deps.trace (query) — transitive depends_on blockers (up) and dependents (down) of one task as a nested tree, with cycles marked',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
+    params: [
+      {
+        name: 'taskId',
+        type: 'string',
+        required: true,
+        description: 'Task to trace from',
+        cli: { positional: true },
+      },
+      {
+        name: 'depth',
+        type: 'number',
+        required: false,
+        description: 'Levels to walk from the task (default: the whole chain)',
+      },
+      {
+        name: 'direction',
+        type: 'string',
+        required: false,
+        description: "'up' (blockers), 'down' (what this unblocks), or 'both' (default)",
+        enum: ['up', 'down', 'both'] as const,
+      },
+    ] satisfies ParamDef[],
+  },
   {
     gateway: 'query',
     domain: 'tasks',
@@ -694,10 +733,10 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'session',
-    operation: 'status',
-    description: 'session.status (query)',
-    tier: 0,
+    domain: 'tasks',
+    operation: 'trash.list',
+    description: 'tasks.trash.list (query) — deleted tasks awaiting restore or purge, newest first',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
@@ -705,160 +744,135 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'session',
-    operation: 'list',
-    description: 'session.list (query)',
+    domain: 'tasks',
+    operation: 'archive.list',
+    description:
+      'tasks.archive.list (query) — archived tasks with the status each held at archive time, newest first',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [
-      { name: 'status', type: 'string', required: false, description: 'Filter by session status' },
       {
-        name: 'active',
-        type: 'boolean',
+        name: 'sagaId',
+        type: 'string',
         required: false,
-        description: 'Legacy active-session filter',
+        description: 'Only tasks archived from under this saga',
+        cli: { flag: 'saga' },
       },
-      { name: 'limit', type: 'number', required: false, description: 'Maximum sessions to return' },
       {
-        name: 'offset',
-        type: 'number',
+        name: 'since',
+        type: 'string',
         required: false,
-        description: 'Skip this many sessions before returning results',
+        description: 'Only tasks archived at or after this date (ISO 8601)',
+        cli: { flag: 'since' },
       },
-    ],
-  },
-  {
-    gateway: 'query',
-    domain: 'session',
-    operation: 'show',
-    description: 'session.show (query) — absorbs debrief.show via include param',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  {
-    gateway: 'query',
-    domain: 'session',
-    operation: 'decision.log',
-    description: 'session.decision.log (query)',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  {
-    gateway: 'query',
-    domain: 'session',
-    operation: 'context.drift',
-    description: 'session.context.drift (query)',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    ] satisfies ParamDef[],
   },
   {
-    gateway: 'query',
-    domain: 'session',
-    operation: 'handoff.show',
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'label.rename',
     description:
-      'session.handoff.show - Show handoff data from the most recent ended session (query)',
-    tier: 0,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  {
-    gateway: 'query',
-    domain: 'session',
-    operation: 'briefing.show',
-    description: 'session.briefing.show - Composite session-start context (query)',
-    tier: 0,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  // T4959: Rich debrief removed — merged into session.show via include param (T5615)
-  {
-    gateway: 'query',
-    domain: 'session',
-    operation: 'find',
-    description: 'session.find (query) — lightweight session discovery',
+      'tasks.label.rename (mutate) — rewrite a label on every task bearing it; merges when the new label exists',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    requiredParams: ['from', 'to'],
+    params: [
+      {
+        name: 'from',
+        type: 'string',
+        required: true,
+        description: 'Existing label',
+        cli: { positional: true },
+      },
+      {
+        name: 'to',
+        type: 'string',
+        required: true,
+        description: 'New label',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
   },
   {
-    gateway: 'query',
-    domain: 'session',
-    operation: 'lint',
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'label.merge',
     description:
-      'session.lint (query) — agent-accountability harness: flag raw-md writes to canonical doc paths in a transcript (T9797)',
+      'tasks.label.merge (mutate) — fold several labels into one, de-duplicating per task; returns tasks touched',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['transcript'],
+    requiredParams: ['sources', 'dest'],
     params: [
       {
-        name: 'transcript',
+        name: 'sources',
+        type: 'array',
+        required: true,
+        description: 'Labels to fold into dest',
+      },
+      {
+        name: 'dest',
         type: 'string',
         required: true,
-        description: 'Absolute path to the *.jsonl session transcript to lint',
+        description: 'Destination label',
       },
-    ],
+    ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'status',
-    description: 'orchestrate.status (query)',
+    domain: 'tasks',
+    operation: 'overdue',
+    description:
+      'tasks.overdue (query) — tasks whose due date is before now (or asOf) and whose status is not done, most overdue first',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [],
+    params: [
+      {
+        name: 'asOf',
+        type: 'string',
+        required: false,
+        description: 'Reference date (RFC 3339); defaults to now',
+        cli: { flag: 'as-of' },
+      },
+    ] satisfies ParamDef[],
   },
-  // T11921 — gateway SSE streaming on /v1. The agent/worker/board event stream
-  // the Studio Kanban (T11929) + Pi-TUI (T11936) consume as a realtime
-  // transport. Served as `GET /v1/orchestrate/events` → a `text/event-stream`
-  // body of `GatewayStreamEvent` frames terminated by a `done` frame, NOT as a
-  // unary POST. Secrets never touch the wire — the source resolves server-side.
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'events',
+    domain: 'tasks',
+    operation: 'stale',
     description:
-      'orchestrate.events (query, streaming) — SSE stream of agent/worker/board lifecycle events',
-    tier: 2,
+      'tasks.stale (query) — tasks left in a non-terminal status without updates for longer than olderThan, stalest first',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
-    streaming: true,
     requiredParams: [],
     params: [
       {
-        name: 'ticks',
-        type: 'number',
+        name: 'status',
+        type: 'string',
         required: false,
-        description:
-          'Maximum number of event frames to emit before the terminal `done` frame (default: open-ended until client disconnect)',
+        description: 'Non-terminal status to scan (in_progress = active); defaults to active',
+        cli: { flag: 'status' },
+      },
+      {
+        name: 'olderThan',
+        type: 'string',
+        required: false,
+        description: 'Staleness threshold since the last update (e.g. 7d, 12h); defaults to 7d',
+        cli: { flag: 'older-than' },
       },
     ] satisfies ParamDef[],
   },
-  // T811 — IVTR multi-agent enforcement
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'ivtr.status',
-    description: 'orchestrate.ivtr.status (query) — current IVTR phase + history',
+    domain: 'tasks',
+    operation: 'commits',
+    description: 'tasks.commits (query) — git commit SHAs linked to a task, in link order',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -868,116 +882,155 @@ export const OPERATIONS: OperationDef[] = [
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Task whose commits to list',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
-    gateway: 'mutate',
-    domain: 'orchestrate',
-    operation: 'ivtr.start',
-    description: 'orchestrate.ivtr.start (mutate) — begin Implement phase',
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'time',
+    description:
+      'tasks.time (query) — tracked work intervals on a task, their total time_spent in seconds (a running interval counted up to now), and the estimate',
     tier: 1,
-    idempotent: false,
-    sessionRequired: true,
+    idempotent: true,
+    sessionRequired: false,
     requiredParams: ['taskId'],
     params: [
       {
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Task whose time to report',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
-    gateway: 'mutate',
-    domain: 'orchestrate',
-    operation: 'ivtr.next',
-    description: 'orchestrate.ivtr.next (mutate) — advance to next phase (requires prior evidence)',
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'fields.list',
+    description:
+      'tasks.fields.list (query) — project custom field declarations (name, type, values)',
     tier: 1,
-    idempotent: false,
-    sessionRequired: true,
-    requiredParams: ['taskId'],
-    params: [
-      {
-        name: 'taskId',
-        type: 'string',
-        required: true,
-        description: 'taskId parameter',
-        cli: { positional: true },
-      },
-    ] satisfies ParamDef[],
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
   },
   {
-    gateway: 'mutate',
-    domain: 'orchestrate',
-    operation: 'ivtr.release',
-    description: 'orchestrate.ivtr.release (mutate) — final gate, requires I+V+T evidence',
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'estimate.rollup',
+    description:
+      'tasks.estimate.rollup (query) — a saga or epic with estimate_total/done/remaining summed over its member tasks; sagas add progress, counts and progressStatus',
     tier: 1,
-    idempotent: false,
-    sessionRequired: true,
+    idempotent: true,
+    sessionRequired: false,
     requiredParams: ['taskId'],
     params: [
       {
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Saga or epic ID',
         cli: { positional: true },
       },
+      {
+        name: 'type',
+        type: 'string',
+        required: false,
+        description: 'Require the task to have this type',
+        enum: ['saga', 'epic'] as const,
+      },
     ] satisfies ParamDef[],
   },
   {
-    gateway: 'mutate',
-    domain: 'orchestrate',
-    operation: 'ivtr.loop-back',
-    description: 'orchestrate.ivtr.loop-back (mutate) — rewind to phase on failure',
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'burndown',
+    description:
+      'tasks.burndown (query) — completed vs remaining estimate per day/week for a saga over a date range',
     tier: 1,
-    idempotent: false,
-    sessionRequired: true,
-    requiredParams: ['taskId', 'phase', 'reason'],
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['sagaId', 'from'],
     params: [
       {
-        name: 'taskId',
+        name: 'sagaId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
-        cli: { positional: true },
+        description: 'Saga ID',
+        cli: { flag: 'saga' },
       },
       {
-        name: 'phase',
+        name: 'from',
         type: 'string',
         required: true,
-        description: 'phase parameter',
+        description: 'Range start (RFC 3339)',
+        cli: { flag: 'from' },
       },
       {
-        name: 'reason',
+        name: 'to',
         type: 'string',
-        required: true,
-        description: 'reason parameter',
+        required: false,
+        description: 'Range end (RFC 3339); defaults to now',
+        cli: { flag: 'to' },
+      },
+      {
+        name: 'bucket',
+        type: 'string',
+        required: false,
+        description: 'Bucket width (default day)',
+        enum: ['day', 'week'] as const,
+        cli: { flag: 'bucket' },
       },
     ] satisfies ParamDef[],
   },
   {
+    // Same payload as `cleo graph export --json`, so an MCP client gets the
+    // whole dependency structure in one call instead of polling tasks.show.
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'next',
-    description: 'orchestrate.next (query)',
+    domain: 'tasks',
+    operation: 'graph',
+    description:
+      'tasks.graph (query) — dependency graph nodes + dep → dependent edges for the project, a saga, or an epic, with DOT/Mermaid rendering',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [],
+    params: [
+      {
+        name: 'sagaId',
+        type: 'string',
+        required: false,
+        description: 'Restrict to this saga and everything beneath it',
+        cli: { flag: 'saga' },
+      },
+      {
+        name: 'epicId',
+        type: 'string',
+        required: false,
+        description: 'Restrict to this epic and everything beneath it',
+        cli: { flag: 'epic' },
+      },
+      {
+        name: 'format',
+        type: 'string',
+        required: false,
+        description: 'Format of the rendered graph (default dot)',
+        enum: ['dot', 'mermaid'] as const,
+      },
+    ] satisfies ParamDef[],
+    mcpExposed: true,
   },
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'ready',
-    description: 'orchestrate.ready (query)',
-    tier: 1,
+    domain: 'session',
+    operation: 'status',
+    description: 'session.status (query)',
+    tier: 0,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
@@ -985,43 +1038,35 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'analyze',
-    description:
-      'orchestrate.analyze (query) — absorbs critical.path via mode param; Wave 7a adds mode="parallel-safety"',
+    domain: 'session',
+    operation: 'list',
+    description: 'session.list (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [
+      { name: 'status', type: 'string', required: false, description: 'Filter by session status' },
       {
-        name: 'epicId',
-        type: 'string',
-        required: false,
-        description:
-          'Epic ID for standard analysis (required unless mode=critical-path or parallel-safety)',
-      },
-      {
-        name: 'mode',
-        type: 'string',
+        name: 'active',
+        type: 'boolean',
         required: false,
-        description:
-          'Analysis mode: omit for standard analysis, "critical-path" for CPM, "parallel-safety" for dep-graph grouping',
+        description: 'Legacy active-session filter',
       },
+      { name: 'limit', type: 'number', required: false, description: 'Maximum sessions to return' },
       {
-        name: 'taskIds',
-        type: 'array',
+        name: 'offset',
+        type: 'number',
         required: false,
-        description:
-          'Task ID list for mode="parallel-safety" — returns {parallelSafe, groups} dep-graph analysis',
+        description: 'Skip this many sessions before returning results',
       },
     ],
   },
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'context',
-    description: 'orchestrate.context (query)',
+    domain: 'session',
+    operation: 'show',
+    description: 'session.show (query) — absorbs debrief.show via include param',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1030,9 +1075,9 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'waves',
-    description: 'orchestrate.waves (query)',
+    domain: 'session',
+    operation: 'decision.log',
+    description: 'session.decision.log (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1041,367 +1086,295 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'report',
+    domain: 'session',
+    operation: 'context.drift',
+    description: 'session.context.drift (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'session',
+    operation: 'handoff.show',
     description:
-      'orchestrate.report (query) — grouped readiness report: ready, blocked, blockedBy, gate-blocked, invalid (T10631)',
+      'session.handoff.show - Show handoff data from the most recent ended session (query)',
+    tier: 0,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'session',
+    operation: 'briefing.show',
+    description: 'session.briefing.show - Composite session-start context (query)',
+    tier: 0,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  // T4959: Rich debrief removed — merged into session.show via include param (T5615)
+  {
+    gateway: 'query',
+    domain: 'session',
+    operation: 'find',
+    description: 'session.find (query) — lightweight session discovery',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['epicId'],
-    params: [
-      {
-        name: 'epicId',
-        type: 'string',
-        required: true,
-        description: 'Epic ID to generate the report for',
-        cli: { positional: true },
-      },
-      {
-        name: 'page',
-        type: 'number',
-        required: false,
-        description: 'Page number for paginated output (1-indexed, default 1)',
-      },
-      {
-        name: 'pageSize',
-        type: 'number',
-        required: false,
-        description: 'Page size for paginated output (default 50, max 200)',
-      },
-    ],
+    requiredParams: [],
+    params: [],
   },
   {
     gateway: 'query',
-    domain: 'orchestrate',
-    operation: 'plan',
-    description: 'orchestrate.plan (query) — deterministic wave+worker plan (T889)',
+    domain: 'session',
+    operation: 'lint',
+    description:
+      'session.lint (query) — agent-accountability harness: flag raw-md writes to canonical doc paths in a transcript (T9797)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['epicId'],
+    requiredParams: ['transcript'],
     params: [
       {
-        name: 'epicId',
+        name: 'transcript',
         type: 'string',
         required: true,
-        description: 'Epic ID to emit a plan for',
-        cli: { positional: true },
-      },
-      {
-        name: 'preferTier',
-        type: 'number',
-        required: false,
-        description: 'Preferred resolver tier (0=project, 1=global, 2=packaged)',
+        description: 'Absolute path to the *.jsonl session transcript to lint',
       },
     ],
   },
   {
     gateway: 'query',
     domain: 'orchestrate',
-    operation: 'bootstrap',
-    description: 'orchestrate.bootstrap (query)',
+    operation: 'status',
+    description: 'orchestrate.status (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
+  // T11921 — gateway SSE streaming on /v1. The agent/worker/board event stream
+  // the Studio Kanban (T11929) + Pi-TUI (T11936) consume as a realtime
+  // transport. Served as `GET /v1/orchestrate/events` → a `text/event-stream`
+  // body of `GatewayStreamEvent` frames terminated by a `done` frame, NOT as a
+  // unary POST. Secrets never touch the wire — the source resolves server-side.
   {
     gateway: 'query',
     domain: 'orchestrate',
-    operation: 'unblock.opportunities',
-    description: 'orchestrate.unblock.opportunities (query)',
-    tier: 1,
+    operation: 'events',
+    description:
+      'orchestrate.events (query, streaming) — SSE stream of agent/worker/board lifecycle events',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
+    streaming: true,
     requiredParams: [],
-    params: [],
-  },
-  // orchestrate.critical.path removed — merged into analyze via mode param (T5615)
-  // ---------------------------------------------------------------------------
-  // memory — brain.db cognitive memory (T5241 cutover)
-  // ---------------------------------------------------------------------------
-  // memory.show removed — use memory.fetch with single-element ids array (T5615)
-  {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'find',
-    description: 'memory.find (query) — cross-table brain.db FTS5 search',
-    tier: 0,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['query'],
     params: [
       {
-        name: 'agent',
-        type: 'string',
+        name: 'ticks',
+        type: 'number',
         required: false,
         description:
-          'Filter results to observations produced by the named agent (T418 mental models)',
-        cli: { flag: '--agent <name>' },
+          'Maximum number of event frames to emit before the terminal `done` frame (default: open-ended until client disconnect)',
       },
     ] satisfies ParamDef[],
   },
+  // T811 — IVTR multi-agent enforcement
   {
     gateway: 'query',
-    domain: 'memory',
-    operation: 'timeline',
-    description: 'memory.timeline (query) — chronological context around anchor',
+    domain: 'orchestrate',
+    operation: 'ivtr.status',
+    description: 'orchestrate.ivtr.status (query) — current IVTR phase + history',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['anchor'],
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'anchor',
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'anchor parameter',
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'fetch',
-    description: 'memory.fetch (query) — batch fetch brain entries by IDs',
+    gateway: 'mutate',
+    domain: 'orchestrate',
+    operation: 'ivtr.start',
+    description: 'orchestrate.ivtr.start (mutate) — begin Implement phase',
     tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['ids'],
+    idempotent: false,
+    sessionRequired: true,
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'ids',
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'ids parameter',
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
-  // memory.stats, memory.contradictions, memory.superseded removed — dashboard-only (T5615)
   {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'decision.find',
-    description: 'memory.decision.find (query) — search decisions in brain.db',
+    gateway: 'mutate',
+    domain: 'orchestrate',
+    operation: 'ivtr.next',
+    description: 'orchestrate.ivtr.next (mutate) — advance to next phase (requires prior evidence)',
     tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'pattern.find',
-    description:
-      'memory.pattern.find (query) — search BRAIN pattern memory by type, impact, or keyword',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  // memory.pattern.stats removed — dashboard-only (T5615)
-  {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'learning.find',
-    description:
-      'memory.learning.find (query) — search BRAIN learning memory by confidence, actionability, or keyword',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  // memory.learning.stats removed — dashboard-only (T5615)
-  // memory — PageIndex graph queries (T5385)
-  {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'graph.show',
-    description: 'memory.graph.show (query) — get a PageIndex graph node and its edges',
-    tier: 2,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['nodeId'],
+    idempotent: false,
+    sessionRequired: true,
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'nodeId',
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'nodeId parameter',
+        description: 'taskId parameter',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'graph.neighbors',
-    description: 'memory.graph.neighbors (query) — get neighbor nodes from the PageIndex graph',
-    tier: 2,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['nodeId'],
+    gateway: 'mutate',
+    domain: 'orchestrate',
+    operation: 'ivtr.release',
+    description: 'orchestrate.ivtr.release (mutate) — final gate, requires I+V+T evidence',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: true,
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'nodeId',
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'nodeId parameter',
+        description: 'taskId parameter',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
-  // memory — Graph traversal (T535)
   {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'graph.trace',
-    description:
-      'memory.graph.trace (query) — BFS traversal from a seed node via recursive CTE (T535)',
-    tier: 2,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['nodeId'],
+    gateway: 'mutate',
+    domain: 'orchestrate',
+    operation: 'ivtr.loop-back',
+    description: 'orchestrate.ivtr.loop-back (mutate) — rewind to phase on failure',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: true,
+    requiredParams: ['taskId', 'phase', 'reason'],
     params: [
       {
-        name: 'nodeId',
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'nodeId parameter',
+        description: 'taskId parameter',
         cli: { positional: true },
       },
-    ] satisfies ParamDef[],
-  },
-  {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'graph.related',
-    description:
-      'memory.graph.related (query) — 1-hop typed neighbours of a brain graph node (T535)',
-    tier: 2,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['nodeId'],
-    params: [
       {
-        name: 'nodeId',
+        name: 'phase',
         type: 'string',
         required: true,
-        description: 'nodeId parameter',
-        cli: { positional: true },
+        description: 'phase parameter',
       },
-    ] satisfies ParamDef[],
-  },
-  {
-    gateway: 'query',
-    domain: 'memory',
-    operation: 'graph.context',
-    description:
-      'memory.graph.context (query) — 360-degree context view: node + all edges + neighbours (T535)',
-    tier: 2,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['nodeId'],
-    params: [
       {
-        name: 'nodeId',
+        name: 'reason',
         type: 'string',
         required: true,
-        description: 'nodeId parameter',
-        cli: { positional: true },
+        description: 'reason parameter',
       },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'memory',
-    operation: 'graph.stats',
-    description: 'memory.graph.stats (query) — aggregate node and edge counts by type (T535)',
-    tier: 2,
+    domain: 'orchestrate',
+    operation: 'next',
+    description: 'orchestrate.next (query)',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
-  // memory — Reasoning & hybrid search (T5388-T5393)
   {
     gateway: 'query',
-    domain: 'memory',
-    operation: 'reason.why',
-    description: 'memory.reason.why (query) — causal trace through task dependency chains',
-    tier: 2,
+    domain: 'orchestrate',
+    operation: 'ready',
+    description: 'orchestrate.ready (query)',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['taskId'],
-    params: [
-      {
-        name: 'taskId',
-        type: 'string',
-        required: true,
-        description: 'taskId parameter',
-        cli: { positional: true },
-      },
-    ] satisfies ParamDef[],
+    requiredParams: [],
+    params: [],
   },
   {
     gateway: 'query',
-    domain: 'memory',
-    operation: 'reason.similar',
-    description: 'memory.reason.similar (query) — find semantically similar brain entries',
-    tier: 2,
+    domain: 'orchestrate',
+    operation: 'tracks',
+    description:
+      'orchestrate.tracks (query) — ready-task count per track: the task track, else its saga track, else the trackRules label globs in config',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['entryId'],
+    requiredParams: ['epicId'],
     params: [
       {
-        name: 'entryId',
+        name: 'epicId',
         type: 'string',
         required: true,
-        description: 'entryId parameter',
+        description: 'Epic or saga to compute the ready set for',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'memory',
-    operation: 'search.hybrid',
-    description: 'memory.search.hybrid (query) — hybrid search across FTS5, vector, and graph',
-    tier: 2,
+    domain: 'orchestrate',
+    operation: 'analyze',
+    description:
+      'orchestrate.analyze (query) — absorbs critical.path via mode param; Wave 7a adds mode="parallel-safety"',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['query'],
+    requiredParams: [],
     params: [
       {
-        name: 'query',
+        name: 'epicId',
         type: 'string',
-        required: true,
-        description: 'query parameter',
-        cli: { positional: true },
+        required: false,
+        description:
+          'Epic ID for standard analysis (required unless mode=critical-path or parallel-safety)',
       },
-    ] satisfies ParamDef[],
-  },
-  {
-    gateway: 'query',
-    domain: 'pipeline',
-    operation: 'stage.validate',
-    description: 'pipeline.stage.validate (query) — absorbs stage.prerequisites in response',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
+      {
+        name: 'mode',
+        type: 'string',
+        required: false,
+        description:
+          'Analysis mode: omit for standard analysis, "critical-path" for CPM, "parallel-safety" for dep-graph grouping',
+      },
+      {
+        name: 'taskIds',
+        type: 'array',
+        required: false,
+        description:
+          'Task ID list for mode="parallel-safety" — returns {parallelSafe, groups} dep-graph analysis',
+      },
+    ],
   },
   {
     gateway: 'query',
-    domain: 'pipeline',
-    operation: 'stage.status',
-    description: 'pipeline.stage.status (query) — absorbs stage.gates via include param',
+    domain: 'orchestrate',
+    operation: 'context',
+    description: 'orchestrate.context (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1410,9 +1383,9 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'pipeline',
-    operation: 'stage.history',
-    description: 'pipeline.stage.history (query)',
+    domain: 'orchestrate',
+    operation: 'waves',
+    description: 'orchestrate.waves (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1421,134 +1394,90 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'pipeline',
-    operation: 'stage.guidance',
+    domain: 'orchestrate',
+    operation: 'report',
     description:
-      'pipeline.stage.guidance (query) — stage-aware LLM prompt guidance (Phase 2). Pi extensions shell out to this on before_agent_start.',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  // stage.gates removed — merged into stage.status via include param (T5615)
-  // stage.prerequisites removed — always returned in stage.validate response (T5615)
-  // T5326: Phase management operations
-  {
-    gateway: 'query',
-    domain: 'pipeline',
-    operation: 'phase.show',
-    description: 'Show phase details by slug or current phase (query)',
+      'orchestrate.report (query) — grouped readiness report: ready, blocked, blockedBy, gate-blocked, invalid (T10631)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
+    requiredParams: ['epicId'],
     params: [
       {
-        name: 'phaseId',
+        name: 'epicId',
         type: 'string',
+        required: true,
+        description: 'Epic ID to generate the report for',
+        cli: { positional: true },
+      },
+      {
+        name: 'page',
+        type: 'number',
         required: false,
-        description: 'Phase slug (omit for current phase)',
+        description: 'Page number for paginated output (1-indexed, default 1)',
+      },
+      {
+        name: 'pageSize',
+        type: 'number',
+        required: false,
+        description: 'Page size for paginated output (default 50, max 200)',
       },
     ],
   },
   {
     gateway: 'query',
-    domain: 'pipeline',
-    operation: 'phase.list',
-    description: 'List all phases with status and task counts (query)',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  // pipeline manifest query operations (T5241 — moved from memory)
-  {
-    gateway: 'query',
-    domain: 'pipeline',
-    operation: 'manifest.show',
-    description: 'pipeline.manifest.show (query) — get manifest entry by ID',
+    domain: 'orchestrate',
+    operation: 'plan',
+    description: 'orchestrate.plan (query) — deterministic wave+worker plan (T889)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['entryId'],
+    requiredParams: ['epicId'],
     params: [
       {
-        name: 'entryId',
+        name: 'epicId',
         type: 'string',
         required: true,
-        description: 'entryId parameter',
+        description: 'Epic ID to emit a plan for',
         cli: { positional: true },
       },
-    ] satisfies ParamDef[],
-  },
-  {
-    gateway: 'query',
-    domain: 'pipeline',
-    operation: 'manifest.list',
-    description: 'pipeline.manifest.list (query) — list manifest entries with filters',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: [],
-    params: [],
-  },
-  {
-    gateway: 'query',
-    domain: 'pipeline',
-    operation: 'manifest.find',
-    description: 'pipeline.manifest.find (query) — search manifest entries by text',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['query'],
-    params: [
       {
-        name: 'query',
-        type: 'string',
-        required: true,
-        description: 'query parameter',
-        cli: { positional: true },
+        name: 'preferTier',
+        type: 'number',
+        required: false,
+        description: 'Preferred resolver tier (0=project, 1=global, 2=packaged)',
       },
-    ] satisfies ParamDef[],
+    ],
   },
-  // manifest.pending removed — merged into manifest.list via filter param (T5615)
   {
     gateway: 'query',
-    domain: 'pipeline',
-    operation: 'manifest.stats',
-    description: 'pipeline.manifest.stats (query) — manifest statistics',
+    domain: 'orchestrate',
+    operation: 'sequence',
+    description:
+      'orchestrate.sequence (query) — open tasks in topological dependency waves, project-wide or per saga',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [],
-  },
-  {
-    gateway: 'query',
-    domain: 'check',
-    operation: 'schema',
-    description: 'check.schema (query)',
-    tier: 1,
-    idempotent: true,
-    sessionRequired: false,
-    requiredParams: ['type'],
     params: [
       {
-        name: 'type',
+        name: 'sagaId',
         type: 'string',
-        required: true,
-        description: 'Schema type to validate (todo, config, archive, log, sessions)',
-        cli: { positional: true },
+        required: false,
+        description: 'Restrict the plan to this saga (default: whole project)',
+        cli: { flag: 'saga' },
       },
-    ] satisfies ParamDef[],
+    ],
+    // The `cleo orchestrate plan` wave grouping. A dependency cycle comes back
+    // as the same CIRCULAR_REFERENCE error (`details.error = 'dependency_cycle'`,
+    // `details.path`) over the CLI and MCP.
+    mcpExposed: true,
   },
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'protocol',
-    description: 'check.protocol (query) — absorbs all protocol.* ops via protocolType param',
+    domain: 'orchestrate',
+    operation: 'bootstrap',
+    description: 'orchestrate.bootstrap (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1557,56 +1486,82 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'task',
-    description: 'check.task (query)',
+    domain: 'orchestrate',
+    operation: 'unblock.opportunities',
+    description: 'orchestrate.unblock.opportunities (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
+  // orchestrate.critical.path removed — merged into analyze via mode param (T5615)
+  // ---------------------------------------------------------------------------
+  // memory — brain.db cognitive memory (T5241 cutover)
+  // ---------------------------------------------------------------------------
+  // memory.show removed — use memory.fetch with single-element ids array (T5615)
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'manifest',
-    description: 'check.manifest (query)',
-    tier: 1,
+    domain: 'memory',
+    operation: 'find',
+    description: 'memory.find (query) — cross-table brain.db FTS5 search',
+    tier: 0,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    requiredParams: ['query'],
+    params: [
+      {
+        name: 'agent',
+        type: 'string',
+        required: false,
+        description:
+          'Filter results to observations produced by the named agent (T418 mental models)',
+        cli: { flag: '--agent <name>' },
+      },
+    ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'output',
-    description: 'check.output (query)',
+    domain: 'memory',
+    operation: 'timeline',
+    description: 'memory.timeline (query) — chronological context around anchor',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    requiredParams: ['anchor'],
+    params: [
+      {
+        name: 'anchor',
+        type: 'string',
+        required: true,
+        description: 'anchor parameter',
+      },
+    ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'compliance.summary',
-    description:
-      'check.compliance.summary (query) — absorbs compliance.violations via detail param',
+    domain: 'memory',
+    operation: 'fetch',
+    description: 'memory.fetch (query) — batch fetch brain entries by IDs',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    requiredParams: ['ids'],
+    params: [
+      {
+        name: 'ids',
+        type: 'string',
+        required: true,
+        description: 'ids parameter',
+      },
+    ] satisfies ParamDef[],
   },
-  // compliance.violations removed — merged into compliance.summary via detail param (T5615)
-  // test.status removed — merged into test op (T5615)
+  // memory.stats, memory.contradictions, memory.superseded removed — dashboard-only (T5615)
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'test',
-    description: 'check.test (query) — combined test status and coverage via format param',
+    domain: 'memory',
+    operation: 'decision.find',
+    description: 'memory.decision.find (query) — search decisions in brain.db',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1615,173 +1570,205 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'test.coverage',
-    description: 'check.test.coverage (query) — dedicated test coverage sub-op (T1434)',
+    domain: 'memory',
+    operation: 'pattern.find',
+    description:
+      'memory.pattern.find (query) — search BRAIN pattern memory by type, impact, or keyword',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
+  // memory.pattern.stats removed — dashboard-only (T5615)
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'coherence',
-    description: 'check.coherence (query) — renamed from coherence.check (T5615)',
+    domain: 'memory',
+    operation: 'learning.find',
+    description:
+      'memory.learning.find (query) — search BRAIN learning memory by confidence, actionability, or keyword',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
-
-  // Protocol validation operations (T5327) — protocol.consensus/contribution/decomposition/implementation/specification
-  // removed — folded into check.protocol via protocolType param (T5615)
-
-  // gate.verify split into gate.status (query) + gate.set (mutate) (T5615)
+  // memory.learning.stats removed — dashboard-only (T5615)
+  // memory — PageIndex graph queries (T5385)
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'gate.status',
-    description: 'check.gate.status (query) — read-only gate state; split from gate.verify (T5615)',
-    tier: 1,
+    domain: 'memory',
+    operation: 'graph.show',
+    description: 'memory.graph.show (query) — get a PageIndex graph node and its edges',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['taskId'],
+    requiredParams: ['nodeId'],
     params: [
       {
-        name: 'taskId',
+        name: 'nodeId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'nodeId parameter',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
-  // check.archive.stats incoming from admin (T5615)
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'archive.stats',
-    description: 'check.archive.stats (query) — archive analytics; moved from admin (T5615)',
-    tier: 1,
+    domain: 'memory',
+    operation: 'graph.neighbors',
+    description: 'memory.graph.neighbors (query) — get neighbor nodes from the PageIndex graph',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    requiredParams: ['nodeId'],
+    params: [
+      {
+        name: 'nodeId',
+        type: 'string',
+        required: true,
+        description: 'nodeId parameter',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
   },
-  // check.grade and check.grade.list incoming from admin (T5615)
+  // memory — Graph traversal (T535)
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'grade',
-    description: 'check.grade (query) — grade agent behavioral session; moved from admin (T5615)',
+    domain: 'memory',
+    operation: 'graph.trace',
+    description:
+      'memory.graph.trace (query) — BFS traversal from a seed node via recursive CTE (T535)',
     tier: 2,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['sessionId'],
+    requiredParams: ['nodeId'],
     params: [
       {
-        name: 'sessionId',
+        name: 'nodeId',
         type: 'string',
         required: true,
-        description: 'sessionId parameter',
+        description: 'nodeId parameter',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'grade.list',
+    domain: 'memory',
+    operation: 'graph.related',
     description:
-      'check.grade.list (query) — list past session grade results; moved from admin (T5615)',
+      'memory.graph.related (query) — 1-hop typed neighbours of a brain graph node (T535)',
     tier: 2,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
+    requiredParams: ['nodeId'],
     params: [
       {
-        name: 'sessionId',
+        name: 'nodeId',
         type: 'string',
-        required: false,
-        description: 'Filter grades by session ID',
-      },
-      {
-        name: 'limit',
-        type: 'number',
-        required: false,
-        description: 'Maximum grade entries to return',
+        required: true,
+        description: 'nodeId parameter',
+        cli: { positional: true },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'query',
+    domain: 'memory',
+    operation: 'graph.context',
+    description:
+      'memory.graph.context (query) — 360-degree context view: node + all edges + neighbours (T535)',
+    tier: 2,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['nodeId'],
+    params: [
       {
-        name: 'offset',
-        type: 'number',
-        required: false,
-        description: 'Skip this many grade entries before returning results',
+        name: 'nodeId',
+        type: 'string',
+        required: true,
+        description: 'nodeId parameter',
+        cli: { positional: true },
       },
-    ],
+    ] satisfies ParamDef[],
   },
-  // T646: Canon drift detection — CI gate
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'canon',
-    description:
-      'check.canon (query) — CI gate: detects canon drift between docs and live code (CANONICAL_DOMAINS count, forbidden phrases, required assertions)',
-    tier: 1,
+    domain: 'memory',
+    operation: 'graph.stats',
+    description: 'memory.graph.stats (query) — aggregate node and edge counts by type (T535)',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
-  // T9796: Docs canon-routing CI gate — block raw *.md writes that bypass SSoT
+  // memory — Reasoning & hybrid search (T5388-T5393)
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'canon.docs',
-    description:
-      'check.canon.docs (query) — CI gate: rejects new *.md files that bypass the docs SSoT (.cleo/canon.yml routing). Scans git diff additions against rawMdPaths whose owning DocKind has rawMdAllowed:false.',
-    tier: 1,
+    domain: 'memory',
+    operation: 'reason.why',
+    description: 'memory.reason.why (query) — causal trace through task dependency chains',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'baseRef',
+        name: 'taskId',
         type: 'string',
-        required: false,
-        description: 'Git revision to diff against (default: origin/main)',
+        required: true,
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
-    ],
+    ] satisfies ParamDef[],
   },
-  // T065: Agent workflow compliance telemetry
   {
     gateway: 'query',
-    domain: 'check',
-    operation: 'workflow.compliance',
-    description:
-      'check.workflow.compliance (query) — WF-001..WF-005 compliance dashboard; AC rate, session rate, gate rate',
-    tier: 1,
+    domain: 'memory',
+    operation: 'reason.similar',
+    description: 'memory.reason.similar (query) — find semantically similar brain entries',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
+    requiredParams: ['entryId'],
     params: [
       {
-        name: 'since',
+        name: 'entryId',
         type: 'string',
-        required: false,
-        description: 'ISO 8601 date; only include tasks/events on or after this date',
+        required: true,
+        description: 'entryId parameter',
+        cli: { positional: true },
       },
-    ],
+    ] satisfies ParamDef[],
   },
-
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'version',
-    description: 'admin.version (query)',
-    tier: 0,
+    domain: 'memory',
+    operation: 'search.hybrid',
+    description: 'memory.search.hybrid (query) — hybrid search across FTS5, vector, and graph',
+    tier: 2,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['query'],
+    params: [
+      {
+        name: 'query',
+        type: 'string',
+        required: true,
+        description: 'query parameter',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'query',
+    domain: 'pipeline',
+    operation: 'stage.validate',
+    description: 'pipeline.stage.validate (query) — absorbs stage.prerequisites in response',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
@@ -1789,10 +1776,10 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'health',
-    description: 'admin.health (query) — absorbs doctor via mode param',
-    tier: 0,
+    domain: 'pipeline',
+    operation: 'stage.status',
+    description: 'pipeline.stage.status (query) — absorbs stage.gates via include param',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
@@ -1800,9 +1787,9 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'config.show',
-    description: 'admin.config.show (query) — show config value',
+    domain: 'pipeline',
+    operation: 'stage.history',
+    description: 'pipeline.stage.history (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1811,88 +1798,73 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'config.presets',
+    domain: 'pipeline',
+    operation: 'stage.guidance',
     description:
-      'admin.config.presets (query) — list all strictness presets with descriptions and values',
+      'pipeline.stage.guidance (query) — stage-aware LLM prompt guidance (Phase 2). Pi extensions shell out to this on before_agent_start.',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
+  // stage.gates removed — merged into stage.status via include param (T5615)
+  // stage.prerequisites removed — always returned in stage.validate response (T5615)
+  // T5326: Phase management operations
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'config.get',
-    description:
-      'admin.config.get (query) — resolve a single config value via the ConfigManifest cascade (T11917)',
+    domain: 'pipeline',
+    operation: 'phase.show',
+    description: 'Show phase details by slug or current phase (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['key'],
+    requiredParams: [],
     params: [
       {
-        name: 'key',
-        type: 'string',
-        required: true,
-        description: 'Dot-notation config key to read (e.g. "release.branchModel")',
-        cli: { positional: true },
-      },
-      {
-        name: 'scope',
+        name: 'phaseId',
         type: 'string',
         required: false,
-        description: 'Cascade slice to read from. Defaults to merged.',
-        enum: ['global', 'project', 'merged'],
+        description: 'Phase slug (omit for current phase)',
       },
-    ] satisfies ParamDef[],
+    ],
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'config.list',
-    description:
-      'admin.config.list (query) — resolve the full config cascade and its flattened keys (T11917)',
+    domain: 'pipeline',
+    operation: 'phase.list',
+    description: 'List all phases with status and task counts (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [
-      {
-        name: 'scope',
-        type: 'string',
-        required: false,
-        description: 'Cascade slice to project. Defaults to merged.',
-        enum: ['global', 'project', 'merged'],
-      },
-    ] satisfies ParamDef[],
+    params: [],
   },
+  // pipeline manifest query operations (T5241 — moved from memory)
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'config.validate',
-    description:
-      'admin.config.validate (query) — schema-validate a scoped config file via its manifest entry (T11917)',
+    domain: 'pipeline',
+    operation: 'manifest.show',
+    description: 'pipeline.manifest.show (query) — get manifest entry by ID',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
+    requiredParams: ['entryId'],
     params: [
       {
-        name: 'scope',
+        name: 'entryId',
         type: 'string',
-        required: false,
-        description: 'Scope to validate (global or project). Defaults to project.',
-        enum: ['global', 'project'],
+        required: true,
+        description: 'entryId parameter',
+        cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'stats',
-    description: 'admin.stats (query)',
+    domain: 'pipeline',
+    operation: 'manifest.list',
+    description: 'pipeline.manifest.list (query) — list manifest entries with filters',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1901,9 +1873,29 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'context',
-    description: 'admin.context (query)',
+    domain: 'pipeline',
+    operation: 'manifest.find',
+    description: 'pipeline.manifest.find (query) — search manifest entries by text',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['query'],
+    params: [
+      {
+        name: 'query',
+        type: 'string',
+        required: true,
+        description: 'query parameter',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
+  },
+  // manifest.pending removed — merged into manifest.list via filter param (T5615)
+  {
+    gateway: 'query',
+    domain: 'pipeline',
+    operation: 'manifest.stats',
+    description: 'pipeline.manifest.stats (query) — manifest statistics',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1911,31 +1903,29 @@ export const OPERATIONS: OperationDef[] = [
     params: [],
   },
   {
-    // T549 Wave 5-A: JIT task context pull — bundles task + brain memories + handoff
     gateway: 'query',
-    domain: 'admin',
-    operation: 'context.pull',
-    description:
-      'admin.context.pull (query) — JIT bundle: task details + brain memories + last handoff',
+    domain: 'check',
+    operation: 'schema',
+    description: 'check.schema (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['taskId'],
+    requiredParams: ['type'],
     params: [
       {
-        name: 'taskId',
+        name: 'type',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Schema type to validate (todo, config, archive, log, sessions)',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'runtime',
-    description: 'admin.runtime (query)',
+    domain: 'check',
+    operation: 'protocol',
+    description: 'check.protocol (query) — absorbs all protocol.* ops via protocolType param',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -1944,59 +1934,32 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'paths',
-    description:
-      'admin.paths (query) — report all CleoOS paths (project + global hub) and scaffolding status',
+    domain: 'check',
+    operation: 'task',
+    description: 'check.task (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
-  // admin.job.status + admin.job.list merged into admin.job via action param (T5615)
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'job',
-    description: 'admin.job (query) — absorbs job.status and job.list via action param',
+    domain: 'check',
+    operation: 'manifest',
+    description: 'check.manifest (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [
-      {
-        name: 'action',
-        type: 'string',
-        required: false,
-        description: 'Action: status (default) or list',
-      },
-      {
-        name: 'status',
-        type: 'string',
-        required: false,
-        description: 'Filter jobs by status (for list action)',
-      },
-      {
-        name: 'limit',
-        type: 'number',
-        required: false,
-        description: 'Maximum jobs to return (for list action)',
-      },
-      {
-        name: 'offset',
-        type: 'number',
-        required: false,
-        description: 'Skip this many jobs before returning results',
-      },
-    ],
+    params: [],
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'dash',
-    description: 'admin.dash (query)',
-    tier: 0,
+    domain: 'check',
+    operation: 'output',
+    description: 'check.output (query)',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
@@ -2004,34 +1967,23 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'roadmap',
+    domain: 'check',
+    operation: 'compliance.summary',
     description:
-      'admin.roadmap (query) — project roadmap from task provenance, epics grouped by status with progress',
+      'check.compliance.summary (query) — absorbs compliance.violations via detail param',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [
-      {
-        name: 'includeHistory',
-        type: 'boolean',
-        required: false,
-        description: 'Include release history from CHANGELOG.md',
-      },
-      {
-        name: 'upcomingOnly',
-        type: 'boolean',
-        required: false,
-        description: 'Only show pending/upcoming epics (exclude completed)',
-      },
-    ],
+    params: [],
   },
+  // compliance.violations removed — merged into compliance.summary via detail param (T5615)
+  // test.status removed — merged into test op (T5615)
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'log',
-    description: 'admin.log (query)',
+    domain: 'check',
+    operation: 'test',
+    description: 'check.test (query) — combined test status and coverage via format param',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -2040,9 +1992,9 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'sequence',
-    description: 'admin.sequence (query)',
+    domain: 'check',
+    operation: 'test.coverage',
+    description: 'check.test.coverage (query) — dedicated test coverage sub-op (T1434)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -2051,104 +2003,162 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'admin',
-    operation: 'help',
-    description: 'Get operations list and guidance filtered to specified disclosure tier',
-    tier: 0,
+    domain: 'check',
+    operation: 'coherence',
+    description: 'check.coherence (query) — renamed from coherence.check (T5615)',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
+    params: [],
+  },
+
+  // Protocol validation operations (T5327) — protocol.consensus/contribution/decomposition/implementation/specification
+  // removed — folded into check.protocol via protocolType param (T5615)
+
+  // gate.verify split into gate.status (query) + gate.set (mutate) (T5615)
+  {
+    gateway: 'query',
+    domain: 'check',
+    operation: 'gate.status',
+    description: 'check.gate.status (query) — read-only gate state; split from gate.verify (T5615)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'tier',
-        type: 'number',
-        required: false,
-        description:
-          'Progressive disclosure tier: 0=basic (default), 1=+memory/check, 2=all operations',
-        cli: { flag: 'tier', short: '-t' },
-      },
-      {
-        name: 'verbose',
-        type: 'boolean',
-        required: false,
-        description: 'Return full operation objects instead of compact domain-grouped format',
-        cli: { flag: 'verbose' },
+        name: 'taskId',
+        type: 'string',
+        required: true,
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
-    ],
+    ] satisfies ParamDef[],
   },
+  // check.archive.stats incoming from admin (T5615)
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'issue.diagnostics',
-    description: 'tools.issue.diagnostics (query) — CLEO install integrity check',
+    domain: 'check',
+    operation: 'archive.stats',
+    description: 'check.archive.stats (query) — archive analytics; moved from admin (T5615)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
-  // tools.issue.templates and tools.issue.validate.labels moved to ct-github-issues plugin (T5615)
+  // check.grade and check.grade.list incoming from admin (T5615)
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.list',
-    description: 'tools.skill.list (query)',
-    tier: 0,
+    domain: 'check',
+    operation: 'grade',
+    description: 'check.grade (query) — grade agent behavioral session; moved from admin (T5615)',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    requiredParams: ['sessionId'],
+    params: [
+      {
+        name: 'sessionId',
+        type: 'string',
+        required: true,
+        description: 'sessionId parameter',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
   },
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.show',
-    description: 'tools.skill.show (query)',
-    tier: 1,
+    domain: 'check',
+    operation: 'grade.list',
+    description:
+      'check.grade.list (query) — list past session grade results; moved from admin (T5615)',
+    tier: 2,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [],
+    params: [
+      {
+        name: 'sessionId',
+        type: 'string',
+        required: false,
+        description: 'Filter grades by session ID',
+      },
+      {
+        name: 'limit',
+        type: 'number',
+        required: false,
+        description: 'Maximum grade entries to return',
+      },
+      {
+        name: 'offset',
+        type: 'number',
+        required: false,
+        description: 'Skip this many grade entries before returning results',
+      },
+    ],
   },
+  // T646: Canon drift detection — CI gate
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.find',
-    description: 'tools.skill.find (query)',
+    domain: 'check',
+    operation: 'canon',
+    description:
+      'check.canon (query) — CI gate: detects canon drift between docs and live code (CANONICAL_DOMAINS count, forbidden phrases, required assertions)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
+  // T9796: Docs canon-routing CI gate — block raw *.md writes that bypass SSoT
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.federated.find',
-    description: 'tools.skill.federated.find (T9731 federation-aware multi-source query)',
+    domain: 'check',
+    operation: 'canon.docs',
+    description:
+      'check.canon.docs (query) — CI gate: rejects new *.md files that bypass the docs SSoT (.cleo/canon.yml routing). Scans git diff additions against rawMdPaths whose owning DocKind has rawMdAllowed:false.',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [],
+    params: [
+      {
+        name: 'baseRef',
+        type: 'string',
+        required: false,
+        description: 'Git revision to diff against (default: origin/main)',
+      },
+    ],
   },
+  // T065: Agent workflow compliance telemetry
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.dispatch',
-    description: 'tools.skill.dispatch (query)',
+    domain: 'check',
+    operation: 'workflow.compliance',
+    description:
+      'check.workflow.compliance (query) — WF-001..WF-005 compliance dashboard; AC rate, session rate, gate rate',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [],
+    params: [
+      {
+        name: 'since',
+        type: 'string',
+        required: false,
+        description: 'ISO 8601 date; only include tasks/events on or after this date',
+      },
+    ],
   },
+
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.verify',
-    description: 'tools.skill.verify (query)',
-    tier: 1,
+    domain: 'admin',
+    operation: 'version',
+    description: 'admin.version (query)',
+    tier: 0,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
@@ -2156,10 +2166,10 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.dependencies',
-    description: 'tools.skill.dependencies (query)',
-    tier: 1,
+    domain: 'admin',
+    operation: 'health',
+    description: 'admin.health (query) — absorbs doctor via mode param',
+    tier: 0,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
@@ -2167,214 +2177,202 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.spawn.providers',
-    description: 'List spawn-capable providers by capability (query)',
+    domain: 'admin',
+    operation: 'config.show',
+    description: 'admin.config.show (query) — show config value',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [],
   },
-  // skill.catalog.protocols/profiles/resources/info merged into skill.catalog via type param (T5615)
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.catalog',
+    domain: 'admin',
+    operation: 'config.presets',
     description:
-      'tools.skill.catalog (query) — CAAMP catalog; absorbs catalog.protocols/profiles/resources/info via type param',
-    tier: 2,
+      'admin.config.presets (query) — list all strictness presets with descriptions and values',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [
-      {
-        name: 'type',
-        type: 'string',
-        required: false,
-        description: 'Catalog type: protocols, profiles, resources, or info (default)',
-      },
-    ],
+    params: [],
   },
-  // skill.precedence.show/resolve merged into skill.precedence via action param (T5615)
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.precedence',
+    domain: 'admin',
+    operation: 'config.get',
     description:
-      'tools.skill.precedence (query) — absorbs precedence.show and precedence.resolve via action param',
+      'admin.config.get (query) — resolve a single config value via the ConfigManifest cascade (T11917)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: [],
+    requiredParams: ['key'],
     params: [
       {
-        name: 'action',
+        name: 'key',
         type: 'string',
-        required: false,
-        description: 'Action: show (default) or resolve',
+        required: true,
+        description: 'Dot-notation config key to read (e.g. "release.branchModel")',
+        cli: { positional: true },
       },
       {
-        name: 'providerId',
+        name: 'scope',
         type: 'string',
         required: false,
-        description: 'Provider ID for resolve action',
+        description: 'Cascade slice to read from. Defaults to merged.',
+        enum: ['global', 'project', 'merged'],
       },
-    ],
+    ] satisfies ParamDef[],
   },
-  // T9652 — skill.doctor.diagnose: read-only health report
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.doctor.diagnose',
+    domain: 'admin',
+    operation: 'config.list',
     description:
-      'tools.skill.doctor.diagnose (query) — read-only health report on skill storage layout, db drift, orphans, and bridge symlinks',
+      'admin.config.list (query) — resolve the full config cascade and its flattened keys (T11917)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [
       {
-        name: 'verbose',
-        type: 'boolean',
+        name: 'scope',
+        type: 'string',
         required: false,
-        description: 'Include per-skill detail in the rendered human-readable summary',
+        description: 'Cascade slice to project. Defaults to merged.',
+        enum: ['global', 'project', 'merged'],
       },
-    ],
+    ] satisfies ParamDef[],
   },
-  // T9693 — skill.prune.telemetry: skill_usage retention sweep
   {
-    gateway: 'mutate',
-    domain: 'tools',
-    operation: 'skill.prune.telemetry',
+    gateway: 'query',
+    domain: 'admin',
+    operation: 'config.validate',
     description:
-      'tools.skill.prune.telemetry (mutate) — delete skill_usage rows older than --older-than DAYS (default 180)',
-    tier: 2,
+      'admin.config.validate (query) — schema-validate a scoped config file via its manifest entry (T11917)',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [
       {
-        name: 'olderThanDays',
-        type: 'number',
-        required: false,
-        description: 'Age threshold in days (default 180)',
-      },
-      {
-        name: 'dryRun',
-        type: 'boolean',
-        required: false,
-        description: 'Return the plan without touching the DB',
-      },
-      {
-        name: 'vacuum',
-        type: 'boolean',
+        name: 'scope',
+        type: 'string',
         required: false,
-        description: 'Run VACUUM after the delete to reclaim disk space',
+        description: 'Scope to validate (global or project). Defaults to project.',
+        enum: ['global', 'project'],
       },
-    ],
+    ] satisfies ParamDef[],
   },
-  // T9691 — skill.import.hermes: Hermes sidecar migration
   {
-    gateway: 'mutate',
-    domain: 'tools',
-    operation: 'skill.import.hermes',
-    description:
-      'tools.skill.import.hermes (mutate) — migrate ~/.hermes/skills/.usage.json sidecars into CLEO skills.db',
-    tier: 2,
+    gateway: 'query',
+    domain: 'admin',
+    operation: 'stats',
+    description: 'admin.stats (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'admin',
+    operation: 'context',
+    description: 'admin.context (query)',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
+    params: [],
+  },
+  {
+    // T549 Wave 5-A: JIT task context pull — bundles task + brain memories + handoff
+    gateway: 'query',
+    domain: 'admin',
+    operation: 'context.pull',
+    description:
+      'admin.context.pull (query) — JIT bundle: task details + brain memories + last handoff',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'hermesHome',
+        name: 'taskId',
         type: 'string',
-        required: false,
-        description: 'Override Hermes home directory (default: $HERMES_HOME or ~/.hermes)',
-      },
-      {
-        name: 'dryRun',
-        type: 'boolean',
-        required: false,
-        description: 'Print planned writes without mutating skills.db',
+        required: true,
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
-    ],
+    ] satisfies ParamDef[],
   },
-  // T9742 — skill.migrate: legacy XDG store → ~/.cleo/skills/
   {
-    gateway: 'mutate',
-    domain: 'tools',
-    operation: 'skill.migrate',
-    description:
-      'tools.skill.migrate (mutate) — migrate ~/.local/share/agents/skills into ~/.cleo/skills (SSoT); supports --dry-run preview + --rollback restore',
-    tier: 2,
+    gateway: 'query',
+    domain: 'admin',
+    operation: 'runtime',
+    description: 'admin.runtime (query)',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [
-      {
-        name: 'dryRun',
-        type: 'boolean',
-        required: false,
-        description: 'Preview the plan without writing anything',
-      },
-      {
-        name: 'rollback',
-        type: 'boolean',
-        required: false,
-        description: 'Restore the legacy tree from the most recent backup tarball',
-      },
-    ],
+    params: [],
   },
-  // T9690 — skill.stats: Sphere B telemetry rollup
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'skill.stats',
+    domain: 'admin',
+    operation: 'paths',
     description:
-      'tools.skill.stats (query) — Sphere B telemetry rollup; top-N usage, lifecycle / source-type breakdowns, agent-created list',
+      'admin.paths (query) — report all CleoOS paths (project + global hub) and scaffolding status',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  // admin.job.status + admin.job.list merged into admin.job via action param (T5615)
+  {
+    gateway: 'query',
+    domain: 'admin',
+    operation: 'job',
+    description: 'admin.job (query) — absorbs job.status and job.list via action param',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
     params: [
       {
-        name: 'top',
-        type: 'number',
-        required: false,
-        description: 'Top-N usage rollup limit (default 10)',
-      },
-      {
-        name: 'sinceDays',
-        type: 'number',
+        name: 'action',
+        type: 'string',
         required: false,
-        description: 'Restrict top-N rollup to the last N days (default: all-time)',
+        description: 'Action: status (default) or list',
       },
       {
-        name: 'bySource',
-        type: 'boolean',
+        name: 'status',
+        type: 'string',
         required: false,
-        description: 'Include source-type breakdown facet',
+        description: 'Filter jobs by status (for list action)',
       },
       {
-        name: 'byLifecycle',
-        type: 'boolean',
+        name: 'limit',
+        type: 'number',
         required: false,
-        description: 'Include lifecycle-state breakdown facet',
+        description: 'Maximum jobs to return (for list action)',
       },
       {
-        name: 'agentCreated',
-        type: 'boolean',
+        name: 'offset',
+        type: 'number',
         required: false,
-        description: 'Include agent-created skill list facet',
+        description: 'Skip this many jobs before returning results',
       },
     ],
   },
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'provider.list',
-    description: 'tools.provider.list (query)',
+    domain: 'admin',
+    operation: 'dash',
+    description: 'admin.dash (query)',
     tier: 0,
     idempotent: true,
     sessionRequired: false,
@@ -2383,20 +2381,34 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'provider.detect',
-    description: 'tools.provider.detect (query)',
-    tier: 0,
+    domain: 'admin',
+    operation: 'roadmap',
+    description:
+      'admin.roadmap (query) — project roadmap from task provenance, epics grouped by status with progress',
+    tier: 1,
     idempotent: true,
     sessionRequired: false,
     requiredParams: [],
-    params: [],
+    params: [
+      {
+        name: 'includeHistory',
+        type: 'boolean',
+        required: false,
+        description: 'Include release history from CHANGELOG.md',
+      },
+      {
+        name: 'upcomingOnly',
+        type: 'boolean',
+        required: false,
+        description: 'Only show pending/upcoming epics (exclude completed)',
+      },
+    ],
   },
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'provider.inject.status',
-    description: 'tools.provider.inject.status (query)',
+    domain: 'admin',
+    operation: 'log',
+    description: 'admin.log (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
@@ -2405,381 +2417,1344 @@ export const OPERATIONS: OperationDef[] = [
   },
   {
     gateway: 'query',
-    domain: 'tools',
-    operation: 'provider.supports',
-    description: 'tools.provider.supports (query) - check if provider supports capability',
+    domain: 'admin',
+    operation: 'sequence',
+    description: 'admin.sequence (query)',
     tier: 1,
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['providerId', 'capability'],
-    params: [
-      {
-        name: 'providerId',
-        type: 'string',
-        required: true,
-        description: 'Provider ID (e.g., claude-code)',
-      },
-      {
-        name: 'capability',
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'admin',
+    operation: 'help',
+    description: 'Get operations list and guidance filtered to specified disclosure tier',
+    tier: 0,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'tier',
+        type: 'number',
+        required: false,
+        description:
+          'Progressive disclosure tier: 0=basic (default), 1=+memory/check, 2=all operations',
+        cli: { flag: 'tier', short: '-t' },
+      },
+      {
+        name: 'verbose',
+        type: 'boolean',
+        required: false,
+        description: 'Return full operation objects instead of compact domain-grouped format',
+        cli: { flag: 'verbose' },
+      },
+    ],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'issue.diagnostics',
+    description: 'tools.issue.diagnostics (query) — CLEO install integrity check',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  // tools.issue.templates and tools.issue.validate.labels moved to ct-github-issues plugin (T5615)
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.list',
+    description: 'tools.skill.list (query)',
+    tier: 0,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.show',
+    description: 'tools.skill.show (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.find',
+    description: 'tools.skill.find (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.federated.find',
+    description: 'tools.skill.federated.find (T9731 federation-aware multi-source query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.dispatch',
+    description: 'tools.skill.dispatch (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.verify',
+    description: 'tools.skill.verify (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.dependencies',
+    description: 'tools.skill.dependencies (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.spawn.providers',
+    description: 'List spawn-capable providers by capability (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  // skill.catalog.protocols/profiles/resources/info merged into skill.catalog via type param (T5615)
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.catalog',
+    description:
+      'tools.skill.catalog (query) — CAAMP catalog; absorbs catalog.protocols/profiles/resources/info via type param',
+    tier: 2,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'type',
+        type: 'string',
+        required: false,
+        description: 'Catalog type: protocols, profiles, resources, or info (default)',
+      },
+    ],
+  },
+  // skill.precedence.show/resolve merged into skill.precedence via action param (T5615)
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.precedence',
+    description:
+      'tools.skill.precedence (query) — absorbs precedence.show and precedence.resolve via action param',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'action',
+        type: 'string',
+        required: false,
+        description: 'Action: show (default) or resolve',
+      },
+      {
+        name: 'providerId',
+        type: 'string',
+        required: false,
+        description: 'Provider ID for resolve action',
+      },
+    ],
+  },
+  // T9652 — skill.doctor.diagnose: read-only health report
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.doctor.diagnose',
+    description:
+      'tools.skill.doctor.diagnose (query) — read-only health report on skill storage layout, db drift, orphans, and bridge symlinks',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'verbose',
+        type: 'boolean',
+        required: false,
+        description: 'Include per-skill detail in the rendered human-readable summary',
+      },
+    ],
+  },
+  // T9693 — skill.prune.telemetry: skill_usage retention sweep
+  {
+    gateway: 'mutate',
+    domain: 'tools',
+    operation: 'skill.prune.telemetry',
+    description:
+      'tools.skill.prune.telemetry (mutate) — delete skill_usage rows older than --older-than DAYS (default 180)',
+    tier: 2,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'olderThanDays',
+        type: 'number',
+        required: false,
+        description: 'Age threshold in days (default 180)',
+      },
+      {
+        name: 'dryRun',
+        type: 'boolean',
+        required: false,
+        description: 'Return the plan without touching the DB',
+      },
+      {
+        name: 'vacuum',
+        type: 'boolean',
+        required: false,
+        description: 'Run VACUUM after the delete to reclaim disk space',
+      },
+    ],
+  },
+  // T9691 — skill.import.hermes: Hermes sidecar migration
+  {
+    gateway: 'mutate',
+    domain: 'tools',
+    operation: 'skill.import.hermes',
+    description:
+      'tools.skill.import.hermes (mutate) — migrate ~/.hermes/skills/.usage.json sidecars into CLEO skills.db',
+    tier: 2,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'hermesHome',
+        type: 'string',
+        required: false,
+        description: 'Override Hermes home directory (default: $HERMES_HOME or ~/.hermes)',
+      },
+      {
+        name: 'dryRun',
+        type: 'boolean',
+        required: false,
+        description: 'Print planned writes without mutating skills.db',
+      },
+    ],
+  },
+  // T9742 — skill.migrate: legacy XDG store → ~/.cleo/skills/
+  {
+    gateway: 'mutate',
+    domain: 'tools',
+    operation: 'skill.migrate',
+    description:
+      'tools.skill.migrate (mutate) — migrate ~/.local/share/agents/skills into ~/.cleo/skills (SSoT); supports --dry-run preview + --rollback restore',
+    tier: 2,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'dryRun',
+        type: 'boolean',
+        required: false,
+        description: 'Preview the plan without writing anything',
+      },
+      {
+        name: 'rollback',
+        type: 'boolean',
+        required: false,
+        description: 'Restore the legacy tree from the most recent backup tarball',
+      },
+    ],
+  },
+  // T9690 — skill.stats: Sphere B telemetry rollup
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'skill.stats',
+    description:
+      'tools.skill.stats (query) — Sphere B telemetry rollup; top-N usage, lifecycle / source-type breakdowns, agent-created list',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'top',
+        type: 'number',
+        required: false,
+        description: 'Top-N usage rollup limit (default 10)',
+      },
+      {
+        name: 'sinceDays',
+        type: 'number',
+        required: false,
+        description: 'Restrict top-N rollup to the last N days (default: all-time)',
+      },
+      {
+        name: 'bySource',
+        type: 'boolean',
+        required: false,
+        description: 'Include source-type breakdown facet',
+      },
+      {
+        name: 'byLifecycle',
+        type: 'boolean',
+        required: false,
+        description: 'Include lifecycle-state breakdown facet',
+      },
+      {
+        name: 'agentCreated',
+        type: 'boolean',
+        required: false,
+        description: 'Include agent-created skill list facet',
+      },
+    ],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'provider.list',
+    description: 'tools.provider.list (query)',
+    tier: 0,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'provider.detect',
+    description: 'tools.provider.detect (query)',
+    tier: 0,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'provider.inject.status',
+    description: 'tools.provider.inject.status (query)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'provider.supports',
+    description: 'tools.provider.supports (query) - check if provider supports capability',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['providerId', 'capability'],
+    params: [
+      {
+        name: 'providerId',
+        type: 'string',
+        required: true,
+        description: 'Provider ID (e.g., claude-code)',
+      },
+      {
+        name: 'capability',
+        type: 'string',
+        required: true,
+        description: 'Capability path in dot notation (e.g., spawn.supportsSubagents)',
+      },
+    ],
+  },
+  {
+    gateway: 'query',
+    domain: 'tools',
+    operation: 'provider.hooks',
+    description: 'tools.provider.hooks (query) - list providers by hook event support',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['event'],
+    params: [
+      {
+        name: 'event',
+        type: 'string',
+        required: true,
+        description: 'Hook event to query (e.g., onSessionStart, onToolComplete)',
+      },
+    ],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'add',
+    description: 'tasks.add (mutate)',
+    tier: 0,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['title'],
+    params: [
+      {
+        name: 'title',
+        type: 'string',
+        required: true,
+        description: 'Task title (3\u2013500 characters)',
+        cli: { positional: true },
+      },
+      {
+        name: 'parent',
+        type: 'string',
+        required: false,
+        description: 'Parent task ID (makes this task a subtask)',
+        cli: { flag: 'parent' },
+      },
+      {
+        name: 'priority',
+        type: 'string',
+        required: false,
+        description: "Task priority (default 'medium'; 'normal' is an alias)",
+        enum: ['low', 'medium', 'high', 'critical'] as const,
+        cli: { flag: 'priority', short: '-p' },
+      },
+      {
+        name: 'type',
+        type: 'string',
+        required: false,
+        description: 'Task type',
+        enum: ['epic', 'task', 'subtask'] as const,
+        cli: { flag: 'type', short: '-t' },
+      },
+      {
+        name: 'size',
+        type: 'string',
+        required: false,
+        description: 'Scope size estimate',
+        enum: ['small', 'medium', 'large'] as const,
+        cli: { flag: 'size' },
+      },
+      {
+        name: 'description',
+        type: 'string',
+        required: false,
+        description: 'Detailed task description (must differ meaningfully from title)',
+        cli: { flag: 'description', short: '-d' },
+      },
+      {
+        name: 'acceptance',
+        type: 'array',
+        required: false,
+        description: 'Pipe-separated acceptance criteria (e.g. "AC1|AC2|AC3")',
+        cli: { flag: 'acceptance' },
+      },
+      {
+        name: 'labels',
+        type: 'array',
+        required: false,
+        description: 'Comma-separated labels',
+        cli: { flag: 'labels', short: '-l' },
+      },
+      {
+        name: 'depends',
+        type: 'array',
+        required: false,
+        description: 'Comma-separated dependency task IDs',
+        cli: { flag: 'depends', short: '-D' },
+      },
+      {
+        name: 'phase',
+        type: 'string',
+        required: false,
+        description: 'Phase slug to assign the task to',
+        cli: { flag: 'phase', short: '-P' },
+      },
+      {
+        name: 'notes',
+        type: 'string',
+        required: false,
+        description: 'Initial note entry for the task',
+        cli: { flag: 'notes' },
+      },
+      {
+        name: 'due',
+        type: 'string',
+        required: false,
+        description: 'Due date (RFC 3339 full-date or date-time)',
+        cli: { flag: 'due' },
+      },
+      {
+        name: 'recurrence',
+        type: 'string',
+        required: false,
+        description: "Recurrence rule: interval like '7d' (m|h|d|w), '@weekly', or JSON {every}",
+        cli: { flag: 'recurrence' },
+      },
+      {
+        name: 'estimate',
+        type: 'number',
+        required: false,
+        description: 'Effort estimate (story points or hours, >= 0)',
+        cli: { flag: 'estimate' },
+      },
+      {
+        name: 'track',
+        type: 'string',
+        required: false,
+        description: 'Orchestration track; overrides the saga track and trackRules',
+        cli: { flag: 'track' },
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'add-batch',
+    description:
+      'tasks.add-batch (mutate) — bulk-create N tasks atomically in a single transaction (epic decomposition: 1 call instead of N)',
+    tier: 0,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['tasks'],
+    params: [
+      {
+        name: 'tasks',
+        type: 'array',
+        required: true,
+        description: 'Array of task specs to insert atomically (each must have a title)',
+      },
+      {
+        name: 'defaultParent',
+        type: 'string',
+        required: false,
+        description: 'Optional default parent task ID applied when a task spec omits parent',
+      },
+      {
+        name: 'dryRun',
+        type: 'boolean',
+        required: false,
+        description: 'Validate and predict IDs without writing to the database',
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    // Transactional multi-step mutate — exposed over MCP so orchestration
+    // agents get the same all-or-nothing guarantee as `cleo batch`.
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'batch',
+    description:
+      'tasks.batch (mutate) — apply an ordered list of create/update/complete steps in ONE transaction; any failing step rolls back the whole batch and reports its index',
+    tier: 0,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['operations'],
+    params: [
+      {
+        name: 'operations',
+        type: 'array',
+        required: true,
+        description:
+          'Ordered steps, each {op:"create"|"update"|"complete", ...same params as tasks.add / tasks.update / tasks.complete}',
+      },
+      {
+        name: 'dryRun',
+        type: 'boolean',
+        required: false,
+        description: 'Apply every step, report the outcome, then roll the whole batch back',
+      },
+    ] satisfies ParamDef[],
+    mcpExposed: true,
+  },
+  {
+    // T11784 (epic T11556 · E1-GATEWAY-CRUD) — the OperationDef.params used to
+    // declare ONLY `taskId`, so every other mutable field a Studio form binds
+    // to was invisible to dispatch introspection (`cleo update --describe`, the
+    // SDK `describeOperation`) and silently dropped at the dispatch boundary.
+    // The full mutable-field set is now enumerated here, grounded in
+    // {@link TasksUpdateQueryParams} / {@link TASKS_UPDATE_INPUT_SCHEMA}; a
+    // regression test (operations-registry tasks-update parity) asserts the
+    // param names stay in sync with the schema's `properties`.
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'update',
+    description: 'tasks.update (mutate) — partial update; only supplied fields are mutated',
+    tier: 0,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
+    params: [
+      {
+        name: 'taskId',
+        type: 'string',
+        required: true,
+        description: 'Task ID to update',
+        cli: { positional: true },
+      },
+      { name: 'title', type: 'string', required: false, description: 'New title (3–500 chars)' },
+      {
+        name: 'description',
+        type: 'string',
+        required: false,
+        description: 'New description',
+        cli: { flag: 'description', short: '-d' },
+      },
+      {
+        name: 'status',
+        type: 'string',
+        required: false,
+        description: 'New status',
+        enum: ['pending', 'active', 'blocked', 'done', 'cancelled'] as const,
+        cli: { flag: 'status', short: '-s' },
+      },
+      {
+        name: 'priority',
+        type: 'string',
+        required: false,
+        description: "New priority (orthogonal to severity; 'normal' is an alias for 'medium')",
+        enum: ['low', 'medium', 'high', 'critical'] as const,
+        cli: { flag: 'priority', short: '-p' },
+      },
+      { name: 'notes', type: 'string', required: false, description: 'Append a note entry' },
+      {
+        name: 'labels',
+        type: 'array',
+        required: false,
+        description: 'Replace labels (comma-separated)',
+        cli: { flag: 'labels', short: '-l' },
+      },
+      {
+        name: 'addLabels',
+        type: 'array',
+        required: false,
+        description: 'Add labels incrementally',
+      },
+      {
+        name: 'removeLabels',
+        type: 'array',
+        required: false,
+        description: 'Remove labels incrementally',
+      },
+      {
+        name: 'depends',
+        type: 'array',
+        required: false,
+        description: 'Replace dependency task IDs',
+        cli: { flag: 'depends', short: '-D' },
+      },
+      {
+        name: 'addDepends',
+        type: 'array',
+        required: false,
+        description: 'Add dependency task IDs incrementally',
+      },
+      {
+        name: 'removeDepends',
+        type: 'array',
+        required: false,
+        description: 'Remove dependency task IDs incrementally',
+      },
+      {
+        name: 'acceptance',
+        type: 'array',
+        required: false,
+        description: 'Replace acceptance criteria (pipe-separated)',
+      },
+      {
+        name: 'parent',
+        type: 'string',
+        required: false,
+        description: 'Set parent task ID (null/empty promotes to root)',
+      },
+      {
+        name: 'type',
+        type: 'string',
+        required: false,
+        description: 'New hierarchy type',
+        enum: ['saga', 'epic', 'task', 'subtask'] as const,
+        cli: { flag: 'type', short: '-t' },
+      },
+      {
+        name: 'size',
+        type: 'string',
+        required: false,
+        description: 'New scope-size estimate',
+        enum: ['small', 'medium', 'large'] as const,
+      },
+      {
+        name: 'files',
+        type: 'array',
+        required: false,
+        description: 'Replace associated file paths',
+      },
+      {
+        name: 'addFiles',
+        type: 'array',
+        required: false,
+        description: 'Add file paths incrementally',
+      },
+      {
+        name: 'removeFiles',
+        type: 'array',
+        required: false,
+        description: 'Remove file paths incrementally',
+      },
+      {
+        name: 'pipelineStage',
+        type: 'string',
+        required: false,
+        description: 'Set pipeline stage (forward-only)',
+      },
+      {
+        name: 'kind',
+        type: 'string',
+        required: false,
+        description: 'Task kind / intent axis (orthogonal to type)',
+        enum: ['work', 'research', 'experiment', 'bug', 'spike', 'release'] as const,
+      },
+      {
+        name: 'scope',
+        type: 'string',
+        required: false,
+        description: 'Task scope / granularity axis',
+        enum: ['project', 'feature', 'unit'] as const,
+      },
+      {
+        name: 'severity',
+        type: 'string',
+        required: false,
+        description: 'Severity level (orthogonal to priority)',
+        enum: ['P0', 'P1', 'P2', 'P3'] as const,
+      },
+      {
+        name: 'due',
+        type: 'string',
+        required: false,
+        description: 'Due date (RFC 3339 full-date or date-time); empty string clears it',
+      },
+      {
+        name: 'recurrence',
+        type: 'string',
+        required: false,
+        description: "Recurrence rule ('7d', '@weekly', JSON {every}); 'none' stops the chain",
+      },
+      {
+        name: 'estimate',
+        type: 'number',
+        required: false,
+        description: 'Effort estimate (story points or hours, >= 0); null clears it',
+      },
+      {
+        name: 'track',
+        type: 'string',
+        required: false,
+        description: 'Orchestration track; null or empty clears it',
+      },
+      {
+        name: 'assignee',
+        type: 'string',
+        required: false,
+        description: 'Agent or person to pin the task to; null or empty clears it',
+      },
+      {
+        name: 'completionRequirements',
+        type: 'array',
+        required: false,
+        description:
+          'Required before completion (commit, note, estimate, assignee); null inherits the saga list',
+      },
+      {
+        name: 'set',
+        type: 'array',
+        required: false,
+        description: 'Custom field assignments (name=value); an empty value clears the field',
+      },
+      {
+        name: 'reason',
+        type: 'string',
+        required: false,
+        description: 'Operator override reason for the AC-immutability guard',
+      },
+      {
+        name: 'dependsWaiver',
+        type: 'string',
+        required: false,
+        description: 'Justification to waive the critical-priority --depends requirement',
+      },
+      {
+        name: 'blockedBy',
+        type: 'string',
+        required: false,
+        description: 'Set the free-text blockedBy reason (gh#1106)',
+      },
+      {
+        name: 'clearBlockedBy',
+        type: 'boolean',
+        required: false,
+        description: 'Clear the free-text blockedBy reason',
+      },
+      {
+        name: 'relates',
+        type: 'array',
+        required: false,
+        description: 'Replace related-task edges ({taskId, type, reason?})',
+      },
+      {
+        name: 'addRelates',
+        type: 'array',
+        required: false,
+        description: 'Add related-task edges without overwriting existing',
+      },
+      {
+        name: 'removeRelates',
+        type: 'array',
+        required: false,
+        description: 'Remove related-task edges by taskId',
+      },
+      {
+        name: 'autoCompleteParent',
+        type: 'boolean',
+        required: false,
+        description: 'Complete the task once its last open child completes; false clears it',
+      },
+    ] satisfies ParamDef[],
+    inputSchema: tasksUpdateInputContract,
+    outputSchema: OUTPUT_CONTRACTS['tasks.update'],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'complete',
+    description: 'tasks.complete (mutate)',
+    tier: 0,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
+    params: [
+      {
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'Capability path in dot notation (e.g., spawn.supportsSubagents)',
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
-    ],
+    ] satisfies ParamDef[],
   },
   {
-    gateway: 'query',
-    domain: 'tools',
-    operation: 'provider.hooks',
-    description: 'tools.provider.hooks (query) - list providers by hook event support',
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'cancel',
+    description:
+      'tasks.cancel (mutate) — cancel task (soft terminal state; reversible via tasks.restore)',
     tier: 1,
+    // T9838: re-cancel returns `alreadyCancelled: true` instead of failing,
+    // so the operation is idempotent at the registry level.
     idempotent: true,
     sessionRequired: false,
-    requiredParams: ['event'],
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'event',
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'Hook event to query (e.g., onSessionStart, onToolComplete)',
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
-    ],
+      {
+        // T9838: reason was implicitly accepted by the dispatch handler but
+        // missing from the registry schema, so dispatch tooling treated it
+        // as an unknown param.
+        name: 'reason',
+        type: 'string',
+        required: false,
+        description: 'Optional human-readable cancellation reason stored on the task',
+      },
+    ] satisfies ParamDef[],
   },
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'add',
-    description: 'tasks.add (mutate)',
-    tier: 0,
+    operation: 'delete',
+    description: 'tasks.delete (mutate) — move a task to the trash, or remove it for good with purge',
+    tier: 1,
     idempotent: false,
     sessionRequired: false,
-    requiredParams: ['title'],
+    requiredParams: ['taskId'],
     params: [
       {
-        name: 'title',
+        name: 'taskId',
         type: 'string',
         required: true,
-        description: 'Task title (3\u2013500 characters)',
+        description: 'taskId parameter',
         cli: { positional: true },
       },
       {
-        name: 'parent',
-        type: 'string',
+        name: 'purge',
+        type: 'boolean',
         required: false,
-        description: 'Parent task ID (makes this task a subtask)',
-        cli: { flag: 'parent' },
+        description: 'Hard-delete instead of moving to the trash',
+        cli: { flag: 'purge' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'trash.restore',
+    description:
+      'tasks.trash.restore (mutate) — restore a deleted task (and children deleted with it) from the trash',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
+    params: [
       {
-        name: 'priority',
+        name: 'taskId',
         type: 'string',
-        required: false,
-        description: 'Task priority',
-        enum: ['low', 'medium', 'high', 'critical'] as const,
-        cli: { flag: 'priority', short: '-p' },
+        required: true,
+        description: 'Trashed task ID',
+        cli: { positional: true },
       },
       {
-        name: 'type',
+        name: 'toSaga',
         type: 'string',
         required: false,
-        description: 'Task type',
-        enum: ['epic', 'task', 'subtask'] as const,
-        cli: { flag: 'type', short: '-t' },
+        description: 'Restore under this saga (required when the original parent is gone)',
+        cli: { flag: 'to-saga' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'archive.restore',
+    description:
+      'tasks.archive.restore (mutate) — return an archived task to the status it held when archived',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
+    params: [
       {
-        name: 'size',
+        name: 'taskId',
         type: 'string',
-        required: false,
-        description: 'Scope size estimate',
-        enum: ['small', 'medium', 'large'] as const,
-        cli: { flag: 'size' },
+        required: true,
+        description: 'Archived task ID',
+        cli: { positional: true },
       },
       {
-        name: 'description',
+        name: 'toSaga',
         type: 'string',
         required: false,
-        description: 'Detailed task description (must differ meaningfully from title)',
-        cli: { flag: 'description', short: '-d' },
+        description: 'Restore under this saga (required when the original parent is gone)',
+        cli: { flag: 'to-saga' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'trash.empty',
+    description: 'tasks.trash.empty (mutate) — permanently remove trashed tasks',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
       {
-        name: 'acceptance',
-        type: 'array',
+        name: 'olderThan',
+        type: 'string',
         required: false,
-        description: 'Pipe-separated acceptance criteria (e.g. "AC1|AC2|AC3")',
-        cli: { flag: 'acceptance' },
+        description: 'Only purge tasks deleted longer ago than this (e.g. 30d)',
+        cli: { flag: 'older-than' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'stale.reset',
+    description:
+      'tasks.stale.reset (mutate) — flip stale tasks back to pending and clear their assignee, reporting the reset IDs',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
       {
-        name: 'labels',
-        type: 'array',
+        name: 'status',
+        type: 'string',
         required: false,
-        description: 'Comma-separated labels',
-        cli: { flag: 'labels', short: '-l' },
+        description: 'Non-terminal status to scan (in_progress = active); defaults to active',
+        cli: { flag: 'status' },
       },
       {
-        name: 'depends',
-        type: 'array',
+        name: 'olderThan',
+        type: 'string',
         required: false,
-        description: 'Comma-separated dependency task IDs',
-        cli: { flag: 'depends', short: '-D' },
+        description: 'Staleness threshold since the last update (e.g. 7d, 12h); defaults to 7d',
+        cli: { flag: 'older-than' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'block',
+    description:
+      'tasks.block (mutate) — set status blocked with a reason and optional until date, remembering the prior status',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId', 'reason'],
+    params: [
       {
-        name: 'phase',
+        name: 'taskId',
         type: 'string',
-        required: false,
-        description: 'Phase slug to assign the task to',
-        cli: { flag: 'phase', short: '-P' },
+        required: true,
+        description: 'Task to block',
+        cli: { positional: true },
       },
       {
-        name: 'notes',
+        name: 'reason',
+        type: 'string',
+        required: true,
+        description: 'What the task is waiting on (vendor, decision, another team)',
+        cli: { flag: 'reason' },
+      },
+      {
+        name: 'until',
         type: 'string',
         required: false,
-        description: 'Initial note entry for the task',
-        cli: { flag: 'notes' },
+        description: 'RFC 3339 date after which orchestrate ready offers the task again',
+        cli: { flag: 'until' },
       },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'add-batch',
+    operation: 'unblock',
     description:
-      'tasks.add-batch (mutate) — bulk-create N tasks atomically in a single transaction (epic decomposition: 1 call instead of N)',
-    tier: 0,
+      'tasks.unblock (mutate) — restore the status held before tasks.block and clear the reason',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
+    params: [
+      {
+        name: 'taskId',
+        type: 'string',
+        required: true,
+        description: 'Blocked task to unblock',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'note',
+    description:
+      'tasks.note (mutate) — append an authored, timestamped entry to the task note history',
+    tier: 1,
     idempotent: false,
     sessionRequired: false,
-    requiredParams: ['tasks'],
+    requiredParams: ['taskId', 'text'],
     params: [
       {
-        name: 'tasks',
-        type: 'array',
+        name: 'taskId',
+        type: 'string',
         required: true,
-        description: 'Array of task specs to insert atomically (each must have a title)',
+        description: 'Task to annotate',
+        cli: { positional: true },
       },
       {
-        name: 'defaultParent',
+        name: 'text',
         type: 'string',
-        required: false,
-        description: 'Optional default parent task ID applied when a task spec omits parent',
+        required: true,
+        description: 'Note text',
+        cli: { flag: 'text' },
       },
       {
-        name: 'dryRun',
-        type: 'boolean',
+        name: 'author',
+        type: 'string',
         required: false,
-        description: 'Validate and predict IDs without writing to the database',
+        description: 'Author recorded on the entry (default CLEO_AGENT_ID, else cleo)',
+        cli: { flag: 'author' },
       },
     ] satisfies ParamDef[],
   },
   {
-    // T11784 (epic T11556 · E1-GATEWAY-CRUD) — the OperationDef.params used to
-    // declare ONLY `taskId`, so every other mutable field a Studio form binds
-    // to was invisible to dispatch introspection (`cleo update --describe`, the
-    // SDK `describeOperation`) and silently dropped at the dispatch boundary.
-    // The full mutable-field set is now enumerated here, grounded in
-    // {@link TasksUpdateQueryParams} / {@link TASKS_UPDATE_INPUT_SCHEMA}; a
-    // regression test (operations-registry tasks-update parity) asserts the
-    // param names stay in sync with the schema's `properties`.
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'update',
-    description: 'tasks.update (mutate) — partial update; only supplied fields are mutated',
-    tier: 0,
-    idempotent: false,
+    operation: 'link-commit',
+    description:
+      'tasks.link-commit (mutate) — link a git commit SHA to a task (hex-validated, deduplicated)',
+    tier: 1,
+    idempotent: true,
     sessionRequired: false,
-    requiredParams: ['taskId'],
+    requiredParams: ['taskId', 'sha'],
     params: [
       {
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'Task ID to update',
+        description: 'Task the commit implements',
         cli: { positional: true },
       },
-      { name: 'title', type: 'string', required: false, description: 'New title (3–500 chars)' },
       {
-        name: 'description',
+        name: 'sha',
         type: 'string',
-        required: false,
-        description: 'New description',
-        cli: { flag: 'description', short: '-d' },
+        required: true,
+        description: 'Commit SHA (7-40 hex characters)',
+        cli: { flag: 'sha' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'commits.scan',
+    description:
+      'tasks.commits.scan (mutate) — scan git log for task IDs (T1234) in commit messages and link those commits',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
       {
-        name: 'status',
+        name: 'since',
         type: 'string',
         required: false,
-        description: 'New status',
-        enum: ['pending', 'active', 'blocked', 'done', 'cancelled'] as const,
-        cli: { flag: 'status', short: '-s' },
+        description: 'Only scan commits after this git ref (<since>..HEAD)',
+        cli: { flag: 'since' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'fields.add',
+    description:
+      'tasks.fields.add (mutate) — declare a project custom field (string, number or enum) for update --set',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['name', 'type'],
+    params: [
       {
-        name: 'priority',
+        name: 'name',
         type: 'string',
-        required: false,
-        description: 'New priority (orthogonal to severity)',
-        enum: ['low', 'medium', 'high', 'critical'] as const,
-        cli: { flag: 'priority', short: '-p' },
-      },
-      { name: 'notes', type: 'string', required: false, description: 'Append a note entry' },
-      {
-        name: 'labels',
-        type: 'array',
-        required: false,
-        description: 'Replace labels (comma-separated)',
-        cli: { flag: 'labels', short: '-l' },
-      },
-      {
-        name: 'addLabels',
-        type: 'array',
-        required: false,
-        description: 'Add labels incrementally',
-      },
-      {
-        name: 'removeLabels',
-        type: 'array',
-        required: false,
-        description: 'Remove labels incrementally',
-      },
-      {
-        name: 'depends',
-        type: 'array',
-        required: false,
-        description: 'Replace dependency task IDs',
-        cli: { flag: 'depends', short: '-D' },
-      },
-      {
-        name: 'addDepends',
-        type: 'array',
-        required: false,
-        description: 'Add dependency task IDs incrementally',
+        required: true,
+        description: 'Field name (lowercase letters, digits, _ or -; starts with a letter)',
+        cli: { flag: 'name' },
       },
       {
-        name: 'removeDepends',
-        type: 'array',
-        required: false,
-        description: 'Remove dependency task IDs incrementally',
+        name: 'type',
+        type: 'string',
+        required: true,
+        description: 'Value type: string, number, or enum',
+        cli: { flag: 'type' },
       },
       {
-        name: 'acceptance',
+        name: 'values',
         type: 'array',
         required: false,
-        description: 'Replace acceptance criteria (pipe-separated)',
-      },
-      {
-        name: 'parent',
-        type: 'string',
-        required: false,
-        description: 'Set parent task ID (null/empty promotes to root)',
+        description: 'Allowed values for an enum field',
+        cli: { flag: 'values' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'import.markdown',
+    description:
+      'tasks.import.markdown (mutate) — create tasks from a Markdown checklist (- [ ] / - [x]), nesting by indentation',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['file'],
+    params: [
       {
-        name: 'type',
+        name: 'file',
         type: 'string',
-        required: false,
-        description: 'New hierarchy type',
-        enum: ['saga', 'epic', 'task', 'subtask'] as const,
-        cli: { flag: 'type', short: '-t' },
+        required: true,
+        description: 'Markdown file to import',
+        cli: { positional: true },
       },
       {
-        name: 'size',
+        name: 'saga',
         type: 'string',
         required: false,
-        description: 'New scope-size estimate',
-        enum: ['small', 'medium', 'large'] as const,
-      },
-      {
-        name: 'files',
-        type: 'array',
-        required: false,
-        description: 'Replace associated file paths',
-      },
-      {
-        name: 'addFiles',
-        type: 'array',
-        required: false,
-        description: 'Add file paths incrementally',
+        description: 'Saga to file the top-level items under (they become epics)',
+        cli: { flag: 'saga' },
       },
       {
-        name: 'removeFiles',
-        type: 'array',
+        name: 'dryRun',
+        type: 'boolean',
         required: false,
-        description: 'Remove file paths incrementally',
+        description: 'Preview the line → task mapping without writing',
+        cli: { flag: 'dry-run' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'renumber',
+    description:
+      'tasks.renumber (mutate) — reassign contiguous task IDs, rewriting parent, dependency, relation and label references',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
       {
-        name: 'pipelineStage',
+        name: 'saga',
         type: 'string',
         required: false,
-        description: 'Set pipeline stage (forward-only)',
+        description: 'Only renumber this saga and its descendants',
+        cli: { flag: 'saga' },
       },
       {
-        name: 'kind',
-        type: 'string',
+        name: 'start',
+        type: 'number',
         required: false,
-        description: 'Task kind / intent axis (orthogonal to type)',
-        enum: ['work', 'research', 'experiment', 'bug', 'spike', 'release'] as const,
+        description: 'First number to assign (default 1)',
+        cli: { flag: 'start' },
       },
       {
-        name: 'scope',
-        type: 'string',
+        name: 'dryRun',
+        type: 'boolean',
         required: false,
-        description: 'Task scope / granularity axis',
-        enum: ['project', 'feature', 'unit'] as const,
+        description: 'Print the old → new mapping without writing',
+        cli: { flag: 'dry-run' },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'undo',
+    description:
+      'tasks.undo (mutate) — restore the task state from before the newest journaled mutate',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'redo',
+    description: 'tasks.redo (mutate) — re-apply the mutate most recently undone with tasks.undo',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'set-status',
+    description:
+      'tasks.set-status (mutate) — move every task matching --where/--label/--saga to a status in one transaction, skipping tasks that fail the completion guards',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['status'],
+    params: [
       {
-        name: 'severity',
+        name: 'status',
         type: 'string',
-        required: false,
-        description: 'Severity level (orthogonal to priority)',
-        enum: ['P0', 'P1', 'P2', 'P3'] as const,
+        required: true,
+        description: 'Target status',
+        cli: { positional: true },
       },
       {
-        name: 'reason',
-        type: 'string',
+        name: 'where',
+        type: 'array',
         required: false,
-        description: 'Operator override reason for the AC-immutability guard',
+        description: 'Filter expression (see cleo find --where)',
+        cli: { flag: 'where' },
       },
       {
-        name: 'dependsWaiver',
+        name: 'label',
         type: 'string',
         required: false,
-        description: 'Justification to waive the critical-priority --depends requirement',
+        description: 'Only tasks carrying this label',
+        cli: { flag: 'label' },
       },
       {
-        name: 'blockedBy',
+        name: 'saga',
         type: 'string',
         required: false,
-        description: 'Set the free-text blockedBy reason (gh#1106)',
+        description: 'Only tasks within this saga',
+        cli: { flag: 'saga' },
       },
       {
-        name: 'clearBlockedBy',
+        name: 'force',
         type: 'boolean',
         required: false,
-        description: 'Clear the free-text blockedBy reason',
-      },
-      {
-        name: 'relates',
-        type: 'array',
-        required: false,
-        description: 'Replace related-task edges ({taskId, type, reason?})',
-      },
-      {
-        name: 'addRelates',
-        type: 'array',
-        required: false,
-        description: 'Add related-task edges without overwriting existing',
+        description: 'Close tasks with open children or dependencies anyway',
+        cli: { flag: 'force' },
       },
       {
-        name: 'removeRelates',
-        type: 'array',
+        name: 'dryRun',
+        type: 'boolean',
         required: false,
-        description: 'Remove related-task edges by taskId',
+        description: 'Report what would change without writing',
+        cli: { flag: 'dry-run' },
       },
-    ] satisfies ParamDef[],
-    inputSchema: tasksUpdateInputContract,
-    outputSchema: OUTPUT_CONTRACTS['tasks.update'],
+    ] satisfies ParamDef[],
   },
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'complete',
-    description: 'tasks.complete (mutate)',
-    tier: 0,
+    operation: 'time.start',
+    description:
+      'tasks.time.start (mutate) — open a time interval on a task; fails when one is already running',
+    tier: 1,
     idempotent: false,
     sessionRequired: false,
     requiredParams: ['taskId'],
@@ -2788,7 +3763,7 @@ export const OPERATIONS: OperationDef[] = [
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Task to start timing',
         cli: { positional: true },
       },
     ] satisfies ParamDef[],
@@ -2796,13 +3771,11 @@ export const OPERATIONS: OperationDef[] = [
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'cancel',
+    operation: 'time.stop',
     description:
-      'tasks.cancel (mutate) — cancel task (soft terminal state; reversible via tasks.restore)',
+      'tasks.time.stop (mutate) — close the running time interval on a task; fails when none is open',
     tier: 1,
-    // T9838: re-cancel returns `alreadyCancelled: true` instead of failing,
-    // so the operation is idempotent at the registry level.
-    idempotent: true,
+    idempotent: false,
     sessionRequired: false,
     requiredParams: ['taskId'],
     params: [
@@ -2810,25 +3783,46 @@ export const OPERATIONS: OperationDef[] = [
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Task to stop timing',
         cli: { positional: true },
       },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'archive',
+    description: 'tasks.archive (mutate)',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'restore',
+    description: 'tasks.restore (mutate) — absorbs reopen and unarchive via from param',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId'],
+    params: [
       {
-        // T9838: reason was implicitly accepted by the dispatch handler but
-        // missing from the registry schema, so dispatch tooling treated it
-        // as an unknown param.
-        name: 'reason',
+        name: 'taskId',
         type: 'string',
-        required: false,
-        description: 'Optional human-readable cancellation reason stored on the task',
+        required: true,
+        description: 'taskId parameter',
+        cli: { positional: true },
       },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'delete',
-    description: 'tasks.delete (mutate)',
+    operation: 'reparent',
+    description: 'tasks.reparent (mutate) — absorbs promote via newParentId:null',
     tier: 1,
     idempotent: false,
     sessionRequired: false,
@@ -2846,50 +3840,109 @@ export const OPERATIONS: OperationDef[] = [
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'archive',
-    description: 'tasks.archive (mutate)',
+    operation: 'move',
+    description:
+      'tasks.move (mutate) — move a task (or its subtree) to another saga/epic, keeping its ID; returns moved IDs',
     tier: 1,
     idempotent: false,
     sessionRequired: false,
-    requiredParams: [],
-    params: [],
+    requiredParams: ['taskId'],
+    params: [
+      {
+        name: 'taskId',
+        type: 'string',
+        required: true,
+        description: 'Task to move',
+        cli: { positional: true },
+      },
+      {
+        name: 'toSaga',
+        type: 'string',
+        required: false,
+        description: 'Target saga ID',
+        cli: { flag: 'to-saga' },
+      },
+      {
+        name: 'toEpic',
+        type: 'string',
+        required: false,
+        description: 'Target epic ID',
+        cli: { flag: 'to-epic' },
+      },
+      {
+        name: 'recursive',
+        type: 'boolean',
+        required: false,
+        description: 'Move all descendant subtasks too',
+        cli: { flag: 'recursive' },
+      },
+      {
+        name: 'force',
+        type: 'boolean',
+        required: false,
+        description: 'Allow dependency edges to cross saga boundaries',
+        cli: { flag: 'force' },
+      },
+    ] satisfies ParamDef[],
   },
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'restore',
-    description: 'tasks.restore (mutate) — absorbs reopen and unarchive via from param',
+    operation: 'merge',
+    description:
+      'tasks.merge (mutate) — fold duplicate tasks into one (labels, notes, dependents, subtasks), then trash the duplicates; returns the merged task',
     tier: 1,
     idempotent: false,
     sessionRequired: false,
-    requiredParams: ['taskId'],
+    requiredParams: ['taskId', 'duplicates'],
     params: [
       {
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Task to keep',
         cli: { positional: true },
       },
+      {
+        name: 'duplicates',
+        type: 'array',
+        required: true,
+        description: 'Duplicate task IDs to fold into the kept task',
+      },
     ] satisfies ParamDef[],
   },
   {
     gateway: 'mutate',
     domain: 'tasks',
-    operation: 'reparent',
-    description: 'tasks.reparent (mutate) — absorbs promote via newParentId:null',
+    operation: 'split',
+    description:
+      'tasks.split (mutate) — break a task into child tasks, moving its dependency edges onto the children; returns the new child IDs',
     tier: 1,
     idempotent: false,
     sessionRequired: false,
-    requiredParams: ['taskId'],
+    requiredParams: ['taskId', 'into'],
     params: [
       {
         name: 'taskId',
         type: 'string',
         required: true,
-        description: 'taskId parameter',
+        description: 'Task to split',
         cli: { positional: true },
       },
+      {
+        name: 'into',
+        type: 'array',
+        required: true,
+        description: 'Child task titles (repeat --into per child)',
+        cli: { flag: 'into' },
+      },
+      {
+        name: 'estimates',
+        type: 'array',
+        required: false,
+        description: 'Per-child estimates matched to --into by position (default: even split)',
+        cli: { flag: 'estimate' },
+      },
     ] satisfies ParamDef[],
   },
   {
@@ -3147,6 +4200,27 @@ export const OPERATIONS: OperationDef[] = [
         description: 'Pipe-separated acceptance criteria',
         cli: { flag: 'acceptance' },
       },
+      {
+        name: 'due',
+        type: 'string',
+        required: false,
+        description: 'Due date (RFC 3339 full-date or date-time)',
+        cli: { flag: 'due' },
+      },
+      {
+        name: 'prefix',
+        type: 'string',
+        required: false,
+        description: 'ID prefix for the saga tasks, e.g. AUTH for AUTH-1, AUTH-2',
+        cli: { flag: 'prefix' },
+      },
+      {
+        name: 'track',
+        type: 'string',
+        required: false,
+        description: 'Orchestration track for member tasks that have none of their own',
+        cli: { flag: 'track' },
+      },
       {
         name: 'dryRun',
         type: 'boolean',
@@ -3219,7 +4293,8 @@ export const OPERATIONS: OperationDef[] = [
     gateway: 'query',
     domain: 'tasks',
     operation: 'saga.list',
-    description: 'tasks.saga.list (query) — list all Sagas (labeled top-level Epics)',
+    description:
+      'tasks.saga.list (query) — list all Sagas (labeled top-level Epics) with progress and progressStatus',
     tier: 0,
     idempotent: true,
     sessionRequired: false,
@@ -3265,6 +4340,120 @@ export const OPERATIONS: OperationDef[] = [
       },
     ] satisfies ParamDef[],
   },
+  {
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'saga.critical-path',
+    description:
+      'tasks.saga.critical-path (query) — longest dependency chain through open Saga work, weighted by estimate (default 1)',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['sagaId'],
+    params: [
+      {
+        name: 'sagaId',
+        type: 'string',
+        required: true,
+        description: 'Saga task ID',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'saga.deps',
+    description:
+      'tasks.saga.deps (query) — other Sagas this Saga depends on, grouped from cross-Saga task dependencies',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['sagaId'],
+    params: [
+      {
+        name: 'sagaId',
+        type: 'string',
+        required: true,
+        description: 'Saga task ID',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'saga.schedule',
+    description:
+      'tasks.saga.schedule (query) — latest start/finish per open task, worked back from the Saga due date along its dependencies',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['sagaId'],
+    params: [
+      {
+        name: 'sagaId',
+        type: 'string',
+        required: true,
+        description: 'Saga task ID',
+        cli: { positional: true },
+      },
+      {
+        name: 'hoursPerUnit',
+        type: 'number',
+        required: false,
+        description: 'Hours one estimate point stands for (default 1)',
+        cli: { flag: 'hours-per-unit' },
+      },
+      {
+        name: 'asOf',
+        type: 'string',
+        required: false,
+        description: 'Reference date for at-risk (RFC 3339); defaults to now',
+        cli: { flag: 'as-of' },
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'query',
+    domain: 'tasks',
+    operation: 'saga.export',
+    description:
+      'tasks.saga.export (query) — serialize a Saga, its descendants, dependencies, and labels into a portable bundle',
+    tier: 1,
+    idempotent: true,
+    sessionRequired: false,
+    requiredParams: ['sagaId'],
+    params: [
+      {
+        name: 'sagaId',
+        type: 'string',
+        required: true,
+        description: 'Saga task ID',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
+  },
+  {
+    gateway: 'mutate',
+    domain: 'tasks',
+    operation: 'saga.import',
+    description:
+      'tasks.saga.import (mutate) — re-create a Saga bundle under fresh task IDs; out-of-bundle depends are dropped',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['file'],
+    params: [
+      {
+        name: 'file',
+        type: 'string',
+        required: true,
+        description: 'Bundle JSON path, relative to the project root',
+        cli: { positional: true },
+      },
+    ] satisfies ParamDef[],
+  },
   {
     // [LEGACY T10117] Detach I5-violating parentId and re-attach via task_relations
     // type=groups (ADR-073 §1.2 invariant I5). Idempotent.
@@ -3635,6 +4824,33 @@ export const OPERATIONS: OperationDef[] = [
     requiredParams: [],
     params: [],
   },
+  {
+    gateway: 'mutate',
+    domain: 'orchestrate',
+    operation: 'claim',
+    description:
+      'orchestrate.claim (mutate) — atomically assign an unassigned task; fails with already_claimed otherwise',
+    tier: 1,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: ['taskId', 'assignee'],
+    params: [
+      {
+        name: 'taskId',
+        type: 'string',
+        required: true,
+        description: 'Task to claim',
+        cli: { positional: true },
+      },
+      {
+        name: 'assignee',
+        type: 'string',
+        required: true,
+        description: 'Agent (or person) taking the task',
+        cli: { flag: 'assignee' },
+      },
+    ],
+  },
   {
     gateway: 'mutate',
     domain: 'orchestrate',
@@ -5086,6 +6302,33 @@ export const OPERATIONS: OperationDef[] = [
     requiredParams: [],
     params: [],
   },
+  {
+    gateway: 'mutate',
+    domain: 'admin',
+    operation: 'webhook.test',
+    description:
+      'admin.webhook.test (mutate) — POST a synthetic task event to the webhook receiver and report the response',
+    tier: 2,
+    idempotent: false,
+    sessionRequired: false,
+    requiredParams: [],
+    params: [
+      {
+        name: 'url',
+        type: 'string',
+        required: false,
+        description: 'Receiver URL (default: webhook.url from config)',
+        cli: { flag: 'url' },
+      },
+      {
+        name: 'event',
+        type: 'string',
+        required: false,
+        description: 'Event name to send (default: task.completed)',
+        cli: { flag: 'event' },
+      },
+    ] satisfies ParamDef[],
+  },
   // admin.grade/grade.list/archive.stats moved to check domain (T5615)
   // admin.token.summary/list/show merged into admin.token (query) (T5615)
   {
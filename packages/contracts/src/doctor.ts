@@ -288,6 +288,59 @@ export interface SagaAuditResult {
   driftCount: number;
 }
 
+// ============================================================================
+// Task Integrity Audit (`cleo doctor --integrity`)
+// ============================================================================
+
+/**
+ * Reference problems surfaced by the task integrity audit.
+ *
+ * - `dangling-dependency` — a `depends` entry names a task that is gone or trashed.
+ * - `missing-parent` — `parentId` names a task that is gone or trashed.
+ * - `duplicate-id` — two records share an ID (only possible in a snapshot file).
+ * - `unused-label` — a label that only trashed tasks still carry.
+ * - `done-with-open-children` — a task is `done` while a child is still open.
+ */
+export type TaskIntegrityIssueKind =
+  | 'dangling-dependency'
+  | 'missing-parent'
+  | 'duplicate-id'
+  | 'unused-label'
+  | 'done-with-open-children';
+
+/** One problem surfaced by {@link auditTaskIntegrity}. */
+export interface TaskIntegrityIssue {
+  kind: TaskIntegrityIssueKind;
+  /** Offending task, or null for `unused-label`. */
+  taskId: string | null;
+  /** The missing target, duplicated ID, label, or open child. */
+  ref: string;
+  message: string;
+  /** Whether `--fix` repairs this kind (dangling dependencies and missing parents). */
+  fixable: boolean;
+}
+
+/** Result of {@link auditTaskIntegrity}. */
+export interface TaskIntegrityResult {
+  /** `store` for the task database, otherwise the scanned snapshot path. */
+  source: string;
+  taskCount: number;
+  /** Issues in task order, then by kind. */
+  issues: TaskIntegrityIssue[];
+  /** Total issue count (drives the non-zero exit). */
+  count: number;
+  /** `true` when repairs were requested. */
+  fix: boolean;
+  /** `true` when repairs were only previewed. */
+  dryRun: boolean;
+  /** Dependency edges removed (or that would be). */
+  removedEdges: Array<{ taskId: string; dependsOn: string }>;
+  /** Orphans moved under the `__unassigned` saga (or that would be). */
+  reparented: Array<{ taskId: string; from: string; to: string | null }>;
+  /** The `__unassigned` saga, when a repair used or created it. */
+  unassignedSagaId: string | null;
+}
+
 // ============================================================================
 // Invariant Registry Audit (T10340 — Saga T10326 SG-SUBSTRATE-RECONCILIATION /
 // Epic T10327 E-INVARIANT-REGISTRY-SSOT / R6)
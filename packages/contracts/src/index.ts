@@ -296,6 +296,8 @@ export type {
   SignalDockConfig,
   SignalDockMode,
   SystemBinding,
+  TrackRule,
+  WebhookConfig,
 } from './config.js';
 export type { AdapterContextMonitorProvider } from './context-monitor.js';
 // === Claude Code credential parsing (T9307 — pure helper, no core imports) ===
@@ -484,6 +486,9 @@ export type {
   SagaAuditResult,
   SagaAuditViolation,
   SagaAuditViolationKind,
+  TaskIntegrityIssue,
+  TaskIntegrityIssueKind,
+  TaskIntegrityResult,
   WorktreeAnomaly,
   WorktreeAnomalyKind,
 } from './doctor.js';
@@ -1170,6 +1175,8 @@ export type {
   AdminTokenMutateParams,
   AdminTokenQueryParams,
   AdminVersionParams,
+  AdminWebhookTestParams,
+  AdminWebhookTestResult,
 } from './operations/admin.js';
 // === Conduit Operation Types (T1422 — typed-dispatch migration) ===
 // Re-exported at top level so CLI dispatch can import without the `ops.` namespace hop.
@@ -1541,9 +1548,15 @@ export type {
 // Commonly used ops types re-exported at top level for convenience
 export type {
   BrainState,
+  OrchestrateClaimParams,
+  OrchestrateClaimResult,
   OrchestrateReportEntry,
   OrchestrateReportGroup,
   OrchestrateReportParams,
+  OrchestrateSequenceParams,
+  OrchestrateSequenceResult,
+  OrchestrateSequenceTask,
+  OrchestrateSequenceWave,
 } from './operations/orchestrate.js';
 // === Operation Output Contracts (T11692 / DHQ-057 — per-op result shape SSoT) ===
 // OUTPUT-side mirror of the input contracts. Surfaces the LAFS envelope `data`
@@ -1646,9 +1659,15 @@ export type {
 } from './operations/session.js';
 // === Task Operation Types (T1425 — typed-dispatch migration) ===
 export type {
+  DependencyTraceDirection,
+  DependencyTraceNode,
   DepGraphIssue,
   DepsTreeEdge,
   DepsTreeNode,
+  SagaProgressStatus,
+  TaskEstimateRollup,
+  TaskProgressCounts,
+  TaskProgressRollup,
   TaskShowAcRowEntry,
   TaskShowAttachmentEntry,
   TaskShowRelationsEntry,
@@ -1659,14 +1678,33 @@ export type {
   TasksAddResult,
   TasksAnalyzeQueryParams,
   TasksAnalyzeQueryResult,
+  TasksArchiveEntry,
+  TasksArchiveListParams,
+  TasksArchiveListResult,
   TasksArchiveQueryParams,
   TasksArchiveQueryResult,
+  TasksArchiveRestoreParams,
+  TasksArchiveRestoreResult,
+  TasksBatchOperation,
+  TasksBatchParams,
+  TasksBatchResult,
+  TasksBatchStepResult,
   TasksBlockersQueryParams,
   TasksBlockersQueryResult,
+  TasksBlockParams,
+  TasksBlockResult,
+  TasksBurndownBucket,
+  TasksBurndownParams,
+  TasksBurndownPoint,
+  TasksBurndownResult,
   TasksCancelParams,
   TasksCancelResult,
   TasksClaimParams,
   TasksClaimResult,
+  TasksCommitsParams,
+  TasksCommitsResult,
+  TasksCommitsScanParams,
+  TasksCommitsScanResult,
   TasksCompleteQueryParams,
   TasksCompleteQueryResult,
   TasksComplexityEstimateParams,
@@ -1687,25 +1725,56 @@ export type {
   TasksDependsParams,
   TasksDependsResult,
   // T1857 — dep-graph validation + tree rendering (T1855 guardrails)
+  TasksDepsTraceParams,
+  TasksDepsTraceResult,
   TasksDepsTreeParams,
   TasksDepsTreeResult,
   TasksDepsValidateParams,
   TasksDepsValidateResult,
+  TasksEstimateRollupParams,
+  TasksEstimateRollupResult,
+  TasksFieldsAddParams,
+  TasksFieldsAddResult,
+  TasksFieldsListParams,
+  TasksFieldsListResult,
   TasksFindParams,
   TasksFindResult,
+  TasksGraphNode,
+  TasksGraphParams,
+  TasksGraphResult,
   TasksHistoryParams,
   TasksHistoryResult,
   TasksImpactParams,
   TasksImpactResult,
+  TasksImportMarkdownItem,
+  TasksImportMarkdownParams,
+  TasksImportMarkdownResult,
   TasksLabelListParams,
   TasksLabelListResult,
+  TasksLabelMergeParams,
+  TasksLabelMergeResult,
+  TasksLabelRenameParams,
+  TasksLinkCommitParams,
+  TasksLinkCommitResult,
   TasksListParams,
   TasksListResult,
+  TasksMergeDroppedEdge,
+  TasksMergeParams,
+  TasksMergeResult,
+  TasksMoveCrossSagaDependency,
+  TasksMoveParams,
+  TasksMoveResult,
   TasksNextQueryParams,
   TasksNextQueryResult,
+  TasksNoteParams,
+  TasksNoteResult,
   TasksOps,
+  TasksOverdueParams,
+  TasksOverdueResult,
   TasksPlanParams,
   TasksPlanResult,
+  TasksRedoParams,
+  TasksRedoResult,
   TasksRelatesAddBatchEntry,
   TasksRelatesAddBatchParams,
   TasksRelatesAddBatchResult,
@@ -1715,6 +1784,8 @@ export type {
   TasksRelatesRemoveParams,
   TasksRelatesRemoveResult,
   TasksRelatesResult,
+  TasksRenumberParams,
+  TasksRenumberResult,
   TasksReorderDispatchResult,
   TasksReorderQueryParams,
   TasksReparentDispatchResult,
@@ -1723,24 +1794,50 @@ export type {
   TasksRestoreResult,
   TasksSagaAddParams,
   TasksSagaAddResult,
+  TasksSagaBundle,
   TasksSagaCreateParams,
   TasksSagaCreateResult,
+  TasksSagaCriticalPathNode,
+  TasksSagaCriticalPathParams,
+  TasksSagaCriticalPathResult,
+  TasksSagaDepsEdge,
+  TasksSagaDepsEntry,
+  TasksSagaDepsParams,
+  TasksSagaDepsResult,
   TasksSagaDetachParams,
   TasksSagaDetachResult,
+  TasksSagaExportParams,
+  TasksSagaExportResult,
+  TasksSagaImportDroppedDep,
+  TasksSagaImportParams,
+  TasksSagaImportResult,
   TasksSagaListParams,
   TasksSagaListResult,
   TasksSagaMembersParams,
   TasksSagaMembersResult,
   TasksSagaRollupParams,
   TasksSagaRollupResult,
+  TasksSagaScheduleEntry,
+  TasksSagaScheduleParams,
+  TasksSagaScheduleResult,
   TasksScopeMember,
   TasksScopeReadyEntry,
   TasksScopeRollup,
+  TasksSetStatusParams,
+  TasksSetStatusResult,
+  TasksSetStatusSkip,
   TasksShowParams,
   TasksShowResult,
   TasksSliceNode,
   TasksSliceParams,
   TasksSliceResult,
+  TasksSplitParams,
+  TasksSplitResult,
+  TasksStaleEntry,
+  TasksStaleParams,
+  TasksStaleResetParams,
+  TasksStaleResetResult,
+  TasksStaleResult,
   TasksStartQueryParams,
   TasksStartQueryResult,
   TasksStopQueryParams,
@@ -1751,10 +1848,27 @@ export type {
   TasksSyncLinksResult,
   TasksSyncReconcileParams,
   TasksSyncReconcileResult,
+  TasksTimeParams,
+  TasksTimeResult,
+  TasksTimeStartParams,
+  TasksTimeStartResult,
+  TasksTimeStopParams,
+  TasksTimeStopResult,
+  TasksTrashEmptyParams,
+  TasksTrashEmptyResult,
+  TasksTrashEntry,
+  TasksTrashListParams,
+  TasksTrashListResult,
+  TasksTrashRestoreParams,
+  TasksTrashRestoreResult,
   TasksTreeDispatchParams,
   TasksTreeDispatchResult,
+  TasksUnblockParams,
+  TasksUnblockResult,
   TasksUnclaimParams,
   TasksUnclaimResult,
+  TasksUndoParams,
+  TasksUndoResult,
   TasksUpdateQueryParams,
   TasksUpdateQueryResult,
 } from './operations/tasks.js';
@@ -2287,6 +2401,9 @@ export type {
   AcceptanceItem,
   CancelledTask,
   CompletedTask,
+  CompletionRequirement,
+  CustomFieldDef,
+  CustomFieldType,
   EpicLifecycle,
   EvidenceAtom,
   FileMeta,
@@ -2304,13 +2421,16 @@ export type {
   TaskCreate,
   // T944 new axes (T9072: renamed TaskRole → TaskKind)
   TaskKind,
+  TaskNoteEntry,
   TaskOrigin,
   TaskPriority,
   TaskProvenance,
+  TaskRecurrence,
   TaskRelation,
   TaskScope,
   TaskSeverity,
   TaskSize,
+  TaskTimeEntry,
   TaskType,
   TaskVerification,
   TaskWorkState,
@@ -2318,7 +2438,13 @@ export type {
   VerificationFailure,
   VerificationGate,
 } from './task.js';
-export { isTestFixtureOrigin, TASK_ORIGIN_CANONICAL } from './task.js';
+export {
+  COMPLETION_REQUIREMENTS,
+  isTestFixtureOrigin,
+  PREFIXED_TASK_ID_PATTERN,
+  TASK_ID_PREFIX_PATTERN,
+  TASK_ORIGIN_CANONICAL,
+} from './task.js';
 // === Task Evidence Types (T801) ===
 export type {
   CommandOutputEvidence,
@@ -1,5 +1,5 @@
 /**
- * Admin Domain Operations Contract (46 operations)
+ * Admin Domain Operations Contract (47 operations)
  *
  * Query operations: 24
  *   version, health (query), config.show, config.presets, stats, context,
@@ -8,12 +8,13 @@
  *   adr.find, adr.show, backup (list), export, map (query), roadmap, smoke,
  *   smoke.provider, hooks.matrix
  *
- * Mutate operations: 19
+ * Mutate operations: 20
  *   init, scaffold-hub, health (repair/diagnose via mode param),
  *   config.set, config.set-preset, backup (create + restore + restore.file
  *   via action param), migrate, cleanup, job.cancel, safestop,
  *   inject.generate, adr.sync, import, detect, token (record + delete + clear
- *   via action param), context.inject, map (mutate), install.global
+ *   via action param), context.inject, map (mutate), install.global,
+ *   webhook.test
  *
  * SYNC: Implementation lives in packages/cleo/src/dispatch/domains/admin.ts.
  * Typed via TypedDomainHandler<AdminOps> once the T983 migration lands.
@@ -422,7 +423,8 @@ export type AdminOp =
   | 'admin.token.mutate'
   | 'admin.context.inject'
   | 'admin.map.mutate'
-  | 'admin.install.global';
+  | 'admin.install.global'
+  | 'admin.webhook.test';
 
 // ============================================================================
 // Query operation params + results
@@ -1224,6 +1226,10 @@ export interface AdminExportParams {
   parent?: string;
   /** Filter by phase for standard task export. */
   phase?: string;
+  /** Limit standard task export to tasks below this saga. */
+  saga?: string;
+  /** Comma-separated CSV/TSV columns for standard task export (default set when omitted). */
+  fields?: string;
   /** Task IDs to export (task package scope). */
   taskIds?: string[];
   /** Include entire subtree when exporting task packages. */
@@ -2098,6 +2104,34 @@ export interface AdminInstallGlobalResult {
   templates: Record<string, unknown>;
 }
 
+// ---------------------------------------------------------------------------
+// admin.webhook.test
+// ---------------------------------------------------------------------------
+
+/** Parameters for `admin.webhook.test`. */
+export interface AdminWebhookTestParams {
+  /** Receiver to test instead of the configured `webhook.url`. */
+  url?: string;
+  /** Event name the synthetic event carries (default `task.completed`). */
+  event?: string;
+}
+
+/** Result of `admin.webhook.test`. */
+export interface AdminWebhookTestResult {
+  /** Receiver the event was POSTed to. */
+  url: string;
+  /** Event name that was sent. */
+  event: string;
+  /** `true` when the receiver answered with a 2xx status. */
+  delivered: true;
+  /** HTTP status of the final attempt. */
+  status: number;
+  /** Number of attempts made, including retries. */
+  attempts: number;
+  /** Wall time spent delivering, in milliseconds. */
+  durationMs: number;
+}
+
 // ============================================================================
 // AdminOps — discriminated union
 // ============================================================================
@@ -2230,4 +2264,5 @@ export type AdminOps =
       op: 'admin.install.global';
       params: AdminInstallGlobalParams;
       result: AdminInstallGlobalResult;
-    };
+    }
+  | { op: 'admin.webhook.test'; params: AdminWebhookTestParams; result: AdminWebhookTestResult };
@@ -163,6 +163,10 @@ export interface OrchestrateNextResult {
 export interface OrchestrateReadyParams {
   /** Epic to compute the ready set for (required). @task T963 */
   epicId: string;
+  /** Ready-set ordering; `priority` orders critical → low, ties by task ID. */
+  sort?: 'priority';
+  /** Only return tasks that are unassigned or already assigned to this agent. */
+  assignee?: string;
 }
 /**
  * A single ready-task descriptor as returned by `orchestrate.ready`.
@@ -417,6 +421,56 @@ export interface OrchestratePlanResult {
   warnings: OrchestratePlanWarning[];
 }
 
+// orchestrate.sequence
+/** Parameters for `orchestrate.sequence`. */
+export interface OrchestrateSequenceParams {
+  /** Restrict the plan to one saga's subtree. Omit to plan the whole project. */
+  sagaId?: string;
+}
+
+/** A task in an `orchestrate.sequence` wave. */
+export interface OrchestrateSequenceTask {
+  /** Task id. */
+  id: string;
+  /** Task title. */
+  title: string;
+  /** Current status (pending, active, or blocked). */
+  status: string;
+  /** Task priority. */
+  priority: string;
+  /** Declared dependency ids. */
+  depends: string[];
+  /** Open dependencies outside the plan; they do not affect wave placement. */
+  externalBlockers: string[];
+}
+
+/** One wave of `orchestrate.sequence`: dependencies are all met by earlier waves. */
+export interface OrchestrateSequenceWave {
+  /** 1-indexed wave number. */
+  wave: number;
+  /** Tasks in the wave, priority-first then by id. */
+  tasks: OrchestrateSequenceTask[];
+}
+
+/**
+ * Result of `orchestrate.sequence` — open work in topological waves.
+ *
+ * @remarks
+ * Done and cancelled tasks are excluded. A dependency cycle fails the whole
+ * call with `details.error = 'dependency_cycle'`, the same error the
+ * create/update dependency check raises.
+ */
+export interface OrchestrateSequenceResult {
+  /** Saga the plan is scoped to, or `null` for the whole project. */
+  sagaId: string | null;
+  /** Ordered waves. */
+  waves: OrchestrateSequenceWave[];
+  /** Number of waves. */
+  totalWaves: number;
+  /** Number of tasks across all waves. */
+  totalTasks: number;
+}
+
 // orchestrate.skill.list
 /** Parameters for `orchestrate.skill.list`. @task T963 */
 export interface OrchestrateSkillListParams {
@@ -505,6 +559,27 @@ export interface OrchestrateStartupResult {
   currentStage: string;
 }
 
+// orchestrate.claim
+/** Parameters for `orchestrate.claim`. */
+export interface OrchestrateClaimParams {
+  /** Task to claim. */
+  taskId: string;
+  /** Agent (or person) taking the task. */
+  assignee: string;
+}
+/**
+ * Result of `orchestrate.claim`. A task already held by another assignee
+ * fails with `E_CLEO_TASK_CLAIMED` and `details.error = 'already_claimed'`.
+ */
+export interface OrchestrateClaimResult {
+  /** Claimed task ID. */
+  taskId: string;
+  /** The assignee now holding the task. */
+  assignee: string;
+  /** Always `true` on success. */
+  claimed: true;
+}
+
 // orchestrate.spawn
 /**
  * Parameters for `orchestrate.spawn` (T882 canonical spawn contract).
@@ -536,6 +611,11 @@ export interface OrchestrateSpawnParams {
    * @task T963
    */
   tier?: 0 | 1 | 2;
+  /**
+   * Claim the task for this assignee before spawning. The spawn fails with
+   * `already_claimed` when a different assignee holds the task.
+   */
+  assignee?: string;
 }
 /**
  * Result of `orchestrate.spawn`.
@@ -24,8 +24,16 @@ import type { TaskAnalysisResult, TaskRef } from '../results.js';
  * Common task types (API contract — matches CLI src/types/task.ts)
  */
 import type { TaskStatus } from '../status-registry.js';
-import type { TaskPriority, TaskType } from '../task.js';
-import type { TaskRecord } from '../task-record.js';
+import type {
+  CustomFieldDef,
+  CustomFieldType,
+  Task,
+  TaskNoteEntry,
+  TaskPriority,
+  TaskTimeEntry,
+  TaskType,
+} from '../task.js';
+import type { MinimalTaskRecord, TaskRecord } from '../task-record.js';
 import type { ExternalTask, ExternalTaskLink, ReconcileResult } from '../task-sync.js';
 import type {
   CompletionEvaluateParams,
@@ -89,6 +97,8 @@ export interface TasksListParams {
   limit?: number;
   offset?: number;
   compact?: boolean;
+  /** Result ordering; `priority` orders critical → low, ties by task ID. */
+  sort?: 'priority';
 }
 export interface TasksListResult {
   tasks: TaskOp[];
@@ -174,6 +184,29 @@ export interface TasksFindParams {
    * @saga T9862
    */
   parent?: string;
+  /**
+   * Result ordering. `priority` orders critical → low, ties by task ID,
+   * replacing relevance order. Applied before `limit`/`offset`.
+   */
+  sort?: 'priority';
+  /**
+   * Task text to match: `title`, `description`, `notes`, or `all`. Unset
+   * keeps fuzzy title + description scoring; `regex` defaults to title.
+   */
+  field?: 'title' | 'description' | 'notes' | 'all';
+  /**
+   * Treat `query` as a case-insensitive regular expression. A pattern that
+   * fails to compile is rejected with `details.error = 'bad_regex'`.
+   */
+  regex?: boolean;
+  /** Filter by hierarchy type (saga | epic | task | subtask). Composes via AND. */
+  type?: TaskType;
+  /**
+   * Filter expressions over task and custom fields, e.g.
+   * `priority >= high and (label:saga or label:wave-1) and status != done`.
+   * All must match. Unknown fields and out-of-set values are rejected.
+   */
+  where?: string[];
 }
 export type TasksFindResult = MinimalTask[];
 
@@ -906,6 +939,506 @@ export interface TasksLabelListResult {
   count: number;
 }
 
+// tasks.label.rename / tasks.label.merge
+export interface TasksLabelRenameParams {
+  /** Existing label. */
+  from: string;
+  /** New label; when it already exists the two are merged. */
+  to: string;
+}
+export interface TasksLabelMergeParams {
+  /** Labels to fold into `dest`. */
+  sources: string[];
+  /** Label every source is rewritten to. */
+  dest: string;
+}
+/** Result of `tasks.label.rename` and `tasks.label.merge`. */
+export interface TasksLabelMergeResult {
+  /** Source labels that were rewritten. */
+  sources: string[];
+  /** Destination label. */
+  dest: string;
+  /** Whether `dest` was already in use (the operation merged into it). */
+  merged: boolean;
+  /** Number of tasks whose labels changed. */
+  tasksTouched: number;
+  /** IDs of the touched tasks. */
+  taskIds: string[];
+}
+
+// tasks.overdue
+export interface TasksOverdueParams {
+  /** Reference instant (RFC 3339); tasks due before it are overdue. Defaults to now. */
+  asOf?: string;
+}
+/**
+ * Result of `tasks.overdue` — same `{ results, total }` envelope as
+ * `tasks.find`, most overdue first.
+ */
+export interface TasksOverdueResult {
+  /** Overdue tasks, each carrying its `due` date. */
+  results: MinimalTaskRecord[];
+  total: number;
+  /** Reference instant the query was evaluated against (ISO 8601). */
+  asOf: string;
+}
+
+// tasks.stale
+export interface TasksStaleParams {
+  /** Non-terminal status to scan (`in_progress` is accepted for `active`). Defaults to `active`. */
+  status?: string;
+  /** Only tasks not updated for longer than this (e.g. `7d`, `12h`). Defaults to `7d`. */
+  olderThan?: string;
+}
+/** A task that has sat in one status without updates. */
+export interface TasksStaleEntry {
+  id: string;
+  title: string;
+  status: TaskStatus;
+  assignee: string | null;
+  /** Last update (falls back to `createdAt` for never-updated tasks). */
+  updatedAt: string;
+  /** Whole days since `updatedAt`. */
+  staleDays: number;
+}
+/** Result of `tasks.stale` — stale tasks, stalest first. */
+export interface TasksStaleResult {
+  tasks: TasksStaleEntry[];
+  total: number;
+  status: TaskStatus;
+  olderThan: string;
+  /** Tasks last updated before this instant are stale (ISO 8601). */
+  cutoff: string;
+}
+
+// tasks.stale.reset
+export type TasksStaleResetParams = TasksStaleParams;
+/** Result of `tasks.stale.reset` — the stale set, flipped back to `pending` and unassigned. */
+export interface TasksStaleResetResult extends TasksStaleResult {
+  /** IDs that were reset. */
+  reset: string[];
+}
+
+// tasks.block
+export interface TasksBlockParams {
+  taskId: string;
+  /** Why the task is blocked — the vendor, decision, or team it waits on. */
+  reason: string;
+  /** RFC 3339 date after which `orchestrate ready` offers the task again. */
+  until?: string;
+}
+/** Result of `tasks.block`. */
+export interface TasksBlockResult {
+  taskId: string;
+  status: 'blocked';
+  reason: string;
+  until: string | null;
+  /** Status `tasks.unblock` will restore. */
+  priorStatus: TaskStatus;
+}
+
+// tasks.unblock
+export interface TasksUnblockParams {
+  taskId: string;
+}
+/** Result of `tasks.unblock` — the restored status and the reason that was cleared. */
+export interface TasksUnblockResult {
+  taskId: string;
+  status: TaskStatus;
+  clearedReason: string | null;
+}
+
+// tasks.note
+export interface TasksNoteParams {
+  taskId: string;
+  /** Note text. */
+  text: string;
+  /** Author recorded on the entry (default `CLEO_AGENT_ID`, else `cleo`). */
+  author?: string;
+}
+/** Result of `tasks.note` — the appended entry and the history length after it. */
+export interface TasksNoteResult {
+  taskId: string;
+  note: TaskNoteEntry;
+  count: number;
+}
+
+// tasks.link-commit
+export interface TasksLinkCommitParams {
+  taskId: string;
+  /** Commit SHA — 7 to 40 hex characters. */
+  sha: string;
+}
+/** Result of `tasks.link-commit`. `added` is false when the SHA was already linked. */
+export interface TasksLinkCommitResult {
+  taskId: string;
+  sha: string;
+  added: boolean;
+  commits: string[];
+}
+
+// tasks.commits
+export interface TasksCommitsParams {
+  taskId: string;
+}
+/** Result of `tasks.commits` — the task's linked SHAs in link order. */
+export interface TasksCommitsResult {
+  taskId: string;
+  commits: string[];
+}
+
+// tasks.commits.scan
+export interface TasksCommitsScanParams {
+  /** Only scan commits after this git ref (`<since>..HEAD`); whole history when omitted. */
+  since?: string;
+}
+/** Result of `tasks.commits.scan` (`cleo git scan`). */
+export interface TasksCommitsScanResult {
+  /** Commits read from `git log`. */
+  scanned: number;
+  /** Newly created task↔commit links; already-linked pairs are not repeated. */
+  linked: Array<{ taskId: string; sha: string }>;
+  /** Task IDs mentioned in commit messages that do not exist in this project. */
+  unknownTaskIds: string[];
+}
+
+// tasks.renumber
+export interface TasksRenumberParams {
+  /** Only renumber this saga and its descendants. */
+  saga?: string;
+  /** First number to assign (default 1). */
+  start?: number;
+  /** Plan the mapping without writing. */
+  dryRun?: boolean;
+}
+/** Result of `tasks.renumber` — the full old → new ID mapping. */
+export interface TasksRenumberResult {
+  dryRun: boolean;
+  saga: string | null;
+  start: number;
+  /** Old task ID → new task ID for every renumbered task, in old-ID order. */
+  idMap: Record<string, string>;
+  /** Number of tasks whose ID actually changed. */
+  changed: number;
+}
+
+// tasks.undo / tasks.redo
+export type TasksUndoParams = Record<string, never>;
+export type TasksRedoParams = Record<string, never>;
+/** Result of `tasks.undo` / `tasks.redo` — the journal entry replayed. */
+export interface TasksUndoResult {
+  kind: 'undo' | 'redo';
+  /** Journal entry that was reversed or re-applied. */
+  entryId: string;
+  /** `domain.operation` of the reversed / re-applied mutate. */
+  operation: string;
+  /** When the reversed / re-applied mutate originally ran. */
+  at: string;
+  /** Tasks written back. */
+  restored: string[];
+  /** Tasks removed because they did not exist in the restored state. */
+  removed: string[];
+}
+export type TasksRedoResult = TasksUndoResult;
+
+// tasks.set-status
+export interface TasksSetStatusParams {
+  /** Status to move every matching task to (`archived` is not accepted). */
+  status: TaskStatus;
+  /** `--where` expressions, all of which must match (see `tasks.find`). */
+  where?: string[];
+  /** Only tasks carrying this label. */
+  label?: string;
+  /** Only tasks within this saga. */
+  saga?: string;
+  /** Skip the open-children and open-dependency guards for `done` / `cancelled`. */
+  force?: boolean;
+  /** Report what would change without writing. */
+  dryRun?: boolean;
+}
+/** A matching task left unchanged by `tasks.set-status`. */
+export interface TasksSetStatusSkip {
+  taskId: string;
+  reason: string;
+}
+/** Result of `tasks.set-status`. */
+export interface TasksSetStatusResult {
+  status: TaskStatus;
+  dryRun: boolean;
+  /** Task IDs moved to `status`, in ID order. */
+  transitioned: string[];
+  /** Matching tasks left unchanged, with why. */
+  skipped: TasksSetStatusSkip[];
+}
+
+// tasks.time.start
+export interface TasksTimeStartParams {
+  taskId: string;
+}
+/** Result of `tasks.time.start` — the interval just opened. */
+export interface TasksTimeStartResult {
+  taskId: string;
+  start: string;
+}
+
+// tasks.time.stop
+export interface TasksTimeStopParams {
+  taskId: string;
+}
+/** Result of `tasks.time.stop` — the interval just closed and the new total. */
+export interface TasksTimeStopResult {
+  taskId: string;
+  entry: TaskTimeEntry;
+  /** Seconds spent in the closed interval. */
+  duration: number;
+  /** Seconds spent across all intervals. */
+  timeSpent: number;
+}
+
+// tasks.time
+export interface TasksTimeParams {
+  taskId: string;
+}
+/** Result of `tasks.time` — tracked intervals, oldest first, and their total. */
+export interface TasksTimeResult {
+  taskId: string;
+  /** Seconds spent across all intervals, a running one counted up to now. */
+  timeSpent: number;
+  /** Effort estimate, when set, for comparison with `timeSpent`. */
+  estimate: number | null;
+  /** Whether an interval is open. */
+  running: boolean;
+  intervals: TaskTimeEntry[];
+}
+
+// tasks.fields.add
+export interface TasksFieldsAddParams {
+  name: string;
+  type: CustomFieldType;
+  /** Allowed values; required for (and only accepted with) `enum`. */
+  values?: string[];
+}
+/** Result of `tasks.fields.add` — the new declaration and the full schema after it. */
+export interface TasksFieldsAddResult {
+  field: CustomFieldDef;
+  fields: CustomFieldDef[];
+}
+
+// tasks.fields.list
+export type TasksFieldsListParams = Record<string, never>;
+export interface TasksFieldsListResult {
+  fields: CustomFieldDef[];
+}
+
+// tasks.import.markdown
+export interface TasksImportMarkdownParams {
+  /** Markdown file to read, relative to the project root. */
+  file: string;
+  /** Saga to file the top-level items under (they become epics). */
+  saga?: string;
+  /** Parse and plan without writing. */
+  dryRun?: boolean;
+}
+/** One checklist item mapped to the task it created (or would create). */
+export interface TasksImportMarkdownItem {
+  /** 1-based source line number. */
+  line: number;
+  title: string;
+  /** Created task ID; `null` on a dry run. */
+  taskId: string | null;
+  /** Source line of the enclosing checklist item, if nested. */
+  parentLine: number | null;
+  /**
+   * Parent task ID — the enclosing item's task, or the saga for top-level
+   * items. `null` for nested items on a dry run and for unparented items.
+   */
+  parentId: string | null;
+  type: TaskType;
+  status: 'pending' | 'done';
+  priority: TaskPriority;
+  labels: string[];
+}
+/** Result of `tasks.import.markdown` (`cleo import markdown`). */
+export interface TasksImportMarkdownResult {
+  file: string;
+  dryRun: boolean;
+  /** Tasks written (0 on a dry run). */
+  created: number;
+  items: TasksImportMarkdownItem[];
+}
+
+// tasks.burndown
+/** Burndown bucket width. Weeks start on Monday (UTC). */
+export type TasksBurndownBucket = 'day' | 'week';
+export interface TasksBurndownParams {
+  /** Saga whose estimated members are burned down. */
+  sagaId: string;
+  /** Range start (RFC 3339). */
+  from: string;
+  /** Range end (RFC 3339), inclusive of its bucket. Defaults to now. */
+  to?: string;
+  /** Bucket width. @defaultValue 'day' */
+  bucket?: TasksBurndownBucket;
+}
+/** One burndown bucket. */
+export interface TasksBurndownPoint {
+  /** Bucket start date (`YYYY-MM-DD`, UTC). */
+  date: string;
+  /** Estimate completed within the bucket. */
+  completed: number;
+  /** Estimate still open at the end of the bucket. */
+  remaining: number;
+}
+/** Result of `tasks.burndown`. */
+export interface TasksBurndownResult {
+  sagaId: string;
+  bucket: TasksBurndownBucket;
+  /** Range start (ISO 8601). */
+  from: string;
+  /** Range end (ISO 8601). */
+  to: string;
+  /** Sum of member estimates — the scope being burned down. */
+  estimateTotal: number;
+  /** One point per bucket, oldest first, including empty buckets. */
+  series: TasksBurndownPoint[];
+}
+
+// tasks.graph
+export interface TasksGraphParams {
+  /** Restrict the graph to this saga and everything beneath it. */
+  sagaId?: string;
+  /** Restrict the graph to this epic and everything beneath it. */
+  epicId?: string;
+  /** Format of `rendered`. @defaultValue 'dot' */
+  format?: 'dot' | 'mermaid';
+}
+/** A task in the dependency graph. */
+export interface TasksGraphNode {
+  id: string;
+  title: string;
+  status: string;
+  /** In-scope dependency IDs. */
+  depends: string[];
+}
+/**
+ * The task dependency graph — the same payload as `cleo graph export --json`.
+ * Edges run dependency → dependent and only join in-scope nodes.
+ */
+export interface TasksGraphResult {
+  format: 'dot' | 'mermaid';
+  /** Root of the scoped subtree, or `null` for the whole project. */
+  scopeId: string | null;
+  nodes: TasksGraphNode[];
+  edges: Array<{ from: string; to: string }>;
+  /** Rendered DOT / Mermaid source. */
+  rendered: string;
+}
+
+// tasks.deps.trace
+/** Which side of a task's dependency chain `tasks.deps.trace` walks. */
+export type DependencyTraceDirection = 'up' | 'down' | 'both';
+export interface TasksDepsTraceParams {
+  taskId: string;
+  /** Levels to walk from the task; omit for the whole chain. */
+  depth?: number;
+  /** `up`: what must finish first; `down`: what this unblocks. @defaultValue 'both' */
+  direction?: DependencyTraceDirection;
+}
+/**
+ * A task in a traced dependency chain. A node that closes a cycle, repeats
+ * a task already expanded elsewhere in the tree, or sits at the depth limit
+ * with more edges beyond it is flagged and has no children.
+ */
+export interface DependencyTraceNode {
+  id: string;
+  title: string;
+  status: string;
+  /** The task is already on the path from the root: following it would loop. */
+  cycle?: true;
+  /** The task's chain is shown where it first appears in the tree. */
+  repeated?: true;
+  /** The depth limit stopped the walk here. */
+  truncated?: true;
+  /** The ID does not resolve to a task. */
+  missing?: true;
+  children: DependencyTraceNode[];
+}
+/** Transitive blockers and dependents of one task. */
+export interface TasksDepsTraceResult {
+  taskId: string;
+  title: string;
+  status: string;
+  direction: DependencyTraceDirection;
+  /** Depth limit applied, or `null` for the whole chain. */
+  depth: number | null;
+  /** Tasks this one depends on, transitively; `null` when direction is `down`. */
+  up: DependencyTraceNode[] | null;
+  /** Tasks that depend on this one, transitively; `null` when direction is `up`. */
+  down: DependencyTraceNode[] | null;
+  /** True when either side contains a cycle. */
+  hasCycle: boolean;
+  /** Indented text rendering of both sides. */
+  rendered: string;
+}
+
+// tasks.estimate.rollup
+export interface TasksEstimateRollupParams {
+  /** Saga or epic ID to roll up. */
+  taskId: string;
+  /** Reject the task unless it has this type (`cleo saga show` / `cleo epic show`). */
+  type?: 'saga' | 'epic';
+}
+/** Estimate totals summed over a container's member tasks. */
+export interface TaskEstimateRollup {
+  /** Sum of all member estimates (`estimateDone + estimateRemaining`). */
+  estimateTotal: number;
+  /** Sum of estimates on done (or archived) members. */
+  estimateDone: number;
+  /** Sum of estimates on members that are still open, including active ones. */
+  estimateRemaining: number;
+  /** Member tasks with no estimate — excluded from the sums. */
+  unestimatedCount: number;
+}
+/** Aggregate state of a Saga's work, derived from its work items. */
+export type SagaProgressStatus = 'not_started' | 'in_progress' | 'blocked' | 'done';
+/** Work items of a container, bucketed by status. */
+export interface TaskProgressCounts {
+  /** Done (or archived) items. */
+  done: number;
+  /** Active items. */
+  in_progress: number;
+  /** Blocked items. */
+  blocked: number;
+  /** Everything else that is still open. */
+  pending: number;
+}
+/**
+ * Completion rollup over a container's work items — its leaf tasks and
+ * subtasks (a task broken into subtasks counts through them). Epics and
+ * cancelled work are not counted.
+ */
+export interface TaskProgressRollup {
+  /** `counts.done / total`, rounded to two decimals (0 when there is no work). */
+  progress: number;
+  counts: TaskProgressCounts;
+  /** Number of work items. */
+  total: number;
+  /**
+   * `done` when every item is done, `blocked` when a blocked task sits on the
+   * Saga's critical path, `in_progress` once any item is active or done, and
+   * `not_started` otherwise.
+   */
+  progressStatus: SagaProgressStatus;
+}
+/**
+ * Result of `tasks.estimate.rollup` — the container task plus its rollup.
+ * The progress fields are present when the task is a saga (`cleo saga show`).
+ */
+export interface TasksEstimateRollupResult extends Partial<TaskProgressRollup> {
+  task: TaskRecord;
+  estimate: TaskEstimateRollup;
+}
+
 // tasks.sync.links
 export interface TasksSyncLinksParams {
   providerId?: string;
@@ -1057,6 +1590,180 @@ export interface TasksReparentDispatchResult {
   newType?: string;
 }
 
+// tasks.move
+export interface TasksMoveParams {
+  taskId: string;
+  /** Target saga. Exactly one of `toSaga` / `toEpic` is required. */
+  toSaga?: string;
+  /** Target epic. */
+  toEpic?: string;
+  /** Move the whole subtree; otherwise direct children stay with the old parent. */
+  recursive?: boolean;
+  /** Allow the move even if dependency edges would cross saga boundaries. */
+  force?: boolean;
+}
+/** A dependency edge that the move would turn into a cross-saga edge. */
+export interface TasksMoveCrossSagaDependency {
+  /** Dependent task ID. */
+  from: string;
+  /** Dependency task ID. */
+  to: string;
+  /** Saga both ends shared before the move. */
+  saga: string | null;
+}
+/** Result of `tasks.move`. */
+export interface TasksMoveResult {
+  /** IDs of every task that moved, the requested task first. */
+  moved: string[];
+  /** Previous parent of the requested task. */
+  fromParent: string | null;
+  /** New parent (the target saga or epic). */
+  toParent: string;
+  /** Direct children left with the old parent (non-recursive moves). */
+  detached: string[];
+  /** Edges now crossing saga boundaries (non-empty only with `force`). */
+  crossSagaDependencies: TasksMoveCrossSagaDependency[];
+}
+
+// tasks.merge
+export interface TasksMergeParams {
+  /** Task that survives the merge. */
+  taskId: string;
+  /** Duplicate task IDs folded into `taskId` and then trashed. */
+  duplicates: string[];
+}
+/** A dependency edge dropped because redirecting it would make a self-loop. */
+export interface TasksMergeDroppedEdge {
+  /** Dependent task ID. */
+  from: string;
+  /** Dependency task ID before the redirect. */
+  to: string;
+}
+/** Result of `tasks.merge`. */
+export interface TasksMergeResult {
+  /** The kept task after the merge. */
+  task: TaskRecord;
+  /** Duplicates that were merged and trashed. */
+  merged: string[];
+  /** Tasks whose dependencies were redirected onto the kept task. */
+  redirected: string[];
+  /** Former children of the duplicates, now children of the kept task. */
+  reparented: string[];
+  /** Edges between the kept task and a duplicate, dropped instead of self-looping. */
+  droppedEdges: TasksMergeDroppedEdge[];
+}
+
+// tasks.split
+export interface TasksSplitParams {
+  taskId: string;
+  /** Titles of the child tasks to create, in order. */
+  into: string[];
+  /**
+   * Per-child estimates, matched to `into` by position. When omitted the
+   * parent's estimate is divided evenly between the children.
+   */
+  estimates?: number[];
+}
+/** Result of `tasks.split`. */
+export interface TasksSplitResult {
+  taskId: string;
+  /** New child task IDs, in `into` order. */
+  children: string[];
+  /** The parent's status, unchanged; it closes when its last child completes. */
+  status: TaskStatus;
+  /** Estimate given to each new child (`null` when none). */
+  estimates: Array<number | null>;
+  /** Dependencies moved from the parent onto every child. */
+  depends: string[];
+  /** Tasks that depended on the parent and now depend on every child. */
+  redirected: string[];
+}
+
+// tasks.trash.list
+export type TasksTrashListParams = Record<string, never>;
+/** A task in the trash. */
+export interface TasksTrashEntry {
+  id: string;
+  title: string;
+  type?: string;
+  /** When the task was deleted. */
+  deletedAt: string;
+  /** Parent at deletion time. */
+  parentId: string | null;
+}
+/** Result of `tasks.trash.list` — trashed tasks, most recently deleted first. */
+export interface TasksTrashListResult {
+  tasks: TasksTrashEntry[];
+  total: number;
+}
+
+// tasks.trash.restore
+export interface TasksTrashRestoreParams {
+  taskId: string;
+  /** Re-home the task under this saga (required when its parent no longer exists). */
+  toSaga?: string;
+}
+/** Result of `tasks.trash.restore`. */
+export interface TasksTrashRestoreResult {
+  task: string;
+  /** Every restored task ID — the task plus children deleted with it. */
+  restored: string[];
+  /** Parent the task was restored under. */
+  parentId: string | null;
+}
+
+// tasks.trash.empty
+export interface TasksTrashEmptyParams {
+  /** Only purge tasks deleted longer ago than this (e.g. `30d`, `12h`). */
+  olderThan?: string;
+}
+/** Result of `tasks.trash.empty`. */
+export interface TasksTrashEmptyResult {
+  /** IDs removed for good. */
+  purged: string[];
+  count: number;
+}
+
+// tasks.archive.list
+export interface TasksArchiveListParams {
+  /** Only tasks archived from under this saga. */
+  sagaId?: string;
+  /** Only tasks archived at or after this date (ISO 8601). */
+  since?: string;
+}
+/** An archived task. */
+export interface TasksArchiveEntry {
+  id: string;
+  title: string;
+  type?: string;
+  /** Status the task held when it was archived — what `archive.restore` returns it to. */
+  status: TaskStatus;
+  /** When the task was archived. */
+  archivedAt: string;
+  archiveReason?: string;
+  parentId: string | null;
+}
+/** Result of `tasks.archive.list` — archived tasks, most recently archived first. */
+export interface TasksArchiveListResult {
+  tasks: TasksArchiveEntry[];
+  total: number;
+}
+
+// tasks.archive.restore
+export interface TasksArchiveRestoreParams {
+  taskId: string;
+  /** Re-home the task under this saga (required when its parent no longer exists). */
+  toSaga?: string;
+}
+/** Result of `tasks.archive.restore`. */
+export interface TasksArchiveRestoreResult {
+  task: string;
+  /** Status the task was restored to. */
+  status: TaskStatus;
+  /** Parent the task was restored under. */
+  parentId: string | null;
+}
+
 // tasks.reorder (dispatch-level params)
 export interface TasksReorderQueryParams {
   taskId: string;
@@ -1395,6 +2102,54 @@ export interface TasksAddBatchResult extends TaskMutationEnvelope<number, [], []
   affectedCount: number;
 }
 
+/**
+ * One step inside a `tasks.batch` payload. The `op` discriminator selects the
+ * mutation; the remaining fields are the same wire params the standalone
+ * `tasks.add` (as an add-batch entry), `tasks.update`, and `tasks.complete`
+ * operations accept.
+ */
+export type TasksBatchOperation =
+  | ({ op: 'create' } & TasksAddBatchParams['tasks'][number])
+  | ({ op: 'update' } & TasksUpdateQueryParams)
+  | ({ op: 'complete' } & Omit<TasksCompleteQueryParams, 'force'>);
+
+/**
+ * Parameters for `tasks.batch` — apply several create / update / complete
+ * steps as ONE transaction. Either every step lands or none do.
+ */
+export interface TasksBatchParams {
+  /** Ordered steps to apply. Later steps see the effects of earlier ones. */
+  operations: TasksBatchOperation[];
+  /** Apply every step, report the outcome, then roll the whole batch back. */
+  dryRun?: boolean;
+}
+
+/** Per-step outcome inside a {@link TasksBatchResult}. */
+export interface TasksBatchStepResult {
+  /** Position of the step in the `operations` input array. */
+  index: number;
+  /** Mutation the step performed. */
+  op: TasksBatchOperation['op'];
+  /** Task created, updated, or completed by the step. */
+  taskId: string;
+  /** Fields changed by an `update` step. @defaultValue undefined */
+  changes?: string[];
+}
+
+/**
+ * Result of a successful `tasks.batch`. A failing batch returns an
+ * `E_BATCH_FAILED` error whose `details` carry `failedIndex`, `op`, the
+ * underlying error `code`, and the step's own error `details`.
+ */
+export interface TasksBatchResult {
+  /** Number of steps applied (0 on a dry run — everything was rolled back). */
+  applied: number;
+  /** Per-step outcomes in input order. */
+  results: TasksBatchStepResult[];
+  /** Whether this was a dry run. @defaultValue undefined */
+  dryRun?: boolean;
+}
+
 // tasks.add (dispatch-level params — extends TasksCreateParams)
 export interface TasksAddParams {
   title: string;
@@ -1416,6 +2171,17 @@ export interface TasksAddParams {
   kind?: string;
   scope?: string;
   severity?: string;
+  /** Due date (RFC 3339 full-date or date-time). */
+  due?: string;
+  /**
+   * Recurrence rule — `7d`-style interval (`m|h|d|w`), `@hourly|@daily|@weekly`,
+   * or JSON `{"every":"7d"}`. Completing the task creates the next occurrence.
+   */
+  recurrence?: string;
+  /** Effort estimate (story points or hours); must be >= 0. */
+  estimate?: number;
+  /** Orchestration track (e.g. `infra`); overrides the saga track and label rules. */
+  track?: string;
   /**
    * Bypass the E_DUPLICATE_TASK_LIKELY guard.
    *
@@ -1489,6 +2255,27 @@ export interface TasksUpdateQueryParams {
    * Appends a signed attestation to `.cleo/audit/severity-attestation.jsonl`.
    */
   severity?: string;
+  /** Due date (RFC 3339 full-date or date-time). An empty string clears it. */
+  due?: string;
+  /** Recurrence rule (see {@link TasksAddParams.recurrence}); `none` stops the chain. */
+  recurrence?: string;
+  /** Effort estimate (story points or hours); `null` clears it. */
+  estimate?: number | null;
+  /** Orchestration track; `null` or an empty string clears it. */
+  track?: string | null;
+  /** Agent or person to pin the task to; `null` or an empty string clears it. */
+  assignee?: string | null;
+  /**
+   * What `tasks.complete` requires of the task (`commit`, `note`, `estimate`, `assignee`).
+   * On a saga the list is the default for its work; `[]` requires nothing and
+   * `null` inherits the saga's list again.
+   */
+  completionRequirements?: string[] | null;
+  /**
+   * Custom field assignments, each `name=value`; an empty value clears the
+   * field. Validated against the project's `cleo fields` declarations.
+   */
+  set?: string[];
   /**
    * Operator override reason for AC-immutability guard (T1590).
    * Required to mutate `acceptance` once stage >= implementation.
@@ -1506,6 +2293,8 @@ export interface TasksUpdateQueryParams {
   addRelates?: Array<{ taskId: string; type: string; reason?: string }>;
   /** Remove related tasks by taskId. @task T9327 */
   removeRelates?: string[];
+  /** Complete the task once its last open child completes; `false` clears the flag. */
+  autoCompleteParent?: boolean;
 }
 /**
  * Result of `tasks.update` — the updated task record with change list.
@@ -1605,6 +2394,8 @@ export interface TasksCompleteQueryResult {
 export interface TasksDeleteQueryParams {
   taskId: string;
   force?: boolean;
+  /** Remove the task for good instead of moving it to the trash. */
+  purge?: boolean;
 }
 /**
  * Result of `tasks.delete` — deletion confirmation with cascade details.
@@ -1618,6 +2409,8 @@ export interface TasksDeleteQueryResult extends TaskMutationEnvelope<[], [], boo
   deleted: boolean;
   /** IDs of child tasks cascade-deleted along with the parent. @defaultValue undefined */
   cascadeDeleted?: string[];
+  /** True when the task was purged rather than moved to the trash. @defaultValue undefined */
+  purged?: boolean;
   /** Whether this was a dry run (no mutation persisted). @defaultValue undefined */
   dryRun?: boolean;
   /** Standardized created bucket; empty for delete. @task T10608 */
@@ -1725,6 +2518,12 @@ export interface TasksSagaCreateParams {
   description?: string;
   /** Pipe-separated acceptance criteria. */
   acceptance?: string[];
+  /** Due date (RFC 3339 full-date or date-time) that `saga.schedule` works back from. */
+  due?: string;
+  /** ID prefix for the saga's tasks (`AUTH` → `AUTH-1`, `AUTH-2`); unique across sagas. */
+  prefix?: string;
+  /** Orchestration track for member tasks that have none of their own. */
+  track?: string;
   /** Validate and preview the Saga without writing rows. */
   dryRun?: boolean;
 }
@@ -1832,8 +2631,8 @@ export interface TasksSagaInvariantI5Warning {
  * @task T10117 — added optional `warnings` array for I5 violations
  */
 export interface TasksSagaListResult {
-  /** Array of Saga task records. */
-  sagas: TaskRecord[];
+  /** Array of Saga task records, each with its completion progress. */
+  sagas: Array<TaskRecord & Pick<TaskProgressRollup, 'progress' | 'progressStatus'>>;
   /** Total count. */
   total: number;
   /**
@@ -2064,6 +2863,198 @@ export interface TasksSagaRollupResult {
   completionPct: number;
 }
 
+/** Params for `tasks.saga.critical-path` — estimate-weighted longest dependency chain. */
+export interface TasksSagaCriticalPathParams {
+  /** Saga task ID. */
+  sagaId: string;
+}
+
+/** One task on a Saga's critical path. */
+export interface TasksSagaCriticalPathNode {
+  /** Task ID. */
+  id: string;
+  /** Task title. */
+  title: string;
+  /** Task status. */
+  status: TaskStatus;
+  /** Node weight — the task's `estimate`, or 1 when it has none. */
+  weight: number;
+  /** True when the task has no estimate and `weight` is the default of 1. */
+  estimateDefaulted: boolean;
+}
+
+/** Result of `tasks.saga.critical-path`. */
+export interface TasksSagaCriticalPathResult {
+  /** Saga task ID. */
+  sagaId: string;
+  /** The longest weighted chain, first task to last. Empty when no work is open. */
+  path: TasksSagaCriticalPathNode[];
+  /** Sum of the weights along `path`. */
+  length: number;
+  /** IDs of open tasks weighted by default because they have no estimate. */
+  unestimated: string[];
+  /** Number of open tasks considered. */
+  taskCount: number;
+}
+
+/** Params for `tasks.saga.deps` — the other Sagas a Saga depends on. */
+export interface TasksSagaDepsParams {
+  /** Saga task ID. */
+  sagaId: string;
+}
+
+/** One dependency edge from a task in the Saga to a task in another Saga. */
+export interface TasksSagaDepsEdge {
+  /** Task in the queried Saga. */
+  taskId: string;
+  /** Task it depends on, in the other Saga. */
+  dependsOn: string;
+  /** Status of the dependency. */
+  status: TaskStatus;
+}
+
+/** Another Saga the queried Saga depends on. */
+export interface TasksSagaDepsEntry {
+  /** The other Saga's task ID. */
+  sagaId: string;
+  title: string;
+  status: TaskStatus;
+  /** Edges into that Saga, by task ID. */
+  edges: TasksSagaDepsEdge[];
+  /** Edges whose dependency is still open (not done, cancelled, or archived). */
+  open: number;
+}
+
+/** Result of `tasks.saga.deps`. */
+export interface TasksSagaDepsResult {
+  /** Saga task ID. */
+  sagaId: string;
+  /** Sagas depended on, by Saga ID. */
+  dependsOn: TasksSagaDepsEntry[];
+  /** Open edges across all of `dependsOn`. */
+  open: number;
+}
+
+/** Params for `tasks.saga.schedule` — latest start/finish per task from the Saga due date. */
+export interface TasksSagaScheduleParams {
+  /** Saga task ID. */
+  sagaId: string;
+  /** Hours one estimate point stands for (default 1). */
+  hoursPerUnit?: number;
+  /** Reference time for `atRisk` (RFC 3339; default: now). */
+  asOf?: string;
+}
+
+/** One open task in a Saga schedule. */
+export interface TasksSagaScheduleEntry {
+  /** Task ID. */
+  id: string;
+  /** Task title. */
+  title: string;
+  /** Task status. */
+  status: TaskStatus;
+  /** The task's `estimate`, or 1 when it has none. */
+  weight: number;
+  /** True when the task has no estimate and `weight` is the default of 1. */
+  estimateDefaulted: boolean;
+  /** Latest start (ISO 8601) that still lets the Saga finish by its due date. */
+  latestStart: string;
+  /** Latest finish (ISO 8601) that still lets the Saga finish by its due date. */
+  latestFinish: string;
+  /** True when `latestStart` is already before `asOf`. */
+  atRisk: boolean;
+}
+
+/** Result of `tasks.saga.schedule`. */
+export interface TasksSagaScheduleResult {
+  /** Saga task ID. */
+  sagaId: string;
+  /** The Saga's due date, as stored. */
+  due: string;
+  /** Reference time `atRisk` was judged against (ISO 8601). */
+  asOf: string;
+  /** Hours one estimate point stood for. */
+  hoursPerUnit: number;
+  /** True when no task is at risk. */
+  feasible: boolean;
+  /** Task IDs on the critical path, first to last. */
+  criticalPath: string[];
+  /** Open tasks by latest start, then task ID. */
+  schedule: TasksSagaScheduleEntry[];
+  /** IDs of the at-risk tasks, in schedule order. */
+  atRisk: string[];
+  /** IDs of open tasks weighted by default because they have no estimate. */
+  unestimated: string[];
+}
+
+/**
+ * A portable Saga snapshot written by `cleo saga export` and read by
+ * `cleo saga import`. Task IDs are those of the source project; `depends`
+ * may name tasks outside the bundle, which import drops.
+ */
+export interface TasksSagaBundle {
+  /** Bundle marker, always `'cleo-saga-bundle'`. */
+  format: 'cleo-saga-bundle';
+  /** Bundle format version. */
+  version: 1;
+  /** ISO timestamp of the export. */
+  exportedAt: string;
+  /** Source ID of the Saga; its task is the bundle's only root. */
+  sagaId: string;
+  /** The Saga and its descendants, parents before children. `relates` is not carried. */
+  tasks: Task[];
+}
+
+/** Params for `tasks.saga.export`. */
+export interface TasksSagaExportParams {
+  /** Saga task ID. */
+  sagaId: string;
+}
+
+/** Result of `tasks.saga.export`. */
+export interface TasksSagaExportResult {
+  /** Saga task ID. */
+  sagaId: string;
+  /** Number of tasks in the bundle, the Saga included. */
+  taskCount: number;
+  /** The bundle document. */
+  bundle: TasksSagaBundle;
+}
+
+/** Params for `tasks.saga.import`. */
+export interface TasksSagaImportParams {
+  /** Bundle path, relative to the project root. */
+  file: string;
+}
+
+/** A dependency dropped on import because its target is not in the bundle. */
+export interface TasksSagaImportDroppedDep {
+  /** Source ID of the task that declared the dependency. */
+  taskId: string;
+  /** Source ID of the missing dependency target. */
+  dependsOn: string;
+}
+
+/** Result of `tasks.saga.import`. */
+export interface TasksSagaImportResult {
+  /** Bundle path as given. */
+  file: string;
+  /** ID of the newly created Saga. */
+  sagaId: string;
+  /** Number of tasks created. */
+  created: number;
+  /** Source task ID → new task ID. */
+  idMap: Record<string, string>;
+  /** Bundle labels already in use in this project. */
+  reusedLabels: string[];
+  /** Bundle labels new to this project. */
+  newLabels: string[];
+  /** Dependencies on tasks outside the bundle, which were not imported. */
+  droppedDepends: TasksSagaImportDroppedDep[];
+  /** Human-readable notes on anything dropped. */
+  warnings: string[];
+}
+
 // ---------------------------------------------------------------------------
 // Typed operation record (Wave D adapter — T1425)
 // ---------------------------------------------------------------------------
@@ -2107,12 +3098,26 @@ export type TasksOps = {
   readonly history: readonly [TasksHistoryParams, TasksHistoryResult];
   readonly current: readonly [TasksCurrentParams, TasksCurrentResult];
   readonly 'label.list': readonly [TasksLabelListParams, TasksLabelListResult];
+  readonly 'trash.list': readonly [TasksTrashListParams, TasksTrashListResult];
+  readonly 'archive.list': readonly [TasksArchiveListParams, TasksArchiveListResult];
+  readonly 'label.merge': readonly [TasksLabelMergeParams, TasksLabelMergeResult];
+  readonly 'label.rename': readonly [TasksLabelRenameParams, TasksLabelMergeResult];
+  readonly overdue: readonly [TasksOverdueParams, TasksOverdueResult];
+  readonly stale: readonly [TasksStaleParams, TasksStaleResult];
+  readonly commits: readonly [TasksCommitsParams, TasksCommitsResult];
+  readonly time: readonly [TasksTimeParams, TasksTimeResult];
+  readonly 'fields.list': readonly [TasksFieldsListParams, TasksFieldsListResult];
+  readonly 'estimate.rollup': readonly [TasksEstimateRollupParams, TasksEstimateRollupResult];
+  readonly burndown: readonly [TasksBurndownParams, TasksBurndownResult];
+  readonly graph: readonly [TasksGraphParams, TasksGraphResult];
+  readonly 'deps.trace': readonly [TasksDepsTraceParams, TasksDepsTraceResult];
   readonly 'sync.links': readonly [TasksSyncLinksParams, TasksSyncLinksResult];
   // T10629 — task-scoped context pack with token budget
   readonly context: readonly [TasksContextParams, TasksContextResult];
   // Mutate ops
   readonly add: readonly [TasksAddParams, TasksAddResult];
   readonly 'add-batch': readonly [TasksAddBatchParams, TasksAddBatchResult];
+  readonly batch: readonly [TasksBatchParams, TasksBatchResult];
   readonly update: readonly [TasksUpdateQueryParams, TasksUpdateQueryResult];
   readonly complete: readonly [TasksCompleteQueryParams, TasksCompleteQueryResult];
   readonly cancel: readonly [TasksCancelParams, TasksCancelResult];
@@ -2120,6 +3125,26 @@ export type TasksOps = {
   readonly archive: readonly [TasksArchiveQueryParams, TasksArchiveQueryResult];
   readonly restore: readonly [TasksRestoreParams, TasksRestoreResult];
   readonly reparent: readonly [TasksReparentQueryParams, TasksReparentDispatchResult];
+  readonly move: readonly [TasksMoveParams, TasksMoveResult];
+  readonly merge: readonly [TasksMergeParams, TasksMergeResult];
+  readonly split: readonly [TasksSplitParams, TasksSplitResult];
+  readonly 'trash.restore': readonly [TasksTrashRestoreParams, TasksTrashRestoreResult];
+  readonly 'trash.empty': readonly [TasksTrashEmptyParams, TasksTrashEmptyResult];
+  readonly 'archive.restore': readonly [TasksArchiveRestoreParams, TasksArchiveRestoreResult];
+  readonly 'stale.reset': readonly [TasksStaleResetParams, TasksStaleResetResult];
+  readonly block: readonly [TasksBlockParams, TasksBlockResult];
+  readonly unblock: readonly [TasksUnblockParams, TasksUnblockResult];
+  readonly note: readonly [TasksNoteParams, TasksNoteResult];
+  readonly 'link-commit': readonly [TasksLinkCommitParams, TasksLinkCommitResult];
+  readonly 'commits.scan': readonly [TasksCommitsScanParams, TasksCommitsScanResult];
+  readonly 'fields.add': readonly [TasksFieldsAddParams, TasksFieldsAddResult];
+  readonly 'import.markdown': readonly [TasksImportMarkdownParams, TasksImportMarkdownResult];
+  readonly renumber: readonly [TasksRenumberParams, TasksRenumberResult];
+  readonly undo: readonly [TasksUndoParams, TasksUndoResult];
+  readonly redo: readonly [TasksRedoParams, TasksRedoResult];
+  readonly 'set-status': readonly [TasksSetStatusParams, TasksSetStatusResult];
+  readonly 'time.start': readonly [TasksTimeStartParams, TasksTimeStartResult];
+  readonly 'time.stop': readonly [TasksTimeStopParams, TasksTimeStopResult];
   readonly reorder: readonly [TasksReorderQueryParams, TasksReorderDispatchResult];
   // T11786 (epic T11556) — bulk task mutate ops Studio's interactive Kanban binds to.
   readonly 'reorder-rank': readonly [TasksReorderRankParams, TasksReorderRankResult];
@@ -2141,6 +3166,14 @@ export type TasksOps = {
   readonly 'saga.list': readonly [TasksSagaListParams, TasksSagaListResult];
   readonly 'saga.members': readonly [TasksSagaMembersParams, TasksSagaMembersResult];
   readonly 'saga.rollup': readonly [TasksSagaRollupParams, TasksSagaRollupResult];
+  readonly 'saga.critical-path': readonly [
+    TasksSagaCriticalPathParams,
+    TasksSagaCriticalPathResult,
+  ];
+  readonly 'saga.deps': readonly [TasksSagaDepsParams, TasksSagaDepsResult];
+  readonly 'saga.schedule': readonly [TasksSagaScheduleParams, TasksSagaScheduleResult];
+  readonly 'saga.export': readonly [TasksSagaExportParams, TasksSagaExportResult];
+  readonly 'saga.import': readonly [TasksSagaImportParams, TasksSagaImportResult];
   /** T10117 — repair an I5-violating saga. */
   readonly 'saga.repair': readonly [TasksSagaRepairParams, TasksSagaRepairResult];
   /** T10121 — idempotent cron-safe auto-close repair (supersedes T10098 scope). */
@@ -2251,6 +3284,10 @@ export const TASKS_ADD_INPUT_SCHEMA: JsonSchema = {
     },
     scope: { type: 'string', enum: ['project', 'feature', 'unit'] },
     severity: { type: 'string', enum: ['P0', 'P1', 'P2', 'P3'] },
+    due: { type: 'string' },
+    recurrence: { type: 'string' },
+    estimate: { type: 'number', minimum: 0 },
+    track: { type: 'string' },
     forceDuplicate: { type: 'boolean' },
   },
 };
@@ -2421,6 +3458,15 @@ export const TASKS_UPDATE_INPUT_SCHEMA: JsonSchema = {
     },
     scope: { type: 'string', enum: ['project', 'feature', 'unit'] },
     severity: { type: 'string', enum: ['P0', 'P1', 'P2', 'P3'] },
+    due: { type: 'string' },
+    recurrence: { type: 'string' },
+    estimate: { type: ['number', 'null'], minimum: 0 },
+    track: { type: ['string', 'null'] },
+    completionRequirements: {
+      type: ['array', 'null'],
+      items: { type: 'string', enum: ['commit', 'note', 'estimate', 'assignee'] },
+    },
+    set: { type: 'array', items: { type: 'string' } },
     reason: { type: 'string' },
     dependsWaiver: { type: 'string' },
     blockedBy: { type: 'string' },
@@ -2452,6 +3498,7 @@ export const TASKS_UPDATE_INPUT_SCHEMA: JsonSchema = {
       },
     },
     removeRelates: { type: 'array', items: { type: 'string' } },
+    autoCompleteParent: { type: 'boolean' },
   },
 };
 
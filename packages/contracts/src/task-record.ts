@@ -8,7 +8,12 @@
  * @epic T4654
  */
 
-import type { TaskVerification } from './task.js';
+import type {
+  TaskNoteEntry,
+  TaskRecurrence,
+  TaskTimeEntry,
+  TaskVerification,
+} from './task.js';
 
 /** A single task relation entry (string-widened version). */
 export interface TaskRecordRelation {
@@ -51,6 +56,22 @@ export interface TaskRecord {
   createdAt: string;
   updatedAt: string | null;
   completedAt?: string | null;
+  /** Due date (RFC 3339 full-date or date-time). */
+  due?: string | null;
+  /** Recurrence rule (`{ every }`); present only on recurring tasks. */
+  recurrence?: TaskRecurrence | null;
+  /** ID of the next occurrence created when this recurring task completed. */
+  recurredTo?: string | null;
+  /** Effort estimate (story points or hours, per project convention). */
+  estimate?: number | null;
+  /** Orchestration track; present only when set on the task itself. */
+  track?: string | null;
+  /** Agent or person the task is pinned to; present only when assigned. */
+  assignee?: string | null;
+  /** When the task was moved to the trash; present only on trashed tasks. */
+  deletedAt?: string | null;
+  /** Parent the task was deleted from; present only on trashed tasks. */
+  deletedParentId?: string | null;
   cancelledAt?: string | null;
   parentId?: string | null;
   position?: number | null;
@@ -72,6 +93,26 @@ export interface TaskRecord {
   lifecycleState?: string | null;
   validationHistory?: ValidationHistoryEntry[];
   blockedBy?: string[];
+  /** When a manual block lapses; `orchestrate ready` offers the task again after it. */
+  blockedUntil?: string | null;
+  /** Status restored by `cleo tasks unblock`. */
+  blockedPriorStatus?: string | null;
+  /** Authored note history (`cleo tasks note`), newest first. */
+  noteHistory?: TaskNoteEntry[];
+  /** Linked git commit SHAs (`cleo tasks link-commit` / `cleo git scan`). */
+  commits?: string[];
+  /** Tracked work intervals (`cleo tasks start` / `cleo tasks stop`), oldest first. */
+  timeEntries?: TaskTimeEntry[];
+  /** Summed duration of `timeEntries` in seconds, a running interval counted up to now. */
+  timeSpent?: number;
+  /** Fields required before completion, when set on the task itself. */
+  completionRequirements?: string[];
+  /** Saga ID prefix its tasks are numbered under (`AUTH` → `AUTH-1`). */
+  idPrefix?: string;
+  /** Project custom field values (`cleo update --set name=value`). */
+  custom?: Record<string, string | number>;
+  /** Complete this task when its last open child completes. */
+  autoCompleteParent?: boolean | null;
   /** Compact counts for relationships and docs, kept in default MVI projection. */
   relationCounts?: TaskRecordRelationCounts;
   cancellationReason?: string;
@@ -126,4 +167,10 @@ export interface MinimalTaskRecord {
    * @task T9905
    */
   severity?: string | null;
+  /** Due date — surfaced so `cleo overdue` rows carry their deadline. */
+  due?: string | null;
+  /** Why the task is blocked (`cleo tasks block --reason`). */
+  blockedBy?: string[];
+  /** When a manual block lapses (`cleo tasks block --until`). */
+  blockedUntil?: string | null;
 }
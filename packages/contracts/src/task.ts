@@ -404,6 +404,78 @@ export interface TaskVerification {
   initializedAt?: string | null;
 }
 
+/**
+ * Recurrence rule for standing work (weekly review, nightly checks).
+ *
+ * When a task carrying a rule is completed, the next occurrence is created
+ * with a fresh ID, `pending` status, and `due` advanced by `every`.
+ */
+export interface TaskRecurrence {
+  /**
+   * Positive interval: `<n><unit>` with unit `m`, `h`, `d`, or `w`
+   * (e.g. `"7d"`), or one of `@hourly`, `@daily`, `@weekly`.
+   */
+  every: string;
+}
+
+/**
+ * One entry in a task's append-only note history (`cleo tasks note`).
+ *
+ * Entries are never edited or removed once written, so the history records
+ * the sequence of observations made by every agent that touched the task.
+ */
+export interface TaskNoteEntry {
+  /** ISO 8601 timestamp the note was recorded. */
+  at: string;
+  /** Agent or user that wrote the note. */
+  author: string;
+  /** Note text. */
+  text: string;
+}
+
+/**
+ * One tracked work interval on a task (`cleo tasks start` / `cleo tasks stop`).
+ *
+ * A task has at most one open interval (no `stop`) at a time.
+ */
+export interface TaskTimeEntry {
+  /** ISO 8601 timestamp the timer was started. */
+  start: string;
+  /** ISO 8601 timestamp the timer was stopped; absent while running. */
+  stop?: string;
+}
+
+/**
+ * Fields a task must carry before it can be completed:
+ *
+ * - `commit` — at least one linked commit (`cleo tasks link-commit`).
+ * - `note` — at least one note (`cleo tasks note`, or `--notes` on update).
+ * - `estimate` — an effort estimate.
+ * - `assignee` — an assignee.
+ */
+export const COMPLETION_REQUIREMENTS = ['commit', 'note', 'estimate', 'assignee'] as const;
+
+/** One of {@link COMPLETION_REQUIREMENTS}. */
+export type CompletionRequirement = (typeof COMPLETION_REQUIREMENTS)[number];
+
+/** Value type of a project-defined custom field (`cleo fields add --type`). */
+export type CustomFieldType = 'string' | 'number' | 'enum';
+
+/**
+ * Project-level custom field declaration (`cleo fields add`).
+ *
+ * Tasks may only carry `custom` values for declared fields; `enum` fields
+ * additionally restrict the value to `values`.
+ */
+export interface CustomFieldDef {
+  /** Field name used in `--set name=value` and `--where name=value`. */
+  name: string;
+  /** Value type. */
+  type: CustomFieldType;
+  /** Allowed values — present (and non-empty) only for `enum` fields. */
+  values?: string[];
+}
+
 /** Task provenance tracking. */
 export interface TaskProvenance {
   /** Agent or user that created this task, or `null` if unknown. */
@@ -427,6 +499,15 @@ export interface TaskRelation {
   reason?: string;
 }
 
+/**
+ * Saga ID prefix (`cleo saga create --prefix AUTH`): 2–10 uppercase letters.
+ * Tasks created under the saga are numbered `<PREFIX>-<n>` instead of `T<n>`.
+ */
+export const TASK_ID_PREFIX_PATTERN = /^[A-Z]{2,10}$/;
+
+/** Saga-prefixed task ID, e.g. `AUTH-1`. The prefix and counter are captured. */
+export const PREFIXED_TASK_ID_PATTERN = /^([A-Z]{2,10})-(\d+)$/;
+
 /**
  * A single CLEO task as stored in the database.
  *
@@ -435,7 +516,10 @@ export interface TaskRelation {
  * at compile time rather than deferring to runtime checks.
  */
 export interface Task {
-  /** Unique task identifier. Must match pattern `T\d{3,}` (e.g., T001, T5800). */
+  /**
+   * Unique task identifier. Matches `T\d{3,}` (e.g., T001, T5800), or
+   * `<PREFIX>-<n>` (e.g., AUTH-1) for tasks under a saga with an ID prefix.
+   */
   id: string;
 
   /** Human-readable task title. Required, max 120 characters. */
@@ -528,6 +612,39 @@ export interface Task {
   /** Timestamped notes appended during task lifecycle. @defaultValue undefined */
   notes?: string[];
 
+  /** Append-only authored note history, oldest first. @defaultValue undefined */
+  noteHistory?: TaskNoteEntry[];
+
+  /** Linked git commit SHAs (lowercase hex, deduplicated). @defaultValue undefined */
+  commits?: string[];
+
+  /** Tracked work intervals, oldest first. @defaultValue undefined */
+  timeEntries?: TaskTimeEntry[];
+
+  /**
+   * Fields required before the task can be completed. Set on a saga, it is
+   * the default for every task in the saga; set on a task (even to `[]`), it
+   * overrides the saga's. `null` / absent inherits.
+   * @defaultValue undefined
+   */
+  completionRequirements?: CompletionRequirement[] | null;
+
+  /**
+   * Saga only: ID prefix for tasks created under the saga (`AUTH` → `AUTH-1`).
+   * Unique across sagas. `null` / absent keeps global `T<n>` IDs.
+   * @defaultValue undefined
+   */
+  idPrefix?: string | null;
+
+  /** Values for project-defined custom fields, keyed by field name. @defaultValue undefined */
+  custom?: Record<string, string | number>;
+
+  /**
+   * When true, the task completes itself once its last open child completes.
+   * @defaultValue undefined
+   */
+  autoCompleteParent?: boolean | null;
+
   /** Classification labels for filtering and grouping. @defaultValue undefined */
   labels?: string[];
 
@@ -540,6 +657,52 @@ export interface Task {
   /** ISO 8601 timestamp of last update. Set automatically on mutation. @defaultValue undefined */
   updatedAt?: string | null;
 
+  /**
+   * Due date — RFC 3339 full-date (`2026-07-01`) or date-time. Tasks past due
+   * and not done are surfaced by `cleo overdue`. @defaultValue undefined
+   */
+  due?: string | null;
+
+  /** Recurrence rule; completing the task creates the next occurrence. @defaultValue undefined */
+  recurrence?: TaskRecurrence | null;
+
+  /** ID of the occurrence created when this recurring task completed. @defaultValue undefined */
+  recurredTo?: string | null;
+
+  /**
+   * Effort estimate — story points or hours, whichever unit the project uses.
+   * Rolled up into saga/epic totals by `tasks.estimate`. @defaultValue undefined
+   */
+  estimate?: number | null;
+
+  /**
+   * Orchestration track (e.g. `infra`, `frontend`). A task without one takes
+   * its saga's track, then the first matching `trackRules` label rule.
+   * @defaultValue undefined
+   */
+  track?: string | null;
+
+  /**
+   * ISO 8601 timestamp the task was moved to the trash by `cleo delete`.
+   * Trashed tasks are archived rows; `cleo trash restore` clears this. @defaultValue undefined
+   */
+  deletedAt?: string | null;
+
+  /** Parent at deletion time, kept even if that parent is later purged. @defaultValue undefined */
+  deletedParentId?: string | null;
+
+  /**
+   * RFC 3339 date a `cleo tasks block --until` hold expires. Past it, the
+   * task is offered by `orchestrate ready` again. @defaultValue undefined
+   */
+  blockedUntil?: string | null;
+
+  /** Status before `cleo tasks block`, restored by `cleo tasks unblock`. @defaultValue undefined */
+  blockedPriorStatus?: TaskStatus | null;
+
+  /** Status held at archive time, restored by `cleo archive restore`. @defaultValue undefined */
+  preArchiveStatus?: TaskStatus | null;
+
   /**
    * ISO 8601 timestamp of task completion. Set when `status` transitions to `'done'`.
    * See {@link CompletedTask} for the status-narrowed type where this is required.
@@ -678,6 +841,18 @@ export interface TaskCreate {
   /** Initial note to attach. @defaultValue undefined */
   notes?: string;
 
+  /** Due date (RFC 3339 full-date or date-time). @defaultValue undefined */
+  due?: string;
+
+  /** Recurrence rule. @defaultValue undefined */
+  recurrence?: TaskRecurrence;
+
+  /** Effort estimate (story points or hours). @defaultValue undefined */
+  estimate?: number;
+
+  /** Orchestration track. @defaultValue undefined */
+  track?: string;
+
   /** Sort position. Auto-calculated if not specified. @defaultValue undefined */
   position?: number;
 }
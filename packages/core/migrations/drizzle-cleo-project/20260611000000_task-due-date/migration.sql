@@ -0,0 +1,12 @@
+-- Task due dates — add nullable `due` to `tasks_tasks` (consolidated PROJECT
+-- cleo.db, drizzle-cleo-project scope).
+--
+-- Holds the RFC 3339 full-date (`2026-07-01`) or date-time the caller passed to
+-- `cleo add --due` / `cleo update --due`, validated in core before write. Read by
+-- `tasks.overdue` (`cleo overdue`). Nullable so every existing row stays valid.
+--
+-- No CHECK constraint: the column is deliberately NOT named `_at` — a full-date
+-- value would not satisfy the ISO-8601 instant GLOB the T11363
+-- consolidation-check injector derives for timestamp columns.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `due` text;
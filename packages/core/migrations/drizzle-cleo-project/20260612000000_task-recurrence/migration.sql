@@ -0,0 +1,12 @@
+-- Recurring tasks — add nullable `recurrence_json` and `recurred_to` to
+-- `tasks_tasks` (consolidated PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `recurrence_json` holds the `{ "every": "7d" }` rule set by
+-- `cleo add --recurrence` / `cleo update --recurrence`. When a recurring task
+-- is completed, `completeTask` creates the next occurrence and records its ID
+-- in the completed row's `recurred_to`. Both nullable so every existing row
+-- stays valid.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `recurrence_json` text;
+--> statement-breakpoint
+ALTER TABLE `tasks_tasks` ADD COLUMN `recurred_to` text;
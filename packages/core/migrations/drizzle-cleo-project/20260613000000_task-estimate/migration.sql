@@ -0,0 +1,8 @@
+-- Task effort estimates — add nullable `estimate` to `tasks_tasks`
+-- (consolidated PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `estimate` holds the story points or hours set by `cleo add --estimate` /
+-- `cleo update --estimate`. `cleo saga show` and `cleo epic show` sum it over
+-- member tasks. Nullable so every existing row stays valid (unestimated).
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `estimate` real;
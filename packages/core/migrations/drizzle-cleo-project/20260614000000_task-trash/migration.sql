@@ -0,0 +1,17 @@
+-- Task trash — add nullable `deleted_at` and `deleted_parent_id` to
+-- `tasks_tasks` (consolidated PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `cleo delete` archives the task as before and stamps `deleted_at`, which
+-- moves it into the trash (`cleo trash list`) instead of the archive.
+-- `cleo trash restore` clears it; `cleo delete --purge` and `cleo trash empty`
+-- remove the row for good. Indexed because `trash empty --older-than` scans it.
+--
+-- `deleted_parent_id` records the parent at deletion time. It is deliberately
+-- not a foreign key: `parent_id` is nulled when the parent is purged, and
+-- restore needs to know the task had a parent that no longer exists.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `deleted_at` text;
+--> statement-breakpoint
+ALTER TABLE `tasks_tasks` ADD COLUMN `deleted_parent_id` text;
+--> statement-breakpoint
+CREATE INDEX `idx_tasks_tasks_deleted_at` ON `tasks_tasks` (`deleted_at`);
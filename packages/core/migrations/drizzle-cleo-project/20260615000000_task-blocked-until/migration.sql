@@ -0,0 +1,12 @@
+-- Blocked tasks — add nullable `blocked_until` and `blocked_prior_status` to
+-- `tasks_tasks` (consolidated PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `cleo tasks block <id> --reason ... [--until <date>]` records the reason in
+-- the existing `blocked_by` column, the hold's expiry in `blocked_until`, and
+-- the status it replaced in `blocked_prior_status`, so `cleo tasks unblock`
+-- can put the task back exactly as it was. `orchestrate ready` offers a
+-- blocked task again once `blocked_until` has passed.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `blocked_until` text;
+--> statement-breakpoint
+ALTER TABLE `tasks_tasks` ADD COLUMN `blocked_prior_status` text;
@@ -0,0 +1,8 @@
+-- Task note history — add `note_history_json` to `tasks_tasks` (consolidated
+-- PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `cleo tasks note <id> --text ... [--author ...]` appends `{ at, author, text }`
+-- entries to this JSON array. Entries are never rewritten, so the column is an
+-- audit trail of observations rather than a mutable description.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `note_history_json` text DEFAULT '[]';
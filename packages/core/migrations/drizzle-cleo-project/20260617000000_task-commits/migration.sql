@@ -0,0 +1,8 @@
+-- Task commit links — add `commits_json` to `tasks_tasks` (consolidated
+-- PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `cleo tasks link-commit <id> --sha <sha>` and `cleo git scan` append
+-- lowercase hex SHAs to this JSON array (deduplicated), so `cleo tasks show`
+-- and `cleo tasks commits` can list the commits that implemented a task.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `commits_json` text DEFAULT '[]';
@@ -0,0 +1,8 @@
+-- Project custom fields — add `custom_json` to `tasks_tasks` (consolidated
+-- PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- Field declarations (`cleo fields add`) live in the `custom_fields` meta
+-- key; this column holds each task's values as a JSON object keyed by field
+-- name, written by `cleo update <id> --set name=value`. NULL means no values.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `custom_json` text;
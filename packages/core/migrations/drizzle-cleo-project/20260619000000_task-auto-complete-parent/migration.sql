@@ -0,0 +1,9 @@
+-- Parent auto-complete opt-in — add `auto_complete_parent` to `tasks_tasks`
+-- (consolidated PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- Set by `cleo update <id> --auto-complete-parent`. When the last open child
+-- of a flagged task completes, the task is completed with it — even when it
+-- lists its own files (unflagged parents only roll up as coordination
+-- parents). NULL means unset.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `auto_complete_parent` integer;
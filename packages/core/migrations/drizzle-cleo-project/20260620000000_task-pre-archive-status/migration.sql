@@ -0,0 +1,9 @@
+-- Archive restore — add `pre_archive_status` to `tasks_tasks`
+-- (consolidated PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- Archiving overwrites `status` with 'archived'; the status the task held at
+-- archive time is kept here so `cleo archive restore <id>` can put it back.
+-- NULL for rows archived before this column existed (restore then falls back
+-- to the lifecycle timestamps, as trash restore does).
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `pre_archive_status` text;
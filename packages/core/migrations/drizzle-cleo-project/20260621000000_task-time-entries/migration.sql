@@ -0,0 +1,8 @@
+-- Task time tracking — add `time_entries_json` to `tasks_tasks` (consolidated
+-- PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `cleo tasks start <id>` appends an open `{ start }` interval to this JSON
+-- array and `cleo tasks stop <id>` closes it with `stop`; `time_spent` is the
+-- summed duration, surfaced by `cleo tasks time` and `cleo tasks show`.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `time_entries_json` text DEFAULT '[]';
@@ -0,0 +1,8 @@
+-- Completion requirements — add `completion_requirements_json` to `tasks_tasks`
+-- (consolidated PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- A JSON array of what `cleo complete` demands before a task may close
+-- (`commit`, `note`, `estimate`, `assignee`). NULL inherits the nearest saga's
+-- list; `[]` explicitly requires nothing, overriding the saga.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `completion_requirements_json` text;
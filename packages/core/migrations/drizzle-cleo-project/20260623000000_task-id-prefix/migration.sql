@@ -0,0 +1,11 @@
+-- Saga ID prefixes — add `id_prefix` to `tasks_tasks` (consolidated PROJECT
+-- cleo.db, drizzle-cleo-project scope).
+--
+-- `cleo saga create --prefix AUTH` stores `AUTH` on the saga; tasks created
+-- under it are numbered `AUTH-1`, `AUTH-2`, … from a per-prefix counter in
+-- `schema_meta` (`task_id_sequence:AUTH`). NULL keeps global `T<n>` IDs.
+-- The unique index keeps two sagas from sharing a prefix (NULLs are distinct).
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `id_prefix` text;
+--> statement-breakpoint
+CREATE UNIQUE INDEX `idx_tasks_tasks_id_prefix` ON `tasks_tasks` (`id_prefix`);
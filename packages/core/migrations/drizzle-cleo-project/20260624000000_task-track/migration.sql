@@ -0,0 +1,9 @@
+-- Orchestration tracks — add nullable `track` to `tasks_tasks` (consolidated
+-- PROJECT cleo.db, drizzle-cleo-project scope).
+--
+-- `track` is set by `cleo add --track` / `cleo update --track` (and on a saga
+-- by `cleo saga create --track`). `cleo orchestrate ready --track-balance`
+-- resolves a task's track from its own value, then its saga's, then the
+-- `trackRules` label globs in config. NULL keeps every existing row untracked.
+
+ALTER TABLE `tasks_tasks` ADD COLUMN `track` text;
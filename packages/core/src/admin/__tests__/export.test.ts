@@ -0,0 +1,81 @@
+/**
+ * Tests for standard task export: RFC 4180 CSV, `--fields` columns, and
+ * `--saga` scoping behind `cleo export csv`.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { exportTasks, parseExportFields } from '../export.js';
+
+describe('exportTasks csv', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga', type: 'saga', status: 'active' },
+      { id: 'T002', title: 'Epic', type: 'epic', parentId: 'T001' },
+      {
+        id: 'T003',
+        title: 'Say "hi", then\nleave',
+        parentId: 'T002',
+        priority: 'high',
+        labels: ['ui', 'copy'],
+        assignee: 'sam',
+        due: '2026-11-01',
+        estimate: 3,
+        notes: ['first', 'a, b'],
+      },
+      { id: 'T010', title: 'Elsewhere' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('writes the requested fields with RFC 4180 quoting', async () => {
+    const result = await exportTasks(env.tempDir, {
+      format: 'csv',
+      saga: 'T001',
+      fields: 'id,title,labels,assignee,due,estimate,notes',
+    });
+
+    expect(result.taskCount).toBe(2);
+    expect(result.content).toBe(
+      [
+        'id,title,labels,assignee,due,estimate,notes',
+        'T002,Epic,,,,,',
+        'T003,"Say ""hi"", then\nleave",ui;copy,sam,2026-11-01,3,"first\na, b"',
+        '',
+      ].join('\r\n'),
+    );
+  });
+
+  it('uses the default field set when none is given', async () => {
+    const result = await exportTasks(env.tempDir, { format: 'csv' });
+    const header = result.content?.split('\r\n')[0];
+    expect(header).toBe(
+      'id,title,status,priority,type,parentId,phase,depends,createdAt,labels,assignee,due,estimate',
+    );
+    expect(result.taskCount).toBe(4);
+  });
+
+  it('rejects unknown fields and non-saga scopes', async () => {
+    expect(() => parseExportFields('id,owner,title,eta')).toThrow(/owner, eta/);
+    await expect(
+      exportTasks(env.tempDir, { format: 'csv', fields: 'id,owner' }),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(exportTasks(env.tempDir, { format: 'csv', saga: 'T002' })).rejects.toMatchObject(
+      { code: ExitCode.VALIDATION_ERROR },
+    );
+    await expect(exportTasks(env.tempDir, { format: 'csv', saga: 'T999' })).rejects.toMatchObject(
+      { code: ExitCode.NOT_FOUND },
+    );
+  });
+});
@@ -8,28 +8,115 @@
 
 import { writeFile } from 'node:fs/promises';
 import type { AdminExportParams, Task } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
 import { getTaskAccessor } from '../store/data-accessor.js';
 
 export type ExportFormat = 'json' | 'csv' | 'tsv' | 'markdown';
 
-function taskToCsvRow(task: Task, delimiter: string): string {
-  const escapeField = (val: string) => {
-    if (val.includes(delimiter) || val.includes('"') || val.includes('\n')) {
-      return '"' + val.replace(/"/g, '""') + '"';
-    }
-    return val;
-  };
-  return [
-    escapeField(task.id),
-    escapeField(task.title),
-    escapeField(task.status),
-    escapeField(task.priority),
-    escapeField(task.type ?? 'task'),
-    escapeField(task.parentId ?? ''),
-    escapeField(task.phase ?? ''),
-    escapeField((task.depends ?? []).join(',')),
-    escapeField(task.createdAt ?? ''),
-  ].join(delimiter);
+/** Cell value for each field `--fields` accepts. Lists join with `;`, except `depends`. */
+const EXPORT_FIELD_VALUES = {
+  id: (t: Task) => t.id,
+  title: (t: Task) => t.title,
+  description: (t: Task) => t.description ?? '',
+  status: (t: Task) => t.status,
+  priority: (t: Task) => t.priority,
+  type: (t: Task) => t.type ?? 'task',
+  parentId: (t: Task) => t.parentId ?? '',
+  phase: (t: Task) => t.phase ?? '',
+  depends: (t: Task) => (t.depends ?? []).join(','),
+  labels: (t: Task) => (t.labels ?? []).join(';'),
+  assignee: (t: Task) => t.assignee ?? '',
+  due: (t: Task) => t.due ?? '',
+  estimate: (t: Task) => (t.estimate == null ? '' : String(t.estimate)),
+  size: (t: Task) => t.size ?? '',
+  notes: (t: Task) => (t.notes ?? []).join('\n'),
+  createdAt: (t: Task) => t.createdAt ?? '',
+  updatedAt: (t: Task) => t.updatedAt ?? '',
+  completedAt: (t: Task) => t.completedAt ?? '',
+} satisfies Record<string, (t: Task) => string>;
+
+/** A column name accepted by `--fields`. */
+export type ExportField = keyof typeof EXPORT_FIELD_VALUES;
+
+/** Every column `--fields` accepts. */
+export const EXPORT_FIELDS = Object.keys(EXPORT_FIELD_VALUES) as ExportField[];
+
+/** Columns written when `--fields` is omitted. */
+export const DEFAULT_EXPORT_FIELDS: readonly ExportField[] = [
+  'id',
+  'title',
+  'status',
+  'priority',
+  'type',
+  'parentId',
+  'phase',
+  'depends',
+  'createdAt',
+  'labels',
+  'assignee',
+  'due',
+  'estimate',
+];
+
+/**
+ * Parse a comma-separated `--fields` list.
+ *
+ * @throws CleoError `VALIDATION_ERROR` naming any unknown field.
+ */
+export function parseExportFields(fields: string | undefined): ExportField[] {
+  if (!fields?.trim()) return [...DEFAULT_EXPORT_FIELDS];
+  const names = fields
+    .split(',')
+    .map((f) => f.trim())
+    .filter(Boolean);
+  const unknown = names.filter((f) => !(f in EXPORT_FIELD_VALUES));
+  if (unknown.length > 0) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Unknown export field: ${unknown.join(', ')}`, {
+      fix: `Valid fields: ${EXPORT_FIELDS.join(', ')}`,
+      details: { field: 'fields', actual: unknown, expected: EXPORT_FIELDS },
+    });
+  }
+  return names as ExportField[];
+}
+
+/** Quote a cell when it holds the delimiter, a quote, or a line break (RFC 4180). */
+function escapeCell(val: string, delimiter: string): string {
+  if (val.includes(delimiter) || /["\r\n]/.test(val)) {
+    return `"${val.replace(/"/g, '""')}"`;
+  }
+  return val;
+}
+
+/**
+ * Render tasks as delimited text with a header row.
+ *
+ * @param delimiter - `,` for CSV, `\t` for TSV.
+ * @param lineEnd - Record separator; RFC 4180 CSV uses CRLF.
+ */
+export function tasksToDelimited(
+  tasks: readonly Task[],
+  fields: readonly ExportField[],
+  delimiter: string,
+  lineEnd = '\n',
+): string {
+  const header = fields.map((f) => escapeCell(f, delimiter)).join(delimiter);
+  const rows = tasks.map((t) =>
+    fields.map((f) => escapeCell(EXPORT_FIELD_VALUES[f](t), delimiter)).join(delimiter),
+  );
+  return [header, ...rows].join(lineEnd) + lineEnd;
+}
+
+/** Whether `task` is `sagaId` or sits below it. */
+function isInSaga(task: Task, sagaId: string, byId: ReadonlyMap<string, Task>): boolean {
+  const seen = new Set<string>();
+  let current: Task | undefined = task;
+  while (current && !seen.has(current.id)) {
+    if (current.id === sagaId) return true;
+    seen.add(current.id);
+    current = current.parentId ? byId.get(current.parentId) : undefined;
+  }
+  return false;
 }
 
 function taskToMarkdown(task: Task): string {
@@ -48,6 +135,13 @@ export interface ExportResult {
 /**
  * Export tasks to a portable format.
  * Returns the formatted content and metadata.
+ *
+ * CSV is RFC 4180: a header row, CRLF record separators, and quoting for
+ * cells that hold commas, quotes, or line breaks. `params.saga` keeps the
+ * tasks below that saga; `params.fields` picks the CSV/TSV columns.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for an unknown field or non-saga `saga`;
+ *   `NOT_FOUND` when `saga` does not exist.
  */
 export async function exportTasks(
   projectRoot: string,
@@ -59,6 +153,23 @@ export async function exportTasks(
 
   let tasks = queryResult.tasks;
 
+  if (params.saga) {
+    const saga = tasks.find((t) => t.id === params.saga);
+    if (!saga) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${params.saga}`, {
+        fix: 'cleo saga list',
+      });
+    }
+    if (saga.type !== 'saga') {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `${saga.id} is a ${saga.type ?? 'task'}, not a saga`,
+        { details: { field: 'saga', expected: 'saga', actual: saga.type } },
+      );
+    }
+    const byId = new Map(tasks.map((t) => [t.id, t]));
+    tasks = tasks.filter((t) => t.id !== saga.id && isInSaga(t, saga.id, byId));
+  }
   if (params.status) {
     const statuses = params.status.split(',').map((s) => s.trim());
     tasks = tasks.filter((t) => statuses.includes(t.status));
@@ -71,6 +182,7 @@ export async function exportTasks(
   }
 
   const format: ExportFormat = params.format ?? 'json';
+  const fields = format === 'csv' || format === 'tsv' ? parseExportFields(params.fields) : [];
   let content: string;
 
   switch (format) {
@@ -88,15 +200,11 @@ export async function exportTasks(
       break;
     }
     case 'csv': {
-      const header = 'id,title,status,priority,type,parentId,phase,depends,createdAt';
-      const rows = tasks.map((t) => taskToCsvRow(t, ','));
-      content = [header, ...rows].join('\n');
+      content = tasksToDelimited(tasks, fields, ',', '\r\n');
       break;
     }
     case 'tsv': {
-      const header = 'id\ttitle\tstatus\tpriority\ttype\tparentId\tphase\tdepends\tcreatedAt';
-      const rows = tasks.map((t) => taskToCsvRow(t, '\t'));
-      content = [header, ...rows].join('\n');
+      content = tasksToDelimited(tasks, fields, '\t');
       break;
     }
     case 'markdown': {
@@ -74,4 +74,5 @@ export declare const adminCoreOps: {
   readonly 'context.inject': AdminCoreOperation<'admin.context.inject'>;
   readonly 'map.mutate': AdminCoreOperation<'admin.map.mutate'>;
   readonly 'install.global': AdminCoreOperation<'admin.install.global'>;
+  readonly 'webhook.test': AdminCoreOperation<'admin.webhook.test'>;
 };
@@ -58,6 +58,8 @@ const MVI_FIELDS: Record<Exclude<ProjectionKind, 'unknown'>, ReadonlySet<string>
     'type',
     'kind',
     'relationCounts',
+    'blockedBy',
+    'blockedUntil',
   ]),
   epic: new Set(['id', 'title', 'status', 'priority', 'parentId', 'type', 'kind', 'childRollup']),
   saga: new Set(['id', 'title', 'status', 'priority', 'type', 'label', 'childRollup']),
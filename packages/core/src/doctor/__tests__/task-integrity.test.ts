@@ -0,0 +1,128 @@
+/**
+ * Tests for the task integrity audit behind `cleo doctor --integrity`.
+ */
+
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import type { DataAccessor, TransactionAccessor } from '../../store/data-accessor.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { auditTaskIntegrity, checkTaskIntegrity, UNASSIGNED_CONTAINER } from '../task-integrity.js';
+
+describe('checkTaskIntegrity', () => {
+  it('reports every kind, in record order', () => {
+    const issues = checkTaskIntegrity(
+      [
+        { id: 'T001', status: 'done' },
+        { id: 'T002', status: 'active', parentId: 'T001', labels: ['api'] },
+        { id: 'T003', status: 'pending', parentId: 'T009', depends: ['T002', 'T008'] },
+        { id: 'T002', status: 'pending' },
+      ],
+      [{ id: 'T008', status: 'archived', labels: ['api', 'spike'] }],
+    );
+
+    expect(issues.map((i) => [i.kind, i.taskId, i.ref])).toEqual([
+      ['done-with-open-children', 'T001', 'T002'],
+      ['duplicate-id', 'T002', 'T002'],
+      ['missing-parent', 'T003', 'T009'],
+      ['dangling-dependency', 'T003', 'T008'],
+      ['unused-label', null, 'spike'],
+    ]);
+    expect(issues.find((i) => i.ref === 'T008')?.message).toContain('is trashed');
+    expect(issues.find((i) => i.ref === 'T009')?.message).toContain('does not exist');
+  });
+});
+
+describe('auditTaskIntegrity', () => {
+  let env: TestDbEnv;
+
+  /** Soft-delete a task without the cleanup `cleo delete` does. */
+  async function trash(id: string): Promise<void> {
+    const now = new Date().toISOString();
+    await env.accessor.archiveSingleTask(id, { archivedAt: now, archiveReason: 'cancelled' });
+    await env.accessor.updateTaskFields(id, { deletedAt: now });
+  }
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga', type: 'saga' },
+      { id: 'T002', title: 'Kept epic', type: 'epic', parentId: 'T001' },
+      { id: 'T003', title: 'Dropped epic', type: 'epic', parentId: 'T001' },
+      { id: 'T004', title: 'Stranded', type: 'task', parentId: 'T003' },
+      { id: 'T005', title: 'Waiting', type: 'task', parentId: 'T002', depends: ['T006'] },
+      { id: 'T006', title: 'Spike', type: 'task', parentId: 'T002', labels: ['spike'] },
+    ]);
+    await trash('T003');
+    await trash('T006');
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('reports orphans without writing', async () => {
+    const result = await auditTaskIntegrity(env.tempDir, {}, env.accessor);
+
+    expect(result.issues.map((i) => [i.kind, i.taskId, i.ref])).toEqual([
+      ['missing-parent', 'T004', 'T003'],
+      ['dangling-dependency', 'T005', 'T006'],
+      ['unused-label', null, 'spike'],
+    ]);
+    expect(result.reparented).toEqual([]);
+    expect((await env.accessor.loadSingleTask('T005'))?.depends).toEqual(['T006']);
+  });
+
+  it('previews repairs on a dry run', async () => {
+    const result = await auditTaskIntegrity(env.tempDir, { fix: true, dryRun: true }, env.accessor);
+
+    expect(result.removedEdges).toEqual([{ taskId: 'T005', dependsOn: 'T006' }]);
+    expect(result.reparented).toEqual([{ taskId: 'T004', from: 'T003', to: UNASSIGNED_CONTAINER }]);
+    expect((await env.accessor.loadSingleTask('T004'))?.parentId).toBe('T003');
+  });
+
+  it('removes dangling edges and files orphans under the __unassigned saga', async () => {
+    const result = await auditTaskIntegrity(env.tempDir, { fix: true }, env.accessor);
+
+    const sagaId = result.unassignedSagaId;
+    expect(sagaId).toBeTruthy();
+    const epicId = result.reparented[0]?.to ?? '';
+    const epic = await env.accessor.loadSingleTask(epicId);
+    expect(epic).toMatchObject({ type: 'epic', title: UNASSIGNED_CONTAINER, parentId: sagaId });
+    expect(await env.accessor.loadSingleTask(sagaId ?? '')).toMatchObject({ type: 'saga' });
+    expect((await env.accessor.loadSingleTask('T004'))?.parentId).toBe(epicId);
+    expect((await env.accessor.loadSingleTask('T005'))?.depends ?? []).toEqual([]);
+
+    // Only the report-only label is left.
+    const again = await auditTaskIntegrity(env.tempDir, { fix: true }, env.accessor);
+    expect(again.issues.map((i) => i.kind)).toEqual(['unused-label']);
+    expect(again.unassignedSagaId).toBeNull();
+  });
+
+  it('rolls the containers back with a failed repair', async () => {
+    const failing: DataAccessor = {
+      ...env.accessor,
+      async transaction<T>(fn: (tx: TransactionAccessor) => Promise<T>): Promise<T> {
+        return env.accessor.transaction(async (tx) =>
+          fn({
+            ...tx,
+            async updateTaskFields() {
+              throw new Error('disk full');
+            },
+          }),
+        );
+      },
+    };
+
+    await expect(auditTaskIntegrity(env.tempDir, { fix: true }, failing)).rejects.toThrow(
+      'disk full',
+    );
+
+    const { tasks } = await env.accessor.queryTasks({});
+    expect(tasks.filter((t) => t.title === UNASSIGNED_CONTAINER)).toEqual([]);
+    expect((await env.accessor.loadSingleTask('T004'))?.parentId).toBe('T003');
+    expect((await env.accessor.loadSingleTask('T005'))?.depends).toEqual(['T006']);
+  });
+});
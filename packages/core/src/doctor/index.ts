@@ -62,6 +62,12 @@ export {
   PRAGMA_VALUE_NORMALISERS,
 } from './pragma-ssot.js';
 export { auditSagaHierarchy } from './saga-audit.js';
+export type { AuditTaskIntegrityOptions, TaskIntegrityRecord } from './task-integrity.js';
+export {
+  auditTaskIntegrity,
+  checkTaskIntegrity,
+  UNASSIGNED_CONTAINER,
+} from './task-integrity.js';
 export type { PruneOptions, ScanOptions } from './worktree-orphans.js';
 export {
   auditWorktreeOrphansComprehensive,
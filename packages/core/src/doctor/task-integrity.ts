@@ -0,0 +1,354 @@
+/**
+ * Task integrity audit primitive for `cleo doctor --integrity`.
+ *
+ * Scans every task record for references that no longer resolve and for
+ * states that cannot be reached through the normal commands:
+ *
+ *   - **dangling-dependency** — a `depends` entry names a task that is gone
+ *     or sits in the trash.
+ *   - **missing-parent** — a task's parent Saga / Epic / Task is gone or
+ *     trashed.
+ *   - **duplicate-id** — two records share an ID. The store's primary key
+ *     rules this out, so it only fires for snapshot files.
+ *   - **unused-label** — a label that no live or archived task carries any
+ *     more, kept alive only by trashed tasks.
+ *   - **done-with-open-children** — a task is `done` while one of its
+ *     children is still open.
+ *
+ * With `fix`, dangling edges are removed and orphans are reparented into an
+ * `__unassigned` Saga, created on first use. The parent type matrix only lets
+ * Epics sit directly under a Saga, so orphaned Tasks and Subtasks go under an
+ * `__unassigned` Epic inside it. The other kinds need a human decision and
+ * are reported only.
+ */
+
+import type {
+  Task,
+  TaskIntegrityIssue,
+  TaskIntegrityIssueKind,
+  TaskIntegrityResult,
+} from '@cleocode/contracts';
+import { ExitCode, TASK_STATUSES } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import { readSnapshot } from '../snapshot/index.js';
+import {
+  type DataAccessor,
+  getTaskAccessor,
+  type TransactionAccessor,
+} from '../store/data-accessor.js';
+import { allocateTaskIdUnder } from '../tasks/id-prefix.js';
+
+/** Title and label of the Saga (and its Epic) that collects reparented orphans. */
+export const UNASSIGNED_CONTAINER = '__unassigned';
+
+/** Statuses a child can be in without keeping its `done` parent open. */
+const CLOSED_STATUSES = new Set(['done', 'cancelled', 'archived']);
+
+/** Order issues are listed in for one task. */
+const KIND_ORDER: TaskIntegrityIssueKind[] = [
+  'duplicate-id',
+  'missing-parent',
+  'dangling-dependency',
+  'done-with-open-children',
+  'unused-label',
+];
+
+/** The fields of a task record the audit reads. */
+export interface TaskIntegrityRecord {
+  id: string;
+  type?: string | null;
+  status: string;
+  parentId?: string | null;
+  depends?: string[];
+  labels?: string[];
+}
+
+/** Options for {@link auditTaskIntegrity}. */
+export interface AuditTaskIntegrityOptions {
+  /** Remove dangling edges and reparent orphans. */
+  fix?: boolean;
+  /** With `fix`, report the repairs without writing them. */
+  dryRun?: boolean;
+  /** Scan this snapshot file (as written by `cleo snapshot export`) instead of the store. */
+  file?: string;
+}
+
+/**
+ * Check task records for broken references and impossible states.
+ *
+ * @param records - Live and archived tasks (or every task in a snapshot).
+ * @param trashed - Trashed tasks; references to them count as dangling.
+ * @returns Issues in record order, then by kind.
+ */
+export function checkTaskIntegrity(
+  records: readonly TaskIntegrityRecord[],
+  trashed: readonly TaskIntegrityRecord[] = [],
+): TaskIntegrityIssue[] {
+  const issues: TaskIntegrityIssue[] = [];
+  const ids = new Set(records.map((t) => t.id));
+  const trashedIds = new Set(trashed.map((t) => t.id));
+  const missing = (id: string): string => (trashedIds.has(id) ? 'is trashed' : 'does not exist');
+
+  const seen = new Set<string>();
+  const reported = new Set<string>();
+  const children = new Map<string, TaskIntegrityRecord[]>();
+  for (const task of records) {
+    if (seen.has(task.id) && !reported.has(task.id)) {
+      reported.add(task.id);
+      issues.push({
+        kind: 'duplicate-id',
+        taskId: task.id,
+        ref: task.id,
+        message: `Task ID ${task.id} appears more than once`,
+        fixable: false,
+      });
+    }
+    seen.add(task.id);
+    if (task.parentId) {
+      const siblings = children.get(task.parentId) ?? [];
+      siblings.push(task);
+      children.set(task.parentId, siblings);
+    }
+  }
+
+  for (const task of records) {
+    if (task.parentId && !ids.has(task.parentId)) {
+      issues.push({
+        kind: 'missing-parent',
+        taskId: task.id,
+        ref: task.parentId,
+        message: `${task.id}: parent ${task.parentId} ${missing(task.parentId)}`,
+        fixable: true,
+      });
+    }
+    for (const dep of task.depends ?? []) {
+      if (ids.has(dep)) continue;
+      issues.push({
+        kind: 'dangling-dependency',
+        taskId: task.id,
+        ref: dep,
+        message: `${task.id}: depends on ${dep}, which ${missing(dep)}`,
+        fixable: true,
+      });
+    }
+    if (task.status === 'done') {
+      for (const child of children.get(task.id) ?? []) {
+        if (CLOSED_STATUSES.has(child.status)) continue;
+        issues.push({
+          kind: 'done-with-open-children',
+          taskId: task.id,
+          ref: child.id,
+          message: `${task.id} is done but its child ${child.id} is ${child.status}`,
+          fixable: false,
+        });
+      }
+    }
+  }
+  const order = new Map<string, number>();
+  records.forEach((t, i) => {
+    if (!order.has(t.id)) order.set(t.id, i);
+  });
+  issues.sort(
+    (a, b) =>
+      (order.get(a.taskId ?? '') ?? 0) - (order.get(b.taskId ?? '') ?? 0) ||
+      KIND_ORDER.indexOf(a.kind) - KIND_ORDER.indexOf(b.kind),
+  );
+
+  const liveLabels = new Set(records.flatMap((t) => t.labels ?? []));
+  const stale = new Set(trashed.flatMap((t) => t.labels ?? []).filter((l) => !liveLabels.has(l)));
+  for (const label of [...stale].sort()) {
+    issues.push({
+      kind: 'unused-label',
+      taskId: null,
+      ref: label,
+      message: `Label '${label}' is only carried by trashed tasks`,
+      fixable: false,
+    });
+  }
+  return issues;
+}
+
+/**
+ * Find or create the `__unassigned` Saga, and its Epic when Tasks or
+ * Subtasks need a home. Rows are written directly through `tx`, like an
+ * import: the containers hold repaired data, so the add-time ceremony does
+ * not apply, and they roll back with the rest of the repair. With no `tx`
+ * (a dry run), a container that does not exist yet comes back as null.
+ */
+async function ensureUnassignedContainers(
+  acc: DataAccessor,
+  tx: TransactionAccessor | null,
+  tasks: readonly Task[],
+  needEpic: boolean,
+  cwd: string,
+): Promise<{ sagaId: string | null; epicId: string | null }> {
+  const now = new Date().toISOString();
+  const create = async (
+    type: 'saga' | 'epic',
+    parentId: string | null,
+  ): Promise<string | null> => {
+    if (!tx) return null;
+    const parent = parentId ? await acc.loadSingleTask(parentId) : null;
+    const id = await allocateTaskIdUnder(parent, acc, cwd);
+    await tx.upsertSingleTask({
+      id,
+      title: UNASSIGNED_CONTAINER,
+      description: 'Holds tasks whose parent was missing, reparented by cleo doctor --integrity',
+      status: 'pending',
+      priority: 'low',
+      type,
+      parentId,
+      labels: [UNASSIGNED_CONTAINER],
+      createdAt: now,
+      updatedAt: now,
+    });
+    return id;
+  };
+
+  const isContainer = (t: Task, type: string): boolean =>
+    t.type === type && t.title === UNASSIGNED_CONTAINER && !CLOSED_STATUSES.has(t.status);
+  const sagaId = tasks.find((t) => isContainer(t, 'saga'))?.id ?? (await create('saga', null));
+  if (!needEpic) return { sagaId, epicId: null };
+  const epicId =
+    tasks.find((t) => isContainer(t, 'epic') && t.parentId === sagaId)?.id ??
+    (await create('epic', sagaId));
+  return { sagaId, epicId };
+}
+
+/** Scan a snapshot file; repairs are not available for files. */
+async function auditSnapshotFile(file: string, fix: boolean): Promise<TaskIntegrityResult> {
+  if (fix) {
+    throw new CleoError(ExitCode.INVALID_INPUT, '--fix only repairs the task store', {
+      fix: `Import the snapshot first (cleo snapshot import ${file}), then run --fix`,
+      details: { field: 'fix' },
+    });
+  }
+  let tasks: TaskIntegrityRecord[];
+  try {
+    tasks = (await readSnapshot(file)).tasks;
+  } catch (err) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `Cannot read snapshot ${file}: ${err instanceof Error ? err.message : String(err)}`,
+      { fix: 'Pass a snapshot file written by: cleo snapshot export' },
+    );
+  }
+  const issues = checkTaskIntegrity(tasks);
+  return {
+    source: file,
+    taskCount: tasks.length,
+    issues,
+    count: issues.length,
+    fix: false,
+    dryRun: false,
+    removedEdges: [],
+    reparented: [],
+    unassignedSagaId: null,
+  };
+}
+
+/**
+ * Audit the task store, or a snapshot file, for orphaned references and
+ * impossible states, optionally repairing what can be repaired mechanically.
+ *
+ * Without `fix` this is read-only. `count` reflects the issues found before
+ * any repair, so a `--fix` run still reports what it changed.
+ *
+ * @param projectRoot - Absolute path to the project root.
+ * @param options - `fix` / `dryRun` to repair, or `file` to scan a snapshot.
+ * @throws CleoError `INVALID_INPUT` when `fix` is combined with `file`;
+ *   `VALIDATION_ERROR` when the file is not a snapshot.
+ *
+ * @example
+ * ```typescript
+ * const audit = await auditTaskIntegrity(projectRoot, { fix: true });
+ * for (const edge of audit.removedEdges) console.log(`${edge.taskId} -x-> ${edge.dependsOn}`);
+ * ```
+ */
+export async function auditTaskIntegrity(
+  projectRoot: string,
+  options: AuditTaskIntegrityOptions = {},
+  accessor?: DataAccessor,
+): Promise<TaskIntegrityResult> {
+  const fix = options.fix === true;
+  if (options.file) return auditSnapshotFile(options.file, fix);
+
+  const dryRun = fix && options.dryRun === true;
+  const acc = accessor ?? (await getTaskAccessor(projectRoot));
+  try {
+    const { tasks } = await acc.queryTasks({ status: [...TASK_STATUSES] });
+    const { tasks: trashed } = await acc.queryTasks({
+      status: [...TASK_STATUSES],
+      trashed: true,
+    });
+    const issues = checkTaskIntegrity(tasks, trashed);
+    const result: TaskIntegrityResult = {
+      source: 'store',
+      taskCount: tasks.length,
+      issues,
+      count: issues.length,
+      fix,
+      dryRun,
+      removedEdges: [],
+      reparented: [],
+      unassignedSagaId: null,
+    };
+    if (!fix) return result;
+
+    const dangling = new Map<string, Set<string>>();
+    const orphans: Task[] = [];
+    const byId = new Map(tasks.map((t) => [t.id, t]));
+    for (const issue of issues) {
+      if (issue.kind === 'dangling-dependency' && issue.taskId) {
+        const refs = dangling.get(issue.taskId) ?? new Set<string>();
+        refs.add(issue.ref);
+        dangling.set(issue.taskId, refs);
+        result.removedEdges.push({ taskId: issue.taskId, dependsOn: issue.ref });
+      } else if (issue.kind === 'missing-parent' && issue.taskId) {
+        const task = byId.get(issue.taskId);
+        if (task) orphans.push(task);
+      }
+    }
+
+    // Sagas are roots, so an orphaned Saga only loses its parent.
+    const needEpic = orphans.some((t) => t.type !== 'saga' && t.type !== 'epic');
+    const planMoves = async (tx: TransactionAccessor | null): Promise<void> => {
+      const containers = orphans.some((t) => t.type !== 'saga')
+        ? await ensureUnassignedContainers(acc, tx, tasks, needEpic, projectRoot)
+        : { sagaId: null, epicId: null };
+      result.unassignedSagaId = containers.sagaId;
+      // A dry run names a container it has not created by its title.
+      const targetOf = (task: Task): string | null => {
+        if (task.type === 'saga') return null;
+        const to = task.type === 'epic' ? containers.sagaId : containers.epicId;
+        return to ?? UNASSIGNED_CONTAINER;
+      };
+      result.reparented = orphans.map((task) => ({
+        taskId: task.id,
+        from: task.parentId ?? '',
+        to: targetOf(task),
+      }));
+    };
+    if (dryRun) {
+      await planMoves(null);
+      return result;
+    }
+
+    const now = new Date().toISOString();
+    await acc.transaction(async (tx) => {
+      await planMoves(tx);
+      for (const [taskId, refs] of dangling) {
+        const task = byId.get(taskId);
+        if (!task) continue;
+        const depends = (task.depends ?? []).filter((d) => !refs.has(d));
+        await tx.upsertSingleTask({ ...task, depends, updatedAt: now });
+      }
+      for (const move of result.reparented) {
+        await tx.updateTaskFields(move.taskId, { parentId: move.to, updatedAt: now });
+      }
+    });
+    return result;
+  } finally {
+    if (!accessor) await acc.close();
+  }
+}
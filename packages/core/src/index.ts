@@ -693,7 +693,7 @@ export {
   validateDepGraph,
 } from './tasks/dep-graph-validator.js';
 export { findTasks } from './tasks/find.js';
-export { normalizeTaskId } from './tasks/id-generator.js';
+export { isPrefixedTaskId, normalizeTaskId } from './tasks/id-generator.js';
 export {
   type InferAddParamsInput,
   type InferAddParamsResult,
@@ -843,6 +843,8 @@ export {
   classifyReadiness,
   classifyTask,
 } from './orchestration/index.js';
+// In-memory task index — resident servers (MCP) keep it between calls.
+export { enableTaskIndexCache } from './tasks/task-index.js';
 // T11918 (M5 / E-API-STANDARD-FOUNDATION T11769) — zod→OpenAPI 3.1 bridge:
 // projects the OPERATIONS registry into an OpenAPI 3.1 document (POST
 // /v1/<domain>/<operation>) for `cleo gateway openapi` + the generated SDK client.
@@ -894,6 +894,7 @@ export { orchestrateHandoff } from './orchestrate/handoff-ops.js';
 export {
   orchestrateBootstrap,
   orchestrateCheck,
+  orchestrateClaim,
   orchestrateCriticalPath,
   orchestrateParallel,
   orchestrateParallelEnd,
@@ -908,6 +909,7 @@ export { PIVOT_AUDIT_FILE, pivotTask } from './orchestrate/pivot.js';
 // Orchestrate plan (T1570 Wave 2 — migrated from orchestrate-engine.ts)
 export type {
   OrchestratePlanInput,
+  OrchestrateSequenceInput,
   PlanWarning,
   PlanWave,
   PlanWorkerEntry,
@@ -916,6 +918,7 @@ export {
   numericToAgentTier,
   openAgentRegistryDbForComposer,
   orchestratePlan,
+  orchestrateSequence,
 } from './orchestrate/plan.js';
 // Orchestrate query ops (T1570 Wave 1 — migrated from orchestrate-engine.ts)
 export {
@@ -933,6 +936,8 @@ export {
   orchestrateValidate,
   orchestrateWaves,
 } from './orchestrate/query-ops.js';
+export { balanceByTrack, createTrackResolver } from './orchestrate/track-resolver.js';
+export { orchestrateTracks } from './orchestrate/tracks.js';
 // Orchestrate spawn ops (T1570 Wave 3 — migrated from orchestrate-engine.ts)
 export type { ConduitOrchestrationEvent, SpawnPipelineStep } from './orchestrate/spawn-ops.js';
 export {
@@ -979,8 +984,14 @@ export type { SpawnTierValue, TierSelectInput } from './orchestration/tier-selec
 export { resolveEffectiveTier, selectTier } from './orchestration/tier-selector.js';
 export { getUnblockOpportunities } from './orchestration/unblock.js';
 export { validateSpawnReadiness } from './orchestration/validate-spawn.js';
-export type { EnrichedWave, EnrichedWaveTask, Wave } from './orchestration/waves.js';
-export { getEnrichedWaves } from './orchestration/waves.js';
+export type {
+  EnrichedWave,
+  EnrichedWaveTask,
+  SequencedWave,
+  SequencedWaveTask,
+  Wave,
+} from './orchestration/waves.js';
+export { computeSequencedWaves, getEnrichedWaves } from './orchestration/waves.js';
 // OTel
 export {
   clearOtelData,
@@ -1217,7 +1228,11 @@ export {
   SHARED_EVIDENCE_THRESHOLD,
 } from './security/shared-evidence-tracker.js';
 // Sequence
-export { allocateNextTaskId, repairSequence } from './sequence/index.js';
+export {
+  allocateNextTaskId,
+  allocatePrefixedTaskId,
+  repairSequence,
+} from './sequence/index.js';
 export { recordAssumption } from './sessions/assumptions.js';
 export type { SessionBriefing } from './sessions/briefing.js';
 export { computeBriefing } from './sessions/briefing.js';
@@ -1453,6 +1468,45 @@ export {
   migrateSignaldockToConduit,
   needsSignaldockToConduitMigration,
 } from './store/migrate-signaldock-to-conduit.js';
+// Project mutate hook scripts (`.cleo/hooks/pre-mutate`, `post-mutate`)
+export type {
+  MutateHookName,
+  MutateHookOptions,
+  MutateHookPayload,
+  MutateHookResult,
+} from './store/mutate-hooks.js';
+export {
+  DEFAULT_MUTATE_HOOK_TIMEOUT_MS,
+  findMutateHook,
+  getMutateHookPath,
+  MAX_MUTATE_HOOK_TIMEOUT_MS,
+  MUTATE_HOOKS,
+  runMutateHook,
+} from './store/mutate-hooks.js';
+export type { MutateLockOptions } from './store/mutate-lock.js';
+export {
+  DEFAULT_MUTATE_LOCK_TIMEOUT_MS,
+  getMutateLockPath,
+  withMutateLock,
+} from './store/mutate-lock.js';
+// Operation journal (`.cleo/journal.ndjson`, `cleo undo` / `cleo redo`)
+export type {
+  JournalEntry,
+  JournalEntryKind,
+  JournalReplayResult,
+} from './store/mutation-journal.js';
+export {
+  getJournalPath,
+  JOURNAL_FILE,
+  JOURNAL_MAX_ENTRIES,
+  readJournal,
+  recordMutation,
+  redoLastMutation,
+  taskRedo,
+  taskUndo,
+  undoLastMutation,
+} from './store/mutation-journal.js';
+export { captureTaskWrites, type TaskWriteSnapshot } from './store/task-write-capture.js';
 // T10162 (Saga T9855 · Epic T10157) — canonical DB-open chokepoint re-export
 // so dispatch + tests that need a project-scoped tasks.db handle can route
 // through `@cleocode/core/internal` without depending on the deep subpath
@@ -1627,6 +1681,20 @@ export type {
   GenericTreeResult,
 } from './tasks/generic-tree.js';
 export { buildGenericTaskTree } from './tasks/generic-tree.js';
+// Dependency-graph export — DOT / Mermaid (`cleo graph export`).
+export type {
+  DependencyGraphExport,
+  DependencyGraphExportOptions,
+  DependencyGraphFormat,
+} from './tasks/graph-export.js';
+export {
+  buildDependencyGraph,
+  exportDependencyGraph,
+  renderDependencyGraphDot,
+  renderDependencyGraphMermaid,
+  taskGraph,
+} from './tasks/graph-export.js';
+export { taskDepsTrace, traceTaskDependencies } from './tasks/dependency-trace.js';
 export { getCriticalPath } from './tasks/graph-ops.js';
 export type { TaskTreeNode } from './tasks/hierarchy.js';
 // Project-agnostic tool resolution + cache + semaphore (T1534 / ADR-061)
@@ -1895,6 +1963,13 @@ export {
   coreValidateSchema,
   coreValidateTask,
 } from './validation/validate-ops.js';
+// Task-store checksum seal — `cleo verify` (no task ID)
+export type {
+  DanglingDependency,
+  TaskChecksumReport,
+  VerifyTaskChecksumOptions,
+} from './validation/task-checksum.js';
+export { refreshTaskChecksum, verifyTaskChecksum } from './validation/task-checksum.js';
 // LOC-drop gate helpers + metrics-delta atom extensions + callsite-coverage gate (T1604 / T1023 / T1605)
 export {
   CALLSITE_COVERAGE_GATE_LABEL,
@@ -2061,12 +2136,34 @@ export { regenerateAndCompare, regenerateAndCompareAll } from './store/restore-j
 // Batch task creation with single-transaction atomicity (T9814)
 export { tasksAddBatchOp } from './tasks/add-batch.js';
 export { taskArchive } from './tasks/archive.js';
-export {
+// Archive browsing and restore (`cleo archive list` / `cleo archive restore`)
+export { taskArchiveList, taskArchiveRestore } from './tasks/archive-restore.js';
+// Transactional multi-step create/update/complete (`tasks.batch`)
+export { runTaskBatch, tasksBatchOp } from './tasks/batch.js';
+// Manual blocking (`tasks.block` / `tasks.unblock`)
+export { taskBlock, taskUnblock } from './tasks/block.js';
+// Saga burndown series (`tasks.burndown`)
+export { computeBurndown, taskBurndown } from './tasks/burndown.js';
+// Commit links (`tasks.link-commit` / `tasks.commits` / `tasks.commits.scan`)
+export { taskCommits, taskCommitsScan, taskLinkCommit } from './tasks/commits.js';
+export {
+  checkStrictCompletionGates,
   completeTaskStrict,
   type TaskCompleteEngineOptions,
   taskComplete,
 } from './tasks/complete.js';
-export { taskDelete } from './tasks/delete.js';
+// Project custom fields (`tasks.fields.add` / `tasks.fields.list`)
+export { taskFieldsAdd, taskFieldsList } from './tasks/custom-fields.js';
+export { taskDelete, taskPurge } from './tasks/delete.js';
+// Task due dates + the overdue query (`tasks.overdue`)
+export { findOverdueTasks, normalizeDueDate, taskOverdue } from './tasks/due.js';
+// Effort estimates + the saga/epic rollup (`tasks.estimate.rollup`)
+export {
+  collectEstimatedMembers,
+  normalizeEstimate,
+  rollupEstimates,
+  taskEstimateRollup,
+} from './tasks/estimate.js';
 // Engine-layer converters and types (T1568 / ADR-057 / ADR-058)
 export {
   type IvtrHistoryEntry,
@@ -2077,8 +2174,16 @@ export {
 } from './tasks/engine-converters.js';
 export { taskContext } from './tasks/engine-wrap-ops.js';
 export { taskFind } from './tasks/find.js';
-export { taskLabelList, taskLabelShow } from './tasks/labels.js';
+export { taskLabelList, taskLabelMerge, taskLabelRename, taskLabelShow } from './tasks/labels.js';
 export { taskList } from './tasks/list.js';
+// Markdown checklist import (`tasks.import.markdown`)
+export { taskImportMarkdown } from './tasks/markdown-import.js';
+// Duplicate folding (`tasks.merge`)
+export { coreTaskMerge, taskMerge } from './tasks/merge.js';
+// Cross-saga/epic task relocation (`tasks.move`)
+export { coreTaskMove, taskMove } from './tasks/move.js';
+// Authored note history (`tasks.note`)
+export { taskNote } from './tasks/note.js';
 export { taskPlan } from './tasks/plan.js';
 // Complex mutations + strict completion (T1568 / ADR-057 / ADR-058) — Wave 4
 export { addTaskWithSessionScope, resolveParentFromSession } from './tasks/session-scope.js';
@@ -2090,8 +2195,21 @@ export {
   taskShowOperation,
   taskShowWithHistory,
 } from './tasks/show.js';
+export { normalizeRecurrence } from './tasks/recurrence.js';
+// Contiguous ID compaction (`tasks.renumber`)
+export { taskRenumber } from './tasks/renumber.js';
+// Bulk status transition by filter (`tasks.set-status`)
+export { taskSetStatus } from './tasks/set-status.js';
+export { compareByPriority, TASK_SORT_KEYS, type TaskSortKey } from './tasks/sort.js';
+// Task breakdown into children (`tasks.split`)
+export { taskSplit } from './tasks/split.js';
+// Stale detection (`tasks.stale` / `tasks.stale.reset`)
+export { taskStale, taskStaleReset } from './tasks/staleness.js';
 // Sync sub-domain (T1568 / ADR-057 / ADR-058) — Wave 3
 export { taskSyncLinks, taskSyncLinksRemove, taskSyncReconcile } from './tasks/sync-ops.js';
+// Task lifecycle event bus
+export type { TaskEvent, TaskEventListener, TaskEventName } from './tasks/task-events.js';
+export { onTaskEvent, publishTaskEvent, TASK_EVENT_NAMES } from './tasks/task-events.js';
 // Tasks (additional — stats)
 export {
   coreTaskStats,
@@ -2133,7 +2251,28 @@ export {
   taskUnarchive,
   taskUnclaim,
 } from './tasks/task-ops.js';
+// Structural lint of task data (`cleo lint`)
+export { lintTaskData, type TaskLintFinding, type TaskLintResult } from './tasks/lint.js';
+// Time tracking (`tasks.time.start` / `tasks.time.stop` / `tasks.time`)
+export { taskTime, taskTimeStart, taskTimeStop } from './tasks/time.js';
+// Trash (soft-deleted tasks)
+export { taskTrashEmpty, taskTrashList, taskTrashRestore } from './tasks/trash.js';
 export { taskUpdate } from './tasks/update.js';
+// Live task change feed (`cleo watch`)
+export { type TaskWatchEvent, watchTasks } from './tasks/watch.js';
+// Webhook emitter for task lifecycle events (`cleo webhook test`)
+export { flushWebhooks, installWebhookEmitter, webhookTest } from './tasks/webhook.js';
+// Multi-project read aggregation (`cleo workspace`)
+export {
+  addWorkspaceProject,
+  getWorkspacePath,
+  queryWorkspaceTasks,
+  readWorkspace,
+  removeWorkspaceProject,
+  type WorkspaceProject,
+  type WorkspaceTaskRecord,
+  type WorkspaceTasksResult,
+} from './tasks/workspace.js';
 
 // ---------------------------------------------------------------------------
 // Additional flat exports (required by @cleocode/cleo)
@@ -3,14 +3,12 @@
  * @task T4468
  * @epic T4454
  *
- * Note: After tasks.json→tasks.db migration, the getMigrationStatus
- * and runMigration functions for 'todo' type read from getTaskPath()
- * which now returns tasks.db. Since readJson cannot parse SQLite files,
- * todoJson status will be null when no legacy tasks.json exists.
- * The pure-function tests (detectVersion, compareSemver) remain unchanged.
+ * Note: the 'todo' and 'archive' file types are the legacy JSON files
+ * (`todo.json`, `todo-archive.json`) that the JSON→SQLite import reads.
+ * When neither exists, their status is null and runMigration throws.
  */
 
-import { mkdir, mkdtemp, rm } from 'node:fs/promises';
+import { mkdir, mkdtemp, readFile, rm, writeFile } from 'node:fs/promises';
 import { tmpdir } from 'node:os';
 import { join } from 'node:path';
 import { afterEach, beforeEach, describe, expect, it } from 'vitest';
@@ -73,9 +71,7 @@ describe('getMigrationStatus', () => {
     expect(status.todoJson).toBeNull();
   });
 
-  it('returns null for todoJson when tasks.db exists (not JSON-readable)', async () => {
-    // After tasks.json→tasks.db migration, getTaskPath returns .cleo/tasks.db
-    // which readJson cannot parse, so todoJson will be null
+  it('returns null for every file type when no legacy JSON exists', async () => {
     const status = await getMigrationStatus(testDir);
     expect(status.todoJson).toBeNull();
     expect(status.configJson).toBeNull();
@@ -89,8 +85,67 @@ describe('runMigration', () => {
   });
 
   it('throws for missing todo file', async () => {
-    // With tasks.db migration, the todo path points to tasks.db
-    // which doesn't exist yet — runMigration throws NOT_FOUND
     await expect(runMigration('todo', {}, testDir)).rejects.toThrow('File not found');
   });
+
+  describe('unversioned (v1) todo.json', () => {
+    const v1 = {
+      tasks: [
+        { id: 'T001', title: 'Epic', type: 'epic' },
+        { id: 'T002', title: 'Child', status: 'done', depends: ['T001'] },
+      ],
+    };
+
+    beforeEach(async () => {
+      await writeFile(join(cleoDir, 'todo.json'), JSON.stringify(v1));
+    });
+
+    it('is reported as needing migration', async () => {
+      const status = await getMigrationStatus(testDir);
+      expect(status.todoJson).toEqual({
+        current: '0.0.0',
+        target: '2.10.0',
+        needsMigration: true,
+      });
+    });
+
+    it('applies every step in order and backfills defaults', async () => {
+      const result = await runMigration('todo', {}, testDir);
+      expect(result).toMatchObject({ success: true, fromVersion: '0.0.0', toVersion: '2.10.0' });
+      expect(result.migrationsApplied).toHaveLength(5);
+
+      const migrated = JSON.parse(await readFile(join(cleoDir, 'todo.json'), 'utf-8'));
+      expect(migrated._meta).toMatchObject({ schemaVersion: '2.10.0', multiSessionEnabled: false });
+      expect(migrated.tasks[0]).toMatchObject({
+        status: 'pending',
+        priority: 'medium',
+        depends: [],
+        labels: [],
+        epicLifecycle: null,
+        verification: null,
+        provenance: null,
+      });
+      expect(migrated.tasks[1]).toMatchObject({ status: 'done', depends: ['T001'] });
+    });
+
+    it('backs the original up to todo.json.bak', async () => {
+      const result = await runMigration('todo', {}, testDir);
+      expect(result.backupPath).toBe(join(cleoDir, 'todo.json.bak'));
+      const backup = JSON.parse(await readFile(join(cleoDir, 'todo.json.bak'), 'utf-8'));
+      expect(backup).toEqual(v1);
+    });
+
+    it('writes nothing on a dry run', async () => {
+      const result = await runMigration('todo', { dryRun: true }, testDir);
+      expect(result.migrationsApplied).toHaveLength(5);
+      expect(result.backupPath).toBeUndefined();
+      expect(JSON.parse(await readFile(join(cleoDir, 'todo.json'), 'utf-8'))).toEqual(v1);
+    });
+
+    it('is a no-op once current', async () => {
+      await runMigration('todo', {}, testDir);
+      const again = await runMigration('todo', {}, testDir);
+      expect(again.migrationsApplied).toEqual([]);
+    });
+  });
 });
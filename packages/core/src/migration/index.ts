@@ -70,10 +70,11 @@ export {
   validateSourceFiles,
 } from './validate.js';
 
+import { copyFile } from 'node:fs/promises';
 import { join } from 'node:path';
 import { ExitCode } from '@cleocode/contracts';
 import { CleoError } from '../errors.js';
-import { getBackupDir, getConfigPath, getTaskPath, resolveCleoDir } from '../paths.js';
+import { getBackupDir, getConfigPath, resolveCleoDir } from '../paths.js';
 import { readJson, saveJson } from '../store/json.js';
 
 /** Schema version info. */
@@ -83,9 +84,12 @@ export interface SchemaVersion {
   needsMigration: boolean;
 }
 
-/** Migration function signature. */
+/** Migration function signature. Must not mutate its input. */
 export type MigrationFn = (data: unknown) => unknown;
 
+/** A legacy task record as read from `todo.json` / `todo-archive.json`. */
+export type LegacyTaskRecord = Record<string, unknown>;
+
 /** Migration definition. */
 export interface MigrationDef {
   fromVersion: string;
@@ -103,6 +107,8 @@ export interface MigrationResult {
   success: boolean;
   errors: string[];
   dryRun: boolean;
+  /** Copy of the pre-migration file (`<file>.bak`), when one was written. */
+  backupPath?: string;
 }
 
 /** Status of all data files. */
@@ -120,56 +126,72 @@ const TARGET_VERSIONS: Record<string, string> = {
   log: '1.0.0',
 };
 
-// Migration registry
+/**
+ * Lift a pure `(tasks) => tasks` transform into a {@link MigrationFn} over a
+ * whole data file. The file object and its `tasks` array are copied, never
+ * mutated, so a failed migration leaves the caller's data untouched.
+ */
+function taskMigration(
+  tasksKey: string,
+  fn: (tasks: LegacyTaskRecord[]) => LegacyTaskRecord[],
+): MigrationFn {
+  return (data: unknown) => {
+    const d = data as Record<string, unknown>;
+    const tasks = (d[tasksKey] as LegacyTaskRecord[] | undefined) ?? [];
+    return { ...d, [tasksKey]: fn(tasks.map((t) => ({ ...t }))) };
+  };
+}
+
+/**
+ * Backfill the core fields older files may omit. Shared by `todo` and
+ * `archive` files (files written before 2.6.0 carry no usable version).
+ */
+const backfillCoreTaskFields = (tasks: LegacyTaskRecord[]): LegacyTaskRecord[] =>
+  tasks.map((t) => ({
+    ...t,
+    status: t['status'] ?? 'pending',
+    priority: t['priority'] ?? 'medium',
+    depends: Array.isArray(t['depends']) ? t['depends'] : [],
+    labels: Array.isArray(t['labels']) ? t['labels'] : [],
+    createdAt: t['createdAt'] ?? t['updatedAt'] ?? new Date().toISOString(),
+  }));
+
+// Migration registry — ordered per file type; each step is a pure function.
 const MIGRATIONS: Record<string, MigrationDef[]> = {
   todo: [
+    {
+      fromVersion: '0.0.0',
+      toVersion: '2.6.0',
+      description: 'Backfill status, priority, depends, labels, and createdAt defaults',
+      migrate: taskMigration('tasks', backfillCoreTaskFields),
+    },
     {
       fromVersion: '2.6.0',
       toVersion: '2.7.0',
       description: 'Add epicLifecycle and origin fields',
-      migrate: (data: unknown) => {
-        const d = data as Record<string, unknown>;
-        const tasks = d['tasks'] as Array<Record<string, unknown>>;
-        for (const task of tasks) {
-          if (task['type'] === 'epic' && !task['epicLifecycle']) {
-            task['epicLifecycle'] = null;
-          }
-          if (!task['origin']) {
-            task['origin'] = null;
-          }
-        }
-        return d;
-      },
+      migrate: taskMigration('tasks', (tasks) =>
+        tasks.map((t) => ({
+          ...t,
+          ...(t['type'] === 'epic' && !t['epicLifecycle'] ? { epicLifecycle: null } : {}),
+          origin: t['origin'] || null,
+        })),
+      ),
     },
     {
       fromVersion: '2.7.0',
       toVersion: '2.8.0',
       description: 'Add verification gates',
-      migrate: (data: unknown) => {
-        const d = data as Record<string, unknown>;
-        const tasks = d['tasks'] as Array<Record<string, unknown>>;
-        for (const task of tasks) {
-          if (!task['verification']) {
-            task['verification'] = null;
-          }
-        }
-        return d;
-      },
+      migrate: taskMigration('tasks', (tasks) =>
+        tasks.map((t) => ({ ...t, verification: t['verification'] || null })),
+      ),
     },
     {
       fromVersion: '2.8.0',
       toVersion: '2.9.0',
       description: 'Add provenance tracking',
-      migrate: (data: unknown) => {
-        const d = data as Record<string, unknown>;
-        const tasks = d['tasks'] as Array<Record<string, unknown>>;
-        for (const task of tasks) {
-          if (!task['provenance']) {
-            task['provenance'] = null;
-          }
-        }
-        return d;
-      },
+      migrate: taskMigration('tasks', (tasks) =>
+        tasks.map((t) => ({ ...t, provenance: t['provenance'] || null })),
+      ),
     },
     {
       fromVersion: '2.9.0',
@@ -177,19 +199,43 @@ const MIGRATIONS: Record<string, MigrationDef[]> = {
       description: 'Add multi-session support fields',
       migrate: (data: unknown) => {
         const d = data as Record<string, unknown>;
-        const meta = d['_meta'] as Record<string, unknown>;
-        if (!meta['multiSessionEnabled']) {
-          meta['multiSessionEnabled'] = false;
-        }
-        if (!meta['activeSessionCount']) {
-          meta['activeSessionCount'] = 0;
-        }
-        return d;
+        const meta = (d['_meta'] ?? {}) as Record<string, unknown>;
+        return {
+          ...d,
+          _meta: {
+            ...meta,
+            multiSessionEnabled: meta['multiSessionEnabled'] || false,
+            activeSessionCount: meta['activeSessionCount'] || 0,
+          },
+        };
       },
     },
   ],
+  archive: [
+    {
+      fromVersion: '0.0.0',
+      toVersion: '2.6.0',
+      description: 'Backfill status, priority, depends, labels, and createdAt defaults',
+      migrate: taskMigration('archivedTasks', backfillCoreTaskFields),
+    },
+  ],
 };
 
+/**
+ * Resolve the on-disk path of a migratable JSON data file.
+ *
+ * `todo` and `archive` are the legacy JSON task files that
+ * {@link migrateJsonToSqlite} imports; they live beside `tasks.db`.
+ */
+function getDataFilePath(fileType: string, cwd?: string): string | undefined {
+  const filePaths: Record<string, string> = {
+    todo: join(resolveCleoDir(cwd), 'todo.json'),
+    config: getConfigPath(cwd),
+    archive: join(resolveCleoDir(cwd), 'todo-archive.json'),
+  };
+  return filePaths[fileType];
+}
+
 /**
  * Detect schema version from a data file.
  * @task T4468
@@ -243,7 +289,7 @@ export async function getMigrationStatus(cwd?: string): Promise<MigrationStatus>
 
   // Check todo.json
   try {
-    const taskData = await readJson(getTaskPath(cwd));
+    const taskData = await readJson(getDataFilePath('todo', cwd)!);
     if (taskData) {
       const current = detectVersion(taskData);
       const target = TARGET_VERSIONS['todo']!;
@@ -275,8 +321,7 @@ export async function getMigrationStatus(cwd?: string): Promise<MigrationStatus>
 
   // Check archive
   try {
-    const archivePath = join(resolveCleoDir(cwd), 'todo-archive.json');
-    const archiveData = await readJson(archivePath);
+    const archiveData = await readJson(getDataFilePath('archive', cwd)!);
     if (archiveData) {
       const current = detectVersion(archiveData);
       const target = TARGET_VERSIONS['archive']!;
@@ -294,25 +339,25 @@ export async function getMigrationStatus(cwd?: string): Promise<MigrationStatus>
 }
 
 /**
- * Run migrations on a data file.
+ * Bring one JSON data file up to its target schema version.
+ *
+ * Applies every registered migration whose `toVersion` lies in
+ * `(current, target]`, in order. Before overwriting, the original file is
+ * copied to `<file>.bak`; the rotated backup in `.cleo/backups/` is kept too.
+ * Nothing is written on a dry run or when any step fails.
+ *
+ * @param fileType - Registry key (`todo`, `config`, `archive`).
+ * @param filePath - Absolute path of the file to migrate.
+ * @param options - `dryRun` reports the plan without writing.
+ * @param cwd - Working directory used to resolve the backup directory.
  * @task T4468
  */
-export async function runMigration(
+export async function migrateDataFile(
   fileType: string,
+  filePath: string,
   options: { dryRun?: boolean } = {},
   cwd?: string,
 ): Promise<MigrationResult> {
-  const filePaths: Record<string, string> = {
-    todo: getTaskPath(cwd),
-    config: getConfigPath(cwd),
-    archive: join(resolveCleoDir(cwd), 'todo-archive.json'),
-  };
-
-  const filePath = filePaths[fileType];
-  if (!filePath) {
-    throw new CleoError(ExitCode.INVALID_INPUT, `Unknown file type: ${fileType}`);
-  }
-
   const data = await readJson(filePath);
   if (!data) {
     throw new CleoError(ExitCode.NOT_FOUND, `File not found: ${filePath}`);
@@ -320,13 +365,12 @@ export async function runMigration(
 
   const currentVersion = detectVersion(data);
   const targetVersion = TARGET_VERSIONS[fileType] ?? '0.0.0';
-  const migrations = MIGRATIONS[fileType] ?? [];
+  const dryRun = options.dryRun ?? false;
 
-  // Find applicable migrations
-  const applicable = migrations
+  const applicable = (MIGRATIONS[fileType] ?? [])
     .filter(
       (m) =>
-        compareSemver(m.fromVersion, currentVersion) >= 0 &&
+        compareSemver(m.toVersion, currentVersion) > 0 &&
         compareSemver(m.toVersion, targetVersion) <= 0,
     )
     .sort((a, b) => compareSemver(a.fromVersion, b.fromVersion));
@@ -339,7 +383,7 @@ export async function runMigration(
       migrationsApplied: [],
       success: true,
       errors: [],
-      dryRun: options.dryRun ?? false,
+      dryRun,
     };
   }
 
@@ -358,27 +402,47 @@ export async function runMigration(
     }
   }
 
-  // Update schema version in _meta
-  const migratedObj = migrated as Record<string, unknown>;
-  const meta = (migratedObj['_meta'] ?? {}) as Record<string, unknown>;
-  meta['schemaVersion'] = errors.length === 0 ? targetVersion : currentVersion;
-  migratedObj['_meta'] = meta;
+  const success = errors.length === 0;
+  let backupPath: string | undefined;
+  if (!dryRun && success) {
+    // Stamp the schema version only on a fully migrated copy.
+    const migratedObj = migrated as Record<string, unknown>;
+    const meta = (migratedObj['_meta'] ?? {}) as Record<string, unknown>;
+    migrated = { ...migratedObj, _meta: { ...meta, schemaVersion: targetVersion } };
 
-  if (!options.dryRun && errors.length === 0) {
+    backupPath = `${filePath}.bak`;
+    await copyFile(filePath, backupPath);
     await saveJson(filePath, migrated, { backupDir: getBackupDir(cwd) });
   }
 
   return {
     file: filePath,
     fromVersion: currentVersion,
-    toVersion: errors.length === 0 ? targetVersion : currentVersion,
+    toVersion: success ? targetVersion : currentVersion,
     migrationsApplied: applied,
-    success: errors.length === 0,
+    success,
     errors,
-    dryRun: options.dryRun ?? false,
+    dryRun,
+    ...(backupPath ? { backupPath } : {}),
   };
 }
 
+/**
+ * Run migrations on a data file.
+ * @task T4468
+ */
+export async function runMigration(
+  fileType: string,
+  options: { dryRun?: boolean } = {},
+  cwd?: string,
+): Promise<MigrationResult> {
+  const filePath = getDataFilePath(fileType, cwd);
+  if (!filePath) {
+    throw new CleoError(ExitCode.INVALID_INPUT, `Unknown file type: ${fileType}`);
+  }
+  return migrateDataFile(fileType, filePath, options, cwd);
+}
+
 /**
  * Run all pending migrations.
  * @task T4468
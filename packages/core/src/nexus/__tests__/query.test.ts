@@ -77,6 +77,8 @@ describe('validateSyntax', () => {
     expect(validateSyntax('T001')).toBe(true);
     expect(validateSyntax('T1234')).toBe(true);
     expect(validateSyntax('T99999')).toBe(true);
+    expect(validateSyntax('AUTH-7')).toBe(true);
+    expect(validateSyntax('my-app:AUTH-7')).toBe(true);
   });
 
   it('accepts project:taskId format', () => {
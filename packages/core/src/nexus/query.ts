@@ -36,11 +36,11 @@ export type NexusResolvedTask = Task & { _project: string };
 
 // ── Query syntax ─────────────────────────────────────────────────────
 
-/** Regex for a bare task ID (T followed by 3+ digits). */
-const TASK_ID_RE = /^T\d{3,}$/;
+/** Regex for a bare task ID (T followed by 3+ digits, or saga-prefixed `AUTH-7`). */
+const TASK_ID_RE = /^(T\d{3,}|[A-Z]{2,10}-\d+)$/;
 
 /** Regex for project:taskId syntax. */
-const QUALIFIED_RE = /^([a-z0-9_-]+|\.|\*):T\d{3,}$/;
+const QUALIFIED_RE = /^([a-z0-9_-]+|\.|\*):(T\d{3,}|[A-Z]{2,10}-\d+)$/;
 
 /**
  * Validate a query string matches expected syntax.
@@ -178,6 +178,24 @@ describe('orchestrateReady — Bug B: priority field', () => {
   });
 });
 
+describe('orchestrateReady — sort: priority', () => {
+  it('orders the ready set critical → high → medium', async () => {
+    const result = await orchestrateReady('T800', TEST_ROOT, { sort: 'priority' });
+    expect(result.success).toBe(true);
+
+    const data = result.data as { readyTasks: Array<{ id: string }> };
+    expect(data.readyTasks.map((t) => t.id)).toEqual(['T803', 'T802', 'T805']);
+  });
+
+  it('rejects an unknown sort key', async () => {
+    const result = await orchestrateReady('T800', TEST_ROOT, {
+      sort: 'size' as unknown as 'priority',
+    });
+    expect(result.success).toBe(false);
+    expect(result.error?.code).toBe('E_INVALID_INPUT');
+  });
+});
+
 // ---------------------------------------------------------------------------
 // Bug A: depends field
 // ---------------------------------------------------------------------------
@@ -0,0 +1,114 @@
+/**
+ * Tests for `orchestrate ready --track-balance` — ready tasks tagged with
+ * their resolved track and interleaved across tracks.
+ *
+ * Fixture layout (parent_id containment):
+ *
+ *   T-S (saga, track=platform)
+ *     ↳ T-E1 (epic)
+ *         ↳ T-A1 (critical, track=infra)
+ *         ↳ T-A2 (high,     track=infra)
+ *         ↳ T-A3 (medium,   track=infra)
+ *         ↳ T-B1 (medium,   labels=[ui-kit])  — trackRules ui* → frontend
+ *         ↳ T-C1 (low)                        — saga track
+ */
+
+import { readFile, writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { createTask, orchestrateReady } from '@cleocode/core/internal';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+
+let env: TestDbEnv;
+
+interface ReadyData {
+  readyTasks: Array<{ id: string; track?: string }>;
+}
+
+/** Add `trackRules` to the test project's config. */
+async function writeTrackRules(trackRules: unknown): Promise<void> {
+  const path = join(env.cleoDir, 'config.json');
+  const config = JSON.parse(await readFile(path, 'utf-8')) as Record<string, unknown>;
+  await writeFile(path, JSON.stringify({ ...config, trackRules }));
+}
+
+async function seed(root: string): Promise<void> {
+  const ts = '2026-10-01T00:00:00Z';
+  const base = { description: 'Track balance fixture', createdAt: ts, updatedAt: null };
+  const tasks = [
+    { ...base, id: 'T-S', title: 'Saga', type: 'saga', status: 'active', track: 'platform' },
+    { ...base, id: 'T-E1', title: 'Epic', type: 'epic', status: 'active', parentId: 'T-S' },
+    { ...base, id: 'T-A1', title: 'A1', priority: 'critical', parentId: 'T-E1', track: 'infra' },
+    { ...base, id: 'T-A2', title: 'A2', priority: 'high', parentId: 'T-E1', track: 'infra' },
+    { ...base, id: 'T-A3', title: 'A3', priority: 'medium', parentId: 'T-E1', track: 'infra' },
+    { ...base, id: 'T-B1', title: 'B1', priority: 'medium', parentId: 'T-E1', labels: ['ui-kit'] },
+    { ...base, id: 'T-C1', title: 'C1', priority: 'low', parentId: 'T-E1' },
+  ];
+  for (const task of tasks) {
+    await createTask(
+      { type: 'task', status: 'pending', ...task } as Parameters<typeof createTask>[0],
+      root,
+    );
+  }
+}
+
+beforeEach(async () => {
+  env = await createTestDb();
+  await writeTrackRules([{ label: 'ui*', track: 'frontend' }]);
+  await seed(env.tempDir);
+});
+
+afterEach(async () => {
+  try {
+    const { closeAllDatabases } = await import('@cleocode/core/internal');
+    await closeAllDatabases();
+  } catch {
+    // ignore cleanup errors
+  }
+  await env.cleanup();
+});
+
+describe('orchestrateReady with trackBalance', () => {
+  it('resolves each track from the task, then the saga, then the label rules', async () => {
+    const result = await orchestrateReady('T-E1', env.tempDir, {
+      sort: 'priority',
+      trackBalance: true,
+    });
+    expect(result.success).toBe(true);
+    const { readyTasks } = result.data as ReadyData;
+
+    expect(Object.fromEntries(readyTasks.map((t) => [t.id, t.track]))).toEqual({
+      'T-A1': 'infra',
+      'T-A2': 'infra',
+      'T-A3': 'infra',
+      'T-B1': 'frontend',
+      'T-C1': 'platform',
+    });
+  });
+
+  it('alternates tracks while keeping priority order within each', async () => {
+    const result = await orchestrateReady('T-E1', env.tempDir, {
+      sort: 'priority',
+      trackBalance: true,
+    });
+    const { readyTasks } = result.data as ReadyData;
+
+    expect(readyTasks.map((t) => t.id)).toEqual(['T-A1', 'T-B1', 'T-C1', 'T-A2', 'T-A3']);
+  });
+
+  it('leaves the ready set untagged without the flag', async () => {
+    const result = await orchestrateReady('T-E1', env.tempDir, { sort: 'priority' });
+    const { readyTasks } = result.data as ReadyData;
+
+    expect(readyTasks.map((t) => t.id)).toEqual(['T-A1', 'T-A2', 'T-A3', 'T-B1', 'T-C1']);
+    expect(readyTasks.every((t) => t.track === undefined)).toBe(true);
+  });
+
+  it('reports malformed trackRules as a config error', async () => {
+    await writeTrackRules([{ label: 'ui*' }]);
+    const result = await orchestrateReady('T-E1', env.tempDir, { trackBalance: true });
+
+    expect(result.success).toBe(false);
+    expect(result.error?.code).toBe('E_CONFIG_ERROR');
+  });
+});
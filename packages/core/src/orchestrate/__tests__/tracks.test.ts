@@ -0,0 +1,142 @@
+/**
+ * Tests for track resolution, track balancing, and `orchestrate tracks`.
+ */
+
+import type { Task, TrackRule } from '@cleocode/contracts';
+import { beforeEach, describe, expect, it, vi } from 'vitest';
+
+vi.mock('../../config.js', () => ({ loadConfig: vi.fn() }));
+vi.mock('../../paths.js', () => ({ getProjectRoot: (root?: string) => root ?? '/proj' }));
+vi.mock('../query-ops.js', () => ({ orchestrateReady: vi.fn() }));
+
+import { loadConfig } from '../../config.js';
+import { orchestrateReady } from '../query-ops.js';
+import {
+  balanceByTrack,
+  createTrackResolver,
+  readTrackRules,
+  UNTRACKED,
+} from '../track-resolver.js';
+import { orchestrateTracks } from '../tracks.js';
+
+describe('createTrackResolver', () => {
+  const trackOf = createTrackResolver([
+    { label: 'infra*', track: 'infra' },
+    { label: 'ui-?', track: 'frontend' },
+    { label: 'infra-docs', track: 'docs' },
+  ]);
+
+  it('classifies by the first matching glob', () => {
+    expect(trackOf({ labels: ['infra-ci'] })).toBe('infra');
+    expect(trackOf({ labels: ['infra-docs'] })).toBe('infra');
+    expect(trackOf({ labels: ['bug', 'ui-a'] })).toBe('frontend');
+  });
+
+  it('leaves unmatched and unlabelled tasks untracked', () => {
+    expect(trackOf({ labels: ['ui-ab'] })).toBe(UNTRACKED);
+    expect(trackOf({})).toBe(UNTRACKED);
+  });
+
+  it('treats regex characters in a glob literally', () => {
+    const literal = createTrackResolver([{ label: 'v1.0', track: 'release' }]);
+    expect(literal({ labels: ['v1.0'] })).toBe('release');
+    expect(literal({ labels: ['v1x0'] })).toBe(UNTRACKED);
+  });
+
+  it('rejects a rule without a track', () => {
+    expect(() => createTrackResolver([{ label: 'infra*', track: ' ' }])).toThrow(/trackRules\[0\]/);
+  });
+
+  it('rejects trackRules that are not an array', () => {
+    expect(readTrackRules({})).toEqual([]);
+    expect(() =>
+      readTrackRules({ trackRules: { label: 'infra*' } as unknown as TrackRule[] }),
+    ).toThrow(/must be an array/);
+  });
+});
+
+describe('track resolution order', () => {
+  const tasks = new Map<string, Partial<Task>>([
+    ['S1', { id: 'S1', type: 'saga', track: 'platform' }],
+    ['S2', { id: 'S2', type: 'saga' }],
+    ['E1', { id: 'E1', type: 'epic', parentId: 'S1' }],
+    ['E2', { id: 'E2', type: 'epic', parentId: 'S2' }],
+  ]);
+  const trackOf = createTrackResolver([{ label: 'ui', track: 'frontend' }], (id) =>
+    tasks.get(id),
+  );
+
+  it('prefers the task track over the saga track and label rules', () => {
+    expect(trackOf({ track: 'docs', parentId: 'E1', labels: ['ui'] })).toBe('docs');
+  });
+
+  it('falls back to the nearest saga track', () => {
+    expect(trackOf({ parentId: 'E1', labels: ['ui'] })).toBe('platform');
+  });
+
+  it('uses the label rules when neither the task nor its saga has a track', () => {
+    expect(trackOf({ parentId: 'E2', labels: ['ui'] })).toBe('frontend');
+    expect(trackOf({ parentId: 'E2' })).toBe(UNTRACKED);
+  });
+});
+
+describe('balanceByTrack', () => {
+  it('alternates tracks and keeps the order within each track', () => {
+    const items = [
+      { id: 'A1', track: 'a' },
+      { id: 'A2', track: 'a' },
+      { id: 'A3', track: 'a' },
+      { id: 'B1', track: 'b' },
+      { id: 'C1', track: 'c' },
+      { id: 'B2', track: 'b' },
+    ];
+    expect(balanceByTrack(items).map((t) => t.id)).toEqual(['A1', 'B1', 'C1', 'A2', 'B2', 'A3']);
+  });
+});
+
+describe('orchestrateTracks', () => {
+  beforeEach(() => {
+    vi.mocked(orchestrateReady).mockClear();
+    vi.mocked(loadConfig).mockResolvedValue({
+      trackRules: [
+        { label: 'infra*', track: 'infra' },
+        { label: 'docs', track: 'docs' },
+      ],
+    } as unknown as Awaited<ReturnType<typeof loadConfig>>);
+    vi.mocked(orchestrateReady).mockResolvedValue({
+      success: true,
+      data: {
+        readyTasks: [
+          { id: 'T2', track: 'infra' },
+          { id: 'T4', track: UNTRACKED },
+          { id: 'T3', track: 'infra' },
+        ],
+      },
+    });
+  });
+
+  it('counts ready tasks per track, listing empty rule tracks', async () => {
+    const result = await orchestrateTracks('T1', '/proj');
+
+    expect(orchestrateReady).toHaveBeenCalledWith('T1', '/proj', { trackBalance: true });
+    expect(result.success).toBe(true);
+    const data = result.data as { tracks: Array<{ track: string; ready: number }>; total: number };
+    expect(data.tracks.map((t) => [t.track, t.ready])).toEqual([
+      ['infra', 2],
+      ['docs', 0],
+      [UNTRACKED, 1],
+    ]);
+    expect(data.total).toBe(3);
+  });
+
+  it('reports malformed rules as a config error', async () => {
+    vi.mocked(loadConfig).mockResolvedValue({
+      trackRules: [{ label: 'infra*' }],
+    } as unknown as Awaited<ReturnType<typeof loadConfig>>);
+
+    const result = await orchestrateTracks('T1', '/proj');
+    expect(result.success).toBe(false);
+    expect(result.error?.code).toBe('E_CONFIG_ERROR');
+    expect(orchestrateReady).not.toHaveBeenCalled();
+  });
+});
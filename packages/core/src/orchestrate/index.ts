@@ -3,12 +3,14 @@
  *
  * Re-exports from all 7 orchestrate sub-modules:
  * - query-ops.ts   — status, analyze, ready, next, waves, context, validate
- * - lifecycle-ops.ts — initLoomForEpic, startup, bootstrap, criticalPath, unblock, check, skillInject, parallel*
+ * - lifecycle-ops.ts — initLoomForEpic, startup, bootstrap, criticalPath, unblock, check, claim, skillInject, parallel*
  * - spawn-ops.ts   — spawnSelectProvider, spawnExecute, spawn, sendConduitEvent, composeSpawnForTask
  * - handoff-ops.ts — handoff + HandoffStep types
- * - plan.ts        — orchestratePlan + 4 interfaces + plan helpers
+ * - plan.ts        — orchestratePlan, orchestrateSequence + interfaces + plan helpers
  * - pivot.ts       — pivotTask (existing)
  * - worker-verify.ts — reVerifyWorkerReport (existing)
+ * - track-resolver.ts — own → saga → label-rule track resolution, track balancing
+ * - tracks.ts      — orchestrateTracks
  *
  * @task T1570
  * @task T1634 — LOOM auto-init export
@@ -26,6 +28,7 @@ export {
   initLoomForEpic,
   orchestrateBootstrap,
   orchestrateCheck,
+  orchestrateClaim,
   orchestrateCriticalPath,
   orchestrateParallel,
   orchestrateParallelEnd,
@@ -38,11 +41,17 @@ export type { PivotOptions, PivotResult } from './pivot.js';
 export { PIVOT_AUDIT_FILE, pivotTask } from './pivot.js';
 export type {
   OrchestratePlanInput,
+  OrchestrateSequenceInput,
   PlanWarning,
   PlanWave,
   PlanWorkerEntry,
 } from './plan.js';
-export { numericToAgentTier, openAgentRegistryDbForComposer, orchestratePlan } from './plan.js';
+export {
+  numericToAgentTier,
+  openAgentRegistryDbForComposer,
+  orchestratePlan,
+  orchestrateSequence,
+} from './plan.js';
 export type { EngineResult } from './query-ops.js';
 export {
   loadTasks,
@@ -63,6 +72,13 @@ export {
   orchestrateSpawnSelectProvider,
   sendConduitEvent,
 } from './spawn-ops.js';
+export {
+  balanceByTrack,
+  createTrackResolver,
+  readTrackRules,
+  UNTRACKED,
+} from './track-resolver.js';
+export { orchestrateTracks } from './tracks.js';
 export type {
   ReVerifyOptions,
   TestRunResult,
@@ -1,7 +1,7 @@
 /**
  * Orchestrate Lifecycle Operations
  *
- * Startup, bootstrap, criticalPath, unblockOpportunities, check,
+ * Startup, bootstrap, criticalPath, unblockOpportunities, check, claim,
  * skillInject, and parallel wrappers migrated from
  * packages/cleo/src/dispatch/engines/orchestrate-engine.ts.
  *
@@ -13,6 +13,7 @@
 
 import type { BrainState } from '@cleocode/contracts';
 import { type EngineResult, engineError } from '../engine-result.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
 import { getLifecycleStatus, recordStageProgress } from '../lifecycle/index.js';
 import { buildBrainState } from '../orchestration/bootstrap.js';
 import { getCriticalPath } from '../orchestration/critical-path.js';
@@ -182,6 +183,35 @@ export async function orchestrateCriticalPath(projectRoot?: string): Promise<Eng
   }
 }
 
+/**
+ * orchestrate.claim - Pin a task to an agent unless another agent holds it
+ *
+ * The claim is one conditional `UPDATE ... WHERE assignee IS NULL OR
+ * assignee = ?`, run under the dispatcher's mutate lock, so two agents racing
+ * for the same task cannot both win. Re-claiming a task you hold is a no-op.
+ *
+ * @param taskId - Task to claim.
+ * @param assignee - Agent (or person) claiming it.
+ * @param projectRoot - Optional project root path.
+ * @returns Engine result with `{ taskId, assignee }`; `E_CLEO_TASK_CLAIMED` with
+ *   `details.error = 'already_claimed'` when the task belongs to someone else.
+ */
+export async function orchestrateClaim(
+  taskId: string,
+  assignee: string,
+  projectRoot?: string,
+): Promise<EngineResult> {
+  if (!taskId) return engineError('E_INVALID_INPUT', 'taskId is required');
+  if (!assignee?.trim()) return engineError('E_INVALID_INPUT', 'assignee is required');
+  try {
+    const accessor = await getTaskAccessor(getProjectRoot(projectRoot));
+    await accessor.claimTask(taskId, assignee.trim());
+    return { success: true, data: { taskId, assignee: assignee.trim(), claimed: true } };
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to claim task');
+  }
+}
+
 /**
  * orchestrate.unblock-opportunities - Analyze dependency graph for unblocking opportunities
  *
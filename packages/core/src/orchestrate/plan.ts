@@ -12,9 +12,15 @@ import { createHash } from 'node:crypto';
 import { createRequire } from 'node:module';
 import type { DatabaseSync as _DatabaseSyncType } from 'node:sqlite';
 import type { AgentTier, ResolvedAgent, Task } from '@cleocode/contracts';
-import type { OrchestratePlanResult } from '@cleocode/contracts/operations/orchestrate';
-import { type EngineResult, engineError } from '../engine-result.js';
-import { getEnrichedWaves } from '../orchestration/waves.js';
+import { ExitCode } from '@cleocode/contracts';
+import type {
+  OrchestratePlanResult,
+  OrchestrateSequenceResult,
+} from '@cleocode/contracts/operations/orchestrate';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { computeSequencedWaves, getEnrichedWaves } from '../orchestration/waves.js';
 import { getProjectRoot } from '../paths.js';
 import {
   ensureGlobalAgentRegistryDb,
@@ -436,3 +442,70 @@ export async function orchestratePlan(
     return engineError(code, (err as Error).message);
   }
 }
+
+// ---------------------------------------------------------------------------
+// orchestrate.sequence
+// ---------------------------------------------------------------------------
+
+/**
+ * Input envelope for {@link orchestrateSequence}.
+ */
+export interface OrchestrateSequenceInput {
+  /** Absolute path to the project root. */
+  projectRoot: string;
+  /** Restrict the plan to this saga's subtree. Omit for the whole project. */
+  sagaId?: string;
+}
+
+/**
+ * Sequence open work into dependency waves — the "do these next, in this
+ * order" view behind `cleo orchestrate plan [--saga <id>]`.
+ *
+ * Unlike {@link orchestratePlan}, which assigns agents to one epic's
+ * children, this walks every open task and subtask in scope, so an agent can
+ * be handed the whole ordering at once instead of polling `ready`.
+ *
+ * @param input - {@link OrchestrateSequenceInput} envelope.
+ * @returns Engine result wrapping {@link OrchestrateSequenceResult}; a
+ *   dependency cycle fails with `details.error = 'dependency_cycle'`.
+ */
+export async function orchestrateSequence(
+  input: OrchestrateSequenceInput,
+): Promise<EngineResult<OrchestrateSequenceResult>> {
+  const root = getProjectRoot(input.projectRoot);
+  try {
+    const accessor = await getTaskAccessor(root);
+    const tasks = await loadTasks(root);
+
+    let scope = tasks;
+    if (input.sagaId) {
+      const saga = tasks.find((t) => t.id === input.sagaId);
+      if (!saga) {
+        throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${input.sagaId}`, {
+          fix: 'cleo saga list',
+        });
+      }
+      if (saga.type !== 'saga') {
+        throw new CleoError(
+          ExitCode.VALIDATION_ERROR,
+          `${saga.id} is a ${saga.type ?? 'task'}, not a saga`,
+          {
+            fix: `cleo orchestrate plan ${saga.id}  (plans a single epic)`,
+            details: { field: 'sagaId', expected: 'saga', actual: saga.type },
+          },
+        );
+      }
+      scope = await accessor.getSubtree(saga.id);
+    }
+
+    const waves = computeSequencedWaves(scope, tasks);
+    return engineSuccess({
+      sagaId: input.sagaId ?? null,
+      waves,
+      totalWaves: waves.length,
+      totalTasks: waves.reduce((n, w) => n + w.tasks.length, 0),
+    });
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to sequence tasks');
+  }
+}
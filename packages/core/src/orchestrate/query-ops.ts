@@ -23,7 +23,7 @@ import { loadConfig } from '../config.js';
 import { type EngineResult, engineError } from '../engine-result.js';
 import { analyzeDependencies } from '../orchestration/analyze.js';
 import { estimateContext } from '../orchestration/context.js';
-import { analyzeEpic, getNextTask, getReadyTasks } from '../orchestration/index.js';
+import { analyzeEpic, computeReadyTasks } from '../orchestration/index.js';
 import { computeEpicStatus, computeOverallStatus } from '../orchestration/status.js';
 import { validateSpawnReadiness } from '../orchestration/validate-spawn.js';
 import type { EnrichedWave } from '../orchestration/waves.js';
@@ -34,7 +34,16 @@ import { resolveSagaMemberIds } from '../sagas/storage.js';
 import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
 import type { DepGraphIssue } from '../tasks/dep-graph-validator.js';
 import { runValidation } from '../tasks/dep-graph-validator.js';
+import { compareByPriority, TASK_SORT_KEYS, type TaskSortKey } from '../tasks/sort.js';
+import { loadTaskIndex } from '../tasks/task-index.js';
 import { computeAgentAdmission } from './admission.js';
+import {
+  balanceByTrack,
+  createTrackResolver,
+  readTrackRules,
+  TRACK_RULES_FIX,
+  UNTRACKED,
+} from './track-resolver.js';
 
 // ---------------------------------------------------------------------------
 // Constants
@@ -284,6 +293,26 @@ export interface OrchestrateReadyOptions {
    * @task T10968 — Deprecate dual via semantics
    */
   via?: OrchestrateTraversal;
+
+  /**
+   * Ready-set ordering. `priority` orders critical → high → medium → low,
+   * ties by task ID. Saga walks always use this order.
+   */
+  sort?: TaskSortKey;
+
+  /**
+   * Agent asking for work. When set, tasks already claimed by a different
+   * assignee are left out of the ready set; unassigned tasks and tasks
+   * assigned to this agent stay in.
+   */
+  assignee?: string;
+
+  /**
+   * Tag every ready task with its track (own track, then its saga's, then
+   * the `trackRules` label globs) and interleave the tracks round-robin, so
+   * consecutive picks pair work from different tracks.
+   */
+  trackBalance?: boolean;
 }
 
 /**
@@ -314,6 +343,12 @@ export async function orchestrateReady(
   if (!epicId) {
     return engineError('E_INVALID_INPUT', 'epicId is required');
   }
+  if (opts?.sort !== undefined && !(TASK_SORT_KEYS as readonly string[]).includes(opts.sort)) {
+    return engineError(
+      'E_INVALID_INPUT',
+      `Invalid sort: ${opts.sort} (must be ${TASK_SORT_KEYS.join('|')})`,
+    );
+  }
 
   try {
     const root = getProjectRoot(projectRoot);
@@ -399,14 +434,41 @@ export async function orchestrateReady(
     const sagaShaped = isSagaShape(epic);
 
     const accessor = await getTaskAccessor(root);
+    // One index serves every member epic instead of a reload per member.
+    const index = await loadTaskIndex(accessor, root);
+
+    // Hide work claimed by someone else when the caller identifies itself.
+    const assigneeOf = new Map(tasks.map((t) => [t.id, t.assignee ?? null]));
+    const isClaimable = (taskId: string): boolean => {
+      const holder = assigneeOf.get(taskId);
+      return !opts?.assignee || !holder || holder === opts.assignee;
+    };
 
     type ReadyTaskOut = {
       id: string;
       title: string;
       priority: string;
       depends: string[];
+      track?: string;
     };
 
+    let balance = (out: ReadyTaskOut[]): ReadyTaskOut[] => out;
+    if (opts?.trackBalance) {
+      let trackOf: ReturnType<typeof createTrackResolver>;
+      try {
+        trackOf = createTrackResolver(readTrackRules(config), (id) => index.byId.get(id));
+      } catch (err) {
+        return engineError('E_CONFIG_ERROR', (err as Error).message, { fix: TRACK_RULES_FIX });
+      }
+      balance = (out) =>
+        balanceByTrack(
+          out.map((t) => {
+            const task = index.byId.get(t.id);
+            return { ...t, track: task ? trackOf(task) : UNTRACKED };
+          }),
+        );
+    }
+
     if (sagaShaped) {
       // T10966: use canonical resolveSagaMemberIds (with type-checking).
       const memberIds = await resolveSagaMemberIds(accessor, epicId);
@@ -422,20 +484,20 @@ export async function orchestrateReady(
         // Recursion safety: sagas SHOULD NOT nest (ADR-073). If a member is
         // itself saga-shaped, skip it and surface the anomaly in meta rather
         // than recursing — preserves O(N) aggregation.
-        const memberTask = tasks.find((t) => t.id === memberId);
+        const memberTask = index.byId.get(memberId);
         if (memberTask && isSagaShape(memberTask)) {
           skippedNested.push(memberId);
           continue;
         }
 
-        const memberReady = await getReadyTasks(memberId, root, accessor);
+        const memberReady = computeReadyTasks(memberId, index);
         aggregatedAllCount += memberReady.length;
         aggregatedBlockedCount += memberReady.filter(
           (t) => !t.ready && t.blockers.length > 0,
         ).length;
 
         for (const t of memberReady) {
-          if (!t.ready) continue;
+          if (!t.ready || !isClaimable(t.taskId)) continue;
           if (seenIds.has(t.taskId)) continue;
           seenIds.add(t.taskId);
           aggregated.push({
@@ -448,18 +510,8 @@ export async function orchestrateReady(
       }
 
       // Preserve priority ordering (critical → high → medium → low) then ID.
-      const priorityWeight: Record<string, number> = {
-        critical: 4,
-        high: 3,
-        medium: 2,
-        low: 1,
-      };
-      aggregated.sort((a, b) => {
-        const wa = priorityWeight[a.priority] ?? 0;
-        const wb = priorityWeight[b.priority] ?? 0;
-        if (wa !== wb) return wb - wa;
-        return a.id.localeCompare(b.id);
-      });
+      aggregated.sort(compareByPriority);
+      const sagaReady = balance(aggregated);
 
       let reason: string | undefined;
       if (aggregated.length === 0) {
@@ -476,14 +528,14 @@ export async function orchestrateReady(
 
       // T12000: annotate which ready tasks are admittable now vs deferred so
       // orchestrators size their fan-out to host capacity (Never-OOM).
-      const admission = await computeAgentAdmission(aggregated.map((t) => t.id));
+      const admission = await computeAgentAdmission(sagaReady.map((t) => t.id));
 
       return {
         success: true,
         data: {
           epicId,
-          readyTasks: aggregated,
-          total: aggregated.length,
+          readyTasks: sagaReady,
+          total: sagaReady.length,
           via: 'saga' as const,
           sagaMembers: members,
           admission,
@@ -495,8 +547,8 @@ export async function orchestrateReady(
     }
 
     // Regular epic: walk parentId.
-    const readyTasks = await getReadyTasks(epicId, root, accessor);
-    const ready = readyTasks.filter((t) => t.ready);
+    const readyTasks = computeReadyTasks(epicId, index);
+    const ready = readyTasks.filter((t) => t.ready && isClaimable(t.taskId));
 
     // T929: when no tasks are ready, include a diagnostic reason so callers
     // can distinguish "all done" from "all blocked" without a second query.
@@ -506,6 +558,8 @@ export async function orchestrateReady(
       const blockedCount = all.filter((t) => !t.ready && t.blockers.length > 0).length;
       if (all.length === 0) {
         reason = 'epic has no children';
+      } else if (all.some((t) => t.ready)) {
+        reason = `all ready tasks are claimed by agents other than ${opts?.assignee}`;
       } else if (blockedCount === all.length) {
         reason = 'all children have unmet dependencies';
       } else {
@@ -519,16 +573,18 @@ export async function orchestrateReady(
       priority: t.priority,
       depends: t.depends,
     }));
+    if (opts?.sort === 'priority') readyOut.sort(compareByPriority);
+    const balanced = balance(readyOut);
     // T12000: annotate which ready tasks are admittable now vs deferred so
     // orchestrators size their fan-out to host capacity (Never-OOM).
-    const admission = await computeAgentAdmission(readyOut.map((t) => t.id));
+    const admission = await computeAgentAdmission(balanced.map((t) => t.id));
 
     return {
       success: true,
       data: {
         epicId,
-        readyTasks: readyOut,
-        total: readyOut.length,
+        readyTasks: balanced,
+        total: balanced.length,
         via: 'parent' as const,
         admission,
         ...(reason !== undefined && { reason }),
@@ -557,7 +613,10 @@ export async function orchestrateNext(epicId: string, projectRoot?: string): Pro
   try {
     const root = getProjectRoot(projectRoot);
     const accessor = await getTaskAccessor(root);
-    const nextTask = await getNextTask(epicId, root, accessor);
+    // The next task and its alternatives come from one readiness pass.
+    const readyTasks = computeReadyTasks(epicId, await loadTaskIndex(accessor, root));
+    const ready = readyTasks.filter((t) => t.ready);
+    const nextTask = ready[0];
 
     if (!nextTask) {
       return {
@@ -570,10 +629,6 @@ export async function orchestrateNext(epicId: string, projectRoot?: string): Pro
       };
     }
 
-    // Get all ready tasks for alternatives
-    const readyTasks = await getReadyTasks(epicId, root, accessor);
-    const ready = readyTasks.filter((t) => t.ready);
-
     return {
       success: true,
       data: {
@@ -72,6 +72,7 @@ import { destroyWorktree, runWorktreeHooks } from '@cleocode/worktree';
 import { findLeastLoadedAgent } from '../agents/capacity.js';
 import { substituteCantAgentBody } from '../agents/variable-substitution.js';
 import { type EngineResult, engineError } from '../engine-result.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
 import { selectHarnessSpawnProvider } from '../harness/spawn-provider-selection.js';
 import type { HarnessSpawnCapability } from '../harness/types.js';
 import { hooks } from '../hooks/registry.js';
@@ -1158,6 +1159,10 @@ export async function orchestrateSpawnExecute(
  *   {@link composeSpawnPayload} so the worker file-scope gate grants the
  *   spawn and records `atomicity_waiver: 'orchestrator-scope-tier1-call'`
  *   instead of returning `E_ATOMICITY_NO_SCOPE`.
+ * @param resume - Attach to the existing locked worktree instead of provisioning.
+ * @param assignee - Claim the task for this assignee before spawning. Fails
+ *   with `already_claimed` when another assignee holds it. When omitted, the
+ *   spawned agent's identity claims the task if it is still unassigned.
  * @returns Engine result with spawn prompt data.
  * @task T4478
  * @task T932
@@ -1179,6 +1184,7 @@ export async function orchestrateSpawn(
    * the expected path, returns E_RESUME_WORKTREE_MISSING.
    */
   resume?: boolean,
+  assignee?: string,
 ): Promise<EngineResult> {
   if (!taskId) {
     return engineError('E_INVALID_INPUT', 'taskId is required');
@@ -1295,6 +1301,16 @@ export async function orchestrateSpawn(
       });
     }
 
+    // Claim before anything is provisioned so two orchestrators cannot spawn
+    // the same task. The conditional UPDATE makes the check-and-set atomic.
+    if (assignee) {
+      try {
+        await accessor.claimTask(taskId, assignee);
+      } catch (err) {
+        return cleoErrorToEngineResult(err, 'E_INTERNAL', `Failed to claim task ${taskId}`);
+      }
+    }
+
     // T10448 — Pre-spawn hygiene gate: validate changesets before composing
     // the prompt. Fail fast so malformed entries are caught before any
     // worktree is provisioned or an agent is dispatched.
@@ -1342,6 +1358,17 @@ export async function orchestrateSpawn(
       }
     }
 
+    // Without an explicit assignee, record the spawned agent on unassigned
+    // tasks. Best-effort: losing this race to a concurrent claim is fine.
+    if (!assignee && spawnAgentId) {
+      try {
+        const current = await accessor.loadSingleTask(taskId);
+        if (!current?.assignee) await accessor.claimTask(taskId, spawnAgentId);
+      } catch {
+        // already claimed elsewhere — leave the existing assignee in place
+      }
+    }
+
     // T1253 — Derive CONDUIT subscription config from the task's parent epic.
     //
     // For tier-1+ spawns, the spawn prompt gains a `## CONDUIT Subscription`
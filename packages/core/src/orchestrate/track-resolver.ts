@@ -0,0 +1,119 @@
+/**
+ * Track resolution for orchestration — which track a task belongs to, and a
+ * ready set interleaved across tracks.
+ *
+ * A task's track is resolved in order from:
+ *
+ *   1. its own `track`;
+ *   2. the `track` of the nearest saga above it;
+ *   3. the first `trackRules` entry whose label glob matches one of its labels;
+ *   4. {@link UNTRACKED}.
+ *
+ * Shared by `orchestrate ready --track-balance` and `orchestrate tracks`.
+ *
+ * @module orchestrate/track-resolver
+ */
+
+import type { CleoConfig, Task, TrackRule } from '@cleocode/contracts';
+
+/** Track of a task that no rule classifies. */
+export const UNTRACKED = 'untracked';
+
+/** Fix hint for a malformed `trackRules` config. */
+export const TRACK_RULES_FIX =
+  'Give each trackRules entry both fields, e.g. { "label": "infra*", "track": "infra" }';
+
+/** The task fields track resolution reads. */
+export type TrackedTask = Pick<Task, 'labels'> & Partial<Pick<Task, 'track' | 'parentId' | 'type'>>;
+
+/** Compile a label glob: `*` matches any run of characters, `?` exactly one. */
+function compileLabelGlob(glob: string): RegExp {
+  const source = glob
+    .split('')
+    .map((ch) => (ch === '*' ? '.*' : ch === '?' ? '.' : ch.replace(/[.+^${}()|[\]\\]/g, '\\$&')))
+    .join('');
+  return new RegExp(`^${source}$`);
+}
+
+/**
+ * Read `trackRules` from the project config.
+ *
+ * @returns The rules in config order; none when the key is unset.
+ * @throws Error when `trackRules` is set but is not an array.
+ */
+export function readTrackRules(config: Pick<CleoConfig, 'trackRules'>): TrackRule[] {
+  const configured = config.trackRules;
+  if (configured === undefined) return [];
+  if (!Array.isArray(configured)) {
+    throw new Error('trackRules must be an array of { label, track } rules');
+  }
+  return configured;
+}
+
+/**
+ * Compile label rules into a track resolver.
+ *
+ * @param rules - Rules in config order; the first match wins.
+ * @param lookup - Finds a task by ID, used to walk up to the task's saga.
+ *   Without it the saga step is skipped.
+ * @returns A function giving a task's track, or {@link UNTRACKED}.
+ * @throws Error naming the first rule without a label glob or a non-empty track.
+ */
+export function createTrackResolver(
+  rules: readonly TrackRule[],
+  lookup?: (id: string) => TrackedTask | undefined,
+): (task: TrackedTask) => string {
+  const compiled = rules.map((rule, i) => {
+    if (typeof rule?.label !== 'string' || !rule.label || typeof rule.track !== 'string') {
+      throw new Error(`trackRules[${i}] needs a label glob and a track`);
+    }
+    if (!rule.track.trim()) throw new Error(`trackRules[${i}] has an empty track`);
+    return { pattern: compileLabelGlob(rule.label), track: rule.track.trim() };
+  });
+
+  const sagaTrackOf = (task: TrackedTask): string | undefined => {
+    const seen = new Set<string>();
+    for (let id = task.parentId; id && lookup && !seen.has(id); ) {
+      seen.add(id);
+      const ancestor = lookup(id);
+      if (!ancestor) return undefined;
+      if (ancestor.type === 'saga') return ancestor.track?.trim() || undefined;
+      id = ancestor.parentId;
+    }
+    return undefined;
+  };
+
+  return (task) => {
+    const own = task.track?.trim();
+    if (own) return own;
+    const fromSaga = sagaTrackOf(task);
+    if (fromSaga) return fromSaga;
+    const labels = task.labels ?? [];
+    return compiled.find((r) => labels.some((l) => r.pattern.test(l)))?.track ?? UNTRACKED;
+  };
+}
+
+/**
+ * Interleave items across their tracks, round-robin in order of each track's
+ * first item, so consecutive picks come from different tracks while any
+ * remain. Order within a track is kept.
+ *
+ * @param items - Items in their preferred order.
+ * @returns The same items, balanced across tracks.
+ */
+export function balanceByTrack<T extends { track: string }>(items: readonly T[]): T[] {
+  const queues = new Map<string, T[]>();
+  for (const item of items) {
+    const queue = queues.get(item.track);
+    if (queue) queue.push(item);
+    else queues.set(item.track, [item]);
+  }
+  const balanced: T[] = [];
+  for (let round = 0; balanced.length < items.length; round++) {
+    for (const queue of queues.values()) {
+      const item = queue[round];
+      if (item) balanced.push(item);
+    }
+  }
+  return balanced;
+}
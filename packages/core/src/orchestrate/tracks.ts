@@ -0,0 +1,72 @@
+/**
+ * Per-track ready counts behind `cleo orchestrate tracks`.
+ *
+ * Tracks are resolved as in `orchestrate ready --track-balance` (see
+ * {@link createTrackResolver}): the task's own track, then its saga's, then
+ * the `trackRules` label globs in the project config.
+ *
+ * @module orchestrate/tracks
+ */
+
+import { loadConfig } from '../config.js';
+import { type EngineResult, engineError } from '../engine-result.js';
+import { getProjectRoot } from '../paths.js';
+import { orchestrateReady } from './query-ops.js';
+import { createTrackResolver, readTrackRules, TRACK_RULES_FIX } from './track-resolver.js';
+
+/**
+ * orchestrate.tracks — ready tasks of an epic or saga grouped by track.
+ *
+ * Every track named in the rules is listed, ready or not, so an empty track
+ * shows up as a zero; any other track, `untracked` included, is listed only
+ * when it has ready tasks. The ready set is exactly `orchestrate ready`'s,
+ * including its dep-graph check, whose failures come back unchanged.
+ *
+ * @param epicId - Epic or saga to compute the ready set for.
+ * @param projectRoot - Optional project root path.
+ * @returns Engine result with `{ epicId, tracks: [{ track, ready, taskIds }], total }`.
+ */
+export async function orchestrateTracks(
+  epicId: string,
+  projectRoot?: string,
+): Promise<EngineResult> {
+  if (!epicId) {
+    return engineError('E_INVALID_INPUT', 'epicId is required');
+  }
+  const root = getProjectRoot(projectRoot);
+  let rules: ReturnType<typeof readTrackRules>;
+  try {
+    rules = readTrackRules(await loadConfig(root));
+    createTrackResolver(rules);
+  } catch (err) {
+    return engineError('E_CONFIG_ERROR', (err as Error).message, { fix: TRACK_RULES_FIX });
+  }
+
+  const ready = await orchestrateReady(epicId, root, { trackBalance: true });
+  if (!ready.success) return ready;
+  const readyTasks = (ready.data as { readyTasks: Array<{ id: string; track: string }> })
+    .readyTasks;
+
+  const byTrack = new Map<string, string[]>(rules.map((r) => [r.track.trim(), []]));
+  for (const { id, track } of readyTasks) {
+    const ids = byTrack.get(track);
+    if (ids) ids.push(id);
+    else byTrack.set(track, [id]);
+  }
+
+  const tracks = [...byTrack].map(([track, taskIds]) => ({
+    track,
+    ready: taskIds.length,
+    taskIds,
+  }));
+  const rendered = tracks.map((t) => `${t.track.padEnd(16)} ${t.ready} ready`).join('\n');
+  return {
+    success: true,
+    data: {
+      epicId,
+      tracks,
+      total: readyTasks.length,
+      rendered: rendered || 'No ready tasks to group by track',
+    },
+  };
+}
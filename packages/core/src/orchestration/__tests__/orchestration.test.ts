@@ -131,6 +131,27 @@ describe('getReadyTasks — orchestration module', () => {
     expect(t005?.ready).toBe(false);
     expect(t005?.blockers).toContain('T003');
   });
+
+  it('resolves blockers in another saga, counting an archived one as met', async () => {
+    await writeTodo([
+      { id: 'T010', title: 'Saga A', type: 'saga', status: 'active' },
+      { id: 'T011', title: 'Epic A', type: 'epic', status: 'active', parentId: 'T010' },
+      { id: 'T012', title: 'Schema', status: 'pending', parentId: 'T011' },
+      { id: 'T013', title: 'Seed data', status: 'done', parentId: 'T011' },
+      { id: 'T020', title: 'Saga B', type: 'saga', status: 'active' },
+      { id: 'T021', title: 'Epic B', type: 'epic', status: 'active', parentId: 'T020' },
+      { id: 'T022', title: 'API', status: 'pending', parentId: 'T021', depends: ['T012'] },
+      { id: 'T023', title: 'Fixtures', status: 'pending', parentId: 'T021', depends: ['T013'] },
+    ]);
+    await accessor.archiveSingleTask('T013', { archiveReason: 'completed' });
+
+    const ready = await getReadyTasks('T021', env.tempDir, accessor);
+    expect(ready.find((r) => r.taskId === 'T022')).toMatchObject({
+      ready: false,
+      blockers: ['T012'],
+    });
+    expect(ready.find((r) => r.taskId === 'T023')?.ready).toBe(true);
+  });
 });
 
 describe('getNextTask — orchestration module', () => {
@@ -0,0 +1,128 @@
+/**
+ * Readiness over a 5000-task project: the indexed pass behind
+ * `orchestrate ready` against the linear-scan lookups it replaces.
+ *
+ * The baseline resolves every dependency with `tasks.find` and every child
+ * list with `tasks.filter`, which is O(n·e) over the project. The indexed
+ * path builds {@link buildTaskIndex} once and answers each lookup from a map.
+ * Both must agree, and the indexed pass must never fall back to scanning the
+ * task list: it asks for one child list per task it visits and one
+ * completion check per dependency edge. Timing lives in scripts/bench.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { describe, expect, it } from 'vitest';
+import { buildTaskIndex } from '../../tasks/task-index.js';
+import { computeReadyTasks } from '../index.js';
+
+const EPICS = 50;
+const TASKS_PER_EPIC = 99;
+
+/** A saga of 50 epics with 99 tasks each; every third task depends on the one before. */
+function buildFixture(): { tasks: Task[]; epicIds: string[] } {
+  const tasks: Task[] = [];
+  const epicIds: string[] = [];
+  const base = {
+    description: '',
+    priority: 'medium',
+    createdAt: '2026-01-01T00:00:00Z',
+  } as const;
+  tasks.push({ ...base, id: 'T00001', title: 'Saga', status: 'active', type: 'saga' } as Task);
+  let n = 2;
+  for (let e = 0; e < EPICS; e++) {
+    const epicId = `T${String(n++).padStart(5, '0')}`;
+    epicIds.push(epicId);
+    tasks.push({ ...base, id: epicId, title: epicId, status: 'active', type: 'epic' } as Task);
+    let previous: string | null = null;
+    for (let i = 0; i < TASKS_PER_EPIC; i++) {
+      const id = `T${String(n++).padStart(5, '0')}`;
+      tasks.push({
+        ...base,
+        id,
+        title: id,
+        type: 'task',
+        parentId: epicId,
+        status: i % 4 === 0 ? 'done' : 'pending',
+        depends: previous && i % 3 === 0 ? [previous] : [],
+      } as Task);
+      previous = id;
+    }
+  }
+  return { tasks, epicIds };
+}
+
+/** Ready task IDs for an epic using linear scans for every lookup. */
+function linearReady(epicId: string, tasks: readonly Task[]): string[] {
+  const isOpen = (t: Task): boolean => t.status !== 'done' && t.status !== 'cancelled';
+  const ready: string[] = [];
+  for (const task of tasks.filter((t) => t.parentId === epicId && isOpen(t))) {
+    const depsMet = (task.depends ?? []).every(
+      (d) => tasks.find((t) => t.id === d)?.status === 'done',
+    );
+    const openChildren = tasks.filter((t) => t.parentId === task.id && isOpen(t));
+    if (depsMet && openChildren.length === 0) ready.push(task.id);
+  }
+  return ready;
+}
+
+/** Count `get`/`has` calls on a map or set while delegating to it. */
+function counting<T extends object>(target: T): { proxy: T; calls: () => number } {
+  let calls = 0;
+  const proxy = new Proxy(target, {
+    get(obj, key) {
+      const value = Reflect.get(obj, key, obj);
+      if ((key === 'get' || key === 'has') && typeof value === 'function') {
+        return (...args: unknown[]) => {
+          calls++;
+          return value.apply(obj, args);
+        };
+      }
+      return typeof value === 'function' ? value.bind(obj) : value;
+    },
+  });
+  return { proxy, calls: () => calls };
+}
+
+describe('orchestrate ready on 5000 tasks', () => {
+  const { tasks, epicIds } = buildFixture();
+
+  it('matches the linear-scan result', () => {
+    const index = buildTaskIndex(tasks);
+    const indexed = epicIds.map((id) =>
+      computeReadyTasks(id, index)
+        .filter((t) => t.ready)
+        .map((t) => t.taskId),
+    );
+
+    expect(tasks).toHaveLength(1 + EPICS * (TASKS_PER_EPIC + 1));
+    expect(indexed).toEqual(epicIds.map((id) => linearReady(id, tasks)));
+  });
+
+  it('answers every lookup from the index without scanning the task list', () => {
+    const built = buildTaskIndex(tasks);
+    const children = counting(built.children);
+    const completed = counting(built.completedIds);
+    const scanned = new Proxy([] as Task[], {
+      get() {
+        throw new Error('computeReadyTasks scanned index.tasks');
+      },
+    });
+    const index = {
+      ...built,
+      tasks: scanned,
+      children: children.proxy,
+      completedIds: completed.proxy,
+    };
+
+    let visited = 0;
+    let edges = 0;
+    for (const epicId of epicIds) {
+      const readiness = computeReadyTasks(epicId, index);
+      visited += readiness.length;
+      edges += readiness.reduce((sum, t) => sum + t.depends.length, 0);
+    }
+
+    expect(children.calls()).toBe(epicIds.length + visited);
+    expect(completed.calls()).toBe(edges);
+  });
+});
@@ -284,4 +284,28 @@ describe('getEnrichedWaves — wave structure (T1202 regression)', () => {
     expect(result.waves).toHaveLength(1);
     expect(result.waves[0]!.tasks.map((t) => t.id)).toEqual(['T002']);
   });
+
+  it('resolves dependencies outside the epic against their live status', async () => {
+    const children: Task[] = [
+      makeTask('T001', 'pending', { depends: ['X001'] }),
+      makeTask('T002', 'pending', { depends: ['X002'] }),
+      makeTask('T003', 'pending', { depends: ['T001'] }),
+    ];
+    const external: Task[] = [makeTask('X001', 'active'), makeTask('X002', 'archived')];
+    const accessor = {
+      ...makeAccessor(children),
+      async loadTasks(ids: string[]): Promise<Task[]> {
+        return external.filter((t) => ids.includes(t.id));
+      },
+    };
+
+    const result = await getEnrichedWaves('EPIC', undefined, accessor as never);
+    expect(result.waves.map((w) => w.tasks.map((t) => t.id))).toEqual([
+      ['T002', 'T001'],
+      ['T003'],
+    ]);
+    const t001 = result.waves[0]!.tasks.find((t) => t.id === 'T001')!;
+    expect(t001).toMatchObject({ blockedBy: ['X001'], ready: false });
+    expect(result.waves[0]!.tasks.find((t) => t.id === 'T002')!.ready).toBe(true);
+  });
 });
@@ -0,0 +1,89 @@
+/**
+ * Tests for computeSequencedWaves — the topological "do these next" plan
+ * behind `cleo orchestrate plan [--saga <id>]`.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { describe, expect, it } from 'vitest';
+import { computeSequencedWaves } from '../waves.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, status: Task['status'], opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    status,
+    priority: 'medium',
+    type: 'task',
+    createdAt: '2026-01-01T00:00:00Z',
+    updatedAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+const idsByWave = (tasks: Task[], all?: Task[]) =>
+  computeSequencedWaves(tasks, all).map((w) => w.tasks.map((t) => t.id));
+
+describe('computeSequencedWaves', () => {
+  it('places each task one wave after its latest in-plan dependency', () => {
+    const tasks = [
+      makeTask('T001', 'pending'),
+      makeTask('T002', 'pending', { depends: ['T001'] }),
+      makeTask('T003', 'pending'),
+      makeTask('T004', 'active', { depends: ['T002', 'T003'] }),
+    ];
+    const waves = computeSequencedWaves(tasks);
+    expect(waves.map((w) => w.wave)).toEqual([1, 2, 3]);
+    expect(idsByWave(tasks)).toEqual([['T001', 'T003'], ['T002'], ['T004']]);
+  });
+
+  it('excludes done work and containers, treating done dependencies as met', () => {
+    const tasks = [
+      makeTask('T001', 'active', { type: 'saga' }),
+      makeTask('T002', 'pending', { type: 'epic', parentId: 'T001' }),
+      makeTask('T003', 'done', { parentId: 'T002' }),
+      makeTask('T004', 'pending', { parentId: 'T002', depends: ['T003'] }),
+      makeTask('T005', 'pending', { type: 'subtask', parentId: 'T004' }),
+    ];
+    expect(idsByWave(tasks)).toEqual([['T004', 'T005']]);
+  });
+
+  it('orders a wave by priority, then ID', () => {
+    const tasks = [
+      makeTask('T001', 'pending', { priority: 'low' }),
+      makeTask('T002', 'pending', { priority: 'critical' }),
+      makeTask('T003', 'pending'),
+    ];
+    expect(idsByWave(tasks)).toEqual([['T002', 'T003', 'T001']]);
+  });
+
+  it('breaks priority ties by natural ID order, as cleo list --sort priority does', () => {
+    const tasks = [makeTask('T10', 'pending'), makeTask('T9', 'pending')];
+    expect(idsByWave(tasks)).toEqual([['T9', 'T10']]);
+  });
+
+  it('reports open dependencies outside the plan without holding the task back', () => {
+    const outside = makeTask('T900', 'pending');
+    const scope = [makeTask('T001', 'pending', { depends: ['T900'] })];
+    const [wave] = computeSequencedWaves(scope, [...scope, outside]);
+    expect(wave?.tasks[0]).toMatchObject({ id: 'T001', externalBlockers: ['T900'] });
+  });
+
+  it('throws dependency_cycle with the cycle path', () => {
+    const tasks = [
+      makeTask('T001', 'pending'),
+      makeTask('T002', 'pending', { depends: ['T001', 'T003'] }),
+      makeTask('T003', 'pending', { depends: ['T002'] }),
+    ];
+    expect(() => computeSequencedWaves(tasks)).toThrow(
+      expect.objectContaining({
+        code: ExitCode.CIRCULAR_REFERENCE,
+        details: expect.objectContaining({
+          error: 'dependency_cycle',
+          path: ['T002', 'T003', 'T002'],
+        }),
+      }),
+    );
+  });
+});
@@ -10,6 +10,8 @@ import { CleoError } from '../errors.js';
 import { resolveOrCwd } from '../paths.js';
 import { getExecutionWaves } from '../phases/deps.js';
 import type { DataAccessor } from '../store/data-accessor.js';
+import { isBlockHeld } from '../tasks/block.js';
+import { loadTaskIndex, type TaskIndex } from '../tasks/task-index.js';
 import {
   buildSpawnPrompt,
   DEFAULT_SPAWN_TIER,
@@ -165,6 +167,7 @@ export interface TaskReadiness {
    */
   depends: string[];
   ready: boolean;
+  /** Unmet dependency IDs, followed by the IDs of any open children. */
   blockers: string[];
   protocol: string;
 }
@@ -262,34 +265,60 @@ export async function analyzeEpic(
 
 /**
  * Get parallel-safe ready tasks for an epic.
+ *
+ * A task manually blocked via `cleo tasks block` is not ready while its hold
+ * lasts; once its `blockedUntil` has passed it is offered again.
+ *
+ * A parent is not ready while any of its children is open — finishing the
+ * parent first would close it over unfinished work. Its open children are
+ * listed in its place (recursively), and it reports them as blockers.
+ *
+ * Pass `index` when asking about several epics in one run (a saga's members)
+ * so the task set is loaded and indexed once; otherwise it is loaded here.
  * @task T4466
  */
 export async function getReadyTasks(
   epicId: string,
-  _cwd?: string,
+  cwd?: string,
   accessor?: DataAccessor,
+  index?: TaskIndex,
 ): Promise<TaskReadiness[]> {
-  const childTasks = await accessor!.getChildren(epicId);
-  const { tasks: allTasks } = await accessor!.queryTasks({});
-  const completedIds = new Set(allTasks.filter((t) => t.status === 'done').map((t) => t.id));
-
-  return childTasks
-    .filter((t) => t.status !== 'done' && t.status !== 'cancelled')
-    .map((task) => {
-      const deps = task.depends ?? [];
-      const unmetDeps = deps.filter((d) => !completedIds.has(d));
-      const protocol = autoDispatch(task);
-
-      return {
-        taskId: task.id,
-        title: task.title,
-        priority: task.priority ?? 'medium',
-        depends: deps,
-        ready: unmetDeps.length === 0,
-        blockers: unmetDeps,
-        protocol,
-      };
+  return computeReadyTasks(epicId, index ?? (await loadTaskIndex(accessor!, cwd)));
+}
+
+/**
+ * Readiness of an epic's open work, answered from a prebuilt index in
+ * O(n + e) over the epic's subtree.
+ *
+ * Dependencies resolve project-wide, not within the queried epic or saga:
+ * a blocker elsewhere holds the task back until it is done, and one that
+ * has since been archived counts as met.
+ */
+export function computeReadyTasks(epicId: string, index: TaskIndex): TaskReadiness[] {
+  const isOpen = (t: Task): boolean => t.status !== 'done' && t.status !== 'cancelled';
+  const openChildrenOf = (id: string): Task[] => (index.children.get(id) ?? []).filter(isOpen);
+
+  const readiness: TaskReadiness[] = [];
+  const seen = new Set<string>();
+  const visit = (task: Task): void => {
+    if (seen.has(task.id)) return;
+    seen.add(task.id);
+    const deps = task.depends ?? [];
+    const unmetDeps = deps.filter((d) => !index.completedIds.has(d));
+    const open = openChildrenOf(task.id);
+    readiness.push({
+      taskId: task.id,
+      title: task.title,
+      priority: task.priority ?? 'medium',
+      depends: deps,
+      ready: !isBlockHeld(task) && unmetDeps.length === 0 && open.length === 0,
+      blockers: [...unmetDeps, ...open.map((c) => c.id)],
+      protocol: autoDispatch(task),
     });
+    for (const child of open) visit(child);
+  };
+  for (const task of openChildrenOf(epicId)) visit(task);
+  return readiness;
 }
 
 /**
@@ -6,6 +6,9 @@
 import type { Task, TaskPriority, TaskRef } from '@cleocode/contracts';
 import type { DataAccessor } from '../store/data-accessor.js';
 import { getTaskAccessor } from '../store/data-accessor.js';
+import { findDependencyCycle } from '../tasks/dependency-check.js';
+import { dependencyCycleError } from '../tasks/dependency-guard.js';
+import { compareByPriority } from '../tasks/sort.js';
 
 /** Basic execution wave: task IDs grouped by dependency depth. */
 export interface Wave {
@@ -36,7 +39,8 @@ export interface EnrichedWaveTask extends TaskRef {
   /**
    * Open (non-terminal) dependency IDs that are currently blocking this task.
    *
-   * A dependency is open when its status is not `'done'` or `'cancelled'`.
+   * A dependency is open when its status is not `'done'`, `'cancelled'`, or
+   * `'archived'`. Dependencies outside the epic are included.
    */
   blockedBy: string[];
   /**
@@ -82,6 +86,9 @@ export interface EnrichedWave {
 // Internal helpers
 // ---------------------------------------------------------------------------
 
+/** Statuses that satisfy a dependency. */
+const SATISFIED_STATUSES = new Set(['done', 'cancelled', 'archived']);
+
 /** Numeric sort weight for each priority level (higher = sort first). */
 const PRIORITY_WEIGHT: Record<string, number> = {
   critical: 4,
@@ -109,7 +116,7 @@ function enrichTask(id: string, taskMap: Map<string, Task>): EnrichedWaveTask {
   const blockedBy = depends.filter((depId) => {
     const dep = taskMap.get(depId);
     if (!dep) return false;
-    return dep.status !== 'done' && dep.status !== 'cancelled';
+    return !SATISFIED_STATUSES.has(dep.status);
   });
 
   const ready = blockedBy.length === 0 && (status === 'pending' || status === 'active');
@@ -236,6 +243,10 @@ export function computeWaves(tasks: Task[]): Wave[] {
  * by priority descending then open-dep count ascending, and attaches a
  * `completedAt` timestamp to completed waves.
  *
+ * Dependencies outside the epic (another epic or saga) do not shape the
+ * waves, but are resolved against their live status: an open one lands in
+ * `blockedBy` and keeps the task from being `ready`.
+ *
  * @param epicId   - The epic task ID to compute waves for.
  * @param cwd      - Optional project root (falls back to `getTaskAccessor` default).
  * @param accessor - Optional pre-constructed data accessor (useful in tests).
@@ -247,8 +258,17 @@ export async function getEnrichedWaves(
 ): Promise<{ epicId: string; waves: EnrichedWave[]; totalWaves: number; totalTasks: number }> {
   const acc = accessor ?? (await getTaskAccessor(cwd));
   const children = await acc.getChildren(epicId);
-  const waves = computeWaves(children);
-  const taskMap = new Map(children.map((t) => [t.id, t]));
+  const childIds = new Set(children.map((t) => t.id));
+  const externalIds = [...new Set(children.flatMap((t) => t.depends ?? []))].filter(
+    (id) => !childIds.has(id),
+  );
+  const external = externalIds.length > 0 ? await acc.loadTasks(externalIds) : [];
+  const waves = computeWaves(
+    externalIds.length > 0
+      ? children.map((t) => ({ ...t, depends: (t.depends ?? []).filter((d) => childIds.has(d)) }))
+      : children,
+  );
+  const taskMap = new Map([...external, ...children].map((t) => [t.id, t]));
 
   const enrichedWaves: EnrichedWave[] = waves.map((w) => {
     const enrichedTasks = sortWaveTasks(w.tasks.map((id) => enrichTask(id, taskMap)));
@@ -280,3 +300,95 @@ export async function getEnrichedWaves(
     totalTasks: children.length,
   };
 }
+
+/** A task placed in a {@link SequencedWave}. */
+export interface SequencedWaveTask extends TaskRef {
+  /** Task priority level. */
+  priority: TaskPriority;
+  /** Declared dependency IDs. */
+  depends: string[];
+  /**
+   * Open dependencies that are not part of the plan (another saga, an epic,
+   * a proposed task). They do not affect wave placement but still block work.
+   */
+  externalBlockers: string[];
+}
+
+/** One wave of a sequenced plan: every dependency is met by an earlier wave. */
+export interface SequencedWave {
+  /** 1-based wave number. */
+  wave: number;
+  /** Tasks in the wave, priority-first then by ID. */
+  tasks: SequencedWaveTask[];
+}
+
+/** Statuses a task must have to be scheduled by {@link computeSequencedWaves}. */
+const SEQUENCED_STATUSES = new Set(['pending', 'active', 'blocked']);
+
+/**
+ * Order open work into dependency waves.
+ *
+ * Only open `task` / `subtask` rows in `scope` are scheduled — sagas and
+ * epics are containers, and done, cancelled, or proposed work is left out.
+ * Wave N holds the tasks whose in-plan dependencies all sit in waves < N,
+ * ordered by {@link compareByPriority} like `--sort priority`.
+ * Dependencies on finished or missing tasks count as satisfied.
+ *
+ * @param scope - Tasks to schedule (e.g. a saga's subtree).
+ * @param allTasks - Every task, used to classify dependencies outside `scope`.
+ * @throws CleoError `CIRCULAR_REFERENCE` with `details.error = 'dependency_cycle'`
+ *   when the scheduled tasks depend on each other in a cycle.
+ */
+export function computeSequencedWaves(scope: Task[], allTasks: Task[] = scope): SequencedWave[] {
+  const planned = scope.filter(
+    (t) => t.type !== 'saga' && t.type !== 'epic' && SEQUENCED_STATUSES.has(t.status),
+  );
+  const plannedIds = new Set(planned.map((t) => t.id));
+  const byId = new Map(allTasks.map((t) => [t.id, t]));
+
+  const inPlanDeps = new Map<string, string[]>();
+  const external = new Map<string, string[]>();
+  for (const task of planned) {
+    const deps = task.depends ?? [];
+    inPlanDeps.set(task.id, deps.filter((d) => plannedIds.has(d)));
+    external.set(
+      task.id,
+      deps.filter((d) => {
+        if (plannedIds.has(d)) return false;
+        const dep = byId.get(d);
+        return dep !== undefined && !SATISFIED_STATUSES.has(dep.status);
+      }),
+    );
+  }
+
+  const waves: SequencedWave[] = [];
+  const placed = new Set<string>();
+  let remaining = planned;
+  while (remaining.length > 0) {
+    const ready = remaining.filter((t) => (inPlanDeps.get(t.id) ?? []).every((d) => placed.has(d)));
+    if (ready.length === 0) {
+      // Everything left waits on something else left: at least one cycle.
+      const stuck = remaining.map((t) => ({ ...t, depends: inPlanDeps.get(t.id) ?? [] }));
+      for (const t of stuck) {
+        const path = findDependencyCycle(t.id, t.depends, stuck);
+        if (path.length > 0) throw dependencyCycleError(path);
+      }
+      // Unreachable — a stuck set always contains a cycle — but never spin.
+      throw dependencyCycleError(stuck.map((t) => t.id));
+    }
+    const tasks = ready
+      .map((t) => ({
+        id: t.id,
+        title: t.title,
+        status: t.status,
+        priority: (t.priority ?? 'medium') as TaskPriority,
+        depends: t.depends ?? [],
+        externalBlockers: external.get(t.id) ?? [],
+      }))
+      .sort(compareByPriority);
+    waves.push({ wave: waves.length + 1, tasks });
+    for (const t of ready) placed.add(t.id);
+    remaining = remaining.filter((t) => !placed.has(t.id));
+  }
+  return waves;
+}
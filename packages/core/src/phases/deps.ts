@@ -521,6 +521,7 @@ export async function addRelation(
   if (!task.depends.includes(relatedId)) {
     task.depends.push(relatedId);
   }
+  task.updatedAt = new Date().toISOString();
 
   await accessor!.upsertSingleTask(task);
   invalidateDepsCache();
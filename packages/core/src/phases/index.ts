@@ -485,6 +485,7 @@ export async function renamePhase(
   let tasksUpdated = 0;
   for (const task of oldPhaseTasks) {
     task.phase = newName;
+    task.updatedAt = new Date().toISOString();
     await accessor!.upsertSingleTask(task);
     tasksUpdated++;
   }
@@ -561,6 +562,7 @@ export async function deletePhase(
   if (options.reassignTo) {
     for (const task of phaseTasks) {
       task.phase = options.reassignTo;
+      task.updatedAt = new Date().toISOString();
       await accessor!.upsertSingleTask(task);
       tasksReassigned++;
     }
@@ -47,9 +47,18 @@ export {
   renderArchive,
   renderComplete,
   renderDelete,
+  renderExportContent,
   renderFind,
+  renderGraphExport,
+  renderLint,
   renderList,
   renderRestore,
+  renderSagaExport,
   renderShow,
+  renderUndo,
   renderUpdate,
+  renderWorkspaceAdd,
+  renderWorkspaceList,
+  renderWorkspaceRemove,
+  renderWorkspaceTasks,
 } from './tasks/index.js';
@@ -0,0 +1,10 @@
+/**
+ * Human-readable renderer for `cleo export` without `--output` — the
+ * exported content itself (CSV, TSV, JSON or markdown), so it can be
+ * redirected straight to a file.
+ */
+
+/** Render exported content as-is. */
+export function renderExportContent(data: Record<string, unknown>, _quiet: boolean): string {
+  return String(data['content'] ?? '').replace(/\n$/, '');
+}
@@ -0,0 +1,9 @@
+/**
+ * Human-readable renderer for `cleo graph export` — the rendered DOT or
+ * Mermaid source, ready to pipe into `dot -Tsvg` or paste into Markdown.
+ */
+
+/** Render a dependency graph export as its source text. */
+export function renderGraphExport(data: Record<string, unknown>, _quiet: boolean): string {
+  return String(data['rendered'] ?? '');
+}
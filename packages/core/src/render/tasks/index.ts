@@ -24,11 +24,22 @@ import { renderAdd } from './add.js';
 import { renderArchive } from './archive.js';
 import { renderComplete } from './complete.js';
 import { renderDelete } from './delete.js';
+import { renderExportContent } from './export.js';
 import { renderFind } from './find.js';
+import { renderGraphExport } from './graph-export.js';
+import { renderLint } from './lint.js';
 import { renderList } from './list.js';
 import { renderRestore } from './restore.js';
+import { renderSagaExport } from './saga-export.js';
 import { renderShow } from './show.js';
+import { renderUndo } from './undo.js';
 import { renderUpdate } from './update.js';
+import {
+  renderWorkspaceAdd,
+  renderWorkspaceList,
+  renderWorkspaceRemove,
+  renderWorkspaceTasks,
+} from './workspace.js';
 
 /**
  * Wrap a legacy `(data, quiet)` renderer as a typed `Renderer<unknown>` for
@@ -62,15 +73,34 @@ registerRenderer('delete', 'generic', asRenderer(renderDelete));
 registerRenderer('rm', 'generic', asRenderer(renderDelete));
 registerRenderer('archive', 'generic', asRenderer(renderArchive));
 registerRenderer('restore', 'generic', asRenderer(renderRestore));
+registerRenderer('export-content', 'generic', asRenderer(renderExportContent));
+registerRenderer('graph-export', 'generic', asRenderer(renderGraphExport));
+registerRenderer('lint', 'generic', asRenderer(renderLint));
+registerRenderer('saga-export', 'generic', asRenderer(renderSagaExport));
+registerRenderer('undo', 'generic', asRenderer(renderUndo));
+registerRenderer('redo', 'generic', asRenderer(renderUndo));
+registerRenderer('workspace-add', 'generic', asRenderer(renderWorkspaceAdd));
+registerRenderer('workspace-remove', 'generic', asRenderer(renderWorkspaceRemove));
+registerRenderer('workspace-list', 'generic', asRenderer(renderWorkspaceList));
+registerRenderer('workspace-tasks', 'generic', asRenderer(renderWorkspaceTasks));
 
 export {
   renderAdd,
   renderArchive,
   renderComplete,
   renderDelete,
+  renderExportContent,
   renderFind,
+  renderGraphExport,
+  renderLint,
   renderList,
   renderRestore,
+  renderSagaExport,
   renderShow,
+  renderUndo,
   renderUpdate,
+  renderWorkspaceAdd,
+  renderWorkspaceList,
+  renderWorkspaceRemove,
+  renderWorkspaceTasks,
 };
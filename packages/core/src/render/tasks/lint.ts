@@ -0,0 +1,25 @@
+/**
+ * Human-readable renderer for `cleo lint` — one line per violation, then a
+ * summary line.
+ */
+
+interface LintFindingRow {
+  taskId: string | null;
+  rule: string;
+  message: string;
+}
+
+/** Render lint findings. */
+export function renderLint(data: Record<string, unknown>, quiet: boolean): string {
+  const findings = (data['findings'] as LintFindingRow[] | undefined) ?? [];
+  const lines = findings.map((f) => `${f.taskId ?? '-'} ${f.rule}: ${f.message}`);
+  if (quiet) return lines.join('\n');
+  const source = data['source'] === 'store' ? 'task store' : String(data['source']);
+  const taskCount = String(data['taskCount'] ?? 0);
+  lines.push(
+    findings.length === 0
+      ? `${source}: ${taskCount} tasks, no violations`
+      : `${source}: ${findings.length} violation(s) in ${taskCount} tasks`,
+  );
+  return lines.join('\n');
+}
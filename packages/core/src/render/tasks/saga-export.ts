@@ -0,0 +1,10 @@
+/**
+ * Human-readable renderer for `cleo saga export` — the bare bundle JSON, so
+ * `cleo saga export <id> > bundle.json` writes a file that `cleo saga import`
+ * reads directly.
+ */
+
+/** Render a saga export result as its bundle. */
+export function renderSagaExport(data: Record<string, unknown>, _quiet: boolean): string {
+  return JSON.stringify(data['bundle'] ?? {}, null, 2);
+}
@@ -21,6 +21,14 @@ import {
   statusSymbol,
 } from './colors.js';
 
+/** `1h 05m` / `12m` / `40s` for a duration in seconds. */
+function formatSeconds(total: number): string {
+  const h = Math.floor(total / 3600);
+  const m = Math.floor((total % 3600) / 60);
+  if (h > 0) return `${h}h ${String(m).padStart(2, '0')}m`;
+  return m > 0 ? `${m}m` : `${total}s`;
+}
+
 /** Render a single task in a box format (mirrors bash display_text). */
 export function renderShow(data: Record<string, unknown>, quiet: boolean): string {
   const task = data['task'] as Task | undefined;
@@ -62,8 +70,20 @@ function renderShowFull(task: Task): string {
   if (task.type) lines.push(`${BOX.v}  ${DIM}Type:${NC}        ${task.type}`);
   if (task.phase) lines.push(`${BOX.v}  ${DIM}Phase:${NC}       ${task.phase}`);
   if (task.size) lines.push(`${BOX.v}  ${DIM}Size:${NC}        ${task.size}`);
+  // Tracked time vs estimate — the show payload is a TaskRecord carrying timeSpent.
+  const timeSpent = (task as { timeSpent?: number }).timeSpent;
+  if (timeSpent !== undefined || task.estimate != null) {
+    const running = task.timeEntries?.some((e) => !e.stop) ? ' (running)' : '';
+    const estimate = task.estimate != null ? ` / estimate ${task.estimate}` : '';
+    const spent = `${formatSeconds(timeSpent ?? 0)} spent${running}`;
+    lines.push(`${BOX.v}  ${DIM}Time:${NC}        ${spent}${estimate}`);
+  }
   if (task.labels?.length)
     lines.push(`${BOX.v}  ${DIM}Labels:${NC}      ${task.labels.join(', ')}`);
+  if (task.completionRequirements) {
+    const required = task.completionRequirements.join(', ') || 'none';
+    lines.push(`${BOX.v}  ${DIM}Requires:${NC}    ${required}`);
+  }
   if (task.parentId) lines.push(`${BOX.v}  ${DIM}Parent:${NC}      ${task.parentId}`);
 
   const created = shortDate(task.createdAt);
@@ -107,6 +127,18 @@ function renderShowFull(task: Task): string {
     }
   }
 
+  // Note history — the show payload is a TaskRecord, already newest first.
+  if (task.noteHistory?.length) {
+    lines.push(`${BOX.ml}${hr}${BOX.mr}`);
+    lines.push(`${BOX.v}  ${BOLD}Note History${NC} (${task.noteHistory.length})`);
+    for (const note of task.noteHistory) {
+      const at = note.at.slice(0, 16).replace('T', ' ');
+      lines.push(`${BOX.v}    ${DIM}${at} ${note.author}${NC}`);
+      const short = note.text.length > 58 ? note.text.slice(0, 55) + '...' : note.text;
+      lines.push(`${BOX.v}      ${short}`);
+    }
+  }
+
   // Files
   if (task.files?.length) {
     lines.push(`${BOX.ml}${hr}${BOX.mr}`);
@@ -114,6 +146,25 @@ function renderShowFull(task: Task): string {
     lines.push(`${BOX.v}    ${task.files.join(', ')}`);
   }
 
+  // Linked commits
+  if (task.commits?.length) {
+    lines.push(`${BOX.ml}${hr}${BOX.mr}`);
+    lines.push(`${BOX.v}  ${BOLD}Commits${NC} (${task.commits.length})`);
+    for (const sha of task.commits) {
+      lines.push(`${BOX.v}    ${sha.slice(0, 12)}`);
+    }
+  }
+
+  // Project custom fields
+  const custom = Object.entries(task.custom ?? {});
+  if (custom.length) {
+    lines.push(`${BOX.ml}${hr}${BOX.mr}`);
+    lines.push(`${BOX.v}  ${BOLD}Custom Fields${NC}`);
+    for (const [name, value] of custom) {
+      lines.push(`${BOX.v}    ${name}: ${value}`);
+    }
+  }
+
   // Acceptance criteria
   if (task.acceptance?.length) {
     lines.push(`${BOX.ml}${hr}${BOX.mr}`);
@@ -0,0 +1,24 @@
+/**
+ * Human-readable renderer for `cleo undo` / `cleo redo` — one journal entry
+ * replayed, with the rows it restored and removed.
+ */
+
+import { BOLD, DIM, GREEN, NC } from './colors.js';
+
+/** Render an undo or redo result. */
+export function renderUndo(data: Record<string, unknown>, quiet: boolean): string {
+  const entryId = data['entryId'] as string | undefined;
+  if (!entryId) return 'Nothing to replay.';
+  if (quiet) return entryId;
+
+  const verb = data['kind'] === 'redo' ? 'Redid' : 'Undid';
+  const restored = (data['restored'] as string[] | undefined) ?? [];
+  const removed = (data['removed'] as string[] | undefined) ?? [];
+  const lines = [
+    `${GREEN}${verb}:${NC} ${BOLD}${String(data['operation'])}${NC} ` +
+      `${DIM}(${entryId}) from ${String(data['at'])}${NC}`,
+  ];
+  if (restored.length > 0) lines.push(`  restored: ${restored.join(', ')}`);
+  if (removed.length > 0) lines.push(`  removed: ${removed.join(', ')}`);
+  return lines.join('\n');
+}
@@ -0,0 +1,68 @@
+/**
+ * Human-readable renderers for `cleo workspace` — registered projects and
+ * tasks queried across them.
+ */
+
+import { BOLD, DIM, GREEN, NC, YELLOW } from './colors.js';
+
+interface WorkspaceProjectRow {
+  name: string;
+  root: string;
+}
+
+interface WorkspaceSkipRow {
+  project: string;
+  root: string;
+  reason: string;
+}
+
+interface WorkspaceTaskRow {
+  id: string;
+  status: string;
+  priority: string;
+  title: string;
+}
+
+/** Render `cleo workspace add`. */
+export function renderWorkspaceAdd(data: Record<string, unknown>, quiet: boolean): string {
+  const project = data['project'] as WorkspaceProjectRow | undefined;
+  if (!project) return 'No project registered.';
+  if (quiet) return project.name;
+  const verb = data['added'] ? 'Added' : 'Updated';
+  return `${GREEN}${verb}:${NC} ${BOLD}${project.name}${NC} ${DIM}(${project.root})${NC}`;
+}
+
+/** Render `cleo workspace remove`. */
+export function renderWorkspaceRemove(data: Record<string, unknown>, quiet: boolean): string {
+  const name = String(data['name'] ?? '');
+  if (!data['removed']) return `${name} is not in the workspace`;
+  if (quiet) return name;
+  return `${GREEN}Removed:${NC} ${BOLD}${name}${NC}`;
+}
+
+/** Render `cleo workspace list` as name / root columns. */
+export function renderWorkspaceList(data: Record<string, unknown>, quiet: boolean): string {
+  const projects = (data['projects'] as WorkspaceProjectRow[] | undefined) ?? [];
+  if (quiet) return projects.map((p) => p.name).join('\n');
+  if (projects.length === 0) return '(no projects; add one with cleo workspace add <path>)';
+  const width = Math.max(...projects.map((p) => p.name.length));
+  return projects.map((p) => `${p.name.padEnd(width)}  ${p.root}`).join('\n');
+}
+
+/** Render `cleo workspace tasks` as one row per `project:T42` task. */
+export function renderWorkspaceTasks(data: Record<string, unknown>, quiet: boolean): string {
+  const results = (data['results'] as WorkspaceTaskRow[] | undefined) ?? [];
+  if (quiet) return results.map((t) => t.id).join('\n');
+  const width = Math.max(0, ...results.map((t) => t.id.length));
+  const lines = results.map(
+    (t) => `${t.id.padEnd(width)}  ${t.status.padEnd(10)}${t.priority.padEnd(9)}${t.title}`,
+  );
+  const skipped = (data['skipped'] as WorkspaceSkipRow[] | undefined) ?? [];
+  for (const s of skipped) {
+    lines.push(`${YELLOW}skipped${NC} ${s.project} (${s.root}): ${s.reason}`);
+  }
+  const total = String(data['total'] ?? results.length);
+  const projects = (data['projects'] as unknown[] | undefined)?.length ?? 0;
+  lines.push(`${DIM}${total} task(s) from ${projects} project(s)${NC}`);
+  return lines.join('\n');
+}
@@ -38,22 +38,30 @@ export const CLI_COMMAND_CATEGORIES: Readonly<Record<string, CliCategory>> = {
   // --- Task Management ---
   add: 'Task Management',
   'add-batch': 'Task Management',
+  batch: 'Task Management',
   show: 'Task Management',
   find: 'Task Management',
   list: 'Task Management',
   update: 'Task Management',
   complete: 'Task Management',
   delete: 'Task Management',
+  trash: 'Task Management',
   cancel: 'Task Management',
   start: 'Task Management',
   stop: 'Task Management',
   current: 'Task Management',
   next: 'Task Management',
+  overdue: 'Task Management',
+  stale: 'Task Management',
   exists: 'Task Management',
+  watch: 'Task Management',
+  undo: 'Task Management',
+  redo: 'Task Management',
 
   // --- Task Organization ---
   archive: 'Task Organization',
   labels: 'Task Organization',
+  fields: 'Task Organization',
   promote: 'Task Organization',
   relates: 'Task Organization',
   reorder: 'Task Organization',
@@ -64,6 +72,7 @@ export const CLI_COMMAND_CATEGORIES: Readonly<Record<string, CliCategory>> = {
   claim: 'Task Organization',
   unclaim: 'Task Organization',
   saga: 'Task Organization',
+  epic: 'Task Organization',
   req: 'Task Organization',
   pivot: 'Task Organization',
 
@@ -96,6 +105,7 @@ export const CLI_COMMAND_CATEGORIES: Readonly<Record<string, CliCategory>> = {
   // --- Analysis & Stats ---
   analyze: 'Analysis & Stats',
   stats: 'Analysis & Stats',
+  report: 'Analysis & Stats',
   history: 'Analysis & Stats',
   'archive-stats': 'Analysis & Stats',
   complexity: 'Analysis & Stats',
@@ -107,6 +117,7 @@ export const CLI_COMMAND_CATEGORIES: Readonly<Record<string, CliCategory>> = {
   // --- Validation & Compliance ---
   check: 'Validation & Compliance',
   verify: 'Validation & Compliance',
+  lint: 'Validation & Compliance',
   testing: 'Validation & Compliance',
   compliance: 'Validation & Compliance',
   consensus: 'Validation & Compliance',
@@ -125,6 +136,7 @@ export const CLI_COMMAND_CATEGORIES: Readonly<Record<string, CliCategory>> = {
   graph: 'Code & Documentation',
   'agent-outputs': 'Code & Documentation',
   changeset: 'Code & Documentation',
+  git: 'Code & Documentation',
 
   // --- Research & Orchestration ---
   research: 'Research & Orchestration',
@@ -151,6 +163,7 @@ export const CLI_COMMAND_CATEGORIES: Readonly<Record<string, CliCategory>> = {
   pull: 'Collaboration',
   checkpoint: 'Collaboration',
   federation: 'Collaboration',
+  workspace: 'Collaboration',
 
   // --- Agents ---
   agent: 'Agents',
@@ -189,6 +202,7 @@ export const CLI_COMMAND_CATEGORIES: Readonly<Record<string, CliCategory>> = {
   skills: 'System & Admin',
   skill: 'System & Admin',
   web: 'System & Admin',
+  webhook: 'System & Admin',
   backup: 'System & Admin',
   restore: 'System & Admin',
   caamp: 'System & Admin',
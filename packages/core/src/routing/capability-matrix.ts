@@ -147,6 +147,83 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'either',
   },
+  {
+    domain: 'tasks',
+    operation: 'trash.list',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'archive.list',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'overdue',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'stale',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'commits',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'time',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'fields.list',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'estimate.rollup',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'burndown',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'graph',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'deps.trace',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
   // Mutate operations
   { domain: 'tasks', operation: 'add', gateway: 'mutate', mode: 'native', preferredChannel: 'cli' },
   {
@@ -156,6 +233,13 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'cli',
   },
+  {
+    domain: 'tasks',
+    operation: 'batch',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
   {
     domain: 'tasks',
     operation: 'update',
@@ -205,6 +289,160 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'either',
   },
+  {
+    domain: 'tasks',
+    operation: 'move',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'merge',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'split',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'trash.restore',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'archive.restore',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'trash.empty',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'stale.reset',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'block',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'unblock',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'note',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'link-commit',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'commits.scan',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'fields.add',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'import.markdown',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'renumber',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'undo',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'redo',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'set-status',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'time.start',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'time.stop',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'label.rename',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
+  {
+    domain: 'tasks',
+    operation: 'label.merge',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
   {
     domain: 'tasks',
     operation: 'reorder',
@@ -255,6 +493,41 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'cli',
   },
+  {
+    domain: 'tasks',
+    operation: 'saga.critical-path',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'cli',
+  },
+  {
+    domain: 'tasks',
+    operation: 'saga.deps',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'cli',
+  },
+  {
+    domain: 'tasks',
+    operation: 'saga.schedule',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'cli',
+  },
+  {
+    domain: 'tasks',
+    operation: 'saga.export',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'cli',
+  },
+  {
+    domain: 'tasks',
+    operation: 'saga.import',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'cli',
+  },
   {
     domain: 'tasks',
     operation: 'start',
@@ -568,6 +841,13 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'either',
   },
+  {
+    domain: 'admin',
+    operation: 'webhook.test',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
   {
     domain: 'admin',
     operation: 'token',
@@ -757,6 +1037,13 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'either',
   },
+  {
+    domain: 'orchestrate',
+    operation: 'tracks',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
   {
     domain: 'orchestrate',
     operation: 'analyze',
@@ -778,6 +1065,13 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'either',
   },
+  {
+    domain: 'orchestrate',
+    operation: 'sequence',
+    gateway: 'query',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
   {
     domain: 'orchestrate',
     operation: 'bootstrap',
@@ -807,6 +1101,13 @@ const CAPABILITY_MATRIX: OperationCapability[] = [
     mode: 'native',
     preferredChannel: 'cli',
   },
+  {
+    domain: 'orchestrate',
+    operation: 'claim',
+    gateway: 'mutate',
+    mode: 'native',
+    preferredChannel: 'either',
+  },
   {
     domain: 'orchestrate',
     operation: 'handoff',
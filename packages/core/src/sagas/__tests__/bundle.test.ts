@@ -0,0 +1,127 @@
+/**
+ * Tests for `cleo saga export` / `cleo saga import` — portable Saga bundles.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { importSagaBundle, parseSagaBundle, sagaExport } from '../bundle.js';
+
+describe('saga bundles', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T100', title: 'Launch', status: 'active', type: 'saga' },
+      { id: 'T101', title: 'Backend', status: 'pending', type: 'epic', parentId: 'T100' },
+      { id: 'T102', title: 'Schema', status: 'done', type: 'task', parentId: 'T101' },
+      {
+        id: 'T103',
+        title: 'API',
+        status: 'pending',
+        type: 'task',
+        parentId: 'T101',
+        labels: ['api', 'v2'],
+        depends: ['T102', 'T900'],
+      },
+      { id: 'T900', title: 'Elsewhere', status: 'pending', type: 'task', labels: ['api'] },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  async function exportToFile(): Promise<void> {
+    const result = await sagaExport(env.tempDir, { sagaId: 'T100' }, env.accessor);
+    expect(result.success).toBe(true);
+    await writeFile(join(env.tempDir, 'bundle.json'), JSON.stringify(result.data?.bundle));
+  }
+
+  it('exports the saga subtree parent-first with depends and labels', async () => {
+    const result = await sagaExport(env.tempDir, { sagaId: 'T100' }, env.accessor);
+    const bundle = result.data?.bundle;
+    expect(bundle?.format).toBe('cleo-saga-bundle');
+    expect(bundle?.tasks.map((t) => t.id)).toEqual(['T100', 'T101', 'T102', 'T103']);
+    expect(bundle?.tasks[3]).toMatchObject({ depends: ['T102', 'T900'], labels: ['api', 'v2'] });
+
+    const missing = await sagaExport(env.tempDir, { sagaId: 'T101' }, env.accessor);
+    expect(missing.error?.code).toBe('E_NOT_FOUND');
+  });
+
+  it('re-creates the tree under fresh IDs and remaps parents and depends', async () => {
+    await exportToFile();
+    const result = await importSagaBundle({ file: 'bundle.json' }, env.tempDir, env.accessor);
+
+    expect(result.created).toBe(4);
+    expect(Object.keys(result.idMap)).toEqual(['T100', 'T101', 'T102', 'T103']);
+    expect(new Set(Object.values(result.idMap)).size).toBe(4);
+    expect(Object.values(result.idMap)).not.toContain('T100');
+    expect(result.sagaId).toBe(result.idMap['T100']);
+
+    const api = await env.accessor.loadSingleTask(result.idMap['T103']!);
+    expect(api).toMatchObject({ parentId: result.idMap['T101'], depends: [result.idMap['T102']] });
+    const saga = await env.accessor.loadSingleTask(result.sagaId);
+    expect(saga).toMatchObject({ type: 'saga', parentId: null });
+  });
+
+  it('reads a bundle still wrapped in the export envelope', async () => {
+    const result = await sagaExport(env.tempDir, { sagaId: 'T100' }, env.accessor);
+    const envelope = JSON.stringify({ success: true, data: result.data, meta: {} });
+    expect(parseSagaBundle(envelope).tasks.map((t) => t.id)).toEqual([
+      'T100',
+      'T101',
+      'T102',
+      'T103',
+    ]);
+  });
+
+  it('drops out-of-bundle depends with a warning and reports label reuse', async () => {
+    await exportToFile();
+    const result = await importSagaBundle({ file: 'bundle.json' }, env.tempDir, env.accessor);
+
+    expect(result.droppedDepends).toEqual([{ taskId: 'T103', dependsOn: 'T900' }]);
+    expect(result.warnings).toHaveLength(1);
+    expect(result.reusedLabels).toEqual(['api', 'v2']);
+    expect(result.newLabels).toEqual([]);
+  });
+
+  it('drops an ID prefix the project already uses instead of failing', async () => {
+    const saga = await env.accessor.loadSingleTask('T100');
+    await env.accessor.upsertSingleTask({ ...saga!, idPrefix: 'LAUNCH' });
+    await exportToFile();
+    const result = await importSagaBundle({ file: 'bundle.json' }, env.tempDir, env.accessor);
+
+    expect(result.created).toBe(4);
+    expect(result.warnings).toContainEqual(expect.stringMatching(/^Dropped ID prefix LAUNCH/));
+    expect((await env.accessor.loadSingleTask(result.sagaId))?.idPrefix ?? null).toBeNull();
+    expect(result.idMap['T101']).toMatch(/^T\d+$/);
+  });
+
+  it('rejects missing files and malformed bundles', async () => {
+    await expect(
+      importSagaBundle({ file: 'nope.json' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+    expect(() => parseSagaBundle('{"format":"other"}')).toThrow(/version 1/);
+    expect(() =>
+      parseSagaBundle(
+        JSON.stringify({
+          format: 'cleo-saga-bundle',
+          version: 1,
+          sagaId: 'T1',
+          tasks: [
+            { id: 'T1', type: 'saga' },
+            { id: 'T2', type: 'epic', parentId: 'T9' },
+          ],
+        }),
+      ),
+    ).toThrow(/parent outside the bundle/);
+  });
+});
@@ -0,0 +1,111 @@
+/**
+ * Tests for computeSagaCriticalPath — the estimate-weighted longest chain
+ * behind `cleo saga critical-path <sagaId>`.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { describe, expect, it } from 'vitest';
+import { computeSagaCriticalPath } from '../critical-path.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    status: 'pending',
+    priority: 'medium',
+    type: 'task',
+    parentId: 'E001',
+    createdAt: '2026-01-01T00:00:00Z',
+    updatedAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+const saga = makeTask('SG01', { type: 'saga', parentId: null });
+const epic = makeTask('E001', { type: 'epic', parentId: 'SG01' });
+
+describe('computeSagaCriticalPath', () => {
+  it('returns the heaviest chain, defaulting unestimated tasks to 1', () => {
+    const result = computeSagaCriticalPath('SG01', [
+      saga,
+      epic,
+      makeTask('T001', { estimate: 3 }),
+      makeTask('T002', { estimate: 2, depends: ['T001'] }),
+      makeTask('T003', { depends: ['T001'] }),
+      makeTask('T004', { estimate: 4, depends: ['T002'] }),
+      makeTask('T005', { depends: ['T003'] }),
+    ]);
+
+    expect(result.path.map((n) => n.id)).toEqual(['T001', 'T002', 'T004']);
+    expect(result.length).toBe(9);
+    expect(result.unestimated).toEqual(['T003', 'T005']);
+    expect(result.taskCount).toBe(5);
+  });
+
+  it('flags defaulted nodes on the path', () => {
+    const result = computeSagaCriticalPath('SG01', [
+      saga,
+      epic,
+      makeTask('T001'),
+      makeTask('T002', { depends: ['T001'] }),
+      makeTask('T003', { estimate: 1 }),
+    ]);
+
+    expect(result.length).toBe(2);
+    expect(result.path).toEqual([
+      { id: 'T001', title: 'T001', status: 'pending', weight: 1, estimateDefaulted: true },
+      { id: 'T002', title: 'T002', status: 'pending', weight: 1, estimateDefaulted: true },
+    ]);
+  });
+
+  it('leaves finished work out and folds subtasks into their estimated parent', () => {
+    const result = computeSagaCriticalPath('SG01', [
+      saga,
+      epic,
+      makeTask('T001', { estimate: 8, status: 'done' }),
+      makeTask('T002', { estimate: 2, depends: ['T001'] }),
+      makeTask('T003', { type: 'subtask', parentId: 'T002', estimate: 5 }),
+      makeTask('T004', { estimate: 1, depends: ['T003'] }),
+    ]);
+
+    expect(result.path.map((n) => n.id)).toEqual(['T002', 'T004']);
+    expect(result.length).toBe(3);
+  });
+
+  it('expands a dependency on an epic to the open work under it', () => {
+    const result = computeSagaCriticalPath('SG01', [
+      saga,
+      epic,
+      makeTask('E002', { type: 'epic', parentId: 'SG01', depends: ['E001'] }),
+      makeTask('T001', { estimate: 2 }),
+      makeTask('T002', { estimate: 5 }),
+      makeTask('T003', { parentId: 'E002', estimate: 1 }),
+    ]);
+
+    expect(result.path.map((n) => n.id)).toEqual(['T002', 'T003']);
+    expect(result.length).toBe(6);
+  });
+
+  it('returns an empty path when nothing is open', () => {
+    const result = computeSagaCriticalPath('SG01', [saga, epic]);
+    expect(result).toEqual({ sagaId: 'SG01', path: [], length: 0, unestimated: [], taskCount: 0 });
+  });
+
+  it('throws dependency_cycle when open units depend on each other', () => {
+    expect(() =>
+      computeSagaCriticalPath('SG01', [
+        saga,
+        epic,
+        makeTask('T001', { depends: ['T002'] }),
+        makeTask('T002', { depends: ['T001'] }),
+      ]),
+    ).toThrow(
+      expect.objectContaining({
+        code: ExitCode.CIRCULAR_REFERENCE,
+        details: expect.objectContaining({ error: 'dependency_cycle' }),
+      }),
+    );
+  });
+});
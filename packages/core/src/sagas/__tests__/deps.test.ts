@@ -0,0 +1,69 @@
+/**
+ * Tests for computeSagaDeps — the cross-Saga coupling behind
+ * `cleo saga deps <sagaId>`.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { describe, expect, it } from 'vitest';
+import { computeSagaDeps } from '../deps.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    status: 'pending',
+    priority: 'medium',
+    type: 'task',
+    createdAt: '2026-01-01T00:00:00Z',
+    updatedAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+const tasks: Task[] = [
+  makeTask('SG01', { type: 'saga', title: 'Platform' }),
+  makeTask('E001', { type: 'epic', parentId: 'SG01' }),
+  makeTask('T001', { parentId: 'E001' }),
+  makeTask('T002', { parentId: 'E001', status: 'done' }),
+  makeTask('SG02', { type: 'saga', title: 'Billing', status: 'active' }),
+  makeTask('E002', { type: 'epic', parentId: 'SG02' }),
+  makeTask('T010', { parentId: 'E002', depends: ['T001', 'T002'] }),
+  makeTask('T011', { type: 'subtask', parentId: 'T010', depends: ['T020', 'T012'] }),
+  makeTask('T012', { parentId: 'E002' }),
+  makeTask('T013', { parentId: 'E002', depends: ['T030'] }),
+  makeTask('SG03', { type: 'saga', title: 'Reporting' }),
+  makeTask('T020', { parentId: 'SG03', status: 'archived' }),
+  makeTask('T030', { title: 'Loose task' }),
+];
+
+describe('computeSagaDeps', () => {
+  it('groups cross-Saga edges by the Saga they point into', () => {
+    const result = computeSagaDeps('SG02', tasks);
+
+    expect(result.dependsOn).toEqual([
+      {
+        sagaId: 'SG01',
+        title: 'Platform',
+        status: 'pending',
+        edges: [
+          { taskId: 'T010', dependsOn: 'T001', status: 'pending' },
+          { taskId: 'T010', dependsOn: 'T002', status: 'done' },
+        ],
+        open: 1,
+      },
+      {
+        sagaId: 'SG03',
+        title: 'Reporting',
+        status: 'pending',
+        edges: [{ taskId: 'T011', dependsOn: 'T020', status: 'archived' }],
+        open: 0,
+      },
+    ]);
+    expect(result.open).toBe(1);
+  });
+
+  it('reports nothing for a Saga with no outgoing cross-Saga edges', () => {
+    expect(computeSagaDeps('SG01', tasks)).toEqual({ sagaId: 'SG01', dependsOn: [], open: 0 });
+  });
+});
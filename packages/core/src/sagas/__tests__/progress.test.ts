@@ -0,0 +1,159 @@
+/**
+ * Tests for computeSagaProgress — the completion rollup carried by
+ * `cleo saga show` and `cleo saga list`.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { deleteTask } from '../../tasks/delete.js';
+import { taskEstimateRollup } from '../../tasks/estimate.js';
+import { sagaList } from '../list.js';
+import { computeSagaProgress } from '../progress.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    status: 'pending',
+    priority: 'medium',
+    type: 'task',
+    parentId: 'E001',
+    createdAt: '2026-01-01T00:00:00Z',
+    updatedAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+const saga = makeTask('SG01', { type: 'saga', parentId: null });
+const epic = makeTask('E001', { type: 'epic', parentId: 'SG01' });
+
+describe('computeSagaProgress', () => {
+  it('counts leaf work items by status, skipping containers and cancelled work', () => {
+    const result = computeSagaProgress('SG01', [
+      saga,
+      epic,
+      makeTask('T001', { status: 'done' }),
+      makeTask('T002', { status: 'active' }),
+      makeTask('T003'),
+      makeTask('T004', { status: 'cancelled' }),
+      // T005 is counted through its subtasks.
+      makeTask('T005', { status: 'active' }),
+      makeTask('T006', { type: 'subtask', parentId: 'T005', status: 'done' }),
+      makeTask('T007', { type: 'subtask', parentId: 'T005', status: 'blocked' }),
+    ]);
+
+    expect(result).toEqual({
+      progress: 0.4,
+      counts: { done: 2, in_progress: 1, blocked: 1, pending: 1 },
+      total: 5,
+      progressStatus: 'in_progress',
+    });
+  });
+
+  it('is blocked only when a blocker sits on the critical path', () => {
+    const onPath = computeSagaProgress('SG01', [
+      saga,
+      epic,
+      makeTask('T001', { status: 'blocked', estimate: 5 }),
+      makeTask('T002', { depends: ['T001'] }),
+      makeTask('T003', { status: 'done' }),
+    ]);
+    const offPath = computeSagaProgress('SG01', [
+      saga,
+      epic,
+      makeTask('T001', { estimate: 5 }),
+      makeTask('T002', { depends: ['T001'] }),
+      makeTask('T003', { status: 'blocked' }),
+    ]);
+
+    expect(onPath.progressStatus).toBe('blocked');
+    expect(offPath.progressStatus).toBe('not_started');
+    expect(offPath.counts.blocked).toBe(1);
+  });
+
+  it('is done when every work item is done', () => {
+    const result = computeSagaProgress('SG01', [
+      saga,
+      epic,
+      makeTask('T001', { status: 'done' }),
+      makeTask('T002', { status: 'archived' }),
+    ]);
+
+    expect(result.progress).toBe(1);
+    expect(result.progressStatus).toBe('done');
+  });
+
+  it('reports an empty saga as not started', () => {
+    expect(computeSagaProgress('SG01', [saga, epic])).toEqual({
+      progress: 0,
+      counts: { done: 0, in_progress: 0, blocked: 0, pending: 0 },
+      total: 0,
+      progressStatus: 'not_started',
+    });
+  });
+});
+
+describe('saga progress in saga show / saga list', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T100', title: 'Saga', type: 'saga', status: 'active' },
+      { id: 'T101', title: 'Epic', type: 'epic', parentId: 'T100' },
+      { id: 'T102', title: 'Done', parentId: 'T101', status: 'done' },
+      { id: 'T103', title: 'Open', parentId: 'T101' },
+      { id: 'T104', title: 'Open', parentId: 'T101' },
+      { id: 'T105', title: 'Working', parentId: 'T101', status: 'active' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('adds the rollup to the saga estimate rollup', async () => {
+    const result = await taskEstimateRollup(env.tempDir, { taskId: 'T100' });
+
+    expect(result.success).toBe(true);
+    if (!result.success) return;
+    expect(result.data).toMatchObject({
+      progress: 0.25,
+      counts: { done: 1, in_progress: 1, blocked: 0, pending: 2 },
+      total: 4,
+      progressStatus: 'in_progress',
+    });
+  });
+
+  it('leaves tasks removed with cleo delete out of the rollup', async () => {
+    await deleteTask({ taskId: 'T103' }, env.tempDir, env.accessor);
+    await deleteTask({ taskId: 'T104' }, env.tempDir, env.accessor);
+    const result = await taskEstimateRollup(env.tempDir, { taskId: 'T100' });
+
+    expect(result.success).toBe(true);
+    if (!result.success) return;
+    expect(result.data).toMatchObject({
+      progress: 0.5,
+      counts: { done: 1, in_progress: 1, blocked: 0, pending: 0 },
+      total: 2,
+    });
+  });
+
+  it('adds progress and progressStatus to each listed saga', async () => {
+    const result = await sagaList(env.tempDir);
+
+    expect(result.success).toBe(true);
+    if (!result.success) return;
+    expect(result.data.sagas[0]).toMatchObject({
+      id: 'T100',
+      progress: 0.25,
+      progressStatus: 'in_progress',
+    });
+  });
+});
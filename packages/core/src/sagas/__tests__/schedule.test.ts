@@ -0,0 +1,113 @@
+/**
+ * Tests for computeSagaSchedule — the backward pass from the Saga due date
+ * behind `cleo saga schedule <sagaId>`.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { describe, expect, it } from 'vitest';
+import { computeSagaSchedule } from '../schedule.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    status: 'pending',
+    priority: 'medium',
+    type: 'task',
+    parentId: 'E001',
+    createdAt: '2026-01-01T00:00:00Z',
+    updatedAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+const saga = makeTask('SG01', { type: 'saga', parentId: null, due: '2026-07-01T00:00:00Z' });
+const epic = makeTask('E001', { type: 'epic', parentId: 'SG01' });
+
+describe('computeSagaSchedule', () => {
+  const tasks = [
+    saga,
+    epic,
+    makeTask('T001', { estimate: 8 }),
+    makeTask('T002', { estimate: 4, depends: ['T001'] }),
+    makeTask('T003', { depends: ['T001'] }),
+    makeTask('T004', { estimate: 2, depends: ['T002', 'T003'] }),
+  ];
+
+  it('works back from the due date through the tightest dependent', () => {
+    const result = computeSagaSchedule('SG01', tasks, {
+      asOf: new Date('2026-06-01T00:00:00Z'),
+    });
+
+    const byId = new Map(result.schedule.map((e) => [e.id, e]));
+    expect(byId.get('T004')).toMatchObject({
+      latestFinish: '2026-07-01T00:00:00.000Z',
+      latestStart: '2026-06-30T22:00:00.000Z',
+    });
+    expect(byId.get('T003')).toMatchObject({
+      latestFinish: '2026-06-30T22:00:00.000Z',
+      latestStart: '2026-06-30T21:00:00.000Z',
+      estimateDefaulted: true,
+    });
+    // T002 (4h) is tighter than T003 (1h), so it bounds T001's finish.
+    expect(byId.get('T001')).toMatchObject({
+      latestFinish: '2026-06-30T18:00:00.000Z',
+      latestStart: '2026-06-30T10:00:00.000Z',
+    });
+    expect(result.schedule.map((e) => e.id)).toEqual(['T001', 'T002', 'T003', 'T004']);
+    expect(result.criticalPath).toEqual(['T001', 'T002', 'T004']);
+    expect(result.unestimated).toEqual(['T003']);
+    expect(result.feasible).toBe(true);
+    expect(result.atRisk).toEqual([]);
+  });
+
+  it('flags tasks whose latest start has passed and scales by hoursPerUnit', () => {
+    const result = computeSagaSchedule('SG01', tasks, {
+      hoursPerUnit: 8,
+      asOf: new Date('2026-06-26T00:00:00Z'),
+    });
+
+    // T001 must start 14 workdays (112h) before the due date: 2026-06-26T08:00Z.
+    expect(result.schedule[0]).toMatchObject({ id: 'T001', atRisk: false });
+    const late = computeSagaSchedule('SG01', tasks, {
+      hoursPerUnit: 8,
+      asOf: new Date('2026-06-26T09:00:00Z'),
+    });
+    expect(late.atRisk).toEqual(['T001']);
+    expect(late.feasible).toBe(false);
+  });
+
+  it('leaves finished work out of the schedule', () => {
+    const result = computeSagaSchedule(
+      'SG01',
+      [
+        saga,
+        epic,
+        makeTask('T001', { estimate: 8, status: 'done' }),
+        makeTask('T002', { estimate: 4, depends: ['T001'] }),
+      ],
+      { asOf: new Date('2026-06-01T00:00:00Z') },
+    );
+
+    expect(result.schedule.map((e) => e.id)).toEqual(['T002']);
+  });
+
+  it('rejects a Saga without a due date', () => {
+    expect(() =>
+      computeSagaSchedule('SG01', [{ ...saga, due: undefined }, epic, makeTask('T001')]),
+    ).toThrow(expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }));
+  });
+
+  it('rejects a dependency cycle', () => {
+    expect(() =>
+      computeSagaSchedule('SG01', [
+        saga,
+        epic,
+        makeTask('T001', { depends: ['T002'] }),
+        makeTask('T002', { depends: ['T001'] }),
+      ]),
+    ).toThrow(expect.objectContaining({ code: ExitCode.CIRCULAR_REFERENCE }));
+  });
+});
@@ -0,0 +1,337 @@
+/**
+ * saga.export / saga.import — move a Saga between projects as a portable
+ * JSON bundle.
+ *
+ * Export snapshots the Saga and every live descendant (trashed tasks are
+ * left out) with their dependencies and labels. Import re-creates the tree
+ * under freshly allocated IDs, rewrites `parentId` and `depends` through the
+ * old→new map, and drops any dependency whose target is not in the bundle.
+ * Labels are plain strings, so a bundle label that already exists in the
+ * target project is simply reused; the result reports which ones were.
+ *
+ * Like `coreTaskImport`, import is a data-movement path: rows are written
+ * directly in one transaction, so creation-time acceptance and session gates
+ * do not apply. `relates` edges are not carried, and custom field values
+ * the target project has not declared are dropped with a warning, as is a
+ * saga ID prefix the target project already uses.
+ */
+
+import { randomBytes } from 'node:crypto';
+import { readFileSync } from 'node:fs';
+import { resolve } from 'node:path';
+import type {
+  Task,
+  TasksSagaBundle,
+  TasksSagaExportParams,
+  TasksSagaExportResult,
+  TasksSagaImportDroppedDep,
+  TasksSagaImportParams,
+  TasksSagaImportResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { resolveOrCwd } from '../paths.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+import { validateLabels } from '../tasks/add.js';
+import { loadCustomFields } from '../tasks/custom-fields.js';
+import {
+  allocateTaskIdUnder,
+  assertIdPrefixAvailable,
+  normalizeIdPrefix,
+} from '../tasks/id-prefix.js';
+import { listLabels } from '../tasks/labels.js';
+import { resolveSagaMemberIds } from './storage.js';
+
+/** Value of {@link TasksSagaBundle.format}. */
+export const SAGA_BUNDLE_FORMAT = 'cleo-saga-bundle';
+
+/**
+ * Order tasks so every parent precedes its children, keeping the input
+ * order among siblings. Tasks whose parent is not in the list come first.
+ */
+function parentFirst(tasks: readonly Task[]): Task[] {
+  const children = new Map<string | null, Task[]>();
+  const ids = new Set(tasks.map((t) => t.id));
+  for (const task of tasks) {
+    const key = task.parentId && ids.has(task.parentId) ? task.parentId : null;
+    children.set(key, [...(children.get(key) ?? []), task]);
+  }
+  const out: Task[] = [];
+  const visit = (key: string | null): void => {
+    for (const task of children.get(key) ?? []) {
+      out.push(task);
+      visit(task.id);
+    }
+  };
+  visit(null);
+  return out;
+}
+
+/**
+ * Build the bundle for `sagaId` from its subtree.
+ *
+ * @param sagaId - Saga task ID.
+ * @param subtree - The Saga's subtree (as returned by `getSubtree`).
+ */
+export function buildSagaBundle(sagaId: string, subtree: readonly Task[]): TasksSagaBundle {
+  const tasks = parentFirst(
+    subtree
+      .filter((t) => !t.deletedAt)
+      .sort((a, b) => (a.position ?? 0) - (b.position ?? 0) || a.id.localeCompare(b.id)),
+  ).map(({ relates: _relates, ...task }) => task);
+  return {
+    format: SAGA_BUNDLE_FORMAT,
+    version: 1,
+    exportedAt: new Date().toISOString(),
+    sagaId,
+    tasks,
+  };
+}
+
+/**
+ * Parse and check a bundle document.
+ *
+ * @throws CleoError `VALIDATION_ERROR` when the text is not a version-1 bundle,
+ *   task IDs repeat, the root is not the Saga, or a parent is missing.
+ */
+export function parseSagaBundle(text: string): TasksSagaBundle {
+  let bundle: Partial<TasksSagaBundle>;
+  try {
+    const parsed = JSON.parse(text) as { data?: { bundle?: Partial<TasksSagaBundle> } };
+    // `cleo saga export` in JSON mode writes the bundle inside its envelope.
+    bundle = parsed?.data?.bundle ?? (parsed as Partial<TasksSagaBundle>);
+  } catch {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'Saga bundle is not valid JSON', {
+      fix: 'Create the file with: cleo saga export <sagaId> > bundle.json',
+    });
+  }
+  if (bundle?.format !== SAGA_BUNDLE_FORMAT || bundle.version !== 1) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'Not a version 1 Saga bundle', {
+      fix: 'Create the file with: cleo saga export <sagaId> > bundle.json',
+      details: {
+        field: 'format',
+        expected: `${SAGA_BUNDLE_FORMAT} v1`,
+        actual: `${bundle?.format} v${bundle?.version}`,
+      },
+    });
+  }
+  const tasks = Array.isArray(bundle.tasks) ? bundle.tasks : [];
+  const ids = new Set<string>();
+  for (const task of tasks) {
+    if (ids.has(task.id)) {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `Saga bundle lists ${task.id} twice`, {
+        details: { field: 'tasks', actual: task.id },
+      });
+    }
+    ids.add(task.id);
+  }
+  const root = tasks.find((t) => t.id === bundle.sagaId);
+  if (!root || root.type !== 'saga') {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `Saga bundle has no saga task for ${bundle.sagaId}`,
+      { details: { field: 'sagaId', expected: 'saga', actual: root?.type ?? null } },
+    );
+  }
+  for (const task of tasks) {
+    if (task.id !== root.id && !(task.parentId && ids.has(task.parentId))) {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `Saga bundle task ${task.id} has a parent outside the bundle`,
+        { details: { field: 'parentId', expected: 'a bundle task', actual: task.parentId } },
+      );
+    }
+  }
+  return { ...(bundle as TasksSagaBundle), tasks };
+}
+
+/**
+ * Re-create a bundle in this project under fresh IDs.
+ *
+ * @throws CleoError `NOT_FOUND` when the file does not exist.
+ * @throws CleoError `VALIDATION_ERROR` for a malformed bundle or bad labels.
+ */
+export async function importSagaBundle(
+  options: TasksSagaImportParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksSagaImportResult> {
+  const path = resolve(resolveOrCwd(cwd), options.file ?? '');
+  let text: string;
+  try {
+    text = readFileSync(path, 'utf-8');
+  } catch {
+    throw new CleoError(ExitCode.NOT_FOUND, `Saga bundle not found: ${options.file}`, {
+      fix: 'Pass a path relative to the project root, e.g. cleo saga import bundle.json',
+      details: { field: 'file', actual: options.file },
+    });
+  }
+  const bundle = parseSagaBundle(text);
+  const tasks = parentFirst(bundle.tasks);
+  const bundleLabels = [...new Set(tasks.flatMap((t) => t.labels ?? []))].sort();
+  validateLabels(bundleLabels);
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const existing = new Set((await listLabels(cwd, acc)).map((l) => l.label));
+  const declared = new Set((await loadCustomFields(acc)).map((f) => f.name));
+  const bundleIds = new Set(tasks.map((t) => t.id));
+  const droppedDepends: TasksSagaImportDroppedDep[] = [];
+  const warnings: string[] = [];
+  for (const task of tasks) {
+    for (const dep of task.depends ?? []) {
+      if (bundleIds.has(dep)) continue;
+      droppedDepends.push({ taskId: task.id, dependsOn: dep });
+      warnings.push(`Dropped dependency ${task.id} → ${dep}: ${dep} is not in the bundle`);
+    }
+    for (const name of Object.keys(task.custom ?? {})) {
+      if (!declared.has(name)) {
+        warnings.push(`Dropped custom field '${name}' on ${task.id}: not declared here`);
+      }
+    }
+  }
+
+  // An ID prefix is unique per project, so a bundle re-imported next to its
+  // original (or into a project that already uses the prefix) cannot keep it.
+  const bundlePrefix = tasks.find((t) => t.id === bundle.sagaId)?.idPrefix;
+  let idPrefix: string | undefined;
+  if (bundlePrefix) {
+    try {
+      idPrefix = normalizeIdPrefix(bundlePrefix);
+      await assertIdPrefixAvailable(idPrefix, acc);
+    } catch (err) {
+      if (!(err instanceof CleoError)) throw err;
+      idPrefix = undefined;
+      warnings.push(`Dropped ID prefix ${bundlePrefix}: ${err.message}; imported tasks use T IDs`);
+    }
+  }
+
+  const idMap: Record<string, string> = {};
+  await acc.transaction(async (tx) => {
+    const created: Task[] = [];
+    const createdById = new Map<string, Task>();
+    for (const source of tasks) {
+      const parentId = source.id === bundle.sagaId ? null : (idMap[source.parentId ?? ''] ?? null);
+      const id = await allocateTaskIdUnder(
+        parentId ? (createdById.get(parentId) ?? null) : null,
+        acc,
+        cwd,
+      );
+      idMap[source.id] = id;
+      const custom = Object.fromEntries(
+        Object.entries(source.custom ?? {}).filter(([name]) => declared.has(name)),
+      );
+      const {
+        recurredTo: _recurredTo,
+        deletedAt: _deletedAt,
+        deletedParentId: _deletedParentId,
+        custom: _custom,
+        idPrefix: _idPrefix,
+        ...rest
+      } = source;
+      const task: Task = {
+        ...rest,
+        id,
+        parentId,
+        position: parentId ? (source.position ?? null) : await acc.getNextPosition(null),
+        positionVersion: 0,
+        updatedAt: new Date().toISOString(),
+        depends: undefined,
+        ...(source.id === bundle.sagaId && idPrefix ? { idPrefix } : {}),
+        ...(Object.keys(custom).length > 0 ? { custom } : {}),
+      };
+      // Pass 1 writes rows without dependencies so every FK target exists.
+      await tx.upsertSingleTask(task);
+      await tx.appendLog({
+        id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+        timestamp: task.updatedAt ?? new Date().toISOString(),
+        action: 'task_created',
+        taskId: id,
+        actor: 'system',
+        details: { title: task.title, status: task.status, source: options.file, from: source.id },
+        before: null,
+        after: { title: task.title, status: task.status, priority: task.priority },
+      });
+      created.push(task);
+      createdById.set(id, task);
+    }
+    for (const [i, source] of tasks.entries()) {
+      const depends = (source.depends ?? []).flatMap((d) => (idMap[d] ? [idMap[d]] : []));
+      const task = created[i];
+      if (task && depends.length > 0) await tx.upsertSingleTask({ ...task, depends });
+    }
+  });
+
+  return {
+    file: options.file,
+    sagaId: idMap[bundle.sagaId] ?? '',
+    created: tasks.length,
+    idMap,
+    reusedLabels: bundleLabels.filter((l) => existing.has(l)),
+    newLabels: bundleLabels.filter((l) => !existing.has(l)),
+    droppedDepends,
+    warnings,
+  };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrappers
+// ---------------------------------------------------------------------------
+
+/**
+ * Export a Saga as a portable bundle.
+ *
+ * @param projectRoot - Absolute path to the project root.
+ * @param params - sagaId of the Saga.
+ * @returns EngineResult with {@link TasksSagaExportResult}; `E_NOT_FOUND`
+ *   when the ID is not a Saga.
+ */
+export async function sagaExport(
+  projectRoot: string,
+  params: TasksSagaExportParams,
+  accessor?: DataAccessor,
+): Promise<EngineResult<TasksSagaExportResult>> {
+  const sagaId = params.sagaId;
+  if (!sagaId) {
+    return engineError('E_INVALID_INPUT', 'sagaId is required');
+  }
+  const acc = accessor ?? (await getTaskAccessor(projectRoot));
+  try {
+    if ((await resolveSagaMemberIds(acc, sagaId)) === null) {
+      return engineError('E_NOT_FOUND', `Saga ${sagaId} not found or is not a saga`);
+    }
+    const bundle = buildSagaBundle(sagaId, await acc.getSubtree(sagaId));
+    return engineSuccess({ sagaId, taskCount: bundle.tasks.length, bundle });
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to export saga');
+  } finally {
+    if (!accessor) await acc.close();
+  }
+}
+
+/**
+ * Import a Saga bundle under fresh task IDs.
+ *
+ * @param projectRoot - Absolute path to the project root.
+ * @param params - Bundle file path.
+ * @returns EngineResult with {@link TasksSagaImportResult}, including the
+ *   old→new ID map and any dropped dependencies.
+ */
+export async function sagaImport(
+  projectRoot: string,
+  params: TasksSagaImportParams,
+  accessor?: DataAccessor,
+): Promise<EngineResult<TasksSagaImportResult>> {
+  if (!params.file) {
+    return engineError('E_INVALID_INPUT', 'file is required');
+  }
+  const acc = accessor ?? (await getTaskAccessor(projectRoot));
+  try {
+    return engineSuccess(await importSagaBundle(params, projectRoot, acc));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to import saga bundle');
+  } finally {
+    if (!accessor) await acc.close();
+  }
+}
@@ -26,6 +26,12 @@ export interface SagaCreateParams {
   description?: string;
   /** Optional acceptance criteria. */
   acceptance?: string[];
+  /** Optional due date (RFC 3339 full-date or date-time). */
+  due?: string;
+  /** Optional ID prefix for the Saga's tasks (`AUTH` → `AUTH-1`). */
+  prefix?: string;
+  /** Optional orchestration track inherited by member tasks without one. */
+  track?: string;
   /** Validate and preview the Saga without writing task, relation, or doc rows. */
   dryRun?: boolean;
 }
@@ -62,6 +68,9 @@ export async function sagaCreate(
     description: params.description,
     type: 'saga',
     acceptance: params.acceptance,
+    due: params.due,
+    idPrefix: params.prefix,
+    track: params.track,
     dryRun: params.dryRun,
   });
 
@@ -0,0 +1,204 @@
+/**
+ * saga.critical-path — the estimate-weighted longest dependency chain
+ * through a Saga's open work.
+ *
+ * Nodes are the Saga's estimate units (see {@link collectEstimatedMembers}):
+ * an estimated task counts once with its subtasks folded in, an unestimated
+ * task is broken down into its subtasks. Each node weighs its `estimate`,
+ * or 1 when it has none — those nodes are flagged, since a missing estimate
+ * can hide the real critical path. Done, cancelled, and archived work is
+ * left out, so `length` is the minimum remaining time to finish the Saga.
+ *
+ * An epic or broken-down task stands for every unit below it, on either end
+ * of a dependency; a subtask of an estimated task stands for that task.
+ */
+
+import type {
+  Task,
+  TasksSagaCriticalPathNode,
+  TasksSagaCriticalPathParams,
+  TasksSagaCriticalPathResult,
+} from '@cleocode/contracts';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+import { findDependencyCycle } from '../tasks/dependency-check.js';
+import { dependencyCycleError } from '../tasks/dependency-guard.js';
+import { collectEstimatedMembers } from '../tasks/estimate.js';
+import { resolveSagaMemberIds } from './storage.js';
+
+/** Statuses whose work is already finished (or will never happen). */
+const CLOSED_STATUSES: ReadonlySet<string> = new Set(['done', 'cancelled', 'archived']);
+
+/** Open estimate units of a Saga and the dependency edges between them. */
+export interface SagaUnitGraph {
+  byId: ReadonlyMap<string, Task>;
+  /** Open units (not done, cancelled, or archived). */
+  open: Task[];
+  /** Unit ID → open units it depends on. */
+  deps: Map<string, Set<string>>;
+}
+
+/**
+ * Build the dependency graph between `sagaId`'s open estimate units, shared
+ * by the critical path and the schedule.
+ *
+ * @param sagaId - Saga task ID.
+ * @param subtree - The Saga's subtree (as returned by `getSubtree`).
+ */
+export function buildSagaUnitGraph(sagaId: string, subtree: readonly Task[]): SagaUnitGraph {
+  const byId = new Map(subtree.map((t) => [t.id, t]));
+  const { estimated, unestimated } = collectEstimatedMembers(sagaId, subtree);
+  const unitIds = new Set([...estimated, ...unestimated].map((t) => t.id));
+
+  /** The unit a task belongs to: itself, or its nearest unit ancestor. */
+  const unitOf = (id: string): string | null => {
+    const seen = new Set<string>();
+    let current = byId.get(id);
+    while (current && !seen.has(current.id)) {
+      if (unitIds.has(current.id)) return current.id;
+      seen.add(current.id);
+      current = current.parentId ? byId.get(current.parentId) : undefined;
+    }
+    return null;
+  };
+  const unitsFor = (id: string): string[] => {
+    const owner = unitOf(id);
+    if (owner) return [owner];
+    const below: string[] = [];
+    for (const unit of unitIds) {
+      let current = byId.get(unit);
+      while (current?.parentId && current.parentId !== sagaId) {
+        if (current.parentId === id) {
+          below.push(unit);
+          break;
+        }
+        current = byId.get(current.parentId);
+      }
+    }
+    return below;
+  };
+
+  const open = [...estimated, ...unestimated].filter((t) => !CLOSED_STATUSES.has(t.status));
+  const openIds = new Set(open.map((t) => t.id));
+  const deps = new Map<string, Set<string>>(open.map((t) => [t.id, new Set()]));
+  for (const task of subtree) {
+    if (!task.depends?.length || task.id === sagaId) continue;
+    const depUnits = task.depends.flatMap(unitsFor);
+    for (const owner of unitsFor(task.id)) {
+      if (!openIds.has(owner)) continue;
+      for (const unit of depUnits) {
+        if (unit !== owner && openIds.has(unit)) deps.get(owner)?.add(unit);
+      }
+    }
+  }
+  return { byId, open, deps };
+}
+
+/**
+ * Compute the critical path for `sagaId` from its subtree.
+ *
+ * Ties are broken towards the lower task ID so the result is stable.
+ *
+ * @param sagaId - Saga task ID.
+ * @param subtree - The Saga's subtree (as returned by `getSubtree`).
+ * @throws CleoError `CIRCULAR_REFERENCE` with `details.error = 'dependency_cycle'`
+ *   when the open units depend on each other in a cycle.
+ */
+export function computeSagaCriticalPath(
+  sagaId: string,
+  subtree: readonly Task[],
+): TasksSagaCriticalPathResult {
+  const { byId, open, deps } = buildSagaUnitGraph(sagaId, subtree);
+  const openIds = new Set(open.map((t) => t.id));
+
+  const weight = (t: Task): number => t.estimate ?? 1;
+  const best = new Map<string, { length: number; prev: string | null }>();
+  const visiting = new Set<string>();
+  const visit = (id: string): number => {
+    const done = best.get(id);
+    if (done) return done.length;
+    if (visiting.has(id)) {
+      const edges = [...deps].map(([tid, set]) => ({ id: tid, depends: [...set] }) as Task);
+      throw dependencyCycleError(findDependencyCycle(id, [...(deps.get(id) ?? [])], edges));
+    }
+    visiting.add(id);
+    let prev: string | null = null;
+    let prevLength = 0;
+    for (const dep of [...(deps.get(id) ?? [])].sort()) {
+      const length = visit(dep);
+      if (length > prevLength) {
+        prev = dep;
+        prevLength = length;
+      }
+    }
+    visiting.delete(id);
+    const length = prevLength + weight(byId.get(id) as Task);
+    best.set(id, { length, prev });
+    return length;
+  };
+
+  let end: string | null = null;
+  let length = 0;
+  for (const id of [...openIds].sort()) {
+    const total = visit(id);
+    if (end === null || total > length) {
+      end = id;
+      length = total;
+    }
+  }
+
+  const path: TasksSagaCriticalPathNode[] = [];
+  for (let id = end; id !== null; id = best.get(id)?.prev ?? null) {
+    const task = byId.get(id) as Task;
+    path.unshift({
+      id: task.id,
+      title: task.title,
+      status: task.status,
+      weight: weight(task),
+      estimateDefaulted: task.estimate == null,
+    });
+  }
+
+  return {
+    sagaId,
+    path,
+    length,
+    unestimated: open
+      .filter((t) => t.estimate == null)
+      .map((t) => t.id)
+      .sort(),
+    taskCount: open.length,
+  };
+}
+
+/**
+ * Compute a Saga's critical path.
+ *
+ * @param projectRoot - Absolute path to the project root.
+ * @param params - sagaId of the Saga.
+ * @returns EngineResult with {@link TasksSagaCriticalPathResult}; `E_NOT_FOUND`
+ *   when the ID is not a Saga, and the `dependency_cycle` error on a cycle.
+ */
+export async function sagaCriticalPath(
+  projectRoot: string,
+  params: TasksSagaCriticalPathParams,
+  accessor?: DataAccessor,
+): Promise<EngineResult<TasksSagaCriticalPathResult>> {
+  const sagaId = params.sagaId;
+  if (!sagaId) {
+    return engineError('E_INVALID_INPUT', 'sagaId is required');
+  }
+  const acc = accessor ?? (await getTaskAccessor(projectRoot));
+  try {
+    const memberIds = await resolveSagaMemberIds(acc, sagaId);
+    if (memberIds === null) {
+      return engineError('E_NOT_FOUND', `Saga ${sagaId} not found or is not a saga`);
+    }
+    return engineSuccess(computeSagaCriticalPath(sagaId, await acc.getSubtree(sagaId)));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to compute critical path');
+  } finally {
+    if (!accessor) await acc.close();
+  }
+}
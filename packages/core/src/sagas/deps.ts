@@ -0,0 +1,132 @@
+/**
+ * saga.deps — the other Sagas a Saga depends on, derived from the
+ * dependency edges of its tasks that cross the Saga boundary.
+ *
+ * Each edge runs from a task in this Saga to a task whose nearest Saga
+ * ancestor is another Saga. Dependencies on tasks that sit under no Saga
+ * are not coupling between Sagas and are left out. Archived tasks still
+ * carry their edges, so finished coupling stays visible with `open: 0`.
+ */
+
+import type {
+  Task,
+  TasksSagaDepsEdge,
+  TasksSagaDepsEntry,
+  TasksSagaDepsParams,
+  TasksSagaDepsResult,
+} from '@cleocode/contracts';
+import { TASK_STATUSES } from '@cleocode/contracts';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+import { resolveSagaMemberIds } from './storage.js';
+
+/** Statuses whose work no longer blocks anything. */
+const CLOSED_STATUSES: ReadonlySet<string> = new Set(['done', 'cancelled', 'archived']);
+
+/** Nearest Saga at or above `taskId`, or null when it sits under none. */
+function sagaOf(
+  taskId: string,
+  byId: ReadonlyMap<string, Task>,
+  cache: Map<string, string | null>,
+): string | null {
+  const cached = cache.get(taskId);
+  if (cached !== undefined) return cached;
+  const chain: string[] = [];
+  let sagaId: string | null = null;
+  let current = byId.get(taskId);
+  while (current && !chain.includes(current.id)) {
+    const known = cache.get(current.id);
+    if (known !== undefined) {
+      sagaId = known;
+      break;
+    }
+    chain.push(current.id);
+    if (current.type === 'saga') {
+      sagaId = current.id;
+      break;
+    }
+    current = current.parentId ? byId.get(current.parentId) : undefined;
+  }
+  for (const id of chain) cache.set(id, sagaId);
+  return sagaId;
+}
+
+/**
+ * Group the cross-Saga dependency edges of `sagaId`'s tasks by the Saga they
+ * point into.
+ *
+ * @param sagaId - Saga task ID.
+ * @param allTasks - Every task in the project, archived included.
+ */
+export function computeSagaDeps(sagaId: string, allTasks: readonly Task[]): TasksSagaDepsResult {
+  const byId = new Map(allTasks.map((t) => [t.id, t]));
+  const cache = new Map<string, string | null>();
+  const grouped = new Map<string, TasksSagaDepsEdge[]>();
+
+  for (const task of allTasks) {
+    if (!task.depends?.length || sagaOf(task.id, byId, cache) !== sagaId) continue;
+    for (const depId of task.depends) {
+      const dep = byId.get(depId);
+      const target = dep ? sagaOf(dep.id, byId, cache) : null;
+      if (!dep || !target || target === sagaId) continue;
+      const edges = grouped.get(target) ?? [];
+      edges.push({ taskId: task.id, dependsOn: dep.id, status: dep.status });
+      grouped.set(target, edges);
+    }
+  }
+
+  const dependsOn: TasksSagaDepsEntry[] = [...grouped]
+    .map(([id, edges]) => {
+      const saga = byId.get(id);
+      edges.sort(
+        (a, b) => a.taskId.localeCompare(b.taskId) || a.dependsOn.localeCompare(b.dependsOn),
+      );
+      return {
+        sagaId: id,
+        title: saga?.title ?? id,
+        status: saga?.status ?? 'pending',
+        edges,
+        open: edges.filter((e) => !CLOSED_STATUSES.has(e.status)).length,
+      };
+    })
+    .sort((a, b) => a.sagaId.localeCompare(b.sagaId));
+
+  return {
+    sagaId,
+    dependsOn,
+    open: dependsOn.reduce((sum, entry) => sum + entry.open, 0),
+  };
+}
+
+/**
+ * List the Sagas a Saga depends on.
+ *
+ * @param projectRoot - Absolute path to the project root.
+ * @param params - sagaId of the Saga.
+ * @returns EngineResult with {@link TasksSagaDepsResult}; `E_NOT_FOUND` when
+ *   the ID is not a Saga.
+ */
+export async function sagaDeps(
+  projectRoot: string,
+  params: TasksSagaDepsParams,
+  accessor?: DataAccessor,
+): Promise<EngineResult<TasksSagaDepsResult>> {
+  const sagaId = params.sagaId;
+  if (!sagaId) {
+    return engineError('E_INVALID_INPUT', 'sagaId is required');
+  }
+  const acc = accessor ?? (await getTaskAccessor(projectRoot));
+  try {
+    const memberIds = await resolveSagaMemberIds(acc, sagaId);
+    if (memberIds === null) {
+      return engineError('E_NOT_FOUND', `Saga ${sagaId} not found or is not a saga`);
+    }
+    const { tasks } = await acc.queryTasks({ status: [...TASK_STATUSES] });
+    return engineSuccess(computeSagaDeps(sagaId, tasks));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to compute saga dependencies');
+  } finally {
+    if (!accessor) await acc.close();
+  }
+}
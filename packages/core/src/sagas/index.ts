@@ -28,8 +28,18 @@ export {
   type RegisteredInvariant,
 } from '@cleocode/contracts';
 export { type SagaAddParams, type SagaAddResult, sagaAdd } from './add.js';
+export {
+  buildSagaBundle,
+  importSagaBundle,
+  parseSagaBundle,
+  SAGA_BUNDLE_FORMAT,
+  sagaExport,
+  sagaImport,
+} from './bundle.js';
 export { LIST_BINDING_SAGA_GROUPS, SAGA_GROUPS_RELATION, SAGA_LABEL } from './constants.js'; // saga-label-ok: T10638 — SSoT re-export
 export { type SagaCreateParams, sagaCreate } from './create.js';
+export { computeSagaCriticalPath, sagaCriticalPath } from './critical-path.js';
+export { computeSagaDeps, sagaDeps } from './deps.js';
 export {
   type DetachResult,
   type DetachSagaMemberParams,
@@ -66,6 +76,7 @@ export {
   type SagaNextResult,
   sagaNext,
 } from './next.js';
+export { computeSagaProgress } from './progress.js';
 export {
   type ReconcileResult,
   type ReconcileSagaParams,
@@ -84,6 +95,11 @@ export {
   sagaRollup,
   sagaTraversal,
 } from './rollup.js';
+export {
+  type ComputeSagaScheduleOptions,
+  computeSagaSchedule,
+  sagaSchedule,
+} from './schedule.js';
 export {
   buildSagaAutoCloseEvidence,
   findSagasGroupingTask,
@@ -23,11 +23,13 @@
  * @see ADR-073-above-epic-naming.md §1.2 — invariant I5
  */
 
-import type { TaskRecord } from '@cleocode/contracts';
+import type { TaskProgressRollup, TaskRecord } from '@cleocode/contracts';
 import { pushWarning } from '@cleocode/lafs';
 import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
 import { type CompactTask, taskList } from '../tasks/list.js';
 import { E_SAGA_INVARIANT_VIOLATION_I5 } from './enforcement.js';
+import { computeSagaProgress } from './progress.js';
 
 /**
  * Single I5-violation warning entry attached to `SagaListResult.warnings`.
@@ -49,8 +51,13 @@ export interface SagaInvariantI5Warning {
 
 /** Result payload for {@link sagaList}. */
 export interface SagaListResult {
-  /** Every `type='saga'` row, regardless of `parentId`. */
-  sagas: Array<TaskRecord | CompactTask>;
+  /**
+   * Every `type='saga'` row, regardless of `parentId`, with its completion
+   * `progress` and aggregate `progressStatus` (see {@link computeSagaProgress}).
+   */
+  sagas: Array<
+    (TaskRecord | CompactTask) & Pick<TaskProgressRollup, 'progress' | 'progressStatus'>
+  >;
   /** Total count — always equal to `sagas.length`. */
   total: number;
   /**
@@ -137,9 +144,26 @@ export async function sagaList(projectRoot: string): Promise<EngineResult<SagaLi
     });
   }
 
+  // Progress per saga, so `cleo saga list` shows the laggards at a glance.
+  const accessor = await getTaskAccessor(projectRoot);
+  let sagas: SagaListResult['sagas'];
+  try {
+    sagas = await Promise.all(
+      tasks.map(async (task) => {
+        const { progress, progressStatus } = computeSagaProgress(
+          task.id,
+          await accessor.getSubtree(task.id),
+        );
+        return { ...task, progress, progressStatus };
+      }),
+    );
+  } finally {
+    await accessor.close();
+  }
+
   const payload: SagaListResult = {
-    sagas: tasks,
-    total: tasks.length,
+    sagas,
+    total: sagas.length,
     ...(warnings.length > 0 ? { warnings } : {}),
   };
   return engineSuccess(payload);
@@ -0,0 +1,80 @@
+/**
+ * Saga progress — completion percentage, status counts, and an aggregate
+ * status over a Saga's work items.
+ *
+ * Work items are the leaf tasks and subtasks below the Saga: epics are
+ * containers, and a task broken into subtasks counts through its subtasks
+ * so nothing is counted twice. Cancelled and trashed work is left out. The aggregate
+ * status is `blocked` only when a blocked task sits on the Saga's critical
+ * path (see {@link computeSagaCriticalPath}) — a blocker off the critical
+ * path does not hold the Saga up.
+ */
+
+import type {
+  SagaProgressStatus,
+  Task,
+  TaskProgressCounts,
+  TaskProgressRollup,
+} from '@cleocode/contracts';
+import { computeSagaCriticalPath } from './critical-path.js';
+
+/** Task types that only group work. */
+const CONTAINER_TYPES: ReadonlySet<string> = new Set(['saga', 'epic']);
+
+/** Status bucket a work item counts toward. */
+function bucketOf(status: string): keyof TaskProgressCounts {
+  switch (status) {
+    case 'done':
+    case 'archived':
+      return 'done';
+    case 'active':
+      return 'in_progress';
+    case 'blocked':
+      return 'blocked';
+    default:
+      return 'pending';
+  }
+}
+
+/** Whether a blocked task sits on the critical path (a dependency cycle counts). */
+function criticalPathBlocked(sagaId: string, subtree: readonly Task[]): boolean {
+  try {
+    return computeSagaCriticalPath(sagaId, subtree).path.some((n) => n.status === 'blocked');
+  } catch {
+    // A dependency cycle: the work on it can never start.
+    return true;
+  }
+}
+
+/**
+ * Compute a Saga's progress from its subtree.
+ *
+ * @param sagaId - Saga task ID.
+ * @param subtree - The Saga's subtree (as returned by `getSubtree`).
+ */
+export function computeSagaProgress(sagaId: string, subtree: readonly Task[]): TaskProgressRollup {
+  // Trashed rows (`cleo delete`) are archived with `deletedAt` — not done work.
+  const live = subtree.filter((t) => !t.deletedAt);
+  const work = live.filter(
+    (t) => t.id !== sagaId && t.status !== 'cancelled' && !CONTAINER_TYPES.has(t.type ?? ''),
+  );
+  const parents = new Set(work.map((t) => t.parentId).filter((id): id is string => !!id));
+  const items = work.filter((t) => !parents.has(t.id));
+
+  const counts: TaskProgressCounts = { done: 0, in_progress: 0, blocked: 0, pending: 0 };
+  for (const task of items) counts[bucketOf(task.status)]++;
+  const total = items.length;
+
+  let progressStatus: SagaProgressStatus;
+  if (total > 0 && counts.done === total) progressStatus = 'done';
+  else if (total > 0 && criticalPathBlocked(sagaId, live)) progressStatus = 'blocked';
+  else if (counts.done + counts.in_progress > 0) progressStatus = 'in_progress';
+  else progressStatus = 'not_started';
+
+  return {
+    progress: total > 0 ? Math.round((counts.done / total) * 100) / 100 : 0,
+    counts,
+    total,
+    progressStatus,
+  };
+}
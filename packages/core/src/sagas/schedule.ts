@@ -0,0 +1,157 @@
+/**
+ * saga.schedule — latest start and finish for each open task in a Saga,
+ * derived backward from the Saga's `due` date.
+ *
+ * Works over the same estimate units and dependency edges as
+ * saga.critical-path. A unit with nothing depending on it must finish by the
+ * Saga's due date; any other unit must finish before the latest start of
+ * everything that depends on it. The latest start is the latest finish minus
+ * the unit's estimate (1 when it has none, flagged as for the critical path).
+ *
+ * Estimates are converted with `hoursPerUnit` (default: an estimate point is
+ * one hour) and scheduled in calendar time. A unit whose latest start is
+ * already behind `asOf` is `atRisk`; the plan is `feasible` when none is.
+ */
+
+import type {
+  Task,
+  TasksSagaScheduleEntry,
+  TasksSagaScheduleParams,
+  TasksSagaScheduleResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+import { parseRfc3339Date } from '../tasks/due.js';
+import { buildSagaUnitGraph, computeSagaCriticalPath } from './critical-path.js';
+import { resolveSagaMemberIds } from './storage.js';
+
+const HOUR_MS = 3_600_000;
+
+/** Options for {@link computeSagaSchedule}. */
+export interface ComputeSagaScheduleOptions {
+  /** Hours one estimate point stands for (default 1). */
+  hoursPerUnit?: number;
+  /** Reference time for `atRisk` (default: now). */
+  asOf?: Date;
+}
+
+/**
+ * Schedule `sagaId`'s open units backward from its due date.
+ *
+ * @param sagaId - Saga task ID.
+ * @param subtree - The Saga's subtree (as returned by `getSubtree`), Saga included.
+ * @throws CleoError `VALIDATION_ERROR` when the Saga has no due date or
+ *   `hoursPerUnit` is not positive.
+ * @throws CleoError `CIRCULAR_REFERENCE` when the open units form a cycle.
+ */
+export function computeSagaSchedule(
+  sagaId: string,
+  subtree: readonly Task[],
+  options: ComputeSagaScheduleOptions = {},
+): TasksSagaScheduleResult {
+  const hoursPerUnit = options.hoursPerUnit ?? 1;
+  if (!Number.isFinite(hoursPerUnit) || hoursPerUnit <= 0) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid hoursPerUnit: ${hoursPerUnit}`, {
+      fix: 'Pass a positive number, e.g. --hours-per-unit 8 for estimates in days',
+      details: { field: 'hoursPerUnit', actual: hoursPerUnit },
+    });
+  }
+  const saga = subtree.find((t) => t.id === sagaId);
+  if (!saga?.due) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Saga ${sagaId} has no due date`, {
+      fix: `cleo update ${sagaId} --due 2026-07-01`,
+      details: { field: 'due' },
+    });
+  }
+  const dueMs = parseRfc3339Date(saga.due);
+  const asOf = options.asOf ?? new Date();
+
+  // Also rejects dependency cycles, which would leave no latest finish.
+  const critical = computeSagaCriticalPath(sagaId, subtree);
+  const { open, deps } = buildSagaUnitGraph(sagaId, subtree);
+  const dependents = new Map<string, string[]>(open.map((t) => [t.id, []]));
+  for (const [id, set] of deps) {
+    for (const dep of set) dependents.get(dep)?.push(id);
+  }
+  const durationMs = new Map(open.map((t) => [t.id, (t.estimate ?? 1) * hoursPerUnit * HOUR_MS]));
+
+  const finish = new Map<string, number>();
+  const latestFinish = (id: string): number => {
+    const known = finish.get(id);
+    if (known !== undefined) return known;
+    let value = dueMs;
+    for (const next of dependents.get(id) ?? []) {
+      value = Math.min(value, latestFinish(next) - (durationMs.get(next) ?? 0));
+    }
+    finish.set(id, value);
+    return value;
+  };
+
+  const schedule: TasksSagaScheduleEntry[] = open
+    .map((task) => {
+      const finishMs = latestFinish(task.id);
+      const startMs = finishMs - (durationMs.get(task.id) ?? 0);
+      return {
+        id: task.id,
+        title: task.title,
+        status: task.status,
+        weight: task.estimate ?? 1,
+        estimateDefaulted: task.estimate == null,
+        latestStart: new Date(startMs).toISOString(),
+        latestFinish: new Date(finishMs).toISOString(),
+        atRisk: startMs < asOf.getTime(),
+      };
+    })
+    .sort((a, b) => a.latestStart.localeCompare(b.latestStart) || a.id.localeCompare(b.id));
+  const atRisk = schedule.filter((e) => e.atRisk).map((e) => e.id);
+
+  return {
+    sagaId,
+    due: saga.due,
+    asOf: asOf.toISOString(),
+    hoursPerUnit,
+    feasible: atRisk.length === 0,
+    criticalPath: critical.path.map((n) => n.id),
+    schedule,
+    atRisk,
+    unestimated: critical.unestimated,
+  };
+}
+
+/**
+ * Schedule a Saga's open work backward from its due date.
+ *
+ * @param projectRoot - Absolute path to the project root.
+ * @param params - sagaId of the Saga, plus optional `hoursPerUnit` and `asOf`.
+ * @returns EngineResult with {@link TasksSagaScheduleResult}; `E_NOT_FOUND`
+ *   when the ID is not a Saga, a validation error when it has no due date.
+ */
+export async function sagaSchedule(
+  projectRoot: string,
+  params: TasksSagaScheduleParams,
+  accessor?: DataAccessor,
+): Promise<EngineResult<TasksSagaScheduleResult>> {
+  const sagaId = params.sagaId;
+  if (!sagaId) {
+    return engineError('E_INVALID_INPUT', 'sagaId is required');
+  }
+  const acc = accessor ?? (await getTaskAccessor(projectRoot));
+  try {
+    const memberIds = await resolveSagaMemberIds(acc, sagaId);
+    if (memberIds === null) {
+      return engineError('E_NOT_FOUND', `Saga ${sagaId} not found or is not a saga`);
+    }
+    const asOf = params.asOf ? new Date(parseRfc3339Date(params.asOf.trim(), 'asOf')) : undefined;
+    const subtree = await acc.getSubtree(sagaId);
+    return engineSuccess(
+      computeSagaSchedule(sagaId, subtree, { hoursPerUnit: params.hoursPerUnit, asOf }),
+    );
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to compute saga schedule');
+  } finally {
+    if (!accessor) await acc.close();
+  }
+}
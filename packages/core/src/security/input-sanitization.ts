@@ -64,7 +64,8 @@ export function sanitizeTaskId(value: unknown): string {
     throw new SecurityError(`Invalid task ID format: ${value}`, 'E_INVALID_TASK_ID', 'taskId');
   }
 
-  const numericPart = parseInt(normalized.slice(1), 10);
+  // Counter after the `T` or the saga prefix (`AUTH-`).
+  const numericPart = parseInt(normalized.replace(/^\D+/, ''), 10);
   if (numericPart > MAX_TASK_ID_NUMBER) {
     throw new SecurityError(
       `Task ID exceeds maximum value: ${value}`,
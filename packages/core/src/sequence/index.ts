@@ -392,3 +392,65 @@ export async function allocateNextTaskId(cwd?: string, retryCount = 0): Promise<
     throw err;
   }
 }
+
+/**
+ * Atomically allocate the next `<prefix>-<n>` ID for a saga with an ID prefix.
+ *
+ * Each prefix has its own counter in `schema_meta` under
+ * `task_id_sequence:<PREFIX>`. The counter only moves forward, so an ID freed
+ * by a purge is never handed out again; it is also floored at the highest
+ * `<prefix>-<n>` already in the table, so a lost or late-created counter
+ * cannot collide with existing rows. Uses a SAVEPOINT like
+ * {@link allocateNextTaskId} so it can run inside an outer transaction.
+ *
+ * @param prefix - Normalised saga prefix (e.g. `AUTH`).
+ * @returns The allocated ID, e.g. `AUTH-7`.
+ */
+export async function allocatePrefixedTaskId(prefix: string, cwd?: string): Promise<string> {
+  const { getDb, getNativeDb } = await import('../store/sqlite.js');
+  await getDb(cwd);
+  const nativeDb = getNativeDb();
+  if (!nativeDb) {
+    throw new CleoError(
+      ExitCode.FILE_ERROR,
+      'Native database not available for atomic ID allocation',
+    );
+  }
+
+  const key = `${SEQUENCE_META_KEY}:${prefix}`;
+  const spName = `_cleo_seq_prefix_alloc_${Date.now()}`;
+  nativeDb.prepare(`SAVEPOINT ${spName}`).run();
+  try {
+    const stored = nativeDb.prepare('SELECT value FROM schema_meta WHERE key = ?').get(key) as
+      | { value: string }
+      | undefined;
+    const storedCounter = stored
+      ? Number((JSON.parse(stored.value) as { counter?: unknown }).counter) || 0
+      : 0;
+    const highest = nativeDb
+      .prepare(
+        'SELECT MAX(CAST(SUBSTR(id, ?) AS INTEGER)) AS n FROM tasks_tasks WHERE id GLOB ?',
+      )
+      .get(prefix.length + 2, `${prefix}-[0-9]*`) as { n: number | null } | undefined;
+
+    const counter = Math.max(storedCounter, highest?.n ?? 0) + 1;
+    const id = `${prefix}-${counter}`;
+    nativeDb
+      .prepare(
+        `INSERT INTO schema_meta (key, value) VALUES (?, ?)
+        ON CONFLICT(key) DO UPDATE SET value = excluded.value`,
+      )
+      .run(key, JSON.stringify({ counter, lastId: id }));
+
+    nativeDb.prepare(`RELEASE SAVEPOINT ${spName}`).run();
+    return id;
+  } catch (err) {
+    try {
+      nativeDb.prepare(`ROLLBACK TO SAVEPOINT ${spName}`).run();
+      nativeDb.prepare(`RELEASE SAVEPOINT ${spName}`).run();
+    } catch {
+      /* ignore rollback errors */
+    }
+    throw err;
+  }
+}
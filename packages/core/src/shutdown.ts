@@ -56,6 +56,7 @@ import { closeLogger } from './logger.js';
 import { shutdownBrainWriter } from './memory/brain-writer-thread.js';
 import { resetEmbeddingQueue } from './memory/embedding-queue.js';
 import { closeAllDatabases } from './store/sqlite.js';
+import { flushWebhooks } from './tasks/webhook.js';
 
 /**
  * Run a teardown step, swallowing any error so a single failure cannot abort
@@ -80,6 +81,9 @@ async function safely(step: () => Promise<void> | void): Promise<void> {
  * rc:124.
  *
  * Order:
+ *   0. {@link flushWebhooks} — give queued task-event webhooks a bounded
+ *      grace period, then abort them so a slow receiver cannot hold the
+ *      process open.
  *   1. {@link shutdownBrainWriter} — terminate the BRAIN single-writer worker
  *      thread (the `MessagePort` proven to hang `cleo memory observe`).
  *   2. {@link resetEmbeddingQueue} — flush + terminate the embedding queue
@@ -106,6 +110,10 @@ async function safely(step: () => Promise<void> | void): Promise<void> {
  * @task T11568
  */
 export async function shutdownCliRuntime(): Promise<void> {
+  // 0. Webhook delivery queue — bounded drain, then abort in-flight requests
+  //    (an open socket to a slow receiver keeps the loop alive too).
+  await safely(() => flushWebhooks());
+
   // 1. BRAIN single-writer worker thread — the live MessagePort that hangs
   //    `cleo memory observe` / `cleo docs add` / any brain.db write path.
   await safely(() => shutdownBrainWriter());
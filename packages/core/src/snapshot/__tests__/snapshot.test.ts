@@ -0,0 +1,54 @@
+/**
+ * Tests for snapshot export determinism — re-saving an unchanged project
+ * must produce a byte-identical file.
+ */
+
+import { readFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { exportSnapshot, importSnapshot, readSnapshot, writeSnapshot } from '../index.js';
+
+describe('snapshot round trip', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    vi.useFakeTimers({ toFake: ['Date'] });
+    vi.setSystemTime(new Date('2026-06-01T00:00:00Z'));
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    // Seeded out of ID order so store order and ID order disagree.
+    await seedTasks(env.accessor, [
+      { id: 'T010', title: 'Ten', labels: ['b', 'a'] },
+      { id: 'T002', title: 'Two', depends: ['T010'] },
+      { id: 'T009', title: 'Nine', status: 'done' },
+    ]);
+  });
+
+  afterEach(async () => {
+    vi.useRealTimers();
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('writes tasks in ID order', async () => {
+    const snapshot = await exportSnapshot(env.tempDir);
+    expect(snapshot.tasks.map((t) => t.id)).toEqual(['T002', 'T009', 'T010']);
+  });
+
+  it('loads, saves, and leaves the file unchanged', async () => {
+    const first = join(env.tempDir, 'first.json');
+    const second = join(env.tempDir, 'second.json');
+    await writeSnapshot(await exportSnapshot(env.tempDir), first);
+
+    const loaded = await readSnapshot(first);
+    const result = await importSnapshot(loaded, env.tempDir);
+    expect(result.updated).toBe(0);
+    await writeSnapshot(await exportSnapshot(env.tempDir), second);
+
+    expect(await readFile(second, 'utf-8')).toBe(await readFile(first, 'utf-8'));
+    expect((await readSnapshot(second))._meta.checksum).toBe(loaded._meta.checksum);
+  });
+});
@@ -5,19 +5,23 @@
  * git commit and cross-contributor review. Imports snapshots back into
  * the local task database with last-write-wins merge.
  *
+ * Tasks are written in ID order and the checksum is canonical (see
+ * {@link computeChecksum}), so re-exporting an unchanged project yields the
+ * same task list and checksum, and git diffs show only real changes.
+ *
  * @task T4882
  */
 
-import { createHash } from 'node:crypto';
 import { existsSync } from 'node:fs';
 import { mkdir, readFile, writeFile } from 'node:fs/promises';
 import { dirname, join } from 'node:path';
 import type { Task } from '@cleocode/contracts';
 import { resolveCleoDir } from '../paths.js';
 import { getTaskAccessor } from '../store/data-accessor.js';
+import { computeChecksum, sortById } from '../store/json.js';
 
 /** Snapshot format version. */
-const SNAPSHOT_FORMAT_VERSION = '1.0.0';
+export const SNAPSHOT_FORMAT_VERSION = '1.0.0';
 
 /** Snapshot metadata. */
 export interface SnapshotMeta {
@@ -94,16 +98,6 @@ function toSnapshotTask(task: Task): SnapshotTask {
   };
 }
 
-/**
- * Compute SHA-256 checksum of snapshot content.
- * @task T4882
- */
-function computeChecksum(tasks: SnapshotTask[]): string {
-  const hash = createHash('sha256');
-  hash.update(JSON.stringify(tasks));
-  return hash.digest('hex').slice(0, 16);
-}
-
 /**
  * Export current task state to a snapshot.
  * @task T4882
@@ -117,7 +111,7 @@ export async function exportSnapshot(cwd?: string): Promise<Snapshot> {
   );
   const version = await accessor.getMetaValue<string>('version');
 
-  const snapshotTasks = tasks.map(toSnapshotTask);
+  const snapshotTasks = sortById(tasks).map(toSnapshotTask);
   const checksum = computeChecksum(snapshotTasks);
 
   return {
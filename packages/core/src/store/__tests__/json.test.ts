@@ -84,6 +84,21 @@ describe('computeChecksum — json module', () => {
     const c2 = computeChecksum({ tasks: [{ id: 'T001' }] });
     expect(c1).not.toBe(c2);
   });
+
+  it('ignores task order and object key order', () => {
+    const c1 = computeChecksum([
+      { id: 'T10', title: 'Ten', meta: { a: 1, b: 2 } },
+      { id: 'T9', title: 'Nine' },
+    ]);
+    const c2 = computeChecksum([
+      { title: 'Nine', id: 'T9' },
+      { meta: { b: 2, a: 1 }, title: 'Ten', id: 'T10' },
+    ]);
+    expect(c1).toBe(c2);
+    expect(computeChecksum([{ id: 'T9', labels: ['a', 'b'] }])).not.toBe(
+      computeChecksum([{ id: 'T9', labels: ['b', 'a'] }]),
+    );
+  });
 });
 
 describe('appendJsonl', () => {
@@ -0,0 +1,80 @@
+/**
+ * Tests for project mutate hook scripts.
+ *
+ * Covers discovery under `.cleo/hooks/`, the JSON payload on stdin, exit
+ * codes and stderr, and killing a hook that outlives its timeout.
+ */
+
+import { chmod, mkdir, mkdtemp, rm, writeFile } from 'node:fs/promises';
+import { tmpdir } from 'node:os';
+import { join } from 'node:path';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import {
+  findMutateHook,
+  getMutateHookPath,
+  type MutateHookPayload,
+  runMutateHook,
+} from '../mutate-hooks.js';
+
+describe('mutate hooks', () => {
+  let tempDir: string;
+
+  beforeEach(async () => {
+    tempDir = await mkdtemp(join(tmpdir(), 'cleo-mutate-hooks-'));
+    await mkdir(join(tempDir, '.cleo', 'hooks'), { recursive: true });
+    process.env['CLEO_DIR'] = join(tempDir, '.cleo');
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    await rm(tempDir, { recursive: true, force: true });
+  });
+
+  async function install(name: 'pre-mutate' | 'post-mutate', body: string): Promise<string> {
+    const path = getMutateHookPath(tempDir, name);
+    await writeFile(path, `#!/bin/sh\n${body}\n`);
+    await chmod(path, 0o755);
+    return path;
+  }
+
+  function payload(): MutateHookPayload {
+    return {
+      hook: 'pre-mutate',
+      domain: 'tasks',
+      operation: 'complete',
+      taskId: 'T001',
+      params: { taskId: 'T001' },
+      before: null,
+      after: null,
+      timestamp: '2026-01-01T00:00:00.000Z',
+      projectRoot: tempDir,
+    };
+  }
+
+  it('finds only executable hook files', async () => {
+    expect(findMutateHook(tempDir, 'pre-mutate')).toBeNull();
+    await writeFile(getMutateHookPath(tempDir, 'post-mutate'), '#!/bin/sh\n');
+    expect(findMutateHook(tempDir, 'post-mutate')).toBeNull();
+    const path = await install('pre-mutate', 'exit 0');
+    expect(findMutateHook(tempDir, 'pre-mutate')).toBe(path);
+  });
+
+  it('passes the payload on stdin and reports exit code and stderr', async () => {
+    const path = await install('pre-mutate', 'cat; echo "no completing T001" >&2; exit 3');
+    const result = await runMutateHook(path, payload());
+
+    expect(result.exitCode).toBe(3);
+    expect(result.timedOut).toBe(false);
+    expect(JSON.parse(result.stdout)).toMatchObject({ operation: 'complete', taskId: 'T001' });
+    expect(result.stderr).toContain('no completing T001');
+  });
+
+  it('kills a hook that runs past its timeout', async () => {
+    const path = await install('pre-mutate', 'sleep 5');
+    const result = await runMutateHook(path, payload(), { timeoutMs: 100 });
+
+    expect(result.timedOut).toBe(true);
+    expect(result.exitCode).toBeNull();
+    expect(result.durationMs).toBeLessThan(4_000);
+  });
+});
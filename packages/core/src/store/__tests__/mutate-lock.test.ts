@@ -0,0 +1,92 @@
+/**
+ * Tests for the project-wide mutate lock.
+ *
+ * Covers serialisation of concurrent writers, reentrancy for nested
+ * dispatch, and the `lock_timeout` failure when the lock stays held.
+ */
+
+import { mkdir, mkdtemp, rm } from 'node:fs/promises';
+import { tmpdir } from 'node:os';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { acquireLock } from '../lock.js';
+import { getMutateLockPath, withMutateLock } from '../mutate-lock.js';
+
+describe('withMutateLock', () => {
+  let tempDir: string;
+
+  beforeEach(async () => {
+    tempDir = await mkdtemp(join(tmpdir(), 'cleo-mutate-lock-'));
+    await mkdir(join(tempDir, '.cleo'));
+    process.env['CLEO_DIR'] = join(tempDir, '.cleo');
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    delete process.env['CLEO_MUTATE_LOCK_TIMEOUT_MS'];
+    await rm(tempDir, { recursive: true, force: true });
+  });
+
+  it('serialises concurrent mutates', async () => {
+    const events: string[] = [];
+    const mutate = (name: string) =>
+      withMutateLock(tempDir, async () => {
+        events.push(`${name}:start`);
+        await new Promise((r) => setTimeout(r, 30));
+        events.push(`${name}:end`);
+      });
+
+    await Promise.all([mutate('a'), mutate('b')]);
+
+    expect(events).toHaveLength(4);
+    expect(events[1]).toBe(events[0]!.replace('start', 'end'));
+  });
+
+  it('is reentrant within one call chain', async () => {
+    const result = await withMutateLock(tempDir, () =>
+      withMutateLock(tempDir, async () => 'nested', { timeoutMs: 0 }),
+    );
+    expect(result).toBe('nested');
+  });
+
+  it('fails with lock_timeout while another holder keeps the lock', async () => {
+    const lockPath = getMutateLockPath(tempDir);
+    await withMutateLock(tempDir, async () => undefined); // creates the lock file
+    const release = await acquireLock(lockPath, { retries: 0 });
+    try {
+      await expect(
+        withMutateLock(tempDir, async () => 'never', { timeoutMs: 120 }),
+      ).rejects.toMatchObject({
+        code: ExitCode.LOCK_TIMEOUT,
+        details: { error: 'lock_timeout', timeoutMs: 120 },
+      });
+    } finally {
+      await release();
+    }
+  });
+
+  it('reads the timeout from CLEO_MUTATE_LOCK_TIMEOUT_MS', async () => {
+    process.env['CLEO_MUTATE_LOCK_TIMEOUT_MS'] = '60';
+    await withMutateLock(tempDir, async () => undefined);
+    const release = await acquireLock(getMutateLockPath(tempDir), { retries: 0 });
+    try {
+      await expect(withMutateLock(tempDir, async () => 'never')).rejects.toMatchObject({
+        details: { timeoutMs: 60 },
+      });
+    } finally {
+      await release();
+    }
+  });
+
+  it('releases the lock when the mutate throws', async () => {
+    await expect(
+      withMutateLock(tempDir, async () => {
+        throw new Error('boom');
+      }),
+    ).rejects.toThrow('boom');
+    await expect(withMutateLock(tempDir, async () => 'ok', { timeoutMs: 0 })).resolves.toBe(
+      'ok',
+    );
+  });
+});
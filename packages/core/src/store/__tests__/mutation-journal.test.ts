@@ -0,0 +1,122 @@
+/**
+ * Tests for the operation journal behind `cleo undo` / `cleo redo`.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import {
+  JOURNAL_MAX_ENTRIES,
+  readJournal,
+  recordMutation,
+  redoLastMutation,
+  undoLastMutation,
+} from '../mutation-journal.js';
+import { resetDbState } from '../sqlite.js';
+import { captureTaskWrites } from '../task-write-capture.js';
+import { createTestDb, seedTasks, type TestDbEnv } from './test-db-helper.js';
+
+describe('mutation journal', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [{ id: 'T001', title: 'Original', status: 'pending' }]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  /** Run `write` as a journaled mutate, the way the dispatch middleware does. */
+  async function mutate(operation: string, write: () => Promise<void>): Promise<void> {
+    const { before } = await captureTaskWrites(write);
+    await recordMutation(env.tempDir, operation, before!);
+  }
+
+  it('journals only the tasks a mutate changed, and nothing for a no-op', async () => {
+    await mutate('tasks.update', () => env.accessor.updateTaskFields('T001', { title: 'Edited' }));
+    await mutate('tasks.update', async () => {});
+
+    const entries = readJournal(env.tempDir);
+    expect(entries).toHaveLength(1);
+    expect(entries[0]).toMatchObject({ kind: 'mutate', operation: 'tasks.update' });
+    expect(Object.keys(entries[0]!.before)).toEqual(['T001']);
+    expect(entries[0]!.before['T001']?.title).toBe('Original');
+    expect(entries[0]!.after['T001']?.title).toBe('Edited');
+  });
+
+  it('undo restores the prior state and redo re-applies it', async () => {
+    await mutate('tasks.update', () => env.accessor.updateTaskFields('T001', { title: 'Edited' }));
+    await mutate('tasks.add', () =>
+      seedTasks(env.accessor, [{ id: 'T002', title: 'Added', status: 'pending' }]),
+    );
+
+    const undoAdd = await undoLastMutation(env.tempDir);
+    expect(undoAdd).toMatchObject({ operation: 'tasks.add', removed: ['T002'], restored: [] });
+    expect(await env.accessor.loadSingleTask('T002')).toBeNull();
+
+    const undoEdit = await undoLastMutation(env.tempDir);
+    expect(undoEdit).toMatchObject({ operation: 'tasks.update', restored: ['T001'] });
+    expect((await env.accessor.loadSingleTask('T001'))?.title).toBe('Original');
+
+    await redoLastMutation(env.tempDir);
+    expect((await env.accessor.loadSingleTask('T001'))?.title).toBe('Edited');
+    expect(await env.accessor.loadSingleTask('T002')).toBeNull();
+
+    expect(readJournal(env.tempDir).map((e) => e.kind)).toEqual([
+      'mutate',
+      'mutate',
+      'undo',
+      'undo',
+      'redo',
+    ]);
+  });
+
+  it('a fresh mutate discards what was left to redo', async () => {
+    await mutate('tasks.update', () => env.accessor.updateTaskFields('T001', { title: 'Edited' }));
+    await undoLastMutation(env.tempDir);
+    await mutate('tasks.update', () => env.accessor.updateTaskFields('T001', { title: 'Other' }));
+
+    await expect(redoLastMutation(env.tempDir)).rejects.toMatchObject({
+      code: ExitCode.NOT_FOUND,
+    });
+  });
+
+  it('refuses to undo after an out-of-band write', async () => {
+    await mutate('tasks.update', () => env.accessor.updateTaskFields('T001', { title: 'Edited' }));
+    await env.accessor.updateTaskFields('T001', { title: 'Hand edit' });
+
+    await expect(undoLastMutation(env.tempDir)).rejects.toMatchObject({
+      code: ExitCode.CHECKSUM_MISMATCH,
+    });
+    expect((await env.accessor.loadSingleTask('T001'))?.title).toBe('Hand edit');
+  });
+
+  it('captures only the rows a mutate writes, including removal side effects', async () => {
+    await seedTasks(env.accessor, [
+      { id: 'T002', title: 'Blocked', status: 'pending', depends: ['T001'] },
+      { id: 'T003', title: 'Bystander', status: 'pending' },
+    ]);
+    const { before } = await captureTaskWrites(() => env.accessor.removeSingleTask('T001'));
+    expect([...before!.keys()].sort()).toEqual(['T001', 'T002']);
+
+    await recordMutation(env.tempDir, 'tasks.delete', before!);
+    expect(await undoLastMutation(env.tempDir)).toMatchObject({ restored: ['T001', 'T002'] });
+    expect((await env.accessor.loadSingleTask('T002'))?.depends).toEqual(['T001']);
+  });
+
+  it('keeps only the newest entries', async () => {
+    for (let i = 0; i <= JOURNAL_MAX_ENTRIES; i++) {
+      await mutate('tasks.update', () =>
+        env.accessor.updateTaskFields('T001', { title: `Edit ${i}` }),
+      );
+    }
+
+    const entries = readJournal(env.tempDir);
+    expect(entries).toHaveLength(JOURNAL_MAX_ENTRIES);
+    expect(entries.at(-1)!.after['T001']?.title).toBe(`Edit ${JOURNAL_MAX_ENTRIES}`);
+  });
+});
@@ -6,16 +6,19 @@
  */
 
 import type {
+  CompletionRequirement,
   Session,
   SessionScope,
   SessionStats,
   Task,
   TaskKind,
+  TaskNoteEntry,
   TaskPriority,
   TaskScope,
   TaskSeverity,
   TaskSize,
   TaskStatus,
+  TaskTimeEntry,
   TaskType,
 } from '@cleocode/contracts';
 import { safeParseJson, safeParseJsonArray } from './parsers.js';
@@ -61,6 +64,25 @@ export function rowToTask(row: TaskRow): Task {
         : undefined,
     pipelineStage: row.pipelineStage ?? undefined,
     assignee: row.assignee ?? undefined,
+    due: row.due ?? undefined,
+    recurrence: row.recurrenceJson ? safeParseJson(row.recurrenceJson) : undefined,
+    recurredTo: row.recurredTo ?? undefined,
+    estimate: row.estimate ?? undefined,
+    track: row.track ?? undefined,
+    deletedAt: row.deletedAt ?? undefined,
+    deletedParentId: row.deletedParentId ?? undefined,
+    blockedUntil: row.blockedUntil ?? undefined,
+    blockedPriorStatus: (row.blockedPriorStatus as TaskStatus | null) ?? undefined,
+    preArchiveStatus: (row.preArchiveStatus as TaskStatus | null) ?? undefined,
+    noteHistory: safeParseJsonArray<TaskNoteEntry>(row.noteHistoryJson),
+    commits: safeParseJsonArray(row.commitsJson),
+    timeEntries: safeParseJsonArray<TaskTimeEntry>(row.timeEntriesJson),
+    completionRequirements: safeParseJson<CompletionRequirement[]>(
+      row.completionRequirementsJson,
+    ),
+    idPrefix: row.idPrefix ?? undefined,
+    custom: row.customJson ? safeParseJson(row.customJson) : undefined,
+    autoCompleteParent: row.autoCompleteParent ?? undefined,
     // T944/T9072: orthogonal axes — kind (intent, DB col 'role') and scope (granularity)
     kind: (row.kind as TaskKind) ?? undefined,
     scope: (row.scope as TaskScope) ?? undefined,
@@ -117,6 +139,25 @@ export function taskToRow(task: Partial<Task> & { id: string }): NewTaskRow {
     sessionId: task.provenance?.sessionId ?? null,
     pipelineStage,
     assignee: task.assignee ?? null,
+    due: task.due ?? null,
+    recurrenceJson: task.recurrence ? JSON.stringify(task.recurrence) : null,
+    recurredTo: task.recurredTo ?? null,
+    estimate: task.estimate ?? null,
+    track: task.track ?? null,
+    deletedAt: task.deletedAt ?? null,
+    deletedParentId: task.deletedParentId ?? null,
+    blockedUntil: task.blockedUntil ?? null,
+    blockedPriorStatus: task.blockedPriorStatus ?? null,
+    preArchiveStatus: task.preArchiveStatus ?? null,
+    noteHistoryJson: task.noteHistory ? JSON.stringify(task.noteHistory) : '[]',
+    commitsJson: task.commits ? JSON.stringify(task.commits) : '[]',
+    timeEntriesJson: task.timeEntries ? JSON.stringify(task.timeEntries) : '[]',
+    completionRequirementsJson: task.completionRequirements
+      ? JSON.stringify(task.completionRequirements)
+      : null,
+    idPrefix: task.idPrefix ?? null,
+    customJson: task.custom ? JSON.stringify(task.custom) : null,
+    autoCompleteParent: task.autoCompleteParent ?? null,
     // T944/T9072: orthogonal axes — use undefined so Drizzle applies the column default
     kind: task.kind ?? undefined,
     scope: task.scope ?? undefined,
@@ -127,6 +168,7 @@ export function taskToRow(task: Partial<Task> & { id: string }): NewTaskRow {
 /** Convert a domain Task to a row suitable for archived tasks. */
 export function archivedTaskToRow(task: Task): NewTaskRow {
   const row = taskToRow(task);
+  if (task.status !== 'archived') row.preArchiveStatus = task.status;
   row.status = 'archived';
   if (!(row as Record<string, unknown>)['archivedAt']) {
     (row as Record<string, unknown>)['archivedAt'] = task.completedAt ?? new Date().toISOString();
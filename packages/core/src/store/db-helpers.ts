@@ -124,6 +124,23 @@ export async function upsertTask(
     // T060: pipeline stage name (RCASD-IVTR+C)
     pipelineStage: row.pipelineStage ?? null,
     assignee: row.assignee ?? null,
+    due: row.due ?? null,
+    recurrenceJson: row.recurrenceJson ?? null,
+    recurredTo: row.recurredTo ?? null,
+    estimate: row.estimate ?? null,
+    track: row.track ?? null,
+    deletedAt: row.deletedAt ?? null,
+    deletedParentId: row.deletedParentId ?? null,
+    blockedUntil: row.blockedUntil ?? null,
+    blockedPriorStatus: row.blockedPriorStatus ?? null,
+    preArchiveStatus: row.preArchiveStatus ?? null,
+    noteHistoryJson: row.noteHistoryJson,
+    commitsJson: row.commitsJson,
+    timeEntriesJson: row.timeEntriesJson,
+    completionRequirementsJson: row.completionRequirementsJson ?? null,
+    idPrefix: row.idPrefix ?? null,
+    customJson: row.customJson ?? null,
+    autoCompleteParent: row.autoCompleteParent ?? null,
     // Always include archive metadata so unarchive clears stale values (T5034)
     archivedAt: archiveFields?.archivedAt ?? null,
     archiveReason: archiveFields?.archiveReason ?? null,
@@ -7,6 +7,7 @@
  */
 
 import type { Task } from '@cleocode/contracts';
+import { isValidTaskId } from '../tasks/id-generator.js';
 
 /** Forward and reverse remap tables. */
 export interface RemapTable {
@@ -71,7 +72,7 @@ export function validateRemapTable(
 
   // Check ID format
   for (const newId of table.reverse.keys()) {
-    if (!/^T\d{3,}$/.test(newId)) {
+    if (!isValidTaskId(newId)) {
       errors.push(`Invalid new task ID format: ${newId}`);
     }
   }
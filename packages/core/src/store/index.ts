@@ -29,6 +29,40 @@ export {
 } from './json.js';
 export type { ReleaseFn } from './lock.js';
 export { acquireLock, isLocked, withLock } from './lock.js';
+export type { MutateLockOptions } from './mutate-lock.js';
+export {
+  DEFAULT_MUTATE_LOCK_TIMEOUT_MS,
+  getMutateLockPath,
+  withMutateLock,
+} from './mutate-lock.js';
+export type {
+  MutateHookName,
+  MutateHookOptions,
+  MutateHookPayload,
+  MutateHookResult,
+} from './mutate-hooks.js';
+export {
+  DEFAULT_MUTATE_HOOK_TIMEOUT_MS,
+  findMutateHook,
+  getMutateHookPath,
+  MAX_MUTATE_HOOK_TIMEOUT_MS,
+  MUTATE_HOOKS,
+  runMutateHook,
+} from './mutate-hooks.js';
+export type {
+  JournalEntry,
+  JournalEntryKind,
+  JournalReplayResult,
+} from './mutation-journal.js';
+export {
+  getJournalPath,
+  JOURNAL_FILE,
+  JOURNAL_MAX_ENTRIES,
+  readJournal,
+  recordMutation,
+  redoLastMutation,
+  undoLastMutation,
+} from './mutation-journal.js';
 export { type CleoDbRole, type DBHandle, openCleoDb } from './open-cleo-db.js';
 export type {
   AddTaskOptions,
@@ -87,6 +121,7 @@ export {
   vacuumIntoBackup,
   vacuumIntoBackupAll,
 } from './sqlite-backup.js';
+export { captureTaskWrites, type TaskWriteSnapshot } from './task-write-capture.js';
 export { UmbrellaDataAccessor } from './umbrella-data-accessor.js';
 
 /**
@@ -8,6 +8,7 @@
 import { createHash } from 'node:crypto';
 import { ExitCode } from '@cleocode/contracts';
 import { CleoError } from '../errors.js';
+import { compareTaskIds } from '../tasks/sort.js';
 import { atomicWrite, atomicWriteJson, safeReadFile } from './atomic.js';
 import { createBackup } from './backup.js';
 import { withLock } from './lock.js';
@@ -38,12 +39,52 @@ export async function readJsonRequired<T = unknown>(filePath: string): Promise<T
   return data;
 }
 
+/**
+ * Serialize a value with the keys of every object sorted, so values that
+ * differ only in key insertion order serialize identically. Array order is
+ * preserved.
+ */
+export function canonicalJson(value: unknown, indent?: number): string {
+  return JSON.stringify(
+    value,
+    (_key, v: unknown) => {
+      if (v === null || typeof v !== 'object' || Array.isArray(v)) return v;
+      return Object.fromEntries(
+        Object.entries(v as Record<string, unknown>).sort(([a], [b]) =>
+          a < b ? -1 : a > b ? 1 : 0,
+        ),
+      );
+    },
+    indent,
+  );
+}
+
+/**
+ * Sort a list of records by their string `id` in natural order (`T9` before
+ * `T10`). Anything that is not such a list is returned unchanged.
+ */
+export function sortById<T>(data: T): T {
+  if (!Array.isArray(data)) return data;
+  const records = data as unknown[];
+  const keyed = records.every(
+    (r) => typeof r === 'object' && r !== null && typeof (r as { id?: unknown }).id === 'string',
+  );
+  if (!keyed) return data;
+  return [...(records as Array<{ id: string }>)].sort((a, b) =>
+    compareTaskIds(a.id, b.id),
+  ) as T;
+}
+
 /**
  * Compute a truncated SHA-256 checksum of a value.
  * Used for integrity verification (matches Bash CLI's 16-char hex format).
+ *
+ * The value is canonicalized first: a task list is sorted by ID and object
+ * keys are sorted, so a logically identical task set always hashes the same
+ * regardless of the order the store returned it in.
  */
 export function computeChecksum(data: unknown): string {
-  const json = JSON.stringify(data);
+  const json = canonicalJson(sortById(data));
   const hash = createHash('sha256').update(json).digest('hex');
   return hash.substring(0, 16);
 }
@@ -11,7 +11,7 @@
  */
 
 import { existsSync, mkdirSync, readFileSync } from 'node:fs';
-import { dirname, join } from 'node:path';
+import { basename, dirname, join } from 'node:path';
 import {
   ARCHIVE_REASON_TOMBSTONE,
   ARCHIVE_REASONS,
@@ -21,6 +21,7 @@ import {
 } from '@cleocode/contracts';
 import type { NodeSQLiteDatabase } from 'drizzle-orm/node-sqlite';
 import { drizzle } from 'drizzle-orm/node-sqlite';
+import { runAllMigrations } from '../migration/index.js';
 import { resolveCleoDir } from '../paths.js';
 import { migrateSanitized } from './migration-manager.js';
 import { dbExists, getDb, openNativeDatabase, resolveMigrationsFolder } from './sqlite.js';
@@ -168,6 +169,31 @@ export function countJsonRecords(cleoDir: string): {
  * Reads todo.json, todo-archive.json, and sessions.json,
  * writes to tasks.db via drizzle-orm.
  */
+/**
+ * Bring legacy `todo.json` / `todo-archive.json` up to the current JSON schema
+ * before any rows are read from them, so files written by older CLEO versions
+ * import with the same field defaults as current ones. Each rewritten file is
+ * first copied to `<file>.bak`.
+ */
+async function upgradeLegacyJsonFiles(
+  cwd: string | undefined,
+  result: MigrationResult,
+): Promise<void> {
+  try {
+    for (const upgrade of await runAllMigrations({}, cwd)) {
+      result.errors.push(...upgrade.errors);
+      if (upgrade.migrationsApplied.length > 0 && upgrade.success) {
+        const name = basename(upgrade.file);
+        result.warnings.push(
+          `Upgraded ${name} from schema ${upgrade.fromVersion} to ${upgrade.toVersion} (original saved as ${name}.bak)`,
+        );
+      }
+    }
+  } catch (err) {
+    result.errors.push(`Failed to upgrade legacy JSON schema: ${String(err)}`);
+  }
+}
+
 /**
  * Migrate JSON data to SQLite with atomic rename pattern.
  * Writes to a temporary database file first, then atomically renames.
@@ -230,6 +256,7 @@ export async function migrateJsonToSqliteAtomic(
     }
 
     // Run the actual migration
+    await upgradeLegacyJsonFiles(cwd, result);
     logger?.info('import', 'data-import', 'Starting data import from JSON files');
     await runMigrationDataImport(db, cleoDir, result, logger);
 
@@ -665,6 +692,7 @@ export async function migrateJsonToSqlite(
     return result;
   }
 
+  await upgradeLegacyJsonFiles(cwd, result);
   const db = await getDb(cwd);
 
   // === MIGRATE TASKS from todo.json ===
@@ -0,0 +1,181 @@
+/**
+ * Project mutate hook scripts — `.cleo/hooks/pre-mutate` and `post-mutate`.
+ *
+ * A project can wire external automation (chat notifications, dashboards,
+ * policy checks) into every mutate without patching cleo: drop an
+ * executable at `.cleo/hooks/<name>` under the project root and it is run
+ * with a JSON {@link MutateHookPayload} on stdin.
+ *
+ *   - `pre-mutate` runs before the handler. A non-zero exit (or a timeout)
+ *     rejects the mutate; the hook's stderr is returned to the caller.
+ *   - `post-mutate` runs after a successful mutate. Its exit status is
+ *     ignored — the write has already happened.
+ *
+ * Hooks run with the project root as their working directory and
+ * `CLEO_HOOK` / `CLEO_PROJECT_ROOT` in the environment. A hook that runs
+ * past its timeout is killed. Timeout resolution order: `options.timeoutMs`
+ * → `CLEO_HOOK_TIMEOUT_MS` → {@link DEFAULT_MUTATE_HOOK_TIMEOUT_MS}, never
+ * more than {@link MAX_MUTATE_HOOK_TIMEOUT_MS}.
+ *
+ * The dispatch layer runs both hooks inside the project mutate lock, so the
+ * `before` / `after` snapshots are consistent with the write.
+ */
+
+import { spawn } from 'node:child_process';
+import { accessSync, constants, statSync } from 'node:fs';
+import { join } from 'node:path';
+import type { Task } from '@cleocode/contracts';
+import { getCleoDirAbsolute } from '../paths.js';
+
+/** Hook scripts cleo looks for under `.cleo/hooks/`. */
+export const MUTATE_HOOKS = ['pre-mutate', 'post-mutate'] as const;
+
+/** Name of a mutate hook script. */
+export type MutateHookName = (typeof MUTATE_HOOKS)[number];
+
+/** Default time a hook may run before it is killed (5s). */
+export const DEFAULT_MUTATE_HOOK_TIMEOUT_MS = 5_000;
+
+/** Upper bound on any hook timeout, however configured (60s). */
+export const MAX_MUTATE_HOOK_TIMEOUT_MS = 60_000;
+
+/** Most bytes of stdout / stderr kept from a hook run. */
+const MAX_OUTPUT_BYTES = 64 * 1024;
+
+/** JSON document written to a hook's stdin. */
+export interface MutateHookPayload {
+  hook: MutateHookName;
+  /** Dispatch domain, e.g. `tasks`. */
+  domain: string;
+  /** Operation name within the domain, e.g. `update`. */
+  operation: string;
+  /** Task the mutate targets, when the request names one. */
+  taskId: string | null;
+  /** Request parameters as dispatched. */
+  params: Record<string, unknown>;
+  /** Task state before the mutate (`null` when none or not found). */
+  before: Task | null;
+  /** Task state after the mutate (`post-mutate` only; `null` otherwise). */
+  after: Task | null;
+  timestamp: string;
+  projectRoot: string;
+}
+
+/** Outcome of running one hook script. */
+export interface MutateHookResult {
+  hook: MutateHookName;
+  path: string;
+  /** Process exit code; `null` when it was killed or failed to start. */
+  exitCode: number | null;
+  timedOut: boolean;
+  stdout: string;
+  stderr: string;
+  durationMs: number;
+}
+
+/** Options for {@link runMutateHook}. */
+export interface MutateHookOptions {
+  /** Time the hook may run, in milliseconds (capped at the maximum). */
+  timeoutMs?: number;
+}
+
+/** Resolve the effective hook timeout. */
+function resolveTimeoutMs(options: MutateHookOptions): number {
+  const fromEnv = Number.parseInt(process.env['CLEO_HOOK_TIMEOUT_MS'] ?? '', 10);
+  const requested =
+    options.timeoutMs ??
+    (Number.isFinite(fromEnv) && fromEnv > 0 ? fromEnv : DEFAULT_MUTATE_HOOK_TIMEOUT_MS);
+  return Math.min(Math.max(1, requested), MAX_MUTATE_HOOK_TIMEOUT_MS);
+}
+
+/**
+ * Absolute path where a hook script is looked up.
+ *
+ * @param projectRoot - Project root directory.
+ * @param hook - Hook name.
+ */
+export function getMutateHookPath(projectRoot: string, hook: MutateHookName): string {
+  return join(getCleoDirAbsolute(projectRoot), 'hooks', hook);
+}
+
+/**
+ * Path of an installed hook, or `null` when there is no executable file.
+ *
+ * @param projectRoot - Project root directory.
+ * @param hook - Hook name.
+ */
+export function findMutateHook(projectRoot: string, hook: MutateHookName): string | null {
+  const path = getMutateHookPath(projectRoot, hook);
+  try {
+    if (!statSync(path).isFile()) return null;
+    accessSync(path, constants.X_OK);
+    return path;
+  } catch {
+    return null;
+  }
+}
+
+/**
+ * Run a hook script with `payload` on stdin.
+ *
+ * Never throws: a script that cannot be started reports `exitCode: null`
+ * with the spawn error in `stderr`.
+ *
+ * @param path - Hook script path (see {@link findMutateHook}).
+ * @param payload - Document written to the script's stdin.
+ * @param options - Optional timeout override.
+ */
+export function runMutateHook(
+  path: string,
+  payload: MutateHookPayload,
+  options: MutateHookOptions = {},
+): Promise<MutateHookResult> {
+  const timeoutMs = resolveTimeoutMs(options);
+  const started = Date.now();
+  return new Promise((resolve) => {
+    const out = { stdout: '', stderr: '' };
+    let timedOut = false;
+    let settled = false;
+    const finish = (exitCode: number | null, spawnError?: string): void => {
+      if (settled) return;
+      settled = true;
+      clearTimeout(timer);
+      resolve({
+        hook: payload.hook,
+        path,
+        exitCode,
+        timedOut,
+        stdout: out.stdout,
+        stderr: spawnError ?? out.stderr,
+        durationMs: Date.now() - started,
+      });
+    };
+
+    const child = spawn(path, [], {
+      cwd: payload.projectRoot,
+      env: { ...process.env, CLEO_HOOK: payload.hook, CLEO_PROJECT_ROOT: payload.projectRoot },
+      stdio: ['pipe', 'pipe', 'pipe'],
+    });
+    const timer = setTimeout(() => {
+      timedOut = true;
+      child.kill('SIGKILL');
+      // Don't wait for 'close': a grandchild may still hold the pipes open.
+      child.stdout?.destroy();
+      child.stderr?.destroy();
+      finish(null);
+    }, timeoutMs);
+
+    const collect = (key: 'stdout' | 'stderr') => (chunk: Buffer) => {
+      if (out[key].length < MAX_OUTPUT_BYTES) {
+        out[key] = (out[key] + chunk.toString('utf-8')).slice(0, MAX_OUTPUT_BYTES);
+      }
+    };
+    child.stdout?.on('data', collect('stdout'));
+    child.stderr?.on('data', collect('stderr'));
+    child.on('error', (err) => finish(null, err.message));
+    child.on('close', (code) => finish(timedOut ? null : code));
+    // A hook may exit without reading stdin; ignore the broken pipe.
+    child.stdin?.on('error', () => {});
+    child.stdin?.end(`${JSON.stringify(payload)}\n`);
+  });
+}
@@ -0,0 +1,135 @@
+/**
+ * Project-wide advisory lock serialising mutate operations.
+ *
+ * Two agents running `cleo add` / `cleo update` / `cleo complete` against the
+ * same project at the same time each read the task graph, decide, and write.
+ * SQLite serialises the individual transactions, but not the read → decide →
+ * write sequence around them, so concurrent mutates can interleave and
+ * clobber each other's view (e.g. both allocating against the same parent,
+ * both passing a cycle check that is only violated by the pair).
+ *
+ * {@link withMutateLock} takes an exclusive `proper-lockfile` lock on
+ * `.cleo/mutate.lock` for the whole mutate — from before the first read until
+ * after the last write — so at most one writer runs per project. Contending
+ * callers back off (50ms doubling, capped at 500ms) until a configurable
+ * timeout, then fail with {@link ExitCode.LOCK_TIMEOUT} and
+ * `details.error === 'lock_timeout'`. Queries never take the lock.
+ *
+ * The lock is reentrant within one async call chain: dispatch paths that
+ * re-enter the dispatcher (e.g. the selfimprove domain) run their nested
+ * mutates under the lock the outer call already holds.
+ *
+ * Timeout resolution order: `options.timeoutMs` → `CLEO_MUTATE_LOCK_TIMEOUT_MS`
+ * → {@link DEFAULT_MUTATE_LOCK_TIMEOUT_MS}.
+ */
+
+import { AsyncLocalStorage } from 'node:async_hooks';
+import { appendFileSync, existsSync } from 'node:fs';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import { getCleoDirAbsolute } from '../paths.js';
+import { acquireLock, type ReleaseFn } from './lock.js';
+
+/** Lock file name inside the project `.cleo/` directory. */
+export const MUTATE_LOCK_FILE = 'mutate.lock';
+
+/** Default time a mutate waits for the lock before failing (10s). */
+export const DEFAULT_MUTATE_LOCK_TIMEOUT_MS = 10_000;
+
+/** Initial backoff between acquisition attempts. */
+const BACKOFF_START_MS = 50;
+
+/** Upper bound on the backoff between acquisition attempts. */
+const BACKOFF_MAX_MS = 500;
+
+/**
+ * Age after which a lock left behind by a crashed process is reclaimed.
+ * `proper-lockfile` refreshes the lock's mtime while it is held, so long
+ * mutates are not mistaken for stale ones.
+ */
+const MUTATE_LOCK_STALE_MS = 10_000;
+
+/** Lock paths held by the current async call chain. */
+const heldLocks = new AsyncLocalStorage<ReadonlySet<string>>();
+
+/** Options for {@link withMutateLock}. */
+export interface MutateLockOptions {
+  /** Maximum time to wait for the lock, in milliseconds. */
+  timeoutMs?: number;
+}
+
+/** Resolve the effective lock timeout. */
+function resolveTimeoutMs(options: MutateLockOptions): number {
+  if (options.timeoutMs !== undefined) return Math.max(0, options.timeoutMs);
+  const fromEnv = Number.parseInt(process.env['CLEO_MUTATE_LOCK_TIMEOUT_MS'] ?? '', 10);
+  return Number.isFinite(fromEnv) && fromEnv >= 0 ? fromEnv : DEFAULT_MUTATE_LOCK_TIMEOUT_MS;
+}
+
+/**
+ * Absolute path of the mutate lock file for a project.
+ *
+ * @param projectRoot - Project root directory.
+ */
+export function getMutateLockPath(projectRoot: string): string {
+  return join(getCleoDirAbsolute(projectRoot), MUTATE_LOCK_FILE);
+}
+
+/** Poll {@link acquireLock} with capped exponential backoff until `deadline`. */
+async function acquireWithBackoff(lockPath: string, timeoutMs: number): Promise<ReleaseFn> {
+  const deadline = Date.now() + timeoutMs;
+  let delayMs = BACKOFF_START_MS;
+  for (;;) {
+    try {
+      return await acquireLock(lockPath, { retries: 0, stale: MUTATE_LOCK_STALE_MS });
+    } catch (err) {
+      const remaining = deadline - Date.now();
+      if (remaining <= 0) {
+        throw new CleoError(
+          ExitCode.LOCK_TIMEOUT,
+          `Timed out after ${timeoutMs}ms waiting for another cleo process to finish writing`,
+          {
+            fix: 'Retry shortly, or raise CLEO_MUTATE_LOCK_TIMEOUT_MS if writes are long-running',
+            details: { field: 'lock', error: 'lock_timeout', timeoutMs, lockPath },
+            cause: err,
+          },
+        );
+      }
+      await new Promise((r) => setTimeout(r, Math.min(delayMs, remaining)));
+      delayMs = Math.min(delayMs * 2, BACKOFF_MAX_MS);
+    }
+  }
+}
+
+/**
+ * Run `fn` while holding the project's exclusive mutate lock.
+ *
+ * Projects without a `.cleo/` directory (e.g. before `cleo init`) have no
+ * task data to protect, so `fn` runs unlocked.
+ *
+ * @param projectRoot - Project root directory.
+ * @param fn - The mutate to run. The lock is released when it settles.
+ * @param options - Optional timeout override.
+ * @returns The resolved value of `fn`.
+ * @throws CleoError `LOCK_TIMEOUT` with `details.error === 'lock_timeout'`
+ *   when the lock is still held by another process after the timeout.
+ */
+export async function withMutateLock<T>(
+  projectRoot: string,
+  fn: () => Promise<T>,
+  options: MutateLockOptions = {},
+): Promise<T> {
+  const cleoDir = getCleoDirAbsolute(projectRoot);
+  const lockPath = join(cleoDir, MUTATE_LOCK_FILE);
+  const held = heldLocks.getStore();
+  if (held?.has(lockPath) || !existsSync(cleoDir)) return fn();
+
+  // proper-lockfile attaches to an existing file; create it zero-length.
+  appendFileSync(lockPath, '', { encoding: 'utf-8' });
+  const release = await acquireWithBackoff(lockPath, resolveTimeoutMs(options));
+  try {
+    return await heldLocks.run(new Set([...(held ?? []), lockPath]), fn);
+  } finally {
+    await release();
+  }
+}
@@ -0,0 +1,306 @@
+/**
+ * Operation journal — `cleo undo` / `cleo redo` for task mutations.
+ *
+ * The dispatch layer appends one entry to `.cleo/journal.ndjson` for every
+ * successful mutate that changed the task store. An entry records the
+ * before- and after-state of each task the mutate changed (`null` for a task
+ * that did not exist). The rows come from {@link captureTaskWrites}, so only
+ * the tasks a mutate wrote are ever loaded. Only the last
+ * {@link JOURNAL_MAX_ENTRIES} entries are kept.
+ *
+ * {@link undoLastMutation} writes the before-state of the newest mutate that
+ * has not been undone; {@link redoLastMutation} re-applies the newest undone
+ * one. Each is itself journaled (`kind: 'undo'` / `'redo'`), so undo and redo
+ * walk the history like an editor's undo stack: a fresh mutate discards
+ * whatever was left to redo.
+ *
+ * Both refuse with {@link ExitCode.CHECKSUM_MISMATCH} when a task they would
+ * rewrite no longer matches the state the entry recorded — something wrote
+ * to it outside the journal, and restoring old state would silently discard
+ * that write. Trashed tasks are part of the state, so a delete undoes into
+ * the task's prior row.
+ *
+ * This is a short-horizon safety net for mistakes, distinct from trash and
+ * archive: it restores task rows exactly, including edits that trash never
+ * sees.
+ */
+
+import { randomBytes } from 'node:crypto';
+import { existsSync, readFileSync } from 'node:fs';
+import { appendFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import type { Task, TasksUndoResult } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { getCleoDirAbsolute } from '../paths.js';
+import { refreshTaskChecksum } from '../validation/task-checksum.js';
+import { atomicWrite } from './atomic.js';
+import { getTaskAccessor } from './data-accessor.js';
+import { canonicalJson } from './json.js';
+import { withMutateLock } from './mutate-lock.js';
+import type { TaskWriteSnapshot } from './task-write-capture.js';
+
+/** Journal file name inside the project `.cleo/` directory. */
+export const JOURNAL_FILE = 'journal.ndjson';
+
+/** Most entries the journal keeps; older ones are dropped on append. */
+export const JOURNAL_MAX_ENTRIES = 100;
+
+/** What wrote a journal entry. */
+export type JournalEntryKind = 'mutate' | 'undo' | 'redo';
+
+/** One line of `.cleo/journal.ndjson`. */
+export interface JournalEntry {
+  id: string;
+  /** ISO 8601 timestamp the entry was written. */
+  at: string;
+  kind: JournalEntryKind;
+  /** `domain.operation` of the mutate (for undo / redo, of the entry reversed). */
+  operation: string;
+  /** Entry an undo reversed or a redo re-applied. */
+  target?: string;
+  /** Each touched task before the write; `null` when it did not exist. */
+  before: Record<string, Task | null>;
+  /** Each touched task after the write; `null` when it no longer exists. */
+  after: Record<string, Task | null>;
+}
+
+/** Result of {@link undoLastMutation} / {@link redoLastMutation}. */
+export type JournalReplayResult = TasksUndoResult;
+
+/**
+ * Absolute path of the journal file for a project.
+ *
+ * @param projectRoot - Project root directory.
+ */
+export function getJournalPath(projectRoot: string): string {
+  return join(getCleoDirAbsolute(projectRoot), JOURNAL_FILE);
+}
+
+/**
+ * Read the journal, oldest first. Lines that do not parse are skipped.
+ *
+ * @param projectRoot - Project root directory.
+ */
+export function readJournal(projectRoot: string): JournalEntry[] {
+  const path = getJournalPath(projectRoot);
+  if (!existsSync(path)) return [];
+  const entries: JournalEntry[] = [];
+  for (const line of readFileSync(path, 'utf-8').split('\n')) {
+    if (!line.trim()) continue;
+    try {
+      entries.push(JSON.parse(line) as JournalEntry);
+    } catch {
+      // A torn final line from a crash mid-append; the rest is still usable.
+    }
+  }
+  return entries;
+}
+
+/** Append `entry`, rewriting the file only when it outgrows the bound. */
+async function appendJournal(projectRoot: string, entry: JournalEntry): Promise<void> {
+  const path = getJournalPath(projectRoot);
+  const entries = readJournal(projectRoot);
+  if (entries.length < JOURNAL_MAX_ENTRIES) {
+    await appendFile(path, `${JSON.stringify(entry)}\n`, 'utf-8');
+    return;
+  }
+  const kept = [...entries.slice(-(JOURNAL_MAX_ENTRIES - 1)), entry];
+  await atomicWrite(path, kept.map((e) => `${JSON.stringify(e)}\n`).join(''));
+}
+
+/** Current row of each of `ids`, any status; `null` for a missing task. */
+async function loadRows(projectRoot: string, ids: readonly string[]): Promise<TaskWriteSnapshot> {
+  const accessor = await getTaskAccessor(projectRoot);
+  const rows = new Map((await accessor.loadTasks([...ids])).map((t) => [t.id, t]));
+  return new Map(ids.map((id) => [id, rows.get(id) ?? null]));
+}
+
+/** Whether two row states are the same (both missing, or equal rows). */
+function sameRow(a: Task | null | undefined, b: Task | null | undefined): boolean {
+  return canonicalJson(a ?? null) === canonicalJson(b ?? null);
+}
+
+/** Entry ID in the style of the audit log IDs. */
+function newEntryId(): string {
+  return `op-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`;
+}
+
+/**
+ * Journal a completed mutate. Nothing is written when no captured task
+ * changed. Callers must hold the project mutate lock from before the mutate
+ * ran until this returns.
+ *
+ * @param projectRoot - Project root directory.
+ * @param operation - `domain.operation` of the mutate.
+ * @param before - Rows {@link captureTaskWrites} saw the mutate write.
+ * @returns The entry written, or `null` when nothing changed.
+ */
+export async function recordMutation(
+  projectRoot: string,
+  operation: string,
+  before: TaskWriteSnapshot,
+): Promise<JournalEntry | null> {
+  if (before.size === 0) return null;
+  const after = await loadRows(projectRoot, [...before.keys()]);
+  const diff: Pick<JournalEntry, 'before' | 'after'> = { before: {}, after: {} };
+  for (const [id, prev] of before) {
+    const next = after.get(id) ?? null;
+    if (sameRow(prev, next)) continue;
+    diff.before[id] = prev;
+    diff.after[id] = next;
+  }
+  if (Object.keys(diff.before).length === 0) return null;
+  const entry: JournalEntry = {
+    id: newEntryId(),
+    at: new Date().toISOString(),
+    kind: 'mutate',
+    operation,
+    ...diff,
+  };
+  await appendJournal(projectRoot, entry);
+  return entry;
+}
+
+/**
+ * Replay the journal into its undo stack (applied mutates, newest last) and
+ * redo stack (undone mutates, most recently undone last).
+ */
+function replayStacks(entries: readonly JournalEntry[]): {
+  applied: JournalEntry[];
+  undone: JournalEntry[];
+} {
+  const applied: JournalEntry[] = [];
+  const undone: JournalEntry[] = [];
+  for (const entry of entries) {
+    if (entry.kind === 'mutate') {
+      applied.push(entry);
+      undone.length = 0;
+      continue;
+    }
+    const [from, to] = entry.kind === 'undo' ? [applied, undone] : [undone, applied];
+    const index = from.findIndex((e) => e.id === entry.target);
+    if (index !== -1) to.push(...from.splice(index, 1));
+  }
+  return { applied, undone };
+}
+
+/** Write `states` back: `null` removes the row, a task replaces it. */
+async function writeStates(
+  projectRoot: string,
+  states: Record<string, Task | null>,
+): Promise<Pick<JournalReplayResult, 'restored' | 'removed'>> {
+  const accessor = await getTaskAccessor(projectRoot);
+  const restore = Object.values(states).filter((t): t is Task => t !== null);
+  const removed = Object.keys(states).filter((id) => states[id] === null);
+  await accessor.transaction(async (tx) => {
+    for (const id of removed) await tx.removeSingleTask(id);
+    // Two passes so dependency rows only reference tasks that already exist.
+    for (const task of restore) await tx.upsertSingleTask({ ...task, depends: undefined });
+    for (const task of restore) {
+      if (task.depends?.length) await tx.upsertSingleTask(task);
+    }
+  });
+  return { restored: restore.map((t) => t.id).sort(), removed: removed.sort() };
+}
+
+/** Shared body of undo and redo. */
+async function replay(projectRoot: string, kind: 'undo' | 'redo'): Promise<JournalReplayResult> {
+  return withMutateLock(projectRoot, async () => {
+    const entries = readJournal(projectRoot);
+    const { applied, undone } = replayStacks(entries);
+    const target = (kind === 'undo' ? applied : undone).at(-1);
+    if (!target) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Nothing to ${kind}`, {
+        fix: kind === 'undo' ? 'Only journaled mutates can be undone' : 'Run cleo undo first',
+      });
+    }
+
+    const [from, to] =
+      kind === 'undo' ? [target.after, target.before] : [target.before, target.after];
+    const current = await loadRows(projectRoot, Object.keys(from));
+    const changed = [...current].filter(([id, row]) => !sameRow(row, from[id])).map(([id]) => id);
+    if (changed.length > 0) {
+      throw new CleoError(
+        ExitCode.CHECKSUM_MISMATCH,
+        `Refusing to ${kind}: ${changed.sort().join(', ')} changed outside the journal`,
+        {
+          fix: `Review the change with cleo show ${changed[0]}; ${kind} cannot discard it`,
+          details: { field: 'state', operation: target.operation, changed },
+        },
+      );
+    }
+
+    const written = await writeStates(projectRoot, to);
+    // Keep the `cleo verify` seal current, as the dispatch layer does after a mutate.
+    await refreshTaskChecksum(projectRoot).catch(() => false);
+    await appendJournal(projectRoot, {
+      id: newEntryId(),
+      at: new Date().toISOString(),
+      kind,
+      operation: target.operation,
+      target: target.id,
+      before: from,
+      after: to,
+    });
+    return { kind, entryId: target.id, operation: target.operation, at: target.at, ...written };
+  });
+}
+
+/**
+ * Restore the task state from before the newest mutate not yet undone.
+ *
+ * @param projectRoot - Project root directory.
+ * @throws CleoError `NOT_FOUND` when there is nothing to undo.
+ * @throws CleoError `CHECKSUM_MISMATCH` when a task the undo would rewrite
+ *   changed outside the journal.
+ */
+export async function undoLastMutation(projectRoot: string): Promise<JournalReplayResult> {
+  return replay(projectRoot, 'undo');
+}
+
+/**
+ * Re-apply the most recently undone mutate.
+ *
+ * @param projectRoot - Project root directory.
+ * @throws CleoError `NOT_FOUND` when there is nothing to redo.
+ * @throws CleoError `CHECKSUM_MISMATCH` when a task the redo would rewrite
+ *   changed outside the journal.
+ */
+export async function redoLastMutation(projectRoot: string): Promise<JournalReplayResult> {
+  return replay(projectRoot, 'redo');
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrappers
+// ---------------------------------------------------------------------------
+
+/**
+ * Undo the newest journaled mutate, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @returns EngineResult with the entry reversed and the tasks written back
+ */
+export async function taskUndo(projectRoot: string): Promise<EngineResult<JournalReplayResult>> {
+  try {
+    return engineSuccess(await undoLastMutation(projectRoot));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to undo');
+  }
+}
+
+/**
+ * Redo the most recently undone mutate, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @returns EngineResult with the entry re-applied and the tasks written back
+ */
+export async function taskRedo(projectRoot: string): Promise<EngineResult<JournalReplayResult>> {
+  try {
+    return engineSuccess(await redoLastMutation(projectRoot));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to redo');
+  }
+}
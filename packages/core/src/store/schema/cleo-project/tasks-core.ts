@@ -77,9 +77,11 @@ import {
   index,
   integer,
   primaryKey,
+  real,
   sqliteTable,
   text,
   unique,
+  uniqueIndex,
 } from 'drizzle-orm/sqlite-core';
 import { SESSION_STATUSES, TASK_STATUSES } from '../../status-registry.js';
 import {
@@ -179,6 +181,40 @@ export const tasksTasks = sqliteTable(
     pipelineStage: text('pipeline_stage'),
     /** Assignee agent id. */
     assignee: text('assignee'),
+    /** Due date — RFC 3339 full-date or date-time as supplied (validated on write). */
+    due: text('due'),
+    /** JSON recurrence rule (`{ every }`); NULL for one-off tasks. */
+    recurrenceJson: text('recurrence_json'),
+    /** ID of the occurrence created when this recurring task completed. */
+    recurredTo: text('recurred_to'),
+    /** Effort estimate (story points or hours); NULL when unestimated. */
+    estimate: real('estimate'),
+    /** Orchestration track; NULL falls back to the saga track and label rules. */
+    track: text('track'),
+    /** ISO-8601 UTC instant the task was moved to the trash; NULL when not trashed. */
+    deletedAt: text('deleted_at'),
+    /** Parent at deletion time (not an FK — survives the parent being purged). */
+    deletedParentId: text('deleted_parent_id'),
+    /** RFC 3339 date after which a blocked task is a ready candidate again; NULL when open-ended. */
+    blockedUntil: text('blocked_until'),
+    /** Status held before `tasks.block`, restored by `tasks.unblock`. */
+    blockedPriorStatus: text('blocked_prior_status'),
+    /** Status held when the task was archived, restored by `tasks.archive.restore`. */
+    preArchiveStatus: text('pre_archive_status'),
+    /** JSON append-only note history — `{ at, author, text }` entries, oldest first. */
+    noteHistoryJson: text('note_history_json').default('[]'),
+    /** JSON array of linked git commit SHAs (lowercase hex, deduplicated). */
+    commitsJson: text('commits_json').default('[]'),
+    /** JSON tracked work intervals — `{ start, stop? }` entries, oldest first. */
+    timeEntriesJson: text('time_entries_json').default('[]'),
+    /** JSON array of fields required before completion; NULL inherits the saga's. */
+    completionRequirementsJson: text('completion_requirements_json'),
+    /** Saga ID prefix (`AUTH` → tasks `AUTH-1`, `AUTH-2`); NULL keeps global `T<n>` IDs. */
+    idPrefix: text('id_prefix'),
+    /** JSON object of project custom field values (`cleo update --set`), keyed by field name. */
+    customJson: text('custom_json'),
+    /** Complete this task when its last open child completes (`--auto-complete-parent`). */
+    autoCompleteParent: integer('auto_complete_parent', { mode: 'boolean' }),
     /** JSON IVTR orchestration state (TEXT per JSON audit). */
     ivtrState: text('ivtr_state'),
     /**
@@ -203,10 +239,12 @@ export const tasksTasks = sqliteTable(
     index('idx_tasks_tasks_status_priority').on(table.status, table.priority),
     index('idx_tasks_tasks_type_phase').on(table.type, table.phase),
     index('idx_tasks_tasks_status_archive_reason').on(table.status, table.archiveReason),
+    index('idx_tasks_tasks_deleted_at').on(table.deletedAt),
     index('idx_tasks_tasks_role').on(table.kind),
     index('idx_tasks_tasks_scope').on(table.scope),
     index('idx_tasks_tasks_role_status').on(table.kind, table.status),
     index('idx_tasks_tasks_created_date').on(sql`date(${table.createdAt})`),
+    uniqueIndex('idx_tasks_tasks_id_prefix').on(table.idPrefix),
     unique('uq_tasks_tasks_idempotency_key').on(table.idempotencyKey),
   ],
 );
@@ -14,11 +14,13 @@
 import {
   ARCHIVE_REASON_TOMBSTONE,
   type ArchiveReasonValue,
+  ExitCode,
   type Session,
   type Task,
   type TaskStatus,
 } from '@cleocode/contracts';
-import { and, eq, inArray, isNull, like, ne, notInArray, or, sql } from 'drizzle-orm';
+import { and, eq, inArray, isNotNull, isNull, like, ne, notInArray, or, sql } from 'drizzle-orm';
+import { CleoError } from '../errors.js';
 import { archivedTaskToRow, rowToSession, rowToTask, taskToRow } from './converters.js';
 import { cleanupBrainRefsOnSessionDelete } from './cross-db-cleanup.js';
 import type {
@@ -43,6 +45,7 @@ import {
 import { resolveCurrentSession } from './session-store.js';
 import { closeDb, getDb, getNativeTasksDb } from './sqlite.js';
 import { TERMINAL_TASK_STATUSES } from './status-registry.js';
+import { isCapturingTaskWrites, noteTaskWrites } from './task-write-capture.js';
 import * as schema from './tasks-schema.js';
 import { withWriteRetry } from './with-retry.js';
 
@@ -54,6 +57,13 @@ import { withWriteRetry } from './with-retry.js';
  */
 let _txSavepointCounter = 0;
 
+/**
+ * `pre_archive_status` value for an archive write: the current status, unless
+ * the row is already archived (re-archiving keeps the original value).
+ */
+const keepPreArchiveStatus = sql`CASE WHEN ${schema.tasks.status} = 'archived'
+  THEN ${schema.tasks.preArchiveStatus} ELSE ${schema.tasks.status} END`;
+
 /**
  * Generate a unique audit log entry ID.
  * @task T4837
@@ -182,6 +192,35 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
   // semantics at the outermost level while enabling batch-insert nesting.
   let _txDepth = 0;
 
+  /** Report tasks about to be written to an active write capture. */
+  function noteWrites(ids: readonly string[]): Promise<void> {
+    return noteTaskWrites(ids, (missing) => accessor.loadTasks(missing));
+  }
+
+  /** Report a task about to be removed, with the rows its removal rewrites. */
+  async function noteRemoval(taskId: string): Promise<void> {
+    if (!isCapturingTaskWrites()) return;
+    const db = await getDb(cwd);
+    const [children, dependents, relations] = await Promise.all([
+      db
+        .select({ id: schema.tasks.id })
+        .from(schema.tasks)
+        .where(eq(schema.tasks.parentId, taskId))
+        .all(),
+      db
+        .select({ id: schema.taskDependencies.taskId })
+        .from(schema.taskDependencies)
+        .where(eq(schema.taskDependencies.dependsOn, taskId))
+        .all(),
+      db
+        .select({ id: schema.taskRelations.taskId })
+        .from(schema.taskRelations)
+        .where(eq(schema.taskRelations.relatedTo, taskId))
+        .all(),
+    ]);
+    await noteWrites([taskId, ...[...children, ...dependents, ...relations].map((r) => r.id)]);
+  }
+
   const accessor: DataAccessor = {
     engine: 'sqlite' as const,
 
@@ -255,6 +294,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
       if (!nativeDb) {
         throw new Error('Native database not initialized');
       }
+      await noteWrites([...archiveIds]);
 
       await runInImmediateTx(nativeDb, async () => {
         // Collect dependency data for batch update
@@ -388,6 +428,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
 
     async upsertSingleTask(task: Task): Promise<void> {
       const db = await getDb(cwd);
+      await noteWrites([task.id]);
       const row = taskToRow(task);
       // gh#391: wrap multi-statement write (task row + dependency rows) in
       // a retry loop. SQLite implicitly auto-commits each statement, but
@@ -405,6 +446,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
       reason?: string,
     ): Promise<void> {
       const db = await getDb(cwd);
+      await noteWrites([taskId]);
       // Validate relation type - throw on invalid (T5168)
       const validTypes = [
         'related',
@@ -438,6 +480,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
 
     async removeRelation(taskId: string, relatedTo: string, relationType?: string): Promise<void> {
       const db = await getDb(cwd);
+      await noteWrites([taskId]);
       const conditions = [
         eq(schema.taskRelations.taskId, taskId),
         eq(schema.taskRelations.relatedTo, relatedTo),
@@ -542,12 +585,14 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
         .where(eq(schema.tasks.id, taskId))
         .all();
       if (rows.length === 0) return;
+      await noteWrites([taskId]);
       // gh#391: retry on SQLITE_BUSY contention.
       await withWriteRetry(() =>
         db
           .update(schema.tasks)
           .set({
             status: 'archived',
+            preArchiveStatus: keepPreArchiveStatus,
             archivedAt: fields.archivedAt ?? new Date().toISOString(),
             archiveReason: fields.archiveReason ?? ARCHIVE_REASON_TOMBSTONE,
             cycleTimeDays: fields.cycleTimeDays ?? null,
@@ -560,6 +605,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
 
     async removeSingleTask(taskId: string): Promise<void> {
       const db = await getDb(cwd);
+      await noteRemoval(taskId);
       // gh#391: wrap the three-statement delete sequence in one retry
       // boundary. SQLITE_BUSY mid-sequence would leak dangling task_dependencies
       // rows; retrying the whole sequence keeps the cleanup atomic at the
@@ -649,10 +695,16 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
         for (const s of excluded) {
           conditions.push(ne(schema.tasks.status, s));
         }
-      } else {
+      } else if (!filters.trashed) {
         conditions.push(ne(schema.tasks.status, 'archived'));
       }
 
+      // Trashed rows (`cleo delete`) never surface through an ordinary query,
+      // whatever status filter is applied — only `trash list` asks for them.
+      conditions.push(
+        filters.trashed ? isNotNull(schema.tasks.deletedAt) : isNull(schema.tasks.deletedAt),
+      );
+
       if (filters.priority) conditions.push(eq(schema.tasks.priority, filters.priority));
       if (filters.type) conditions.push(eq(schema.tasks.type, filters.type));
       if (filters.phase) conditions.push(eq(schema.tasks.phase, filters.phase));
@@ -708,10 +760,12 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
           orderClause = sql`${schema.tasks.updatedAt} DESC NULLS LAST`;
           break;
         case 'priority': {
-          // Map priority to numeric sort: critical=0, high=1, medium=2, low=3
+          // Map priority to numeric sort: critical=0, high=1, medium=2, low=3;
+          // ties break on natural ID order (T9 before T10) for stable output.
           orderClause = sql`CASE ${schema.tasks.priority}
             WHEN 'critical' THEN 0 WHEN 'high' THEN 1
-            WHEN 'medium' THEN 2 WHEN 'low' THEN 3 ELSE 4 END ASC`;
+            WHEN 'medium' THEN 2 WHEN 'low' THEN 3 ELSE 4 END ASC,
+            length(${schema.tasks.id}) ASC, ${schema.tasks.id} ASC`;
           break;
         }
         default:
@@ -987,6 +1041,14 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
       if (!nativeDb) {
         throw new Error('Native database not initialized');
       }
+      if (isCapturingTaskWrites()) {
+        const shifted = nativeDb
+          .prepare(
+            `SELECT id FROM tasks_tasks WHERE parent_id IS ? AND position >= ? AND status != 'archived'`,
+          )
+          .all(parentId, fromPosition) as Array<{ id: string }>;
+        await noteWrites(shifted.map((r) => r.id));
+      }
       if (parentId === null) {
         nativeDb
           .prepare(
@@ -1006,6 +1068,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
 
     async updateTaskFields(taskId: string, fields: TaskFieldUpdates): Promise<void> {
       const db = await getDb(cwd);
+      await noteWrites([taskId]);
       const updateRow: Record<string, unknown> = {
         updatedAt: fields.updatedAt ?? new Date().toISOString(),
       };
@@ -1039,6 +1102,22 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
         ['sessionId', 'sessionId'],
         ['assignee', 'assignee'],
         ['pipelineStage', 'pipelineStage'],
+        ['due', 'due'],
+        ['recurrenceJson', 'recurrenceJson'],
+        ['recurredTo', 'recurredTo'],
+        ['estimate', 'estimate'],
+        ['track', 'track'],
+        ['deletedAt', 'deletedAt'],
+        ['deletedParentId', 'deletedParentId'],
+        ['blockedUntil', 'blockedUntil'],
+        ['blockedPriorStatus', 'blockedPriorStatus'],
+        ['noteHistoryJson', 'noteHistoryJson'],
+        ['commitsJson', 'commitsJson'],
+        ['timeEntriesJson', 'timeEntriesJson'],
+        ['completionRequirementsJson', 'completionRequirementsJson'],
+        ['idPrefix', 'idPrefix'],
+        ['customJson', 'customJson'],
+        ['autoCompleteParent', 'autoCompleteParent'],
       ];
 
       for (const [key, col] of fieldMap) {
@@ -1082,15 +1161,18 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
       try {
         const tx: TransactionAccessor = {
           async upsertSingleTask(task: Task): Promise<void> {
+            await noteWrites([task.id]);
             const row = taskToRow(task);
             await upsertTask(db, row);
             await updateDependencies(db, task.id, task.depends ?? []);
           },
           async archiveSingleTask(taskId: string, fields: ArchiveFields): Promise<void> {
+            await noteWrites([taskId]);
             await db
               .update(schema.tasks)
               .set({
                 status: 'archived',
+                preArchiveStatus: keepPreArchiveStatus,
                 archivedAt: fields.archivedAt ?? new Date().toISOString(),
                 archiveReason: fields.archiveReason ?? ARCHIVE_REASON_TOMBSTONE,
                 cycleTimeDays: fields.cycleTimeDays ?? null,
@@ -1100,6 +1182,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
               .run();
           },
           async removeSingleTask(taskId: string): Promise<void> {
+            await noteRemoval(taskId);
             await db
               .delete(schema.taskDependencies)
               .where(eq(schema.taskDependencies.taskId, taskId))
@@ -1146,6 +1229,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
             await accessor.removeRelation(taskId, relatedTo, relationType);
           },
           async clearRelations(taskId: string): Promise<void> {
+            await noteWrites([taskId]);
             await db
               .delete(schema.taskRelations)
               .where(eq(schema.taskRelations.taskId, taskId))
@@ -1365,6 +1449,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
       if (!existsRow) {
         throw new Error(`Task not found: ${taskId}`);
       }
+      await noteWrites([taskId]);
 
       // Atomic claim: only succeeds if assignee IS NULL or already claimed by this agent.
       // This prevents race conditions between concurrent agents.
@@ -1379,8 +1464,14 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
         const currentRow = nativeDb
           .prepare('SELECT assignee FROM tasks_tasks WHERE id = ?')
           .get(taskId) as { assignee: string | null } | undefined;
-        throw new Error(
-          `Task ${taskId} is already claimed by agent: ${currentRow?.assignee ?? 'unknown'}`,
+        const holder = currentRow?.assignee ?? 'unknown';
+        throw new CleoError(
+          ExitCode.TASK_CLAIMED,
+          `Task ${taskId} is already claimed by agent: ${holder}`,
+          {
+            fix: `Pick another task, or release it first with 'cleo unclaim ${taskId}'`,
+            details: { field: 'assignee', error: 'already_claimed', actual: holder },
+          },
         );
       }
     },
@@ -1398,6 +1489,7 @@ export async function createSqliteDataAccessor(cwd?: string): Promise<DataAccess
       if (!existsRow) {
         throw new Error(`Task not found: ${taskId}`);
       }
+      await noteWrites([taskId]);
 
       // Clear the assignee — no-op if already null
       nativeDb
@@ -0,0 +1,86 @@
+/**
+ * Task write capture — the before-image of every task row a mutate writes.
+ *
+ * {@link captureTaskWrites} runs a mutate with a capture active in its async
+ * call chain. The SQLite DataAccessor reports each task it is about to write
+ * through {@link noteTaskWrites}, which loads the row once — on its first
+ * write, so the image is the state from before the mutate — and remembers
+ * it. The operation journal diffs those images against the rows afterwards,
+ * so journaling a mutate costs work proportional to the rows it touched
+ * rather than to the size of the project.
+ *
+ * Rows a write changes as a side effect are reported too: removing a task
+ * also reports its children and every task that depends on or relates to it.
+ * Writes that bypass the DataAccessor (raw SQL in renumber, repair and
+ * migrations) are not seen. Captures nest: a mutate dispatched from inside
+ * another reports its rows to both.
+ */
+
+import { AsyncLocalStorage } from 'node:async_hooks';
+import type { Task } from '@cleocode/contracts';
+
+/** Each captured task before its first write; `null` when it did not exist. */
+export type TaskWriteSnapshot = Map<string, Task | null>;
+
+/** One active capture and the capture it runs inside. */
+interface CaptureFrame {
+  before: TaskWriteSnapshot;
+  /** Set when a before-image could not be loaded; the capture is unusable. */
+  failed: boolean;
+  parent: CaptureFrame | undefined;
+}
+
+const activeCapture = new AsyncLocalStorage<CaptureFrame>();
+
+/**
+ * Run `fn` while capturing the before-image of every task row it writes.
+ *
+ * @param fn - The mutate to run.
+ * @returns The result of `fn` and the captured rows, or `before: null` when
+ *   an image could not be loaded (the mutate itself still ran).
+ */
+export async function captureTaskWrites<T>(
+  fn: () => Promise<T>,
+): Promise<{ result: T; before: TaskWriteSnapshot | null }> {
+  const parent = activeCapture.getStore();
+  const frame: CaptureFrame = { before: new Map(), failed: false, parent };
+  const result = await activeCapture.run(frame, fn);
+  return { result, before: frame.failed ? null : frame.before };
+}
+
+/** Whether a {@link captureTaskWrites} is active in this async call chain. */
+export function isCapturingTaskWrites(): boolean {
+  return activeCapture.getStore() !== undefined;
+}
+
+/**
+ * Record the before-image of `ids` in every active capture, loading only the
+ * rows not captured yet. Does nothing outside {@link captureTaskWrites}, and
+ * never throws: a failed load marks the capture unusable instead of failing
+ * the write.
+ *
+ * @param ids - Tasks about to be written.
+ * @param load - Loads the current rows (any status, trashed included).
+ */
+export async function noteTaskWrites(
+  ids: readonly string[],
+  load: (ids: string[]) => Promise<Task[]>,
+): Promise<void> {
+  const frames: CaptureFrame[] = [];
+  for (let frame = activeCapture.getStore(); frame; frame = frame.parent) frames.push(frame);
+  const missing = [...new Set(ids)].filter((id) => frames.some((f) => !f.before.has(id)));
+  if (missing.length === 0) return;
+  let rows: Task[];
+  try {
+    rows = await load(missing);
+  } catch {
+    for (const frame of frames) frame.failed = true;
+    return;
+  }
+  const byId = new Map(rows.map((t) => [t.id, t]));
+  for (const frame of frames) {
+    for (const id of missing) {
+      if (!frame.before.has(id)) frame.before.set(id, byId.get(id) ?? null);
+    }
+  }
+}
@@ -119,6 +119,31 @@ export async function updateTask(
   if (updates.verification !== undefined)
     updateRow.verificationJson = JSON.stringify(updates.verification);
   if (updates.assignee !== undefined) updateRow.assignee = updates.assignee;
+  if (updates.due !== undefined) updateRow.due = updates.due;
+  if (updates.recurrence !== undefined)
+    updateRow.recurrenceJson = updates.recurrence ? JSON.stringify(updates.recurrence) : null;
+  if (updates.recurredTo !== undefined) updateRow.recurredTo = updates.recurredTo;
+  if (updates.estimate !== undefined) updateRow.estimate = updates.estimate;
+  if (updates.track !== undefined) updateRow.track = updates.track;
+  if (updates.deletedAt !== undefined) updateRow.deletedAt = updates.deletedAt;
+  if (updates.deletedParentId !== undefined) updateRow.deletedParentId = updates.deletedParentId;
+  if (updates.blockedUntil !== undefined) updateRow.blockedUntil = updates.blockedUntil;
+  if (updates.blockedPriorStatus !== undefined)
+    updateRow.blockedPriorStatus = updates.blockedPriorStatus;
+  if (updates.noteHistory !== undefined)
+    updateRow.noteHistoryJson = JSON.stringify(updates.noteHistory);
+  if (updates.commits !== undefined) updateRow.commitsJson = JSON.stringify(updates.commits);
+  if (updates.timeEntries !== undefined)
+    updateRow.timeEntriesJson = JSON.stringify(updates.timeEntries);
+  if (updates.completionRequirements !== undefined)
+    updateRow.completionRequirementsJson = updates.completionRequirements
+      ? JSON.stringify(updates.completionRequirements)
+      : null;
+  if (updates.idPrefix !== undefined) updateRow.idPrefix = updates.idPrefix;
+  if (updates.custom !== undefined)
+    updateRow.customJson = updates.custom ? JSON.stringify(updates.custom) : null;
+  if (updates.autoCompleteParent !== undefined)
+    updateRow.autoCompleteParent = updates.autoCompleteParent;
 
   db.update(schema.tasks).set(updateRow).where(eq(schema.tasks.id, taskId)).run();
 
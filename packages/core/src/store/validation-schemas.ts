@@ -193,7 +193,7 @@ export const brainEdgeTypeSchema = z.enum(BRAIN_EDGE_TYPES);
 
 /** Task field refinements matching schema-validator.ts constraints. */
 const taskRefinements = {
-  id: (s: z.ZodString) => s.regex(/^T\d{3,}$/),
+  id: (s: z.ZodString) => s.regex(/^(?:T\d{3,}|[A-Z]{2,10}-\d+)$/),
   title: (s: z.ZodString) => s.min(1).max(120),
   description: (s: z.ZodString) => s.max(2000),
 };
@@ -0,0 +1,117 @@
+/**
+ * Tests for `archive.list` and `archive.restore`: the status held at archive
+ * time is restored, and the parent is validated like `trash.restore`.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { archiveTasks } from '../archive.js';
+import { listArchived, restoreFromArchive } from '../archive-restore.js';
+import { deleteTask } from '../delete.js';
+import { findTasks } from '../find.js';
+
+describe('task archive list / restore', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga one', type: 'saga', status: 'active', createdAt },
+      { id: 'T002', title: 'Epic one', type: 'epic', parentId: 'T001', createdAt },
+      {
+        id: 'T003',
+        title: 'Shipped widget',
+        parentId: 'T002',
+        status: 'done',
+        completedAt: '2026-02-01T00:00:00.000Z',
+        createdAt,
+      },
+      { id: 'T004', title: 'Parked widget', parentId: 'T002', status: 'blocked', createdAt },
+      { id: 'T010', title: 'Saga two', type: 'saga', status: 'active', createdAt },
+      { id: 'T011', title: 'Epic two', type: 'epic', parentId: 'T010', createdAt },
+      { id: 'T012', title: 'Other widget', parentId: 'T011', status: 'cancelled', createdAt },
+      { id: 'T013', title: 'Dropped widget', parentId: 'T011', createdAt },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('lists archived tasks by saga and date, leaving out the trash', async () => {
+    await archiveTasks({ taskIds: ['T003', 'T012'] }, env.tempDir, env.accessor);
+    await env.accessor.archiveSingleTask('T004', {
+      archivedAt: '2026-01-10T00:00:00.000Z',
+      archiveReason: 'manual',
+    });
+
+    const all = await listArchived({}, env.tempDir, env.accessor);
+    expect(all.tasks.map((t) => t.id).sort()).toEqual(['T003', 'T004', 'T012']);
+    expect(all.tasks[all.tasks.length - 1]).toMatchObject({ id: 'T004', status: 'blocked' });
+
+    const underSaga = await listArchived({ sagaId: 'T001' }, env.tempDir, env.accessor);
+    expect(underSaga.tasks.map((t) => t.id).sort()).toEqual(['T003', 'T004']);
+    const recent = await listArchived({ since: '2026-02-01' }, env.tempDir, env.accessor);
+    expect(recent.tasks.map((t) => t.id)).not.toContain('T004');
+
+    await deleteTask({ taskId: 'T013' }, env.tempDir, env.accessor);
+    const afterDelete = await listArchived({}, env.tempDir, env.accessor);
+    expect(afterDelete.tasks.map((t) => t.id)).not.toContain('T013');
+
+    await expect(
+      listArchived({ since: 'last week' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      listArchived({ sagaId: 'T002' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+
+  it('restores the status a task held when it was archived', async () => {
+    await env.accessor.archiveSingleTask('T004', { archiveReason: 'manual' });
+    const found = await findTasks(
+      { query: 'Parked', includeArchive: true },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(found.results.map((r) => r.id)).toContain('T004');
+
+    const result = await restoreFromArchive({ taskId: 'T004' }, env.tempDir, env.accessor);
+
+    expect(result).toEqual({ task: 'T004', status: 'blocked', parentId: 'T002' });
+    const task = await env.accessor.loadSingleTask('T004');
+    expect(task?.status).toBe('blocked');
+    expect(task?.preArchiveStatus ?? null).toBeNull();
+    expect((await listArchived({}, env.tempDir, env.accessor)).total).toBe(0);
+
+    await expect(
+      restoreFromArchive({ taskId: 'T004' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+  });
+
+  it('refuses to restore under an archived parent unless --to-saga is given', async () => {
+    await archiveTasks({ taskIds: ['T003'] }, env.tempDir, env.accessor);
+    await env.accessor.archiveSingleTask('T002', { archiveReason: 'manual' });
+
+    await expect(
+      restoreFromArchive({ taskId: 'T003' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({
+      code: ExitCode.PARENT_NOT_FOUND,
+      fix: expect.stringContaining('cleo archive restore T002'),
+    });
+
+    const epic = await restoreFromArchive(
+      { taskId: 'T002', toSaga: 'T010' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(epic).toMatchObject({ status: 'pending', parentId: 'T010' });
+    const task = await restoreFromArchive({ taskId: 'T003' }, env.tempDir, env.accessor);
+    expect(task).toMatchObject({ status: 'done', parentId: 'T002' });
+  });
+});
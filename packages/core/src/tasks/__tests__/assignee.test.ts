@@ -9,14 +9,17 @@
  * - Unclaim is a no-op on an already unclaimed task
  * - claimTask / unclaimTask throw on non-existent task IDs
  * - Assignee persists through rowToTask round-trip
+ * - updateTask sets and clears the assignee (`cleo update --assignee`)
  */
 
 import { writeFile } from 'node:fs/promises';
 import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
 import { afterEach, beforeEach, describe, expect, it } from 'vitest';
 import { createTestDb, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
 import type { DataAccessor } from '../../store/data-accessor.js';
 import { addTask } from '../add.js';
+import { updateTask } from '../update.js';
 
 /** Minimal config that disables enforcement so tests run in isolation. */
 const NO_ENFORCEMENT_CONFIG = JSON.stringify({
@@ -70,6 +73,11 @@ describe('claimTask', () => {
   it('throws when task is claimed by a different agent', async () => {
     await accessor.claimTask('T001', 'agent-alpha');
     await expect(accessor.claimTask('T001', 'agent-beta')).rejects.toThrow('already claimed');
+    await expect(accessor.claimTask('T001', 'agent-beta')).rejects.toMatchObject({
+      code: ExitCode.TASK_CLAIMED,
+      details: expect.objectContaining({ error: 'already_claimed', actual: 'agent-alpha' }),
+    });
+    expect((await accessor.loadSingleTask('T001'))?.assignee).toBe('agent-alpha');
   });
 
   it('throws when task does not exist', async () => {
@@ -171,4 +179,12 @@ describe('assignee updateTaskFields integration', () => {
     const task = await accessor.loadSingleTask('T001');
     expect(task?.assignee).toBeUndefined();
   });
+
+  it('sets and clears assignee via updateTask', async () => {
+    await updateTask({ taskId: 'T001', assignee: 'agent-via-cli' }, env.tempDir, accessor);
+    expect((await accessor.loadSingleTask('T001'))?.assignee).toBe('agent-via-cli');
+
+    await updateTask({ taskId: 'T001', assignee: null }, env.tempDir, accessor);
+    expect((await accessor.loadSingleTask('T001'))?.assignee).toBeUndefined();
+  });
 });
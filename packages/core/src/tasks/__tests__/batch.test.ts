@@ -0,0 +1,143 @@
+/**
+ * Tests for the atomic `tasks.batch` CORE operation.
+ *
+ * Covers:
+ *   (a) Happy path — create + update + complete land together, later steps
+ *       see earlier ones.
+ *   (b) Rollback — a dependency_cycle rejection on step 3 leaves steps 1–2 unwritten
+ *       and reports the failing index.
+ *   (c) Dry run — every step applies, then the whole batch is rolled back.
+ *   (d) Shape validation — a malformed step is rejected before any write.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import type { DataAccessor } from '../../store/data-accessor.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { runTaskBatch } from '../batch.js';
+
+describe('runTaskBatch', () => {
+  let env: TestDbEnv;
+  let accessor: DataAccessor;
+  const now = new Date().toISOString();
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    accessor = env.accessor;
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: {
+          session: { requiredForMutate: false },
+          acceptance: { mode: 'off' },
+        },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    await seedTasks(accessor, [
+      {
+        id: 'T100',
+        title: 'Epic',
+        type: 'epic',
+        status: 'pending',
+        priority: 'medium',
+        createdAt: now,
+      },
+      {
+        id: 'T101',
+        title: 'First',
+        parentId: 'T100',
+        status: 'pending',
+        priority: 'medium',
+        createdAt: now,
+      },
+      {
+        id: 'T102',
+        title: 'Second',
+        parentId: 'T100',
+        status: 'pending',
+        priority: 'medium',
+        depends: ['T101'],
+        createdAt: now,
+      },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('(a) applies create, update, and complete in order', async () => {
+    const result = await runTaskBatch(
+      [
+        { op: 'create', title: 'Third', description: 'Created in batch', parent: 'T100' },
+        { op: 'update', taskId: 'T102', title: 'Second (renamed)' },
+        { op: 'complete', taskId: 'T101' },
+      ],
+      accessor,
+      env.tempDir,
+    );
+
+    expect(result.applied).toBe(3);
+    expect(result.results.map((r) => r.op)).toEqual(['create', 'update', 'complete']);
+    expect(result.results[1]?.changes).toContain('title');
+
+    const created = await accessor.loadSingleTask(result.results[0]!.taskId);
+    expect(created?.parentId).toBe('T100');
+    expect((await accessor.loadSingleTask('T102'))?.title).toBe('Second (renamed)');
+    expect((await accessor.loadSingleTask('T101'))?.status).toBe('done');
+  });
+
+  it('(b) rolls back every step when one fails and reports its index', async () => {
+    await expect(
+      runTaskBatch(
+        [
+          { op: 'update', taskId: 'T101', title: 'Renamed' },
+          { op: 'create', title: 'Extra', description: 'Should vanish', parent: 'T100' },
+          { op: 'update', taskId: 'T101', addDepends: ['T102'] },
+        ],
+        accessor,
+        env.tempDir,
+      ),
+    ).rejects.toMatchObject({
+      details: { failedIndex: 2, op: 'update', taskId: 'T101', code: 'E_CLEO_CIRCULAR_REF' },
+    });
+
+    expect((await accessor.loadSingleTask('T101'))?.title).toBe('First');
+    const { tasks } = await accessor.queryTasks({});
+    expect(tasks.map((t) => t.id).sort()).toEqual(['T100', 'T101', 'T102']);
+  });
+
+  it('(c) dry run reports the outcome but writes nothing', async () => {
+    const result = await runTaskBatch(
+      [{ op: 'update', taskId: 'T101', title: 'Preview only' }],
+      accessor,
+      env.tempDir,
+      { dryRun: true },
+    );
+
+    expect(result).toMatchObject({ applied: 0, dryRun: true });
+    expect(result.results).toHaveLength(1);
+    expect((await accessor.loadSingleTask('T101'))?.title).toBe('First');
+  });
+
+  it('(d) rejects a malformed step before writing anything', async () => {
+    await expect(
+      runTaskBatch(
+        [
+          { op: 'update', taskId: 'T101', title: 'Renamed' },
+          { op: 'complete' } as never,
+        ],
+        accessor,
+        env.tempDir,
+      ),
+    ).rejects.toMatchObject({ details: { failedIndex: 1 } });
+    expect((await accessor.loadSingleTask('T101'))?.title).toBe('First');
+  });
+});
@@ -0,0 +1,95 @@
+/**
+ * Tests for `tasks.block` / `tasks.unblock` — external-cause blocking with a
+ * lossless status restore.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { getReadyTasks } from '../../orchestration/index.js';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { blockTask, unblockTask } from '../block.js';
+
+describe('block / unblock', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Epic', type: 'epic', status: 'active' },
+      { id: 'T002', title: 'In flight', parentId: 'T001', status: 'active' },
+      { id: 'T003', title: 'Queued', parentId: 'T001', status: 'pending' },
+      { id: 'T004', title: 'Shipped', parentId: 'T001', status: 'done' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('blocks with a reason and until date, then restores the prior status', async () => {
+    const blocked = await blockTask(
+      { taskId: 'T002', reason: 'waiting on vendor', until: '2099-01-01' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(blocked).toMatchObject({ status: 'blocked', priorStatus: 'active' });
+
+    const stored = await env.accessor.loadSingleTask('T002');
+    expect(stored).toMatchObject({
+      status: 'blocked',
+      blockedBy: 'waiting on vendor',
+      blockedPriorStatus: 'active',
+    });
+    expect(stored?.blockedUntil).toBe('2099-01-01');
+
+    // Re-blocking replaces the reason but keeps the original prior status.
+    await blockTask({ taskId: 'T002', reason: 'still waiting' }, env.tempDir, env.accessor);
+    const result = await unblockTask({ taskId: 'T002' }, env.tempDir, env.accessor);
+    expect(result).toEqual({ taskId: 'T002', status: 'active', clearedReason: 'still waiting' });
+
+    const restored = await env.accessor.loadSingleTask('T002');
+    expect(restored?.status).toBe('active');
+    expect(restored?.blockedBy ?? null).toBeNull();
+    expect(restored?.blockedUntil ?? null).toBeNull();
+  });
+
+  it('rejects missing reasons, bad dates, terminal tasks, and unblocking unblocked tasks', async () => {
+    await expect(
+      blockTask({ taskId: 'T003', reason: '  ' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      blockTask({ taskId: 'T003', reason: 'x', until: 'soon' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      blockTask({ taskId: 'T004', reason: 'x' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      blockTask({ taskId: 'T999', reason: 'x' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+    await expect(
+      unblockTask({ taskId: 'T003' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+
+  it('holds blocked tasks out of the ready set until blockedUntil has passed', async () => {
+    await blockTask(
+      { taskId: 'T002', reason: 'vendor', until: '2099-01-01' },
+      env.tempDir,
+      env.accessor,
+    );
+    await blockTask(
+      { taskId: 'T003', reason: 'decision', until: '2000-01-01' },
+      env.tempDir,
+      env.accessor,
+    );
+
+    const ready = await getReadyTasks('T001', env.tempDir, env.accessor);
+    const byId = new Map(ready.map((t) => [t.taskId, t.ready]));
+    expect(byId.get('T002')).toBe(false);
+    expect(byId.get('T003')).toBe(true);
+  });
+});
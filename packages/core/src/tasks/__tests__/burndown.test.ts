@@ -0,0 +1,117 @@
+/**
+ * Tests for the saga burndown report (`tasks.burndown`).
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { bucketBurndown, computeBurndown } from '../burndown.js';
+
+const at = (iso: string) => Date.parse(iso);
+
+describe('bucketBurndown', () => {
+  const burns = [
+    { atMs: Number.NEGATIVE_INFINITY, estimate: 1 },
+    { atMs: at('2026-05-30T10:00:00Z'), estimate: 2 },
+    { atMs: at('2026-06-01T09:00:00Z'), estimate: 3 },
+    { atMs: at('2026-06-03T23:59:00Z'), estimate: 5 },
+  ];
+
+  it('emits one point per day, including empty days', () => {
+    const series = bucketBurndown(burns, 20, at('2026-06-01'), at('2026-06-03'), 'day');
+    expect(series).toEqual([
+      { date: '2026-06-01', completed: 3, remaining: 14 },
+      { date: '2026-06-02', completed: 0, remaining: 14 },
+      { date: '2026-06-03', completed: 5, remaining: 9 },
+    ]);
+  });
+
+  it('aligns weekly buckets to Monday', () => {
+    // 2026-06-03 is a Wednesday; its week starts Monday 2026-06-01.
+    const series = bucketBurndown(burns, 20, at('2026-06-03'), at('2026-06-10'), 'week');
+    expect(series).toEqual([
+      { date: '2026-06-01', completed: 8, remaining: 9 },
+      { date: '2026-06-08', completed: 0, remaining: 9 },
+    ]);
+  });
+});
+
+describe('computeBurndown', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    const createdAt = '2026-05-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Launch', type: 'saga', status: 'active', createdAt },
+      { id: 'T002', title: 'Backend', type: 'epic', parentId: 'T001', createdAt },
+      {
+        id: 'T003',
+        title: 'API',
+        parentId: 'T002',
+        status: 'done',
+        estimate: 5,
+        completedAt: '2026-06-02T12:00:00.000Z',
+        createdAt,
+      },
+      {
+        id: 'T004',
+        title: 'Reopened',
+        parentId: 'T002',
+        status: 'active',
+        estimate: 3,
+        // Completed once, then reopened — still remaining.
+        completedAt: '2026-06-01T12:00:00.000Z',
+        createdAt,
+      },
+      { id: 'T005', title: 'Docs', parentId: 'T002', status: 'pending', estimate: 2, createdAt },
+      { id: 'T006', title: 'Elsewhere', status: 'done', estimate: 40, createdAt },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('burns down saga members at their latest completion', async () => {
+    const result = await computeBurndown(
+      { sagaId: 'T001', from: '2026-06-01', to: '2026-06-03' },
+      env.tempDir,
+      env.accessor,
+    );
+
+    expect(result).toMatchObject({ sagaId: 'T001', bucket: 'day', estimateTotal: 10 });
+    expect(result.series).toEqual([
+      { date: '2026-06-01', completed: 0, remaining: 10 },
+      { date: '2026-06-02', completed: 5, remaining: 5 },
+      { date: '2026-06-03', completed: 0, remaining: 5 },
+    ]);
+  });
+
+  it('rejects a reversed range and an unknown bucket', async () => {
+    await expect(
+      computeBurndown(
+        { sagaId: 'T001', from: '2026-06-05', to: '2026-06-01' },
+        env.tempDir,
+        env.accessor,
+      ),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      computeBurndown(
+        { sagaId: 'T001', from: '2026-06-01', bucket: 'month' as 'day' },
+        env.tempDir,
+        env.accessor,
+      ),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR, details: { field: 'bucket' } });
+  });
+
+  it('rejects a non-saga', async () => {
+    await expect(
+      computeBurndown({ sagaId: 'T002', from: '2026-06-01' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+  });
+});
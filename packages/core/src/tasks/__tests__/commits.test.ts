@@ -0,0 +1,89 @@
+/**
+ * Tests for task ↔ commit links — `tasks.link-commit`, `tasks.commits`, and
+ * `tasks.commits.scan`.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { extractTaskIds, linkTaskCommit, listTaskCommits, scanTaskCommits } from '../commits.js';
+
+const SHA_A = 'a'.repeat(40);
+const SHA_B = 'b'.repeat(40);
+const SHA_C = 'c'.repeat(40);
+
+describe('task commit links', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Parser', status: 'active' },
+      { id: 'T002', title: 'Lexer', status: 'pending' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('links SHAs as lowercase hex and de-dupes abbreviated forms', async () => {
+    const first = await linkTaskCommit(
+      { taskId: 'T001', sha: SHA_A.toUpperCase() },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(first).toMatchObject({ added: true, sha: SHA_A, commits: [SHA_A] });
+
+    const again = await linkTaskCommit(
+      { taskId: 'T001', sha: SHA_A.slice(0, 7) },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(again.added).toBe(false);
+
+    const listed = await listTaskCommits({ taskId: 'T001' }, env.tempDir, env.accessor);
+    expect(listed.commits).toEqual([SHA_A]);
+  });
+
+  it('rejects non-hex SHAs and unknown tasks', async () => {
+    await expect(
+      linkTaskCommit({ taskId: 'T001', sha: 'not-a-sha' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      linkTaskCommit({ taskId: 'T001', sha: 'abc12' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      listTaskCommits({ taskId: 'T999' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+  });
+
+  it('scans commit messages for task IDs, oldest first, skipping known links', async () => {
+    await linkTaskCommit({ taskId: 'T002', sha: SHA_B }, env.tempDir, env.accessor);
+
+    // git log order: newest first.
+    const result = await scanTaskCommits({}, env.tempDir, env.accessor, [
+      { sha: SHA_C, message: 'fix(parser): T001 follow-up\n\nAlso touches T404' },
+      { sha: SHA_B, message: 'feat: lexer (T002)' },
+      { sha: SHA_A, message: 'feat: parser for T001 and T002' },
+    ]);
+
+    expect(result.scanned).toBe(3);
+    expect(result.linked).toEqual([
+      { taskId: 'T001', sha: SHA_A },
+      { taskId: 'T002', sha: SHA_A },
+      { taskId: 'T001', sha: SHA_C },
+    ]);
+    expect(result.unknownTaskIds).toEqual(['T404']);
+    expect((await env.accessor.loadSingleTask('T001'))?.commits).toEqual([SHA_A, SHA_C]);
+    expect((await env.accessor.loadSingleTask('T002'))?.commits).toEqual([SHA_B, SHA_A]);
+  });
+
+  it('extracts distinct task IDs in order of appearance', () => {
+    expect(extractTaskIds('T12 then T3, T12 again; not XT9 or T')).toEqual(['T12', 'T3']);
+  });
+});
@@ -0,0 +1,103 @@
+/**
+ * Parent/child completion ordering.
+ *
+ * Coverage:
+ *   1. `completeTask` refuses a non-epic parent with open children and
+ *      reports them as `{ error: 'incomplete_children', children }`.
+ *   2. `--override-reason` completes it anyway (audited).
+ *   3. `autoCompleteParent` rolls a parent with its own files up when its
+ *      last open child completes.
+ *   4. `getReadyTasks` holds a parent back until its children are done and
+ *      offers the open children in its place.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { getReadyTasks } from '../../orchestration/index.js';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { completeTask } from '../complete.js';
+import { taskUpdate } from '../update.js';
+
+describe('completion of parents with open children', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false }, acceptance: { mode: 'off' } },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Epic', type: 'epic', status: 'active' },
+      { id: 'T002', title: 'Parent', parentId: 'T001', status: 'active', files: ['src/a.ts'] },
+      { id: 'T003', title: 'Done child', type: 'subtask', parentId: 'T002', status: 'done' },
+      { id: 'T005', title: 'Open child', type: 'subtask', parentId: 'T002' },
+      { id: 'T007', title: 'Active child', type: 'subtask', parentId: 'T002', status: 'active' },
+      { id: 'T008', title: 'Sibling', parentId: 'T001' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('refuses to complete a parent whose children are still open', async () => {
+    await expect(
+      completeTask({ taskId: 'T002' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({
+      code: ExitCode.HAS_CHILDREN,
+      details: { field: 'children', error: 'incomplete_children', children: ['T005', 'T007'] },
+    });
+    expect((await env.accessor.loadSingleTask('T002'))?.status).toBe('active');
+  });
+
+  it('completes the parent anyway with an override reason', async () => {
+    const result = await completeTask(
+      { taskId: 'T002', overrideReason: 'children moved to a follow-up' },
+      env.tempDir,
+      env.accessor,
+    );
+
+    expect(result.task.status).toBe('done');
+  });
+
+  it('leaves a parent with its own files open unless it opted in', async () => {
+    await completeTask({ taskId: 'T005' }, env.tempDir, env.accessor);
+    const result = await completeTask({ taskId: 'T007' }, env.tempDir, env.accessor);
+
+    expect(result.autoCompleted ?? []).not.toContain('T002');
+    expect((await env.accessor.loadSingleTask('T002'))?.status).toBe('active');
+  });
+
+  it('auto-completes a flagged parent when its last open child completes', async () => {
+    const updated = await taskUpdate(env.tempDir, 'T002', { autoCompleteParent: true });
+    expect(updated.success).toBe(true);
+
+    await completeTask({ taskId: 'T005' }, env.tempDir, env.accessor);
+    const result = await completeTask({ taskId: 'T007' }, env.tempDir, env.accessor);
+
+    expect(result.autoCompleted).toContain('T002');
+    expect((await env.accessor.loadSingleTask('T002'))?.status).toBe('done');
+  });
+
+  it('holds a parent back from the ready set until its children are done', async () => {
+    const readiness = await getReadyTasks('T001', env.tempDir, env.accessor);
+    const byId = new Map(readiness.map((r) => [r.taskId, r]));
+
+    expect(byId.get('T002')).toMatchObject({ ready: false, blockers: ['T005', 'T007'] });
+    expect(byId.get('T005')?.ready).toBe(true);
+    expect(byId.get('T007')?.ready).toBe(true);
+    expect(byId.get('T008')?.ready).toBe(true);
+    expect(byId.has('T003')).toBe(false);
+  });
+});
@@ -0,0 +1,86 @@
+/**
+ * Completion requirements — saga defaults, task overrides, and the
+ * `unmet_requirement` error raised by `completeTask`.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { completeTask } from '../complete.js';
+import { updateTask } from '../update.js';
+
+describe('completion requirements', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false }, acceptance: { mode: 'off' } },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga', type: 'saga' },
+      { id: 'T002', title: 'Epic', type: 'epic', parentId: 'T001', status: 'active' },
+      { id: 'T003', title: 'Bare', parentId: 'T002', status: 'active' },
+      { id: 'T004', title: 'Linked', parentId: 'T002', status: 'active', commits: ['abc1234'] },
+      { id: 'T005', title: 'Other', parentId: 'T002', status: 'active' },
+    ]);
+    await updateTask(
+      { taskId: 'T001', completionRequirements: ['commit', 'note'] },
+      env.tempDir,
+      env.accessor,
+    );
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it("refuses a task missing its saga's requirements", async () => {
+    await expect(
+      completeTask({ taskId: 'T003' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({
+      code: ExitCode.VALIDATION_ERROR,
+      details: { error: 'unmet_requirement', missing: ['commit', 'note'], source: 'saga' },
+    });
+    expect((await env.accessor.loadSingleTask('T003'))?.status).toBe('active');
+  });
+
+  it('completes once the requirements are met', async () => {
+    await updateTask({ taskId: 'T004', notes: 'Shipped behind a flag' }, env.tempDir, env.accessor);
+    const result = await completeTask({ taskId: 'T004' }, env.tempDir, env.accessor);
+
+    expect(result.task.status).toBe('done');
+  });
+
+  it("lets a task override the saga's list", async () => {
+    await updateTask(
+      { taskId: 'T005', completionRequirements: ['assignee'] },
+      env.tempDir,
+      env.accessor,
+    );
+    await expect(
+      completeTask({ taskId: 'T005' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ details: { missing: ['assignee'], source: 'task' } });
+
+    await updateTask({ taskId: 'T003', completionRequirements: [] }, env.tempDir, env.accessor);
+    const result = await completeTask({ taskId: 'T003' }, env.tempDir, env.accessor);
+    expect(result.task.status).toBe('done');
+  });
+
+  it('rejects an unknown requirement', async () => {
+    await expect(
+      updateTask({ taskId: 'T003', completionRequirements: ['review'] }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.INVALID_INPUT });
+  });
+});
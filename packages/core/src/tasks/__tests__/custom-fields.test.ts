@@ -0,0 +1,98 @@
+/**
+ * Tests for project custom fields — `tasks.fields.add`, `update --set`, and
+ * `find --where`.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { addCustomField, listCustomFields } from '../custom-fields.js';
+import { findTasks } from '../find.js';
+import { updateTask } from '../update.js';
+
+describe('project custom fields', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: {
+          session: { requiredForMutate: false },
+          acceptance: { mode: 'off' },
+        },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Parser', status: 'pending' },
+      { id: 'T002', title: 'Lexer', status: 'pending' },
+    ]);
+    await addCustomField(
+      { name: 'risk', type: 'enum', values: ['low', 'med', 'high'] },
+      env.tempDir,
+      env.accessor,
+    );
+    await addCustomField({ name: 'cost', type: 'number' }, env.tempDir, env.accessor);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('stores declarations and rejects duplicates and enums without values', async () => {
+    const { fields } = await listCustomFields({}, env.tempDir, env.accessor);
+    expect(fields).toEqual([
+      { name: 'risk', type: 'enum', values: ['low', 'med', 'high'] },
+      { name: 'cost', type: 'number' },
+    ]);
+    await expect(
+      addCustomField({ name: 'risk', type: 'string' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      addCustomField({ name: 'tier', type: 'enum' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+
+  it('sets validated values on task.custom and clears them with an empty value', async () => {
+    const result = await updateTask(
+      { taskId: 'T001', set: ['risk=high,cost=3'] },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.changes).toContain('custom');
+    expect((await env.accessor.loadSingleTask('T001'))?.custom).toEqual({ risk: 'high', cost: 3 });
+
+    await updateTask({ taskId: 'T001', set: ['cost='] }, env.tempDir, env.accessor);
+    expect((await env.accessor.loadSingleTask('T001'))?.custom).toEqual({ risk: 'high' });
+  });
+
+  it('rejects undeclared fields, out-of-set enum values, and non-numbers', async () => {
+    for (const set of ['owner=alice', 'risk=extreme', 'cost=lots']) {
+      await expect(
+        updateTask({ taskId: 'T001', set: [set] }, env.tempDir, env.accessor),
+      ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    }
+    expect((await env.accessor.loadSingleTask('T001'))?.custom).toBeUndefined();
+  });
+
+  it('filters find results with --where', async () => {
+    await updateTask({ taskId: 'T001', set: ['risk=high'] }, env.tempDir, env.accessor);
+    await updateTask({ taskId: 'T002', set: ['risk=low'] }, env.tempDir, env.accessor);
+
+    const found = await findTasks({ where: ['risk=high'] }, env.tempDir, env.accessor);
+    expect(found.results.map((r) => r.id)).toEqual(['T001']);
+
+    await expect(
+      findTasks({ where: ['risk=extreme'] }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+});
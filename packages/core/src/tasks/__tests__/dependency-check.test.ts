@@ -8,6 +8,7 @@ import type { Task } from '@cleocode/contracts';
 import { describe, expect, it } from 'vitest';
 import {
   detectCircularDeps,
+  findDependencyCycle,
   getBlockedTasks,
   getDependentIds,
   getDependents,
@@ -74,6 +75,46 @@ describe('wouldCreateCycle', () => {
   });
 });
 
+describe('findDependencyCycle', () => {
+  it('returns the direct cycle path starting at the mutated task', () => {
+    const tasks = [makeTask({ id: 'T100' }), makeTask({ id: 'T101', depends: ['T100'] })];
+    expect(findDependencyCycle('T100', ['T101'], tasks)).toEqual(['T100', 'T101', 'T100']);
+  });
+
+  it('returns the transitive cycle path', () => {
+    const tasks = [
+      makeTask({ id: 'T001' }),
+      makeTask({ id: 'T002', depends: ['T003'] }),
+      makeTask({ id: 'T003', depends: ['T001'] }),
+    ];
+    expect(findDependencyCycle('T001', ['T002'], tasks)).toEqual(['T001', 'T002', 'T003', 'T001']);
+  });
+
+  it('reports a self-dependency', () => {
+    expect(findDependencyCycle('T001', ['T001'], [makeTask({ id: 'T001' })])).toEqual([
+      'T001',
+      'T001',
+    ]);
+  });
+
+  it('uses the proposed edges instead of the stored ones', () => {
+    const tasks = [
+      makeTask({ id: 'T001', depends: ['T002'] }),
+      makeTask({ id: 'T002', depends: ['T001'] }),
+    ];
+    expect(findDependencyCycle('T001', [], tasks)).toEqual([]);
+  });
+
+  it('returns empty for an acyclic diamond', () => {
+    const tasks = [
+      makeTask({ id: 'T001' }),
+      makeTask({ id: 'T002', depends: ['T001'] }),
+      makeTask({ id: 'T003', depends: ['T001'] }),
+    ];
+    expect(findDependencyCycle('T004', ['T002', 'T003'], tasks)).toEqual([]);
+  });
+});
+
 describe('getBlockedTasks', () => {
   it('returns tasks with unmet dependencies', () => {
     const tasks = [
@@ -0,0 +1,108 @@
+/**
+ * Tests for the transitive dependency trace behind `cleo tasks tree`.
+ */
+
+import type { DependencyTraceNode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { traceDependencies, traceTaskDependencies } from '../dependency-trace.js';
+
+/** Node IDs with their flags, depth-first, for compact assertions. */
+function flatten(nodes: readonly DependencyTraceNode[], indent = ''): string[] {
+  return nodes.flatMap((n) => {
+    const flags = (['cycle', 'repeated', 'truncated', 'missing'] as const).filter((f) => n[f]);
+    const line = `${indent}${n.id}${flags.length ? ` ${flags.join(',')}` : ''}`;
+    return [line, ...flatten(n.children, `${indent}  `)];
+  });
+}
+
+describe('traceDependencies', () => {
+  const byId = new Map(
+    ['A', 'B', 'C', 'D'].map((id) => [id, { id, title: id, status: 'pending' as const }]),
+  );
+
+  it('marks cycles instead of recursing forever', () => {
+    const edges: Record<string, string[]> = { A: ['B'], B: ['C'], C: ['A', 'B'] };
+    expect(flatten(traceDependencies('A', (id) => edges[id] ?? [], byId, null))).toEqual([
+      'B',
+      '  C',
+      '    A cycle',
+      '    B cycle',
+    ]);
+  });
+
+  it('expands a shared blocker once and honours the depth limit', () => {
+    const edges: Record<string, string[]> = { A: ['B', 'C'], B: ['D', 'X'], C: ['D'], D: ['C'] };
+    expect(flatten(traceDependencies('A', (id) => edges[id] ?? [], byId, null))).toEqual([
+      'B',
+      '  D',
+      '    C',
+      '      D cycle',
+      '  X missing',
+      'C repeated',
+    ]);
+    expect(flatten(traceDependencies('A', (id) => edges[id] ?? [], byId, 1))).toEqual([
+      'B truncated',
+      'C truncated',
+    ]);
+  });
+});
+
+describe('traceTaskDependencies', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga', type: 'saga' },
+      { id: 'T002', title: 'Epic', type: 'epic', parentId: 'T001' },
+      { id: 'T003', title: 'Schema', type: 'task', parentId: 'T002', status: 'done' },
+      { id: 'T004', title: 'API', type: 'task', parentId: 'T002', depends: ['T003'] },
+      { id: 'T005', title: 'UI', type: 'task', parentId: 'T002', depends: ['T004'] },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('walks blockers up and dependents down', async () => {
+    const trace = await traceTaskDependencies(env.tempDir, { taskId: 'T004' }, env.accessor);
+
+    expect(flatten(trace.up ?? [])).toEqual(['T003']);
+    expect(flatten(trace.down ?? [])).toEqual(['T005']);
+    expect(trace.hasCycle).toBe(false);
+    expect(trace.rendered).toBe(
+      [
+        'T004 API [pending]',
+        '  blocked by',
+        '    T003 Schema [done]',
+        '  unblocks',
+        '    T005 UI [pending]',
+      ].join('\n'),
+    );
+  });
+
+  it('limits the walk to one direction', async () => {
+    const up = await traceTaskDependencies(
+      env.tempDir,
+      { taskId: 'T005', direction: 'up' },
+      env.accessor,
+    );
+    expect(flatten(up.up ?? [])).toEqual(['T004', '  T003']);
+    expect(up.down).toBeNull();
+  });
+
+  it('rejects an unknown task and a bad depth', async () => {
+    await expect(
+      traceTaskDependencies(env.tempDir, { taskId: 'T999' }, env.accessor),
+    ).rejects.toThrow(/Task not found/);
+    await expect(
+      traceTaskDependencies(env.tempDir, { taskId: 'T004', depth: 0 }, env.accessor),
+    ).rejects.toThrow(/positive integer/);
+  });
+});
@@ -0,0 +1,126 @@
+/**
+ * Tests for task due dates: `--due` validation/clearing on update and the
+ * `tasks.overdue` query.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { findOverdueTasks, normalizeDueDate, parseRfc3339Date } from '../due.js';
+import { updateTask } from '../update.js';
+
+describe('normalizeDueDate', () => {
+  it('accepts RFC 3339 full-dates and date-times', () => {
+    expect(normalizeDueDate('2026-07-01')).toBe('2026-07-01');
+    expect(normalizeDueDate(' 2026-07-01T17:00:00Z ')).toBe('2026-07-01T17:00:00Z');
+    expect(normalizeDueDate('2026-07-01T17:00:00.5+02:00')).toBe('2026-07-01T17:00:00.5+02:00');
+  });
+
+  it('maps an empty string to null (clear)', () => {
+    expect(normalizeDueDate('')).toBeNull();
+    expect(normalizeDueDate('   ')).toBeNull();
+  });
+
+  it.each(['tomorrow', '07/01/2026', '2026-02-30', '2026-13-01', '2026-07-01T25:00:00Z'])(
+    'rejects %s',
+    (value) => {
+      expect(() => normalizeDueDate(value)).toThrow(
+        expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }),
+      );
+    },
+  );
+
+  it('reports the field name in the error details', () => {
+    expect(() => parseRfc3339Date('nope', 'asOf')).toThrow(
+      expect.objectContaining({ details: expect.objectContaining({ field: 'asOf' }) }),
+    );
+  });
+});
+
+describe('due dates on tasks', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: {
+          session: { requiredForMutate: false },
+          acceptance: { mode: 'off' },
+        },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Late', status: 'pending', due: '2026-03-01', createdAt },
+      { id: 'T002', title: 'Later', status: 'active', due: '2026-03-10T12:00:00Z', createdAt },
+      { id: 'T003', title: 'Shipped', status: 'done', due: '2026-02-01', createdAt },
+      { id: 'T004', title: 'Future', status: 'pending', due: '2026-12-31', createdAt },
+      { id: 'T005', title: 'No date', status: 'pending', createdAt },
+      { id: 'T006', title: 'Dropped', status: 'cancelled', due: '2026-02-15', createdAt },
+      { id: 'T007', title: 'Archived', status: 'archived', due: '2026-02-20', createdAt },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('sets, persists, and clears the due date through updateTask', async () => {
+    const set = await updateTask({ taskId: 'T005', due: '2026-04-01' }, env.tempDir, env.accessor);
+    expect(set.changes).toContain('due');
+    expect((await env.accessor.loadSingleTask('T005'))?.due).toBe('2026-04-01');
+
+    await updateTask({ taskId: 'T005', due: '' }, env.tempDir, env.accessor);
+    expect((await env.accessor.loadSingleTask('T005'))?.due ?? null).toBeNull();
+  });
+
+  it('rejects an invalid due date without writing', async () => {
+    await expect(
+      updateTask({ taskId: 'T001', title: 'Renamed', due: 'soon' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+
+    const task = await env.accessor.loadSingleTask('T001');
+    expect(task).toMatchObject({ title: 'Late', due: '2026-03-01' });
+  });
+
+  it('lists not-done tasks due before asOf, most overdue first', async () => {
+    const result = await findOverdueTasks({ asOf: '2026-06-01' }, env.tempDir, env.accessor);
+
+    expect(result.results.map((r) => r.id)).toEqual(['T001', 'T002']);
+    expect(result.total).toBe(2);
+    expect(result.results[0]).toMatchObject({ title: 'Late', due: '2026-03-01' });
+    expect(result.asOf).toBe('2026-06-01T00:00:00.000Z');
+  });
+
+  it('never lists cancelled or archived tasks as overdue', async () => {
+    const result = await findOverdueTasks({ asOf: '2026-06-01' }, env.tempDir, env.accessor);
+
+    expect(result.results.map((r) => r.id)).not.toContain('T006');
+    expect(result.results.map((r) => r.id)).not.toContain('T007');
+  });
+
+  it('compares date-times precisely against asOf', async () => {
+    const result = await findOverdueTasks(
+      { asOf: '2026-03-10T12:00:00Z' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.results.map((r) => r.id)).toEqual(['T001']);
+  });
+
+  it('rejects an invalid asOf', async () => {
+    await expect(
+      findOverdueTasks({ asOf: 'last week' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR, details: { field: 'asOf' } });
+  });
+});
@@ -0,0 +1,150 @@
+/**
+ * Tests for task effort estimates: `--estimate` validation and the
+ * saga/epic rollup behind `cleo saga show` / `cleo epic show`.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { deleteTask } from '../delete.js';
+import { normalizeEstimate, taskEstimateRollup } from '../estimate.js';
+import { updateTask } from '../update.js';
+
+describe('normalizeEstimate', () => {
+  it('accepts non-negative numbers and numeric strings', () => {
+    expect(normalizeEstimate(3)).toBe(3);
+    expect(normalizeEstimate(0)).toBe(0);
+    expect(normalizeEstimate(' 1.5 ')).toBe(1.5);
+  });
+
+  it('maps none, an empty string, and null to null', () => {
+    expect(normalizeEstimate('none')).toBeNull();
+    expect(normalizeEstimate('')).toBeNull();
+    expect(normalizeEstimate(null)).toBeNull();
+  });
+
+  it.each([-1, Number.NaN, Number.POSITIVE_INFINITY, 'lots', '-2'])('rejects %s', (value) => {
+    expect(() => normalizeEstimate(value)).toThrow(
+      expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }),
+    );
+  });
+});
+
+describe('estimate rollup', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: {
+          session: { requiredForMutate: false },
+          acceptance: { mode: 'off' },
+        },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Launch', type: 'saga', status: 'active', createdAt },
+      { id: 'T002', title: 'Backend', type: 'epic', parentId: 'T001', estimate: 100, createdAt },
+      { id: 'T003', title: 'API', parentId: 'T002', status: 'done', estimate: 5, createdAt },
+      { id: 'T004', title: 'Auth', parentId: 'T002', status: 'active', estimate: 3, createdAt },
+      { id: 'T005', title: 'Docs', parentId: 'T002', status: 'pending', createdAt },
+      {
+        id: 'T006',
+        title: 'Dropped',
+        parentId: 'T002',
+        status: 'cancelled',
+        estimate: 8,
+        createdAt,
+      },
+      { id: 'T007', title: 'Frontend', type: 'epic', parentId: 'T001', createdAt },
+      { id: 'T008', title: 'Shell', parentId: 'T007', status: 'pending', estimate: 2, createdAt },
+      { id: 'T009', title: 'Breakdown', parentId: 'T008', estimate: 40, createdAt },
+      { id: 'T010', title: 'Polish', parentId: 'T007', createdAt },
+      {
+        id: 'T011',
+        title: 'Polish colors',
+        parentId: 'T010',
+        status: 'done',
+        estimate: 1,
+        createdAt,
+      },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('sums a saga across its epics, ignoring container estimates and cancelled work', async () => {
+    const result = await taskEstimateRollup(env.tempDir, { taskId: 'T001' }, env.accessor);
+
+    expect(result.success).toBe(true);
+    expect(result.data?.task.id).toBe('T001');
+    expect(result.data?.estimate).toEqual({
+      estimateTotal: 11,
+      estimateDone: 6,
+      estimateRemaining: 5,
+      unestimatedCount: 1,
+    });
+  });
+
+  it('counts an estimated parent once instead of its breakdown', async () => {
+    const result = await taskEstimateRollup(
+      env.tempDir,
+      { taskId: 'T007', type: 'epic' },
+      env.accessor,
+    );
+
+    expect(result.data?.estimate).toEqual({
+      estimateTotal: 3,
+      estimateDone: 1,
+      estimateRemaining: 2,
+      unestimatedCount: 0,
+    });
+  });
+
+  it('reflects estimates set and cleared through updateTask', async () => {
+    const set = await updateTask({ taskId: 'T005', estimate: 4 }, env.tempDir, env.accessor);
+    expect(set.changes).toContain('estimate');
+    let result = await taskEstimateRollup(env.tempDir, { taskId: 'T002' }, env.accessor);
+    expect(result.data?.estimate).toMatchObject({ estimateRemaining: 7, unestimatedCount: 0 });
+
+    await updateTask({ taskId: 'T005', estimate: null }, env.tempDir, env.accessor);
+    expect((await env.accessor.loadSingleTask('T005'))?.estimate ?? null).toBeNull();
+    result = await taskEstimateRollup(env.tempDir, { taskId: 'T002' }, env.accessor);
+    expect(result.data?.estimate).toMatchObject({ estimateRemaining: 3, unestimatedCount: 1 });
+  });
+
+  it('ignores members removed with cleo delete', async () => {
+    await deleteTask({ taskId: 'T004' }, env.tempDir, env.accessor);
+    const result = await taskEstimateRollup(env.tempDir, { taskId: 'T002' }, env.accessor);
+
+    expect(result.data?.estimate).toEqual({
+      estimateTotal: 5,
+      estimateDone: 5,
+      estimateRemaining: 0,
+      unestimatedCount: 1,
+    });
+  });
+
+  it('rejects a task of the wrong type', async () => {
+    const result = await taskEstimateRollup(
+      env.tempDir,
+      { taskId: 'T002', type: 'saga' },
+      env.accessor,
+    );
+    expect(result.success).toBe(false);
+    expect(result.error?.code).toBe('E_INVALID_INPUT');
+  });
+});
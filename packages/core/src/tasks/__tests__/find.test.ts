@@ -4,6 +4,7 @@
  * @epic T4454
  */
 
+import { ExitCode } from '@cleocode/contracts';
 import { afterEach, beforeEach, describe, expect, it } from 'vitest';
 import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
 import type { DataAccessor } from '../../store/data-accessor.js';
@@ -182,4 +183,108 @@ describe('findTasks', () => {
     expect(result.results).toHaveLength(1);
     expect(result.results[0]!.id).toBe('T002');
   });
+
+  describe('--regex and --field', () => {
+    beforeEach(async () => {
+      const createdAt = new Date().toISOString();
+      await seedTasks(accessor, [
+        { id: 'T001', title: 'Auth epic', type: 'epic', createdAt },
+        {
+          id: 'T002',
+          title: 'Fix login-42 crash',
+          type: 'task',
+          parentId: 'T001',
+          labels: ['bug'],
+          createdAt,
+        },
+        {
+          id: 'T003',
+          title: 'Refactor session store',
+          type: 'task',
+          parentId: 'T001',
+          description: 'Touches login-7 and login-9 flows',
+          createdAt,
+        },
+        {
+          id: 'T004',
+          title: 'Write runbook',
+          type: 'task',
+          parentId: 'T001',
+          notes: ['seen again on login-13'],
+          createdAt,
+        },
+      ]);
+    });
+
+    it('matches the title by default and widens with --field', async () => {
+      const byTitle = await findTasks({ query: 'login-\\d+', regex: true }, env.tempDir, accessor);
+      expect(byTitle.searchType).toBe('regex');
+      expect(byTitle.results.map((r) => r.id)).toEqual(['T002']);
+
+      const byNotes = await findTasks(
+        { query: 'login-\\d+', regex: true, field: 'notes' },
+        env.tempDir,
+        accessor,
+      );
+      expect(byNotes.results.map((r) => r.id)).toEqual(['T004']);
+
+      const byAll = await findTasks(
+        { query: 'login-\\d+', regex: true, field: 'all' },
+        env.tempDir,
+        accessor,
+      );
+      expect(byAll.results.map((r) => r.id)).toEqual(['T002', 'T003', 'T004']);
+    });
+
+    it('ANDs the regex with --label and --type', async () => {
+      const result = await findTasks(
+        { query: 'login', regex: true, field: 'all', label: 'bug', type: 'task' },
+        env.tempDir,
+        accessor,
+      );
+      expect(result.results.map((r) => r.id)).toEqual(['T002']);
+
+      const none = await findTasks(
+        { query: '^auth', regex: true, type: 'task' },
+        env.tempDir,
+        accessor,
+      );
+      expect(none.total).toBe(0);
+    });
+
+    it('scopes fuzzy search to the chosen field', async () => {
+      const titleOnly = await findTasks(
+        { query: 'session', field: 'description' },
+        env.tempDir,
+        accessor,
+      );
+      expect(titleOnly.total).toBe(0);
+      const desc = await findTasks({ query: 'flows', field: 'description' }, env.tempDir, accessor);
+      expect(desc.results.map((r) => r.id)).toEqual(['T003']);
+    });
+
+    it('still matches task IDs for --in id', async () => {
+      const fuzzy = await findTasks({ query: 't003', field: 'id' }, env.tempDir, accessor);
+      expect(fuzzy.results[0]?.id).toBe('T003');
+
+      const byRegex = await findTasks(
+        { query: '^T00[34]$', regex: true, field: 'id' },
+        env.tempDir,
+        accessor,
+      );
+      expect(byRegex.results.map((r) => r.id)).toEqual(['T003', 'T004']);
+    });
+
+    it('rejects a pattern that does not compile with a bad_regex error', async () => {
+      await expect(
+        findTasks({ query: 'login-(', regex: true }, env.tempDir, accessor),
+      ).rejects.toMatchObject({
+        code: ExitCode.INVALID_INPUT,
+        details: { error: 'bad_regex' },
+      });
+      await expect(
+        findTasks({ query: 'a'.repeat(300), regex: true }, env.tempDir, accessor),
+      ).rejects.toMatchObject({ details: { error: 'bad_regex' } });
+    });
+  });
 });
@@ -0,0 +1,115 @@
+/**
+ * Tests for dependency-graph export (DOT / Mermaid rendering).
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import {
+  buildDependencyGraph,
+  renderDependencyGraphDot,
+  renderDependencyGraphMermaid,
+  taskGraph,
+} from '../graph-export.js';
+
+function makeTask(overrides: Partial<Task> & { id: string }): Task {
+  return {
+    title: `Task ${overrides.id}`,
+    status: 'pending',
+    priority: 'medium',
+    createdAt: new Date().toISOString(),
+    ...overrides,
+  } as Task;
+}
+
+const tasks = [
+  makeTask({ id: 'T001', status: 'done' }),
+  makeTask({ id: 'T002', status: 'active', depends: ['T001'] }),
+  makeTask({ id: 'T003', status: 'blocked', depends: ['T002', 'T999'] }),
+  makeTask({ id: 'T004', status: 'archived', depends: ['T001'] }),
+];
+
+describe('buildDependencyGraph', () => {
+  it('drops archived nodes and out-of-scope edges', () => {
+    const { nodes, edges } = buildDependencyGraph(tasks);
+    expect(nodes.map((n) => n.id)).toEqual(['T001', 'T002', 'T003']);
+    expect(edges).toEqual([
+      { from: 'T001', to: 'T002' },
+      { from: 'T002', to: 'T003' },
+    ]);
+  });
+});
+
+describe('renderDependencyGraphDot', () => {
+  it('emits a digraph with status-styled nodes and dep -> dependent edges', () => {
+    const { nodes, edges } = buildDependencyGraph(tasks);
+    const dot = renderDependencyGraphDot(nodes, edges);
+    expect(dot.startsWith('digraph dependencies {')).toBe(true);
+    expect(dot).toContain('T001 [label="T001: Task T001\\n(done)"');
+    expect(dot).toMatch(/T003 \[[^\]]*shape=octagon/);
+    expect(dot).toContain('T001 -> T002;');
+    expect(dot).toContain('T002 -> T003;');
+    expect(dot.trimEnd().endsWith('}')).toBe(true);
+  });
+
+  it('escapes quotes and truncates long titles', () => {
+    const long = makeTask({ id: 'T010', title: `Say "hi" ${'x'.repeat(80)}` });
+    const { nodes, edges } = buildDependencyGraph([long]);
+    const dot = renderDependencyGraphDot(nodes, edges);
+    expect(dot).toContain('Say \\"hi\\"');
+    expect(dot).toContain('…');
+  });
+});
+
+describe('renderDependencyGraphMermaid', () => {
+  it('emits a graph with classDefs only for statuses present', () => {
+    const { nodes, edges } = buildDependencyGraph(tasks);
+    const mermaid = renderDependencyGraphMermaid(nodes, edges);
+    expect(mermaid.split('\n')[0]).toBe('graph LR');
+    expect(mermaid).toContain('T001["T001: Task T001 (done)"]');
+    expect(mermaid).toContain('T003{{"T003: Task T003 (blocked)"}}');
+    expect(mermaid).toContain('T001 --> T002');
+    expect(mermaid).toContain('class T002 active;');
+    expect(mermaid).not.toContain('classDef cancelled');
+  });
+});
+
+describe('taskGraph (tasks.graph)', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T100', title: 'Epic', type: 'epic' },
+      { id: 'T101', title: 'First', parentId: 'T100' },
+      { id: 'T102', title: 'Second', parentId: 'T100', depends: ['T101'] },
+      { id: 'T200', title: 'Elsewhere' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('returns the scoped nodes, edges and rendering', async () => {
+    const result = await taskGraph(env.tempDir, { epicId: 'T100', format: 'mermaid' });
+
+    expect(result.success).toBe(true);
+    if (!result.success) return;
+    expect(result.data.scopeId).toBe('T100');
+    expect(result.data.nodes.map((n) => n.id).sort()).toEqual(['T100', 'T101', 'T102']);
+    expect(result.data.edges).toEqual([{ from: 'T101', to: 'T102' }]);
+    expect(result.data.rendered.startsWith('graph LR')).toBe(true);
+  });
+
+  it('reports an unknown scope as an error envelope', async () => {
+    const result = await taskGraph(env.tempDir, { sagaId: 'T999' });
+
+    expect(result.success).toBe(false);
+    expect(result.error?.code).toBe('E_NOT_FOUND');
+  });
+});
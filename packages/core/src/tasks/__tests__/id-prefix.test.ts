@@ -0,0 +1,140 @@
+/**
+ * Saga ID prefixes — `<PREFIX>-<n>` allocation under a prefixed saga, and the
+ * lookups that must accept the mixed `T<n>` / `<PREFIX>-<n>` ID space.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { getNativeDb, resetDbState } from '../../store/sqlite.js';
+import { addTask } from '../add.js';
+import { extractTaskIds } from '../commits.js';
+import { deleteTask } from '../delete.js';
+import { normalizeTaskId } from '../id-generator.js';
+import { normalizeIdPrefix } from '../id-prefix.js';
+import { splitTask } from '../split.js';
+
+describe('normalizeIdPrefix', () => {
+  it('uppercases and drops a trailing dash', () => {
+    expect(normalizeIdPrefix('auth')).toBe('AUTH');
+    expect(normalizeIdPrefix(' Api- ')).toBe('API');
+  });
+
+  it('rejects anything but 2-10 letters', () => {
+    for (const bad of ['A', 'T1', 'AUTH_X', 'ABCDEFGHIJK', '']) {
+      expect(() => normalizeIdPrefix(bad)).toThrow(/Invalid ID prefix/);
+    }
+  });
+});
+
+describe('mixed-prefix IDs', () => {
+  it('normalises prefixed IDs alongside T<n>', () => {
+    expect(normalizeTaskId('auth-7')).toBe('AUTH-7');
+    expect(normalizeTaskId('T-123')).toBeNull();
+    expect(normalizeTaskId('t042')).toBe('T042');
+  });
+
+  it('reads prefixed mentions only for known prefixes', () => {
+    const message = 'AUTH-3: fix UTF-8 login, see T042 and API-1';
+    expect(extractTaskIds(message)).toEqual(['T042']);
+    expect(extractTaskIds(message, ['AUTH', 'API'])).toEqual(['AUTH-3', 'T042', 'API-1']);
+  });
+});
+
+describe('prefixed ID allocation', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false }, acceptance: { mode: 'off' } },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Auth saga', type: 'saga', idPrefix: 'AUTH' },
+      { id: 'T002', title: 'Login', type: 'epic', parentId: 'T001' },
+      { id: 'T003', title: 'Unprefixed epic', type: 'epic' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  const add = (title: string, parentId: string, depends?: string[]) =>
+    addTask(
+      { title, description: `${title} work`, parentId, depends },
+      env.tempDir,
+      env.accessor,
+    );
+
+  it('numbers tasks under a prefixed saga per prefix and never reuses a purged ID', async () => {
+    expect((await add('Form', 'T002')).task.id).toBe('AUTH-1');
+    expect((await add('Session', 'T002')).task.id).toBe('AUTH-2');
+    expect((await add('Elsewhere', 'T003')).task.id).toMatch(/^T\d{3,}$/);
+
+    getNativeDb()?.prepare("DELETE FROM tasks_tasks WHERE id = 'AUTH-2'").run();
+    expect((await add('Tokens', 'T002')).task.id).toBe('AUTH-3');
+  });
+
+  it('accepts prefixed IDs as parents and dependencies', async () => {
+    await seedTasks(env.accessor, [
+      { id: 'AUTH-5', title: 'Imported epic', type: 'epic', parentId: 'T001' },
+    ]);
+    const form = await add('Form', 'AUTH-5');
+    const validation = await add('Validation', 'AUTH-5', [form.task.id]);
+
+    // The counter starts above the highest existing AUTH-<n>.
+    expect(form.task.id).toBe('AUTH-6');
+    expect(validation.task.parentId).toBe('AUTH-5');
+    expect(validation.task.depends).toEqual(['AUTH-6']);
+  });
+
+  it('numbers tasks created by split under the saga prefix', async () => {
+    const result = await splitTask(
+      { taskId: 'T002', into: ['Form', 'Session'] },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.children).toEqual(['AUTH-1', 'AUTH-2']);
+  });
+
+  it('keeps a prefix unique across sagas', async () => {
+    await expect(
+      addTask(
+        { title: 'Other saga', description: 'Second', type: 'saga', idPrefix: 'auth' },
+        env.tempDir,
+        env.accessor,
+      ),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+
+  it('keeps the prefix of a trashed saga reserved', async () => {
+    const saga = await addTask(
+      { title: 'API saga', description: 'First', type: 'saga', idPrefix: 'API' },
+      env.tempDir,
+      env.accessor,
+    );
+    await deleteTask({ taskId: saga.task.id }, env.tempDir, env.accessor);
+
+    await expect(
+      addTask(
+        { title: 'API again', description: 'Second', type: 'saga', idPrefix: 'API' },
+        env.tempDir,
+        env.accessor,
+      ),
+    ).rejects.toMatchObject({
+      code: ExitCode.VALIDATION_ERROR,
+      message: expect.stringContaining(`already used by saga ${saga.task.id} (in the trash)`),
+    });
+  });
+});
@@ -0,0 +1,88 @@
+/**
+ * Tests for `tasks.label.rename` / `tasks.label.merge`.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { listLabels, mergeLabels, renameLabel } from '../labels.js';
+
+describe('label rename / merge', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'One', labels: ['bug', 'ui'] },
+      { id: 'T002', title: 'Two', labels: ['defect', 'bug'] },
+      { id: 'T003', title: 'Three', labels: ['fault', 'api', 'defect'] },
+      { id: 'T004', title: 'Four', labels: ['api'] },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  const labelsOf = async (id: string) => (await env.accessor.loadSingleTask(id))?.labels;
+
+  it('renames a label in place and reports the tasks touched', async () => {
+    const result = await renameLabel('ui', 'frontend', env.tempDir, env.accessor);
+
+    expect(result).toEqual({
+      sources: ['ui'],
+      dest: 'frontend',
+      merged: false,
+      tasksTouched: 1,
+      taskIds: ['T001'],
+    });
+    expect(await labelsOf('T001')).toEqual(['bug', 'frontend']);
+    const { tasks } = await env.accessor.queryTasks({ label: 'frontend' });
+    expect(tasks.map((t) => t.id)).toEqual(['T001']);
+  });
+
+  it('merges into an existing label instead of erroring on collision', async () => {
+    const result = await renameLabel('defect', 'bug', env.tempDir, env.accessor);
+
+    expect(result).toMatchObject({ merged: true, tasksTouched: 2 });
+    expect(await labelsOf('T002')).toEqual(['bug']);
+    expect(await labelsOf('T003')).toEqual(['fault', 'api', 'bug']);
+  });
+
+  it('folds several labels into one without duplicating dest', async () => {
+    const result = await mergeLabels(['defect', 'fault'], 'bug', env.tempDir, env.accessor);
+
+    expect(result.tasksTouched).toBe(2);
+    expect([...result.taskIds].sort()).toEqual(['T002', 'T003']);
+    expect(await labelsOf('T003')).toEqual(['bug', 'api']);
+    const labels = await listLabels(env.tempDir, env.accessor);
+    expect(labels.map((l) => l.label).sort()).toEqual(['api', 'bug', 'ui']);
+  });
+
+  it('rewrites archived and trashed tasks too', async () => {
+    await seedTasks(env.accessor, [
+      { id: 'T005', title: 'Old', status: 'archived', labels: ['ui'] },
+      { id: 'T006', title: 'Binned', labels: ['ui'] },
+    ]);
+    await env.accessor.updateTaskFields('T006', { deletedAt: '2026-01-15T00:00:00.000Z' });
+
+    const result = await renameLabel('ui', 'frontend', env.tempDir, env.accessor);
+
+    expect([...result.taskIds].sort()).toEqual(['T001', 'T005', 'T006']);
+    expect(await labelsOf('T005')).toEqual(['frontend']);
+    expect(await labelsOf('T006')).toEqual(['frontend']);
+  });
+
+  it('rejects malformed labels and an empty source list', async () => {
+    await expect(
+      renameLabel('bug', 'Not Valid', env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(mergeLabels([], 'bug', env.tempDir, env.accessor)).rejects.toMatchObject({
+      code: ExitCode.VALIDATION_ERROR,
+    });
+  });
+});
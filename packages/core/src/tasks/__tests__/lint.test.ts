@@ -0,0 +1,105 @@
+/**
+ * Tests for the structural lint behind `cleo lint`: every violation is
+ * reported, for both the task store and a snapshot file.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { SNAPSHOT_FORMAT_VERSION } from '../../snapshot/index.js';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState, SQLITE_SCHEMA_VERSION } from '../../store/sqlite.js';
+import { lintTaskData, lintTaskRecords } from '../lint.js';
+
+const createdAt = '2026-01-01T00:00:00.000Z';
+
+describe('lintTaskRecords', () => {
+  it('reports every violation, not just the first', () => {
+    const findings = lintTaskRecords([
+      { id: 'T001', status: 'pending', createdAt },
+      { id: 'T001', status: 'pending', createdAt },
+      { id: 'T002', status: 'doing', parentId: 'T404', depends: ['T001', 'T405'], createdAt },
+      {
+        id: 'T003',
+        status: 'done',
+        createdAt: 'yesterday',
+        due: '2026-13-45',
+        labels: ['ok', ' '],
+      },
+      { status: 'pending', createdAt },
+    ]);
+
+    expect(findings.map((f) => [f.taskId, f.rule, f.field])).toEqual([
+      ['T001', 'unique-id', 'id'],
+      ['T002', 'valid-status', 'status'],
+      ['T002', 'valid-parent', 'parentId'],
+      ['T002', 'valid-dependency', 'depends'],
+      ['T003', 'valid-date', 'createdAt'],
+      ['T003', 'valid-date', 'due'],
+      ['T003', 'valid-label', 'labels'],
+      [null, 'required-id', 'id'],
+    ]);
+  });
+
+  it('accepts well-formed records', () => {
+    expect(
+      lintTaskRecords([
+        { id: 'T001', status: 'active', createdAt, labels: ['ui'] },
+        { id: 'T002', status: 'pending', parentId: 'T001', depends: ['T001'], createdAt },
+      ]),
+    ).toEqual([]);
+  });
+});
+
+describe('lintTaskData', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('lints the task store against the binary schema version', async () => {
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Epic', type: 'epic' },
+      { id: 'T002', title: 'Child', parentId: 'T001', depends: ['T001'] },
+    ]);
+
+    const result = await lintTaskData({}, env.tempDir, env.accessor);
+
+    expect(result).toMatchObject({
+      source: 'store',
+      schemaVersion: SQLITE_SCHEMA_VERSION,
+      taskCount: 2,
+      findings: [],
+    });
+  });
+
+  it('lints a snapshot file, flagging a schema version mismatch', async () => {
+    const file = join(env.tempDir, 'tasks.json');
+    await writeFile(
+      file,
+      JSON.stringify({
+        _meta: { format: 'cleo-snapshot', version: '0.9.0' },
+        tasks: [{ id: 'T001', status: 'pending', createdAt, parentId: 'T009' }],
+      }),
+    );
+
+    const result = await lintTaskData({ file });
+
+    expect(result.expectedSchemaVersion).toBe(SNAPSHOT_FORMAT_VERSION);
+    expect(result.findings.map((f) => f.rule)).toEqual(['schema-version', 'valid-parent']);
+
+    await writeFile(file, '{"tasks": ');
+    await expect(lintTaskData({ file })).rejects.toMatchObject({
+      code: ExitCode.VALIDATION_ERROR,
+    });
+  });
+});
@@ -0,0 +1,104 @@
+/**
+ * Tests for `tasks.import.markdown` — Markdown checklist → tasks.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { importMarkdownChecklist, parseMarkdownChecklist } from '../markdown-import.js';
+
+const PLAN = [
+  '# Launch plan',
+  '',
+  '## Backend',
+  '- [ ] Build API #high',
+  '  - [x] Schema @db',
+  '  - [ ] Endpoints',
+  '- [x] Fix issue #42',
+  '',
+  '## Docs',
+  '* [ ] Write guide @writing #low',
+].join('\n');
+
+describe('markdown checklist import', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T100', title: 'Launch', status: 'active', type: 'saga' },
+      { id: 'T101', title: 'Not a saga', status: 'pending', type: 'task', parentId: 'T100' },
+    ]);
+    await writeFile(join(env.tempDir, 'plan.md'), PLAN);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('parses nesting, heading labels, and trailing tokens', () => {
+    const items = parseMarkdownChecklist(PLAN);
+    expect(items.map((i) => [i.line, i.title, i.checked, i.parentIndex])).toEqual([
+      [4, 'Build API', false, null],
+      [5, 'Schema', true, 0],
+      [6, 'Endpoints', false, 0],
+      [7, 'Fix issue #42', true, null],
+      [10, 'Write guide', false, null],
+    ]);
+    expect(items[0]).toMatchObject({ priority: 'high', labels: ['backend'] });
+    expect(items[1]?.labels).toEqual(['backend', 'db']);
+    expect(items[4]).toMatchObject({ priority: 'low', labels: ['docs', 'writing'] });
+  });
+
+  it('previews without writing on --dry-run', async () => {
+    const result = await importMarkdownChecklist(
+      { file: 'plan.md', saga: 'T100', dryRun: true },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.created).toBe(0);
+    expect(result.items.map((i) => i.taskId)).toEqual([null, null, null, null, null]);
+    expect(result.items.map((i) => i.type)).toEqual(['epic', 'task', 'task', 'epic', 'epic']);
+    expect(await env.accessor.getChildren('T100')).toHaveLength(1);
+  });
+
+  it('creates tasks under the saga with parent links and checked → done', async () => {
+    const result = await importMarkdownChecklist(
+      { file: 'plan.md', saga: 'T100' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.created).toBe(5);
+
+    const [api, schema, endpoints, fix] = result.items;
+    expect(api?.parentId).toBe('T100');
+    expect(schema?.parentId).toBe(api?.taskId);
+    expect(endpoints?.parentLine).toBe(4);
+
+    const schemaTask = await env.accessor.loadSingleTask(schema!.taskId!);
+    expect(schemaTask).toMatchObject({ status: 'done', type: 'task', parentId: api?.taskId });
+    expect(schemaTask?.completedAt).toBeTruthy();
+    const fixTask = await env.accessor.loadSingleTask(fix!.taskId!);
+    expect(fixTask).toMatchObject({ title: 'Fix issue #42', status: 'done', type: 'epic' });
+  });
+
+  it('rejects a non-saga --saga, a missing file, and too-deep nesting', async () => {
+    await expect(
+      importMarkdownChecklist({ file: 'plan.md', saga: 'T101' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      importMarkdownChecklist({ file: 'missing.md' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+
+    await writeFile(join(env.tempDir, 'deep.md'), '- [ ] a\n  - [ ] b\n    - [ ] c\n');
+    await expect(
+      importMarkdownChecklist({ file: 'deep.md' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+});
@@ -0,0 +1,130 @@
+/**
+ * Tests for `tasks.merge` — folding duplicate tasks into one.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { coreTaskMerge } from '../merge.js';
+
+describe('coreTaskMerge', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false } },
+        lifecycle: { mode: 'off' },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Epic', type: 'epic', status: 'active' },
+      {
+        id: 'T002',
+        title: 'Login page',
+        type: 'task',
+        parentId: 'T001',
+        labels: ['ui'],
+        depends: ['T003'],
+        noteHistory: [{ at: '2026-01-03T00:00:00.000Z', author: 'ana', text: 'keep note' }],
+      },
+      {
+        id: 'T003',
+        title: 'Login screen',
+        type: 'task',
+        parentId: 'T001',
+        labels: ['ui', 'auth'],
+        depends: ['T002'],
+        noteHistory: [{ at: '2026-01-02T00:00:00.000Z', author: 'bo', text: 'dup note' }],
+      },
+      { id: 'T004', title: 'Form fields', type: 'subtask', parentId: 'T003' },
+      { id: 'T005', title: 'Login tests', type: 'task', parentId: 'T001', depends: ['T003'] },
+      { id: 'T006', title: 'Unrelated', type: 'task', parentId: 'T001' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('unions labels, merges notes, redirects dependents, and reparents subtasks', async () => {
+    const result = await coreTaskMerge(env.tempDir, { taskId: 'T002', duplicates: ['T003'] });
+
+    expect(result.task).toMatchObject({ id: 'T002', labels: ['ui', 'auth'] });
+    expect(result.task.depends ?? []).toEqual([]);
+    expect(result.redirected).toEqual(['T005']);
+    expect(result.reparented).toEqual(['T004']);
+
+    const kept = await env.accessor.loadSingleTask('T002');
+    expect(kept?.noteHistory?.map((n) => n.text)).toEqual(['dup note', 'keep note']);
+    expect(await env.accessor.loadSingleTask('T004')).toMatchObject({ parentId: 'T002' });
+    expect(await env.accessor.loadSingleTask('T005')).toMatchObject({ depends: ['T002'] });
+    expect((await env.accessor.loadSingleTask('T003'))?.deletedAt).toBeTruthy();
+  });
+
+  it('drops mutual edges instead of creating a self-loop', async () => {
+    const result = await coreTaskMerge(env.tempDir, { taskId: 'T002', duplicates: ['T003'] });
+    expect(result.droppedEdges).toEqual([
+      { from: 'T002', to: 'T003' },
+      { from: 'T003', to: 'T002' },
+    ]);
+    expect((await env.accessor.loadSingleTask('T002'))?.depends ?? []).not.toContain('T002');
+  });
+
+  it('refuses a merge whose redirected edges would form a cycle', async () => {
+    await seedTasks(env.accessor, [
+      { id: 'T007', title: 'Shared', type: 'task', parentId: 'T001', depends: ['T006'] },
+      { id: 'T006', title: 'Unrelated', type: 'task', parentId: 'T001', depends: ['T002'] },
+    ]);
+    await expect(
+      coreTaskMerge(env.tempDir, { taskId: 'T007', duplicates: ['T003'] }),
+    ).rejects.toMatchObject({ code: ExitCode.CIRCULAR_REFERENCE });
+    expect((await env.accessor.loadSingleTask('T003'))?.deletedAt).toBeFalsy();
+  });
+
+  it('rolls the whole merge back when a later step fails', async () => {
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false } },
+        lifecycle: { mode: 'off' },
+        hierarchy: { maxSiblings: 1 },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T007', title: 'Login view', type: 'task', parentId: 'T001', labels: ['web'] },
+      { id: 'T008', title: 'Copy text', type: 'subtask', parentId: 'T007' },
+      { id: 'T009', title: 'Login form', type: 'task', parentId: 'T001', depends: ['T002'] },
+      { id: 'T010', title: 'Validation', type: 'subtask', parentId: 'T009' },
+    ]);
+    // T008 moves under T006 first; T010 then exceeds the sibling limit.
+    await expect(
+      coreTaskMerge(env.tempDir, { taskId: 'T006', duplicates: ['T007', 'T009'] }),
+    ).rejects.toThrow(/max siblings/);
+
+    expect(await env.accessor.loadSingleTask('T008')).toMatchObject({ parentId: 'T007' });
+    expect(await env.accessor.loadSingleTask('T009')).toMatchObject({ depends: ['T002'] });
+    expect((await env.accessor.loadSingleTask('T006'))?.labels ?? []).toEqual([]);
+    expect((await env.accessor.loadSingleTask('T007'))?.deletedAt).toBeFalsy();
+  });
+
+  it('rejects self-merges, missing duplicates, and keeping a descendant', async () => {
+    await expect(
+      coreTaskMerge(env.tempDir, { taskId: 'T002', duplicates: ['T002'] }),
+    ).rejects.toMatchObject({ code: ExitCode.INVALID_INPUT });
+    await expect(
+      coreTaskMerge(env.tempDir, { taskId: 'T002', duplicates: ['T999'] }),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+    await expect(
+      coreTaskMerge(env.tempDir, { taskId: 'T004', duplicates: ['T003'] }),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+});
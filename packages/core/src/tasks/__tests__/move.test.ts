@@ -0,0 +1,123 @@
+/**
+ * Tests for `tasks.move` — relocating a task or subtree between sagas/epics.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { coreTaskMove } from '../move.js';
+
+describe('coreTaskMove', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false } },
+        lifecycle: { mode: 'off' },
+      }),
+    );
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga one', type: 'saga', status: 'active', createdAt },
+      { id: 'T002', title: 'Epic one', type: 'epic', parentId: 'T001', createdAt },
+      { id: 'T003', title: 'Misfiled', type: 'task', parentId: 'T002', createdAt },
+      { id: 'T004', title: 'Misfiled part', type: 'subtask', parentId: 'T003', createdAt },
+      {
+        id: 'T005',
+        title: 'Needs misfiled',
+        type: 'task',
+        parentId: 'T002',
+        depends: ['T003'],
+        createdAt,
+      },
+      { id: 'T010', title: 'Saga two', type: 'saga', status: 'active', createdAt },
+      { id: 'T011', title: 'Epic two', type: 'epic', parentId: 'T010', createdAt },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('rejects a move that would make a dependency cross sagas', async () => {
+    await expect(
+      coreTaskMove(env.tempDir, { taskId: 'T003', toEpic: 'T011', recursive: true }),
+    ).rejects.toMatchObject({
+      code: ExitCode.DEPENDENCY_ERROR,
+      details: { crossSagaDependencies: [{ from: 'T005', to: 'T003', saga: 'T001' }] },
+    });
+    expect((await env.accessor.loadSingleTask('T003'))?.parentId).toBe('T002');
+  });
+
+  it('moves the whole subtree with --recursive --force and reports moved IDs', async () => {
+    const result = await coreTaskMove(env.tempDir, {
+      taskId: 'T003',
+      toEpic: 'T011',
+      recursive: true,
+      force: true,
+    });
+
+    expect(result.moved[0]).toBe('T003');
+    expect([...result.moved].sort()).toEqual(['T003', 'T004']);
+    expect(result).toMatchObject({ fromParent: 'T002', toParent: 'T011', detached: [] });
+    expect(result.crossSagaDependencies).toHaveLength(1);
+    expect((await env.accessor.loadSingleTask('T003'))?.parentId).toBe('T011');
+    expect((await env.accessor.loadSingleTask('T004'))?.parentId).toBe('T003');
+  });
+
+  it('leaves direct children with the old parent without --recursive', async () => {
+    const result = await coreTaskMove(env.tempDir, {
+      taskId: 'T003',
+      toEpic: 'T011',
+      force: true,
+    });
+
+    expect(result.moved).toEqual(['T003']);
+    expect(result.detached).toEqual(['T004']);
+    expect((await env.accessor.loadSingleTask('T003'))?.parentId).toBe('T011');
+    expect((await env.accessor.loadSingleTask('T004'))?.parentId).toBe('T002');
+  });
+
+  it('rolls the whole move back when a child cannot be re-attached', async () => {
+    await seedTasks(env.accessor, [
+      { id: 'T006', title: 'Second part', type: 'subtask', parentId: 'T003' },
+    ]);
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false } },
+        lifecycle: { mode: 'off' },
+        hierarchy: { maxSiblings: 2 },
+      }),
+    );
+
+    // T004 fits back under T002; T006 would be its third child.
+    await expect(
+      coreTaskMove(env.tempDir, { taskId: 'T003', toEpic: 'T011', force: true }),
+    ).rejects.toThrow(/max siblings/);
+    expect((await env.accessor.loadSingleTask('T003'))?.parentId).toBe('T002');
+    expect((await env.accessor.loadSingleTask('T004'))?.parentId).toBe('T003');
+    expect((await env.accessor.loadSingleTask('T006'))?.parentId).toBe('T003');
+  });
+
+  it('validates the target exists and has the requested type', async () => {
+    await expect(
+      coreTaskMove(env.tempDir, { taskId: 'T002', toSaga: 'T999' }),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+    await expect(
+      coreTaskMove(env.tempDir, { taskId: 'T002', toSaga: 'T011' }),
+    ).rejects.toMatchObject({ code: ExitCode.INVALID_PARENT_TYPE });
+    await expect(coreTaskMove(env.tempDir, { taskId: 'T002' })).rejects.toMatchObject({
+      code: ExitCode.INVALID_INPUT,
+    });
+  });
+});
@@ -0,0 +1,58 @@
+/**
+ * Tests for `tasks.note` — the append-only authored note history.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { taskToRecord } from '../engine-converters.js';
+import { addTaskNote } from '../note.js';
+
+describe('task note history', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [{ id: 'T001', title: 'Shared task', status: 'active' }]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    delete process.env['CLEO_AGENT_ID'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('appends entries without touching earlier ones and shows them newest first', async () => {
+    process.env['CLEO_AGENT_ID'] = 'agent-a';
+    const first = await addTaskNote(
+      { taskId: 'T001', text: 'Repro confirmed on main' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(first.note.author).toBe('agent-a');
+    expect(first.count).toBe(1);
+
+    const second = await addTaskNote(
+      { taskId: 'T001', text: 'Root cause is the cache key', author: 'agent-b' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(second.count).toBe(2);
+
+    const task = await env.accessor.loadSingleTask('T001');
+    expect(task?.noteHistory).toEqual([first.note, second.note]);
+    expect(taskToRecord(task!).noteHistory?.map((n) => n.author)).toEqual(['agent-b', 'agent-a']);
+  });
+
+  it('rejects empty text and unknown tasks', async () => {
+    await expect(
+      addTaskNote({ taskId: 'T001', text: '   ' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      addTaskNote({ taskId: 'T999', text: 'hello' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+  });
+});
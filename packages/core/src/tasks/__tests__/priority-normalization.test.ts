@@ -32,6 +32,11 @@ describe('normalizePriority', () => {
       expect(normalizePriority('Low')).toBe('low');
     });
 
+    it('should accept "normal" as an alias for "medium"', () => {
+      expect(normalizePriority('normal')).toBe('medium');
+      expect(normalizePriority('Normal')).toBe('medium');
+    });
+
     it('should trim whitespace from string priorities', () => {
       expect(normalizePriority('  high  ')).toBe('high');
       expect(normalizePriority(' medium')).toBe('medium');
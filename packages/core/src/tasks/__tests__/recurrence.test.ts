@@ -0,0 +1,152 @@
+/**
+ * Tests for recurring tasks: rule parsing and regeneration on completion.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { completeTask } from '../complete.js';
+import { advanceDue, normalizeRecurrence, recurrenceIntervalMs } from '../recurrence.js';
+import { updateTask } from '../update.js';
+
+const DAY_MS = 24 * 60 * 60 * 1000;
+
+describe('normalizeRecurrence', () => {
+  it('accepts intervals, cron shorthands, and the JSON rule form', () => {
+    expect(normalizeRecurrence('7d')).toEqual({ every: '7d' });
+    expect(normalizeRecurrence('@Weekly')).toEqual({ every: '@weekly' });
+    expect(normalizeRecurrence('{"every":"12h"}')).toEqual({ every: '12h' });
+    expect(normalizeRecurrence({ every: '30m' })).toEqual({ every: '30m' });
+  });
+
+  it('maps none and an empty string to null', () => {
+    expect(normalizeRecurrence('none')).toBeNull();
+    expect(normalizeRecurrence('')).toBeNull();
+  });
+
+  it.each(['0d', '-1d', '{"every":"0h"}', 'fortnightly', '{"every":7}', '{oops'])(
+    'rejects %s',
+    (value) => {
+      expect(() => normalizeRecurrence(value)).toThrow(
+        expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }),
+      );
+    },
+  );
+
+  it('never yields a non-positive interval', () => {
+    expect(recurrenceIntervalMs('0w')).toBeNull();
+    expect(recurrenceIntervalMs('@daily')).toBe(DAY_MS);
+  });
+});
+
+describe('advanceDue', () => {
+  const from = new Date('2026-05-01T09:00:00.000Z');
+
+  it('keeps a full-date a full-date for whole-day intervals', () => {
+    expect(advanceDue('2026-05-04', 7 * DAY_MS, from)).toBe('2026-05-11');
+  });
+
+  it('returns an instant for sub-day intervals or date-times', () => {
+    expect(advanceDue('2026-05-04', 12 * 3600_000, from)).toBe('2026-05-04T12:00:00.000Z');
+    expect(advanceDue('2026-05-04T17:00:00Z', DAY_MS, from)).toBe('2026-05-05T17:00:00.000Z');
+  });
+
+  it('counts from the completion time when there is no due date', () => {
+    expect(advanceDue(undefined, DAY_MS, from)).toBe('2026-05-02T09:00:00.000Z');
+  });
+
+  it('skips the occurrences missed by a late completion', () => {
+    const late = new Date('2026-05-20T09:00:00.000Z');
+    expect(advanceDue('2026-05-04', 7 * DAY_MS, late)).toBe('2026-05-25');
+    expect(advanceDue('2026-04-20T17:00:00Z', DAY_MS, late)).toBe('2026-05-20T17:00:00.000Z');
+  });
+
+  it('never returns the completion instant itself', () => {
+    const onTheDot = new Date('2026-05-11T00:00:00.000Z');
+    expect(advanceDue('2026-05-04', 7 * DAY_MS, onTheDot)).toBe('2026-05-18');
+  });
+});
+
+describe('completing a recurring task', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    vi.useFakeTimers({ toFake: ['Date'] });
+    vi.setSystemTime(new Date('2026-05-03T10:00:00.000Z'));
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: {
+          session: { requiredForMutate: false },
+          acceptance: { mode: 'off' },
+        },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Ops', type: 'epic', status: 'active', createdAt },
+      {
+        id: 'T002',
+        title: 'Weekly review',
+        description: 'Review the board',
+        parentId: 'T001',
+        status: 'active',
+        labels: ['ritual'],
+        due: '2026-05-04',
+        recurrence: { every: '7d' },
+        createdAt,
+      },
+      { id: 'T003', title: 'Other work', parentId: 'T001', status: 'pending', createdAt },
+    ]);
+  });
+
+  afterEach(async () => {
+    vi.useRealTimers();
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('creates the next occurrence and links it via recurredTo', async () => {
+    const result = await completeTask({ taskId: 'T002' }, env.tempDir, env.accessor);
+
+    expect(result.recurredTo).toBeDefined();
+    const original = await env.accessor.loadSingleTask('T002');
+    expect(original).toMatchObject({ status: 'done', recurredTo: result.recurredTo });
+
+    const next = await env.accessor.loadSingleTask(result.recurredTo!);
+    expect(next).toMatchObject({
+      title: 'Weekly review',
+      status: 'pending',
+      parentId: 'T001',
+      labels: ['ritual'],
+      due: '2026-05-11',
+      recurrence: { every: '7d' },
+    });
+    expect(next?.id).not.toBe('T002');
+  });
+
+  it('schedules a late completion after the completion date', async () => {
+    vi.setSystemTime(new Date('2026-05-20T09:00:00.000Z'));
+
+    const result = await completeTask({ taskId: 'T002' }, env.tempDir, env.accessor);
+
+    expect((await env.accessor.loadSingleTask(result.recurredTo!))?.due).toBe('2026-05-25');
+  });
+
+  it('--recurrence none stops the chain', async () => {
+    await updateTask({ taskId: 'T002', recurrence: 'none' }, env.tempDir, env.accessor);
+
+    const result = await completeTask({ taskId: 'T002' }, env.tempDir, env.accessor);
+
+    expect(result.recurredTo).toBeUndefined();
+    expect((await env.accessor.loadSingleTask('T002'))?.recurredTo ?? null).toBeNull();
+  });
+});
@@ -0,0 +1,101 @@
+/**
+ * Tests for `tasks.renumber` — contiguous task ID compaction.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { allocateNextTaskId } from '../../sequence/index.js';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { getNativeDb, resetDbState } from '../../store/sqlite.js';
+import { renumberTasks } from '../renumber.js';
+
+describe('renumberTasks', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Launch', status: 'active', type: 'saga' },
+      { id: 'T004', title: 'Backend', status: 'pending', type: 'epic', parentId: 'T001' },
+      {
+        id: 'T005',
+        title: 'API',
+        status: 'pending',
+        type: 'task',
+        parentId: 'T004',
+        labels: ['api', 'T009'],
+        depends: ['T009'],
+      },
+      { id: 'T009', title: 'Schema', status: 'done', type: 'task', parentId: 'T004' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('plans the mapping on --dry-run without writing', async () => {
+    const result = await renumberTasks({ dryRun: true }, env.tempDir, env.accessor);
+    expect(result.idMap).toEqual({ T001: 'T001', T004: 'T002', T005: 'T003', T009: 'T004' });
+    expect(result.changed).toBe(3);
+    expect(await env.accessor.loadSingleTask('T009')).toBeTruthy();
+  });
+
+  it('rewrites parents, dependencies, and task-ID labels', async () => {
+    await renumberTasks({}, env.tempDir, env.accessor);
+
+    expect(await env.accessor.loadSingleTask('T009')).toBeNull();
+    expect(await env.accessor.loadSingleTask('T002')).toMatchObject({
+      title: 'Backend',
+      parentId: 'T001',
+    });
+    expect(await env.accessor.loadSingleTask('T003')).toMatchObject({
+      title: 'API',
+      parentId: 'T002',
+      depends: ['T004'],
+      labels: ['api', 'T004'],
+    });
+    expect(await env.accessor.loadSingleTask('T004')).toMatchObject({
+      title: 'Schema',
+      parentId: 'T002',
+    });
+    expect(await allocateNextTaskId(env.tempDir)).toBe('T005');
+  });
+
+  it('never moves the ID sequence back onto freed IDs', async () => {
+    // T010–T012 were allocated and then purged before the renumber.
+    getNativeDb()
+      ?.prepare(
+        `UPDATE schema_meta SET value = json_set(value, '$.counter', 12)
+         WHERE key = 'task_id_sequence'`,
+      )
+      .run();
+
+    await renumberTasks({}, env.tempDir, env.accessor);
+    expect(await allocateNextTaskId(env.tempDir)).toBe('T013');
+  });
+
+  it('scopes to a saga and refuses IDs held outside it', async () => {
+    await seedTasks(env.accessor, [{ id: 'T003', title: 'Loose', status: 'pending' }]);
+    await expect(
+      renumberTasks({ saga: 'T001' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+
+    const result = await renumberTasks({ saga: 'T001', start: 20 }, env.tempDir, env.accessor);
+    expect(Object.values(result.idMap)).toEqual(['T020', 'T021', 'T022', 'T023']);
+    expect(await env.accessor.loadSingleTask('T003')).toMatchObject({ title: 'Loose' });
+    expect(await env.accessor.loadSingleTask('T022')).toMatchObject({ depends: ['T023'] });
+  });
+
+  it('rejects a non-saga --saga and a bad --start', async () => {
+    await expect(
+      renumberTasks({ saga: 'T004' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(renumberTasks({ start: 0 }, env.tempDir, env.accessor)).rejects.toMatchObject({
+      code: ExitCode.INVALID_INPUT,
+    });
+  });
+});
@@ -0,0 +1,136 @@
+/**
+ * Tests for `tasks.set-status`: filtered bulk transitions that close a wave
+ * together while honouring the completion guards.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { setTaskStatus } from '../set-status.js';
+import { updateTask } from '../update.js';
+
+describe('setTaskStatus', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false }, acceptance: { mode: 'off' } },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga', type: 'saga', status: 'active' },
+      { id: 'T002', title: 'Epic', type: 'epic', parentId: 'T001', status: 'active' },
+      { id: 'T003', title: 'Wave task A', parentId: 'T002', labels: ['wave-1'], status: 'active' },
+      { id: 'T004', title: 'Wave task B', parentId: 'T002', labels: ['wave-1'], depends: ['T003'] },
+      { id: 'T005', title: 'Wave parent', parentId: 'T002', labels: ['wave-1'] },
+      { id: 'T006', title: 'Open child', parentId: 'T005' },
+      {
+        id: 'T007',
+        title: 'Needs next wave',
+        parentId: 'T002',
+        labels: ['wave-1'],
+        depends: ['T008'],
+      },
+      { id: 'T008', title: 'Next wave', parentId: 'T002', labels: ['wave-2'] },
+      { id: 'T009', title: 'Done already', parentId: 'T002', labels: ['wave-1'], status: 'done' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('closes a wave in one save and reports what was skipped', async () => {
+    const result = await setTaskStatus(
+      { status: 'done', where: ['label:wave-1'] },
+      env.tempDir,
+      env.accessor,
+    );
+
+    expect(result.transitioned).toEqual(['T003', 'T004']);
+    expect(result.skipped).toEqual([
+      { taskId: 'T005', reason: 'open children: T006' },
+      { taskId: 'T007', reason: 'open dependencies: T008' },
+      { taskId: 'T009', reason: 'already done' },
+    ]);
+    const t004 = await env.accessor.loadSingleTask('T004');
+    expect(t004).toMatchObject({ status: 'done', pipelineStage: 'contribution' });
+    expect(t004?.completedAt).toBeTruthy();
+    expect((await env.accessor.loadSingleTask('T005'))?.status).toBe('pending');
+  });
+
+  it('closes a parent together with its children; --force skips the guards', async () => {
+    const withChild = await setTaskStatus(
+      { status: 'done', saga: 'T001', where: ['id = T005 or id = T006'], dryRun: true },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(withChild).toMatchObject({ dryRun: true, transitioned: ['T005', 'T006'], skipped: [] });
+    expect((await env.accessor.loadSingleTask('T006'))?.status).toBe('pending');
+
+    const forced = await setTaskStatus(
+      { status: 'cancelled', label: 'wave-1', force: true },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(forced.transitioned).toEqual(['T003', 'T004', 'T005', 'T007', 'T009']);
+    expect((await env.accessor.loadSingleTask('T007'))?.pipelineStage).toBe('cancelled');
+  });
+
+  it('cannot close a task its completion gates reject, even with --force', async () => {
+    await updateTask(
+      { taskId: 'T003', completionRequirements: ['note'] },
+      env.tempDir,
+      env.accessor,
+    );
+    const result = await setTaskStatus(
+      { status: 'done', where: ['id = T003 or id = T004'], force: true },
+      env.tempDir,
+      env.accessor,
+    );
+
+    expect(result.transitioned).toEqual(['T004']);
+    expect(result.skipped).toEqual([
+      { taskId: 'T003', reason: expect.stringMatching(/missing completion requirements: note/) },
+    ]);
+    expect((await env.accessor.loadSingleTask('T003'))?.status).toBe('active');
+  });
+
+  it('clears completedAt when reopening a done task', async () => {
+    await setTaskStatus({ status: 'done', where: ['id = T003'] }, env.tempDir, env.accessor);
+    expect((await env.accessor.loadSingleTask('T003'))?.completedAt).toBeTruthy();
+
+    const reopened = await setTaskStatus(
+      { status: 'pending', where: ['id = T003'] },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(reopened.transitioned).toEqual(['T003']);
+    const task = await env.accessor.loadSingleTask('T003');
+    expect(task?.status).toBe('pending');
+    expect(task?.completedAt ?? null).toBeNull();
+  });
+
+  it('requires a filter and a settable status', async () => {
+    await expect(
+      setTaskStatus({ status: 'done' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      setTaskStatus({ status: 'archived', label: 'wave-1' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      setTaskStatus({ status: 'done', saga: 'T002' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+});
@@ -0,0 +1,85 @@
+/**
+ * Tests for `--sort priority` ordering across list and find.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { findTasks } from '../find.js';
+import { listTasks } from '../list.js';
+import { compareByPriority, compareTaskIds, validateTaskSort } from '../sort.js';
+
+describe('compareByPriority', () => {
+  it('orders critical → high → medium → low, then by natural ID', () => {
+    const tasks = [
+      { id: 'T10', priority: 'high' },
+      { id: 'T3', priority: 'low' },
+      { id: 'T9', priority: 'high' },
+      { id: 'T1', priority: 'medium' },
+      { id: 'T2', priority: 'critical' },
+    ];
+    expect([...tasks].sort(compareByPriority).map((t) => t.id)).toEqual([
+      'T2',
+      'T9',
+      'T10',
+      'T1',
+      'T3',
+    ]);
+  });
+
+  it('sorts unknown priorities last', () => {
+    const sorted = [{ id: 'T1', priority: undefined }, { id: 'T2', priority: 'low' }].sort(
+      compareByPriority,
+    );
+    expect(sorted.map((t) => t.id)).toEqual(['T2', 'T1']);
+  });
+
+  it('compareTaskIds puts shorter IDs first', () => {
+    expect(['T100', 'T20', 'T3'].sort(compareTaskIds)).toEqual(['T3', 'T20', 'T100']);
+  });
+
+  it('rejects unknown sort keys', () => {
+    expect(() => validateTaskSort('size')).toThrow(
+      expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }),
+    );
+  });
+});
+
+describe('priority sort on list and find', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T10', title: 'Alpha ten', priority: 'high', createdAt },
+      { id: 'T2', title: 'Alpha two', priority: 'low', createdAt },
+      { id: 'T9', title: 'Alpha nine', priority: 'high', createdAt },
+      { id: 'T1', title: 'Alpha one', priority: 'medium', createdAt },
+      { id: 'T5', title: 'Alpha five', priority: 'critical', createdAt },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('listTasks orders by priority with an ID tiebreaker', async () => {
+    const result = await listTasks({ sortByPriority: true }, env.tempDir, env.accessor);
+    expect(result.tasks.map((t) => t.id)).toEqual(['T5', 'T9', 'T10', 'T1', 'T2']);
+  });
+
+  it('findTasks sorts before pagination', async () => {
+    const result = await findTasks(
+      { query: 'alpha', sort: 'priority', limit: 3 },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.results.map((r) => r.id)).toEqual(['T5', 'T9', 'T10']);
+    expect(result.total).toBe(5);
+  });
+});
@@ -0,0 +1,113 @@
+/**
+ * Tests for `tasks.split` — breaking a task into child tasks.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { completeTask } from '../complete.js';
+import { splitTask } from '../split.js';
+
+describe('splitTask', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false }, acceptance: { mode: 'off' } },
+        lifecycle: { mode: 'off' },
+        verification: { enabled: false },
+      }),
+    );
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Epic', type: 'epic', status: 'active' },
+      { id: 'T002', title: 'Schema', type: 'task', parentId: 'T001', status: 'done' },
+      {
+        id: 'T003',
+        title: 'Build API',
+        type: 'task',
+        parentId: 'T001',
+        status: 'active',
+        estimate: 5,
+        labels: ['api'],
+        depends: ['T002'],
+      },
+      { id: 'T004', title: 'Ship', type: 'task', parentId: 'T001', depends: ['T003'] },
+      { id: 'T005', title: 'Part', type: 'subtask', parentId: 'T003' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('creates children that inherit the parent edges and divide its estimate', async () => {
+    const result = await splitTask(
+      { taskId: 'T003', into: ['Routes', 'Handlers', 'Docs'] },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.children).toHaveLength(3);
+    expect(result.estimates).toEqual([1.66, 1.66, 1.68]);
+    expect(result.redirected).toEqual(['T004']);
+
+    const [first] = result.children;
+    expect(await env.accessor.loadSingleTask(first!)).toMatchObject({
+      parentId: 'T003',
+      type: 'subtask',
+      depends: ['T002'],
+      labels: ['api'],
+      estimate: 1.66,
+    });
+    expect((await env.accessor.loadSingleTask('T004'))?.depends).toEqual(result.children);
+    const parent = await env.accessor.loadSingleTask('T003');
+    expect(parent?.depends ?? []).toEqual([]);
+    expect(parent?.estimate ?? null).toBeNull();
+    expect(parent?.status).toBe('active');
+    expect(result.status).toBe('active');
+  });
+
+  it('uses per-child estimates when given', async () => {
+    const result = await splitTask(
+      { taskId: 'T003', into: ['A', 'B'], estimates: [4, 1] },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.estimates).toEqual([4, 1]);
+    await expect(
+      splitTask({ taskId: 'T003', into: ['A', 'B'], estimates: [4] }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.INVALID_INPUT });
+  });
+
+  it('rejects closed tasks and subtasks', async () => {
+    await expect(
+      splitTask({ taskId: 'T002', into: ['A'] }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      splitTask({ taskId: 'T005', into: ['A'] }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+
+  it('closes the parent through the completion rollup when its children finish', async () => {
+    const result = await splitTask(
+      { taskId: 'T003', into: ['Routes', 'Handlers'] },
+      env.tempDir,
+      env.accessor,
+    );
+    await completeTask({ taskId: 'T005' }, env.tempDir, env.accessor);
+    await completeTask({ taskId: result.children[0]! }, env.tempDir, env.accessor);
+    expect((await env.accessor.loadSingleTask('T003'))?.status).toBe('active');
+
+    const last = await completeTask({ taskId: result.children[1]! }, env.tempDir, env.accessor);
+    expect(last.autoCompleted).toContain('T003');
+    expect((await env.accessor.loadSingleTask('T003'))?.status).toBe('done');
+  });
+});
@@ -0,0 +1,75 @@
+/**
+ * Tests for `tasks.stale` / `tasks.stale.reset` — work stuck in one status.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { listStaleTasks, resetStaleTasks } from '../staleness.js';
+
+const daysAgo = (days: number) => new Date(Date.now() - days * 86_400_000).toISOString();
+
+describe('stale tasks', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Stuck', status: 'active', assignee: 'agent-a', updatedAt: daysAgo(9) },
+      { id: 'T002', title: 'Stuck longer', status: 'active', updatedAt: daysAgo(30) },
+      { id: 'T003', title: 'Fresh', status: 'active', updatedAt: daysAgo(1) },
+      { id: 'T004', title: 'Old but pending', status: 'pending', updatedAt: daysAgo(40) },
+      { id: 'T005', title: 'Never updated', status: 'blocked', createdAt: daysAgo(20) },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('lists active tasks older than 7d by default, stalest first', async () => {
+    const result = await listStaleTasks({}, env.tempDir, env.accessor);
+
+    expect(result.status).toBe('active');
+    expect(result.olderThan).toBe('7d');
+    expect(result.tasks.map((t) => t.id)).toEqual(['T002', 'T001']);
+    expect(result.tasks[0]?.staleDays).toBe(30);
+    expect(result.tasks[1]).toMatchObject({ assignee: 'agent-a', staleDays: 9 });
+  });
+
+  it('honours --status (including in_progress) and --older-than', async () => {
+    const inProgress = await listStaleTasks(
+      { status: 'in_progress', olderThan: '2w' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(inProgress.tasks.map((t) => t.id)).toEqual(['T002']);
+
+    const blocked = await listStaleTasks({ status: 'blocked' }, env.tempDir, env.accessor);
+    expect(blocked.tasks.map((t) => t.id)).toEqual(['T005']);
+  });
+
+  it('resets stale tasks to pending and clears the assignee', async () => {
+    const result = await resetStaleTasks({}, env.tempDir, env.accessor);
+
+    expect(result.reset).toEqual(['T002', 'T001']);
+    const t001 = await env.accessor.loadSingleTask('T001');
+    expect(t001?.status).toBe('pending');
+    expect(t001?.assignee ?? null).toBeNull();
+    expect((await env.accessor.loadSingleTask('T003'))?.status).toBe('active');
+    expect((await listStaleTasks({}, env.tempDir, env.accessor)).total).toBe(0);
+  });
+
+  it('rejects terminal statuses and malformed durations', async () => {
+    await expect(
+      listStaleTasks({ status: 'done' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+    await expect(
+      listStaleTasks({ olderThan: 'ages' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+});
@@ -0,0 +1,153 @@
+/**
+ * Tests for task events published by the engine wrappers beyond add /
+ * update / complete / delete / archive: block, merge, split, set-status,
+ * move, restore, and batch.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { join } from 'node:path';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { taskArchiveRestore } from '../archive-restore.js';
+import { tasksBatchOp } from '../batch.js';
+import { taskBlock, taskUnblock } from '../block.js';
+import { deleteTask } from '../delete.js';
+import { taskMerge } from '../merge.js';
+import { taskMove } from '../move.js';
+import { taskSetStatus } from '../set-status.js';
+import { taskSplit } from '../split.js';
+import { onTaskEvent, type TaskEvent } from '../task-events.js';
+import { taskTrashRestore } from '../trash.js';
+
+describe('task events from the engine wrappers', () => {
+  let env: TestDbEnv;
+  let events: TaskEvent[];
+  let off: () => void;
+
+  /** Published events as `[event, taskId]` pairs. */
+  const published = (): Array<[string, string]> => events.map((e) => [e.event, e.task.id]);
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false } },
+        lifecycle: { mode: 'off' },
+      }),
+    );
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga one', type: 'saga', status: 'active', createdAt },
+      { id: 'T002', title: 'Epic one', type: 'epic', parentId: 'T001', createdAt },
+      { id: 'T003', title: 'Widget', parentId: 'T002', labels: ['wave'], createdAt },
+      { id: 'T004', title: 'Gadget', parentId: 'T002', labels: ['wave'], createdAt },
+      { id: 'T005', title: 'Gadget again', parentId: 'T002', createdAt },
+      { id: 'T011', title: 'Epic two', type: 'epic', parentId: 'T001', createdAt },
+    ]);
+    events = [];
+    off = onTaskEvent((e) => events.push(e));
+  });
+
+  afterEach(async () => {
+    off();
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('publishes task.updated with the task state for block and unblock', async () => {
+    await taskBlock(env.tempDir, { taskId: 'T003', reason: 'Waiting on vendor' });
+    await taskUnblock(env.tempDir, { taskId: 'T003' });
+
+    expect(published()).toEqual([
+      ['task.updated', 'T003'],
+      ['task.updated', 'T003'],
+    ]);
+    expect(events[0]?.task).toMatchObject({ status: 'blocked' });
+    expect(events[1]?.task).toMatchObject({ status: 'pending' });
+  });
+
+  it('publishes the kept task as updated and the duplicates as deleted on merge', async () => {
+    const result = await taskMerge(env.tempDir, { taskId: 'T004', duplicates: ['T005'] });
+
+    expect(result.success).toBe(true);
+    expect(published()).toEqual([
+      ['task.updated', 'T004'],
+      ['task.deleted', 'T005'],
+    ]);
+  });
+
+  it('publishes the new children as created, then the parent as updated, on split', async () => {
+    const result = await taskSplit(env.tempDir, { taskId: 'T003', into: ['Left', 'Right'] });
+    const children = result.data?.children ?? [];
+
+    expect(children).toHaveLength(2);
+    expect(published()).toEqual([
+      ...children.map((id): [string, string] => ['task.created', id]),
+      ['task.updated', 'T003'],
+    ]);
+    expect(events[0]?.task).toMatchObject({ title: 'Left', parentId: 'T003' });
+  });
+
+  it('publishes every transitioned task on set-status, and nothing on a dry run', async () => {
+    await taskSetStatus(env.tempDir, { status: 'active', label: 'wave', dryRun: true });
+    expect(events).toEqual([]);
+
+    await taskSetStatus(env.tempDir, { status: 'active', label: 'wave' });
+    expect(published()).toEqual([
+      ['task.updated', 'T003'],
+      ['task.updated', 'T004'],
+    ]);
+  });
+
+  it('publishes task.completed when set-status closes tasks', async () => {
+    await taskSetStatus(env.tempDir, { status: 'done', where: ['id = T005'] });
+
+    expect(published()).toEqual([['task.completed', 'T005']]);
+    expect(events[0]?.task).toMatchObject({ status: 'done' });
+  });
+
+  it('publishes the moved task with its new parent', async () => {
+    await taskMove(env.tempDir, { taskId: 'T003', toEpic: 'T011' });
+
+    expect(published()).toEqual([['task.updated', 'T003']]);
+    expect(events[0]?.task).toMatchObject({ parentId: 'T011' });
+  });
+
+  it('publishes task.created for a task restored from the trash or the archive', async () => {
+    await deleteTask({ taskId: 'T004' }, env.tempDir, env.accessor);
+    await env.accessor.archiveSingleTask('T005', { archiveReason: 'manual' });
+
+    await taskTrashRestore(env.tempDir, { taskId: 'T004' });
+    await taskArchiveRestore(env.tempDir, { taskId: 'T005' });
+
+    expect(published()).toEqual([
+      ['task.created', 'T004'],
+      ['task.created', 'T005'],
+    ]);
+    expect(events[0]?.task).toMatchObject({ title: 'Gadget' });
+  });
+
+  it('publishes one event per committed batch step, and none for a dry run', async () => {
+    const operations = [
+      { op: 'create' as const, title: 'Sprocket', description: 'Batch add', parent: 'T002' },
+      { op: 'update' as const, taskId: 'T003', title: 'Widget (renamed)' },
+      { op: 'complete' as const, taskId: 'T004' },
+    ];
+    await tasksBatchOp(env.tempDir, { operations, dryRun: true });
+    expect(events).toEqual([]);
+
+    const result = await tasksBatchOp(env.tempDir, { operations });
+    const created = result.data?.results[0]?.taskId;
+
+    expect(published()).toEqual([
+      ['task.created', created],
+      ['task.updated', 'T003'],
+      ['task.completed', 'T004'],
+    ]);
+    expect(events[1]?.task).toMatchObject({ title: 'Widget (renamed)' });
+  });
+});
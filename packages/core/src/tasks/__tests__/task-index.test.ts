@@ -0,0 +1,73 @@
+/**
+ * Tests for the in-memory task index and its resident-process cache.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { buildTaskIndex, enableTaskIndexCache, loadTaskIndex } from '../task-index.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    description: '',
+    status: 'pending',
+    priority: 'medium',
+    createdAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+describe('buildTaskIndex', () => {
+  it('indexes IDs, children, dependents, and completion', () => {
+    const index = buildTaskIndex(
+      [
+        makeTask('T001', { type: 'epic' }),
+        makeTask('T002', { parentId: 'T001', status: 'done' }),
+        makeTask('T003', { parentId: 'T001', depends: ['T002', 'T009'] }),
+        makeTask('T004', { parentId: 'T001', depends: ['T002'] }),
+      ],
+      [makeTask('T009', { status: 'archived' }), makeTask('T010', { deletedAt: '2026-02-01' })],
+    );
+
+    expect(index.byId.get('T003')?.depends).toEqual(['T002', 'T009']);
+    expect(index.children.get('T001')?.map((t) => t.id)).toEqual(['T002', 'T003', 'T004']);
+    expect(index.dependents.get('T002')).toEqual(['T003', 'T004']);
+    expect([...index.completedIds].sort()).toEqual(['T002', 'T009']);
+  });
+});
+
+describe('loadTaskIndex cache', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    await seedTasks(env.accessor, [{ id: 'T001', title: 'Saga', type: 'saga' }]);
+  });
+
+  afterEach(async () => {
+    enableTaskIndexCache(false);
+    await env.cleanup();
+  });
+
+  it('rebuilds on every call unless caching is on', async () => {
+    const first = await loadTaskIndex(env.accessor, env.tempDir);
+    expect(await loadTaskIndex(env.accessor, env.tempDir)).not.toBe(first);
+
+    enableTaskIndexCache();
+    const cached = await loadTaskIndex(env.accessor, env.tempDir);
+    expect(await loadTaskIndex(env.accessor, env.tempDir)).toBe(cached);
+  });
+
+  it('drops the cached index once the database changes', async () => {
+    enableTaskIndexCache();
+    const before = await loadTaskIndex(env.accessor, env.tempDir);
+    await seedTasks(env.accessor, [{ id: 'T002', title: 'Epic', type: 'epic', parentId: 'T001' }]);
+
+    const after = await loadTaskIndex(env.accessor, env.tempDir);
+    expect(after).not.toBe(before);
+    expect(after.byId.has('T002')).toBe(true);
+  });
+});
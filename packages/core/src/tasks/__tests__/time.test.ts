@@ -0,0 +1,84 @@
+/**
+ * Tests for task time tracking — `tasks.time.start`, `tasks.time.stop`, and
+ * `tasks.time`.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it, vi } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { getTaskTime, startTaskTimer, stopTaskTimer, sumTimeEntries } from '../time.js';
+
+const T0 = Date.parse('2026-05-01T09:00:00.000Z');
+
+describe('sumTimeEntries', () => {
+  it('sums closed intervals and counts an open one up to now', () => {
+    const entries = [
+      { start: '2026-05-01T09:00:00.000Z', stop: '2026-05-01T09:30:00.000Z' },
+      { start: '2026-05-01T10:00:00.000Z' },
+    ];
+    expect(sumTimeEntries(entries, '2026-05-01T10:15:00.000Z')).toBe(45 * 60);
+  });
+});
+
+describe('task time tracking', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    vi.useFakeTimers({ toFake: ['Date'] });
+    vi.setSystemTime(T0);
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [{ id: 'T001', title: 'Parser', status: 'active', estimate: 3 }]);
+  });
+
+  afterEach(async () => {
+    vi.useRealTimers();
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('records start/stop intervals and totals them against the estimate', async () => {
+    await startTaskTimer({ taskId: 'T001' }, env.tempDir, env.accessor);
+    vi.setSystemTime(T0 + 90_000);
+    const stopped = await stopTaskTimer({ taskId: 'T001' }, env.tempDir, env.accessor);
+    expect(stopped).toMatchObject({ duration: 90, timeSpent: 90 });
+
+    vi.setSystemTime(T0 + 120_000);
+    await startTaskTimer({ taskId: 'T001' }, env.tempDir, env.accessor);
+    vi.setSystemTime(T0 + 150_000);
+
+    const time = await getTaskTime({ taskId: 'T001' }, env.tempDir, env.accessor);
+    expect(time).toEqual({
+      taskId: 'T001',
+      timeSpent: 120,
+      estimate: 3,
+      running: true,
+      intervals: [
+        { start: '2026-05-01T09:00:00.000Z', stop: '2026-05-01T09:01:30.000Z' },
+        { start: '2026-05-01T09:02:00.000Z' },
+      ],
+    });
+  });
+
+  it('rejects starting a running timer and stopping an idle one', async () => {
+    await expect(
+      stopTaskTimer({ taskId: 'T001' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+
+    await startTaskTimer({ taskId: 'T001' }, env.tempDir, env.accessor);
+    await expect(
+      startTaskTimer({ taskId: 'T001' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+
+    const time = await getTaskTime({ taskId: 'T001' }, env.tempDir, env.accessor);
+    expect(time.intervals).toHaveLength(1);
+  });
+
+  it('fails with NOT_FOUND for an unknown task', async () => {
+    await expect(
+      startTaskTimer({ taskId: 'T999' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.NOT_FOUND });
+  });
+});
@@ -0,0 +1,125 @@
+/**
+ * Tests for the task trash: soft delete, `trash.list`, `trash.restore`,
+ * `trash.empty`, and `delete --purge`.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { deleteTask } from '../delete.js';
+import { findTasks } from '../find.js';
+import { emptyTrash, listTrash, restoreFromTrash } from '../trash.js';
+
+describe('task trash', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    const createdAt = '2026-01-01T00:00:00.000Z';
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga one', type: 'saga', status: 'active', createdAt },
+      { id: 'T002', title: 'Epic one', type: 'epic', parentId: 'T001', createdAt },
+      {
+        id: 'T003',
+        title: 'Shipped widget',
+        type: 'task',
+        parentId: 'T002',
+        status: 'done',
+        completedAt: '2026-02-01T00:00:00.000Z',
+        createdAt,
+      },
+      { id: 'T004', title: 'Loose widget', type: 'task', parentId: 'T002', createdAt },
+      { id: 'T010', title: 'Saga two', type: 'saga', status: 'active', createdAt },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('hides trashed tasks from queries and find, and lists them in the trash', async () => {
+    await deleteTask({ taskId: 'T004' }, env.tempDir, env.accessor);
+
+    const { tasks } = await env.accessor.queryTasks({});
+    expect(tasks.map((t) => t.id)).not.toContain('T004');
+    // The orchestrator loads every status, archived included — still hidden.
+    const all = await env.accessor.queryTasks({ status: ['pending', 'archived'] });
+    expect(all.tasks.map((t) => t.id)).not.toContain('T004');
+    const found = await findTasks(
+      { query: 'widget', includeArchive: true },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(found.results.map((r) => r.id)).toContain('T003');
+    expect(found.results.map((r) => r.id)).not.toContain('T004');
+
+    const trash = await listTrash(env.tempDir, env.accessor);
+    expect(trash.total).toBe(1);
+    expect(trash.tasks[0]).toMatchObject({ id: 'T004', parentId: 'T002' });
+    expect(trash.tasks[0]?.deletedAt).toBeTruthy();
+  });
+
+  it('restores a cascade-deleted subtree with its original parent and status', async () => {
+    await deleteTask({ taskId: 'T002', cascade: true }, env.tempDir, env.accessor);
+    expect((await listTrash(env.tempDir, env.accessor)).total).toBe(3);
+
+    const result = await restoreFromTrash({ taskId: 'T002' }, env.tempDir, env.accessor);
+
+    expect([...result.restored].sort()).toEqual(['T002', 'T003', 'T004']);
+    expect(result.parentId).toBe('T001');
+    expect((await env.accessor.loadSingleTask('T003'))?.status).toBe('done');
+    expect((await env.accessor.loadSingleTask('T004'))?.status).toBe('pending');
+    expect((await listTrash(env.tempDir, env.accessor)).total).toBe(0);
+  });
+
+  it('refuses to restore under a purged saga unless --to-saga is given', async () => {
+    await deleteTask({ taskId: 'T002', cascade: true }, env.tempDir, env.accessor);
+    await deleteTask({ taskId: 'T001', purge: true }, env.tempDir, env.accessor);
+    expect(await env.accessor.loadSingleTask('T001')).toBeNull();
+
+    await expect(
+      restoreFromTrash({ taskId: 'T002' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.PARENT_NOT_FOUND });
+
+    const result = await restoreFromTrash(
+      { taskId: 'T002', toSaga: 'T010' },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(result.parentId).toBe('T010');
+    expect((await env.accessor.loadSingleTask('T002'))?.parentId).toBe('T010');
+    expect((await env.accessor.loadSingleTask('T004'))?.parentId).toBe('T002');
+  });
+
+  it('rejects a --to-saga target that cannot hold the task', async () => {
+    await deleteTask({ taskId: 'T004' }, env.tempDir, env.accessor);
+    await expect(
+      restoreFromTrash({ taskId: 'T004', toSaga: 'T010' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.INVALID_PARENT_TYPE });
+  });
+
+  it('purges with delete --purge and trash empty --older-than', async () => {
+    await deleteTask({ taskId: 'T004' }, env.tempDir, env.accessor);
+    await deleteTask({ taskId: 'T003' }, env.tempDir, env.accessor);
+    await env.accessor.updateTaskFields('T003', { deletedAt: '2026-01-15T00:00:00.000Z' });
+
+    const emptied = await emptyTrash({ olderThan: '30d' }, env.tempDir, env.accessor);
+    expect(emptied).toEqual({ purged: ['T003'], count: 1 });
+    expect(await env.accessor.loadSingleTask('T003')).toBeNull();
+
+    await expect(
+      deleteTask({ taskId: 'T004' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.INVALID_INPUT });
+    const purged = await deleteTask({ taskId: 'T004', purge: true }, env.tempDir, env.accessor);
+    expect(purged.purged).toBe(true);
+    expect(await env.accessor.loadSingleTask('T004')).toBeNull();
+
+    await expect(
+      emptyTrash({ olderThan: 'soon' }, env.tempDir, env.accessor),
+    ).rejects.toMatchObject({ code: ExitCode.VALIDATION_ERROR });
+  });
+});
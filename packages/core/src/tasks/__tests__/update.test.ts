@@ -605,4 +605,107 @@ describe('updateTask', () => {
       expect(result.task.files ?? []).toHaveLength(0);
     });
   });
+
+  describe('depends edge guard', () => {
+    const now = new Date().toISOString();
+
+    it('rejects a direct cycle with the cycle path', async () => {
+      await seedTasks(accessor, [
+        { id: 'T100', title: 'A', status: 'pending', priority: 'medium', createdAt: now },
+        {
+          id: 'T101',
+          title: 'B',
+          status: 'pending',
+          priority: 'medium',
+          depends: ['T100'],
+          createdAt: now,
+        },
+      ]);
+
+      await expect(
+        updateTask({ taskId: 'T100', addDepends: ['T101'] }, env.tempDir, accessor),
+      ).rejects.toMatchObject({
+        details: { error: 'dependency_cycle', path: ['T100', 'T101', 'T100'] },
+      });
+      const stored = await accessor.loadSingleTask('T100');
+      expect(stored?.depends ?? []).toEqual([]);
+    });
+
+    it('rejects a transitive cycle', async () => {
+      await seedTasks(accessor, [
+        { id: 'T001', title: 'A', status: 'pending', priority: 'medium', createdAt: now },
+        {
+          id: 'T002',
+          title: 'B',
+          status: 'pending',
+          priority: 'medium',
+          depends: ['T001'],
+          createdAt: now,
+        },
+        {
+          id: 'T003',
+          title: 'C',
+          status: 'pending',
+          priority: 'medium',
+          depends: ['T002'],
+          createdAt: now,
+        },
+      ]);
+
+      await expect(
+        updateTask({ taskId: 'T001', depends: ['T003'] }, env.tempDir, accessor),
+      ).rejects.toMatchObject({
+        details: { error: 'dependency_cycle', path: ['T001', 'T003', 'T002', 'T001'] },
+      });
+    });
+
+    it('rejects a self-dependency', async () => {
+      await seedTasks(accessor, [
+        { id: 'T001', title: 'A', status: 'pending', priority: 'medium', createdAt: now },
+      ]);
+
+      await expect(
+        updateTask({ taskId: 'T001', addDepends: ['T001'] }, env.tempDir, accessor),
+      ).rejects.toMatchObject({ details: { error: 'dependency_cycle', path: ['T001', 'T001'] } });
+    });
+
+    it('rejects a dependency on a missing task as missing_dependency', async () => {
+      await seedTasks(accessor, [
+        { id: 'T001', title: 'A', status: 'pending', priority: 'medium', createdAt: now },
+      ]);
+
+      await expect(
+        updateTask({ taskId: 'T001', addDepends: ['T999'] }, env.tempDir, accessor),
+      ).rejects.toMatchObject({
+        details: { error: 'missing_dependency', actual: 'T999', reason: 'not_found' },
+      });
+    });
+
+    it('rejects a dependency on an archived task as missing_dependency', async () => {
+      await seedTasks(accessor, [
+        { id: 'T001', title: 'A', status: 'pending', priority: 'medium', createdAt: now },
+        { id: 'T002', title: 'Old', status: 'archived', priority: 'medium', createdAt: now },
+      ]);
+
+      await expect(
+        updateTask({ taskId: 'T001', addDepends: ['T002'] }, env.tempDir, accessor),
+      ).rejects.toMatchObject({
+        details: { error: 'missing_dependency', actual: 'T002', reason: 'archived' },
+      });
+    });
+
+    it('accepts an acyclic edge', async () => {
+      await seedTasks(accessor, [
+        { id: 'T001', title: 'A', status: 'pending', priority: 'medium', createdAt: now },
+        { id: 'T002', title: 'B', status: 'pending', priority: 'medium', createdAt: now },
+      ]);
+
+      const result = await updateTask(
+        { taskId: 'T002', addDepends: ['T001'] },
+        env.tempDir,
+        accessor,
+      );
+      expect(result.task.depends).toEqual(['T001']);
+    });
+  });
 });
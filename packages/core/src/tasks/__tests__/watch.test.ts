@@ -0,0 +1,124 @@
+/**
+ * Tests for the task watch feed: snapshot diffing and the directory watcher
+ * behind `cleo watch`.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import {
+  diffTaskSnapshots,
+  loadTaskSnapshot,
+  type TaskSnapshot,
+  type TaskWatchEvent,
+  watchTasks,
+} from '../watch.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    status: 'pending',
+    priority: 'medium',
+    createdAt: '2026-01-01T00:00:00Z',
+    updatedAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+function snapshot(...tasks: Task[]): TaskSnapshot {
+  return new Map(tasks.map((t) => [t.id, t]));
+}
+
+describe('diffTaskSnapshots', () => {
+  it('reports creations, status transitions, edits, and removals', () => {
+    const prev = snapshot(makeTask('T001'), makeTask('T002'), makeTask('T003'));
+    const next = snapshot(
+      makeTask('T001', { status: 'active', updatedAt: '2026-01-02T00:00:00Z' }),
+      makeTask('T002', { title: 'Renamed', labels: ['ui'] }),
+      makeTask('T004'),
+    );
+
+    expect(diffTaskSnapshots(prev, next, 'now')).toEqual([
+      {
+        event: 'task.status',
+        taskId: 'T001',
+        title: 'T001',
+        from: 'pending',
+        to: 'active',
+        at: 'now',
+      },
+      {
+        event: 'task.updated',
+        taskId: 'T002',
+        title: 'Renamed',
+        fields: ['labels', 'title'],
+        at: 'now',
+      },
+      { event: 'task.created', taskId: 'T004', title: 'T004', to: 'pending', at: 'now' },
+      { event: 'task.removed', taskId: 'T003', title: 'T003', from: 'pending', at: 'now' },
+    ]);
+  });
+
+  it('reports nothing when only bookkeeping fields changed', () => {
+    const prev = snapshot(makeTask('T001'));
+    const next = snapshot(makeTask('T001', { updatedAt: '2026-03-01T00:00:00Z' }));
+
+    expect(diffTaskSnapshots(prev, next)).toEqual([]);
+  });
+});
+
+describe('watchTasks', () => {
+  let env: TestDbEnv;
+  let stop: (() => void) | undefined;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Saga', type: 'saga', status: 'active' },
+      { id: 'T002', title: 'Epic', type: 'epic', parentId: 'T001' },
+      { id: 'T003', title: 'In saga', parentId: 'T002' },
+      { id: 'T010', title: 'Elsewhere' },
+    ]);
+  });
+
+  afterEach(async () => {
+    stop?.();
+    stop = undefined;
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('scopes the snapshot to a saga', async () => {
+    const scoped = await loadTaskSnapshot('T001', env.tempDir, env.accessor);
+    expect([...scoped.keys()].sort()).toEqual(['T001', 'T002', 'T003']);
+  });
+
+  it('emits the delta after a write, debounced into one reload', async () => {
+    const events: TaskWatchEvent[] = [];
+    stop = await watchTasks(
+      { sagaId: 'T001', debounceMs: 50, onEvent: (e) => events.push(e) },
+      env.tempDir,
+      env.accessor,
+    );
+
+    await env.accessor.updateTaskFields('T003', { status: 'active' });
+    await env.accessor.updateTaskFields('T010', { status: 'active' });
+    await seedTasks(env.accessor, [{ id: 'T004', title: 'New in saga', parentId: 'T002' }]);
+
+    const deadline = Date.now() + 5_000;
+    while (events.length < 2 && Date.now() < deadline) {
+      await new Promise((resolve) => setTimeout(resolve, 25));
+    }
+
+    expect(events.map((e) => [e.event, e.taskId])).toEqual([
+      ['task.status', 'T003'],
+      ['task.created', 'T004'],
+    ]);
+    expect(events[0]).toMatchObject({ from: 'pending', to: 'active' });
+  });
+});
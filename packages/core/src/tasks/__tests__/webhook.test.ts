@@ -0,0 +1,154 @@
+/**
+ * Tests for the task event bus and the webhook emitter.
+ *
+ * A local HTTP server stands in for the receiver, so delivery, retries and
+ * the exit-time flush are exercised against real sockets.
+ */
+
+import { writeFile } from 'node:fs/promises';
+import { createServer, type IncomingMessage, type Server, type ServerResponse } from 'node:http';
+import type { AddressInfo } from 'node:net';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { taskPurge } from '../delete.js';
+import { onTaskEvent, type TaskEvent } from '../task-events.js';
+import { taskUpdate } from '../update.js';
+import {
+  enqueueWebhook,
+  flushWebhooks,
+  installWebhookEmitter,
+  resetWebhookEmitter,
+  sendWebhookTest,
+} from '../webhook.js';
+
+type Handler = (req: IncomingMessage, res: ServerResponse, body: string) => void;
+
+describe('webhook emitter', () => {
+  let env: TestDbEnv;
+  let server: Server;
+  let url: string;
+  let received: Array<Record<string, unknown>>;
+  let handler: Handler;
+
+  async function configure(webhook: Record<string, unknown>): Promise<void> {
+    await writeFile(
+      join(env.cleoDir, 'config.json'),
+      JSON.stringify({
+        enforcement: { session: { requiredForMutate: false } },
+        lifecycle: { mode: 'off' },
+        webhook,
+      }),
+    );
+  }
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    received = [];
+    handler = (_req, res) => res.writeHead(204).end();
+    server = createServer((req, res) => {
+      let body = '';
+      req.on('data', (chunk: Buffer) => {
+        body += chunk.toString('utf-8');
+      });
+      req.on('end', () => {
+        received.push(JSON.parse(body) as Record<string, unknown>);
+        handler(req, res, body);
+      });
+    });
+    await new Promise<void>((resolve) => server.listen(0, '127.0.0.1', resolve));
+    url = `http://127.0.0.1:${(server.address() as AddressInfo).port}/hook`;
+    await configure({ url });
+  });
+
+  afterEach(async () => {
+    resetWebhookEmitter();
+    server.closeAllConnections();
+    await new Promise<void>((resolve) => server.close(() => resolve()));
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('publishes a typed event from the engine wrappers', async () => {
+    await seedTasks(env.accessor, [{ id: 'T001', title: 'Write docs' }]);
+    const events: TaskEvent[] = [];
+    const off = onTaskEvent((e) => events.push(e));
+    await taskUpdate(env.tempDir, 'T001', { title: 'Write the docs' });
+    off();
+    await taskUpdate(env.tempDir, 'T001', { title: 'Ignored' });
+
+    expect(events).toHaveLength(1);
+    expect(events[0]).toMatchObject({
+      event: 'task.updated',
+      task: { id: 'T001', title: 'Write the docs' },
+      projectRoot: env.tempDir,
+    });
+  });
+
+  it('publishes task.deleted for a purged task and its cascaded children', async () => {
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Spike', type: 'epic' },
+      { id: 'T002', title: 'Spike notes', parentId: 'T001' },
+    ]);
+    const events: TaskEvent[] = [];
+    const off = onTaskEvent((e) => events.push(e));
+    const result = await taskPurge(env.tempDir, 'T001', true);
+    off();
+
+    expect(result.success).toBe(true);
+    expect(events.map((e) => [e.event, e.task.id])).toEqual([
+      ['task.deleted', 'T001'],
+      ['task.deleted', 'T002'],
+    ]);
+  });
+
+  it('POSTs task events to webhook.url in publish order', async () => {
+    await seedTasks(env.accessor, [{ id: 'T001', title: 'Write docs' }]);
+    installWebhookEmitter();
+    await taskUpdate(env.tempDir, 'T001', { title: 'Write the docs' });
+    await taskUpdate(env.tempDir, 'T001', { status: 'active' });
+    await flushWebhooks(5_000);
+
+    expect(received.map((e) => e['event'])).toEqual(['task.updated', 'task.updated']);
+    expect(received[1]).toMatchObject({ task: { id: 'T001', status: 'active' } });
+    expect(typeof received[0]?.['at']).toBe('string');
+  });
+
+  it('retries a 5xx response', async () => {
+    let calls = 0;
+    handler = (_req, res) => res.writeHead(++calls === 1 ? 503 : 200).end();
+    const result = await sendWebhookTest(env.tempDir);
+
+    expect(result).toMatchObject({ delivered: true, status: 200, attempts: 2 });
+    expect(received[0]).toMatchObject({ event: 'task.completed', test: true });
+  });
+
+  it('aborts deliveries a slow receiver still holds at exit', async () => {
+    handler = () => {
+      // Never answer.
+    };
+    enqueueWebhook(env.tempDir, { event: 'task.created', task: { id: 'T001' }, at: 'now' });
+    enqueueWebhook(env.tempDir, { event: 'task.created', task: { id: 'T002' }, at: 'now' });
+    const started = Date.now();
+    await flushWebhooks(100);
+
+    expect(Date.now() - started).toBeLessThan(1_500);
+    expect(received).toHaveLength(1);
+  });
+
+  it('reports a missing receiver and a rejected event', async () => {
+    await configure({});
+    await expect(sendWebhookTest(env.tempDir)).rejects.toMatchObject({
+      code: ExitCode.CONFIG_ERROR,
+    });
+    handler = (_req, res) => res.writeHead(404).end();
+    await expect(sendWebhookTest(env.tempDir, { url })).rejects.toMatchObject({
+      code: ExitCode.GENERAL_ERROR,
+      details: { actual: 404, attempts: 1 },
+    });
+  });
+});
@@ -0,0 +1,108 @@
+/**
+ * Tests for the `cleo find --where` expression language.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { findTasks } from '../find.js';
+import { compileWhere, parseWhere } from '../where.js';
+
+/** Minimal Task factory for test brevity. */
+function makeTask(id: string, opts: Partial<Task> = {}): Task {
+  return {
+    id,
+    title: id,
+    status: 'pending',
+    priority: 'medium',
+    createdAt: '2026-01-01T00:00:00Z',
+    ...opts,
+  } as Task;
+}
+
+const TASKS: Task[] = [
+  makeTask('T001', { priority: 'critical', labels: ['saga'], status: 'active' }),
+  makeTask('T002', { priority: 'high', labels: ['wave-1'] }),
+  makeTask('T003', { priority: 'high', labels: ['saga'], status: 'done' }),
+  makeTask('T004', { priority: 'low', labels: ['wave-1'], title: 'Fix login' }),
+  makeTask('T005', { priority: 'medium', estimate: 3 }),
+];
+
+function ids(expression: string): string[] {
+  const matches = compileWhere(parseWhere(expression));
+  return TASKS.filter(matches).map((t) => t.id);
+}
+
+describe('parseWhere / compileWhere', () => {
+  it('evaluates comparisons with and/or and parentheses', () => {
+    expect(
+      ids('priority >= high and (label:saga or label:wave-1) and status != done'),
+    ).toEqual(['T001', 'T002']);
+  });
+
+  it('binds and tighter than or, and supports not', () => {
+    expect(ids('priority = low or label:saga and status = done')).toEqual(['T003', 'T004']);
+    expect(ids('not label:saga and priority < critical')).toEqual(['T002', 'T004', 'T005']);
+  });
+
+  it('matches substrings with ~ and compares numbers', () => {
+    expect(ids('title ~ login')).toEqual(['T004']);
+    expect(ids('estimate > 2')).toEqual(['T005']);
+    expect(ids("estimate != 2, title ~ 'T00'")).toEqual(TASKS.map((t) => t.id));
+  });
+
+  it('reports syntax errors with the offending token position', () => {
+    expect(() => parseWhere('priority >= high and (label:saga')).toThrow(
+      expect.objectContaining({
+        code: ExitCode.INVALID_INPUT,
+        details: expect.objectContaining({ position: 33, actual: null }),
+      }),
+    );
+    expect(() => parseWhere('status done')).toThrow(
+      expect.objectContaining({ details: expect.objectContaining({ position: 8 }) }),
+    );
+  });
+
+  it('rejects unknown fields, bad values and unsupported operators', () => {
+    expect(() => ids('colour = red')).toThrow(
+      expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }),
+    );
+    expect(() => ids('status = finished')).toThrow(
+      expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }),
+    );
+    expect(() => ids('label > saga')).toThrow(
+      expect.objectContaining({ code: ExitCode.INVALID_INPUT }),
+    );
+  });
+});
+
+describe('findTasks with --where', () => {
+  let env: TestDbEnv;
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      { id: 'T001', title: 'Plan', priority: 'high', labels: ['saga'] },
+      { id: 'T002', title: 'Build', priority: 'low', labels: ['saga'] },
+      { id: 'T003', title: 'Ship', priority: 'critical' },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('returns only matching tasks', async () => {
+    const found = await findTasks(
+      { where: ['priority >= high and label:saga'] },
+      env.tempDir,
+      env.accessor,
+    );
+    expect(found.results.map((r) => r.id)).toEqual(['T001']);
+  });
+});
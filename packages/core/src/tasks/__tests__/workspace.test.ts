@@ -0,0 +1,89 @@
+/**
+ * Tests for the workspace index and cross-project reads behind `cleo workspace`.
+ */
+
+import { mkdirSync, mkdtempSync, rmSync, writeFileSync } from 'node:fs';
+import { tmpdir } from 'node:os';
+import { join } from 'node:path';
+import { ExitCode } from '@cleocode/contracts';
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import {
+  addWorkspaceProject,
+  queryWorkspaceTasks,
+  readWorkspace,
+  removeWorkspaceProject,
+} from '../workspace.js';
+
+describe('workspace', () => {
+  let api: TestDbEnv;
+  let web: TestDbEnv;
+  let scratch: string;
+  let indexPath: string;
+
+  beforeEach(async () => {
+    scratch = mkdtempSync(join(tmpdir(), 'cleo-workspace-'));
+    indexPath = join(scratch, 'workspace.json');
+    // Seed each project before creating the next: the task store is a singleton.
+    api = await createTestDb();
+    await seedTasks(api.accessor, [
+      { id: 'T001', title: 'Rate limit', status: 'active', priority: 'high' },
+      { id: 'T002', title: 'Docs', status: 'done' },
+    ]);
+    web = await createTestDb();
+    await seedTasks(web.accessor, [{ id: 'T001', title: 'Board', status: 'pending' }]);
+    // Reads resolve each project from its own root, not a pinned CLEO_DIR.
+    delete process.env['CLEO_DIR'];
+  });
+
+  afterEach(async () => {
+    await web.cleanup();
+    await api.cleanup();
+    rmSync(scratch, { recursive: true, force: true });
+  });
+
+  it('registers the enclosing project root and namespaces its task IDs', async () => {
+    const nested = join(api.tempDir, 'src', 'lib');
+    mkdirSync(nested, { recursive: true });
+    expect(addWorkspaceProject(nested, { name: 'api' }, indexPath)).toMatchObject({
+      project: { name: 'api', root: api.tempDir },
+      added: true,
+    });
+    addWorkspaceProject(web.tempDir, { name: 'web' }, indexPath);
+    expect(addWorkspaceProject(api.tempDir, {}, indexPath).added).toBe(false);
+
+    const result = await queryWorkspaceTasks({ where: ['status != done'] }, indexPath);
+    expect(result.results.map((t) => t.id).sort()).toEqual(['api:T001', 'web:T001']);
+    expect(result.results.find((t) => t.project === 'web')).toMatchObject({
+      taskId: 'T001',
+      title: 'Board',
+    });
+    expect(result.projects).toEqual(['api', 'web']);
+  });
+
+  it('rejects a name another project already uses', () => {
+    addWorkspaceProject(api.tempDir, { name: 'app' }, indexPath);
+    expect(() => addWorkspaceProject(web.tempDir, { name: 'app' }, indexPath)).toThrow(
+      expect.objectContaining({ code: ExitCode.VALIDATION_ERROR }),
+    );
+    expect(() => addWorkspaceProject(scratch, {}, indexPath)).toThrow(
+      expect.objectContaining({ code: ExitCode.NOT_FOUND }),
+    );
+  });
+
+  it('skips a project that is gone and forgets it on remove', async () => {
+    addWorkspaceProject(api.tempDir, { name: 'api' }, indexPath);
+    const index = readWorkspace(indexPath);
+    const gone = { name: 'old', root: join(scratch, 'old'), addedAt: '2026-01-01T00:00:00Z' };
+    writeFileSync(indexPath, JSON.stringify({ ...index, projects: [...index.projects, gone] }));
+
+    const result = await queryWorkspaceTasks({}, indexPath);
+    expect(result.projects).toEqual(['api']);
+    expect(result.total).toBe(2);
+    expect(result.skipped).toEqual([expect.objectContaining({ project: 'old' })]);
+
+    expect(removeWorkspaceProject('old', indexPath)).toBe(true);
+    expect(removeWorkspaceProject('old', indexPath)).toBe(false);
+    expect(readWorkspace(indexPath).projects.map((p) => p.name)).toEqual(['api']);
+  });
+});
@@ -256,6 +256,37 @@ export interface AddBatchTaskSpec {
   forceDuplicate?: boolean;
 }
 
+/**
+ * Map one wire-format {@link AddBatchTaskSpec} onto the Core
+ * {@link AddTaskOptions} shape (ADR-057 D2: `parent` → `parentId`).
+ *
+ * Shared by `tasks.add-batch` and the `create` steps of `tasks.batch`.
+ *
+ * @param spec - Wire-format task spec.
+ * @returns Options accepted by {@link addTask}.
+ */
+export function addBatchSpecToOptions(spec: AddBatchTaskSpec): AddTaskOptions {
+  return {
+    title: spec.title,
+    description: spec.description,
+    // ADR-057 D2: wire field `parent` maps to Core internal `parentId`
+    parentId: spec.parent,
+    depends: spec.depends,
+    priority: spec.priority as AddTaskOptions['priority'],
+    labels: spec.labels,
+    type: spec.type as AddTaskOptions['type'],
+    acceptance: spec.acceptance,
+    phase: spec.phase,
+    size: spec.size as AddTaskOptions['size'],
+    notes: spec.notes,
+    files: spec.files,
+    kind: spec.kind as AddTaskOptions['kind'],
+    scope: spec.scope as AddTaskOptions['scope'],
+    severity: spec.severity as AddTaskOptions['severity'],
+    forceDuplicate: spec.forceDuplicate,
+  };
+}
+
 // ---------------------------------------------------------------------------
 // Op wrapper (ADR-057 D1 shape — returns EngineResult for wrapCoreResult)
 // ---------------------------------------------------------------------------
@@ -285,25 +316,7 @@ export async function tasksAddBatchOp(
   },
 ): Promise<EngineResult<AddBatchResult>> {
   // Map wire-format specs (parent) → AddTaskOptions (parentId)
-  const taskOpts: AddTaskOptions[] = params.tasks.map((spec) => ({
-    title: spec.title,
-    description: spec.description,
-    // ADR-057 D2: wire field `parent` maps to Core internal `parentId`
-    parentId: spec.parent,
-    depends: spec.depends,
-    priority: spec.priority as AddTaskOptions['priority'],
-    labels: spec.labels,
-    type: spec.type as AddTaskOptions['type'],
-    acceptance: spec.acceptance,
-    phase: spec.phase,
-    size: spec.size as AddTaskOptions['size'],
-    notes: spec.notes,
-    files: spec.files,
-    kind: spec.kind as AddTaskOptions['kind'],
-    scope: spec.scope as AddTaskOptions['scope'],
-    severity: spec.severity as AddTaskOptions['severity'],
-    forceDuplicate: spec.forceDuplicate,
-  }));
+  const taskOpts: AddTaskOptions[] = params.tasks.map(addBatchSpecToOptions);
 
   const { getTaskAccessor } = await import('../store/data-accessor.js');
   const accessor = await getTaskAccessor(projectRoot);
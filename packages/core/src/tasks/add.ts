@@ -11,6 +11,7 @@ import type {
   Task,
   TaskKind,
   TaskPriority,
+  TaskRecurrence,
   TaskScope,
   TaskSeverity,
   TaskSize,
@@ -28,7 +29,6 @@ import {
 import { loadConfig } from '../config.js';
 import { CleoError } from '../errors.js';
 import { resolveOrCwd } from '../paths.js';
-import { allocateNextTaskId } from '../sequence/index.js';
 import { requireActiveSession } from '../sessions/session-enforcement.js';
 import { trackBackgroundOp } from '../store/background-ops.js';
 import type { DataAccessor, TransactionAccessor } from '../store/data-accessor.js';
@@ -41,14 +41,20 @@ import {
   childProjectionFreshnessFingerprint,
   childProjectionSourceKey,
 } from './ac-table.js';
+import { assertDependencyEdges } from './dependency-guard.js';
+import { normalizeDueDate } from './due.js';
 import { createAcceptanceEnforcement } from './enforcement.js';
 import {
   findEpicAncestor,
   validateChildStageCeiling,
   validateEpicCreation,
 } from './epic-enforcement.js';
+import { normalizeEstimate } from './estimate.js';
 import { resolveHierarchyPolicy } from './hierarchy-policy.js';
+import { isPrefixedTaskId } from './id-generator.js';
+import { allocateTaskIdUnder, assertIdPrefixAvailable, normalizeIdPrefix } from './id-prefix.js';
 import { resolveDefaultPipelineStage, validatePipelineStage } from './pipeline-stage.js';
+import { normalizeRecurrence } from './recurrence.js';
 
 /**
  * Options for creating a task.
@@ -94,6 +100,16 @@ export interface AddTaskOptions {
    * @task T9073
    */
   severity?: TaskSeverity;
+  /** Due date (RFC 3339 full-date or date-time). An empty string means no due date. */
+  due?: string;
+  /** Recurrence rule or its shorthand (`7d`, `@weekly`); `none` means one-off. */
+  recurrence?: string | TaskRecurrence;
+  /** Effort estimate (story points or hours); must be >= 0. */
+  estimate?: number;
+  /** Orchestration track; blank means none. */
+  track?: string;
+  /** Saga only: ID prefix for tasks created under it (`AUTH` → `AUTH-1`). */
+  idPrefix?: string;
   /**
    * Bypass the E_DUPLICATE_TASK_LIKELY rejection guard.
    *
@@ -246,9 +262,13 @@ export const VALID_PRIORITIES: readonly TaskPriority[] = [
   'low',
 ] as const;
 
+/** Accepted spellings that map onto a canonical priority. */
+const PRIORITY_ALIASES: Readonly<Record<string, TaskPriority>> = { normal: 'medium' };
+
 /**
  * Normalize priority to canonical string format.
  * Accepts both string names ("critical","high","medium","low") and numeric (1-9).
+ * `normal` is accepted as an alias for `medium`.
  * Returns the canonical string format per todo.schema.json.
  * @task T4572
  *
@@ -297,6 +317,8 @@ export function normalizePriority(priority: string | number): TaskPriority {
   if (VALID_PRIORITIES.includes(lower as TaskPriority)) {
     return lower as TaskPriority;
   }
+  const alias = PRIORITY_ALIASES[lower];
+  if (alias) return alias;
 
   throw new CleoError(
     ExitCode.VALIDATION_ERROR,
@@ -471,13 +493,13 @@ export function validateDepends(depends: string[], tasks: Task[]): void {
   const existingIds = new Set(tasks.map((t) => t.id));
   for (const depId of depends) {
     const trimmed = depId.trim();
-    if (!/^T\d{3,}$/.test(trimmed)) {
+    if (!/^T\d{3,}$/.test(trimmed) && !isPrefixedTaskId(trimmed)) {
       throw new CleoError(
         ExitCode.VALIDATION_ERROR,
-        `Invalid dependency ID format: '${trimmed}' (must be T### format)`,
+        `Invalid dependency ID format: '${trimmed}' (must be T### or PREFIX-# format)`,
         {
-          fix: 'Dependency IDs must match T### format (e.g. T123, T4567)',
-          details: { field: 'depends', expected: 'T###', actual: trimmed },
+          fix: 'Dependency IDs must match T### or PREFIX-# format (e.g. T123, AUTH-4)',
+          details: { field: 'depends', expected: 'T### or PREFIX-#', actual: trimmed },
         },
       );
     }
@@ -823,6 +845,52 @@ export async function addTask(
       }
     }
   }
+  let due: string | null = null;
+  if (options.due !== undefined) {
+    try {
+      due = normalizeDueDate(options.due);
+    } catch (err) {
+      if (err instanceof CleoError) {
+        issues.push({ field: 'due', message: err.message, fix: err.fix });
+      }
+    }
+  }
+  let recurrence: TaskRecurrence | null = null;
+  if (options.recurrence !== undefined) {
+    try {
+      recurrence = normalizeRecurrence(options.recurrence);
+    } catch (err) {
+      if (err instanceof CleoError) {
+        issues.push({ field: 'recurrence', message: err.message, fix: err.fix });
+      }
+    }
+  }
+  let estimate: number | null = null;
+  if (options.estimate !== undefined) {
+    try {
+      estimate = normalizeEstimate(options.estimate);
+    } catch (err) {
+      if (err instanceof CleoError) {
+        issues.push({ field: 'estimate', message: err.message, fix: err.fix });
+      }
+    }
+  }
+  let idPrefix: string | null = null;
+  if (options.idPrefix !== undefined) {
+    try {
+      if (options.type !== 'saga') {
+        throw new CleoError(ExitCode.INVALID_INPUT, 'Only a saga can carry an ID prefix', {
+          fix: 'Create the saga with: cleo saga create --title "<t>" --prefix <PREFIX>',
+        });
+      }
+      idPrefix = normalizeIdPrefix(options.idPrefix);
+      await assertIdPrefixAvailable(idPrefix, dataAccessor);
+    } catch (err) {
+      if (err instanceof CleoError) {
+        issues.push({ field: 'idPrefix', message: err.message, fix: err.fix });
+      }
+    }
+  }
 
   // Skip enforcement checks for dry-run — no data is written
   if (!options.dryRun) {
@@ -860,28 +928,9 @@ export async function addTask(
     throwCombinedValidationError(issues, options);
   }
 
-  // Validate dependency IDs exist using targeted queries
+  // Validate dependency IDs exist (and are not archived) using targeted queries
   if (options.depends?.length) {
-    for (const depId of options.depends) {
-      const trimmed = depId.trim();
-      if (!/^T\d{3,}$/.test(trimmed)) {
-        throw new CleoError(
-          ExitCode.VALIDATION_ERROR,
-          `Invalid dependency ID format: '${trimmed}' (must be T### format)`,
-          {
-            fix: 'Dependency IDs must match T### format (e.g. T123, T4567)',
-            details: { field: 'depends', expected: 'T###', actual: trimmed },
-          },
-        );
-      }
-      const exists = await dataAccessor.taskExists(trimmed);
-      if (!exists) {
-        throw new CleoError(ExitCode.NOT_FOUND, `Dependency task not found: ${trimmed}`, {
-          fix: `cleo find "${trimmed}"`,
-          details: { field: 'depends', actual: trimmed },
-        });
-      }
-    }
+    await assertDependencyEdges(dataAccessor, { taskId: null, depends: options.depends });
   }
 
   // Phase validation using targeted metadata queries
@@ -925,10 +974,10 @@ export async function addTask(
   // Parent hierarchy validation using targeted queries
   let parentTaskForProjection: Task | null = null;
   if (parentId) {
-    if (!/^T\d{3,}$/.test(parentId)) {
+    if (!/^T\d{3,}$/.test(parentId) && !isPrefixedTaskId(parentId)) {
       throw new CleoError(ExitCode.INVALID_INPUT, `Invalid parent ID format: ${parentId}`, {
-        fix: 'Parent IDs must match T### format (e.g. T123)',
-        details: { field: 'parentId', expected: 'T###', actual: parentId },
+        fix: 'Parent IDs must match T### or PREFIX-# format (e.g. T123, AUTH-4)',
+        details: { field: 'parentId', expected: 'T### or PREFIX-#', actual: parentId },
       });
     }
     // Validate parent exists
@@ -1264,6 +1313,10 @@ export async function addTask(
     if (options.kind !== undefined) previewTask.kind = options.kind;
     if (options.scope !== undefined) previewTask.scope = options.scope;
     if (options.severity !== undefined) previewTask.severity = options.severity;
+    if (due) previewTask.due = due;
+    if (recurrence) previewTask.recurrence = recurrence;
+    if (estimate !== null) previewTask.estimate = estimate;
+    if (options.track?.trim()) previewTask.track = options.track.trim();
     if (options.labels?.length) previewTask.labels = options.labels.map((l) => l.trim());
     if (options.files?.length) previewTask.files = options.files.map((f) => f.trim());
     if (normalizedAcceptance?.length) previewTask.acceptance = normalizedAcceptance;
@@ -1290,7 +1343,8 @@ export async function addTask(
     return { task: previewTask, dryRun: true };
   }
 
-  const taskId = await allocateNextTaskId(cwd);
+  // Under a saga with an ID prefix the task is numbered `<PREFIX>-<n>`.
+  const taskId = await allocateTaskIdUnder(parentTaskForProjection, dataAccessor, cwd);
 
   const now = new Date().toISOString();
 
@@ -1362,6 +1416,11 @@ export async function addTask(
   if (options.severity !== undefined) task.severity = options.severity;
 
   // Add optional fields
+  if (due) task.due = due;
+  if (recurrence) task.recurrence = recurrence;
+  if (estimate !== null) task.estimate = estimate;
+  if (options.track?.trim()) task.track = options.track.trim();
+  if (idPrefix) task.idPrefix = idPrefix;
   if (phase) task.phase = phase;
   if (options.labels?.length) task.labels = options.labels.map((l) => l.trim());
   if (options.files?.length) task.files = options.files.map((f) => f.trim());
@@ -0,0 +1,216 @@
+/**
+ * Archive browsing and restore — list archived tasks and bring one back.
+ *
+ * `cleo archive` overwrites a task's status with `archived` and records the
+ * status it held in `preArchiveStatus`; restore returns the task to that
+ * status. Trashed tasks are archived rows too, but belong to `cleo trash`
+ * and never show up here. Restoring validates the parent the same way
+ * `cleo trash restore` does (see {@link resolveRestoreParent}).
+ */
+
+import type {
+  ArchivedTask,
+  Task,
+  TasksArchiveEntry,
+  TasksArchiveListParams,
+  TasksArchiveListResult,
+  TasksArchiveRestoreParams,
+  TasksArchiveRestoreResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { rebuildChildProjectionAc } from './ac-table.js';
+import { publishTaskEvents } from './task-events.js';
+import { resolveRestoreParent, restoredStatus } from './trash.js';
+
+/** Archived, non-trashed tasks. */
+async function loadArchived(acc: DataAccessor): Promise<ArchivedTask[]> {
+  const archive = await acc.loadArchive();
+  return (archive?.archivedTasks ?? []).filter((t) => !t.deletedAt);
+}
+
+/** Whether `task` sits below `sagaId`, walking parents through live and archived rows. */
+async function isUnderSaga(
+  acc: DataAccessor,
+  task: Task,
+  sagaId: string,
+  cache: Map<string, Task | null>,
+): Promise<boolean> {
+  const seen = new Set<string>();
+  let parentId = task.parentId ?? null;
+  while (parentId && !seen.has(parentId)) {
+    if (parentId === sagaId) return true;
+    seen.add(parentId);
+    let parent = cache.get(parentId);
+    if (parent === undefined) {
+      parent = await acc.loadSingleTask(parentId);
+      cache.set(parentId, parent);
+    }
+    parentId = parent?.parentId ?? null;
+  }
+  return false;
+}
+
+/**
+ * List archived tasks, most recently archived first.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for a malformed `since`, or a `sagaId` that is not a saga.
+ * @throws CleoError `NOT_FOUND` when `sagaId` does not exist.
+ */
+export async function listArchived(
+  options: TasksArchiveListParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksArchiveListResult> {
+  let since = Number.NEGATIVE_INFINITY;
+  if (options.since) {
+    since = Date.parse(options.since);
+    if (Number.isNaN(since)) {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid --since: ${options.since}`, {
+        fix: 'Use an ISO 8601 date like 2026-01-31',
+        details: { field: 'since', expected: 'ISO 8601 date', actual: options.since },
+      });
+    }
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  if (options.sagaId) {
+    const saga = await acc.loadSingleTask(options.sagaId);
+    if (!saga || saga.deletedAt) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${options.sagaId}`, {
+        fix: 'cleo saga list',
+      });
+    }
+    if (saga.type !== 'saga') {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `${saga.id} is a ${saga.type ?? 'task'}, not a saga`,
+        { details: { field: 'sagaId', expected: 'saga', actual: saga.type } },
+      );
+    }
+  }
+
+  const archived = await loadArchived(acc);
+  const cache = new Map<string, Task | null>(archived.map((t) => [t.id, t]));
+  const entries: TasksArchiveEntry[] = [];
+  for (const t of archived) {
+    if (!t.archivedAt || Date.parse(t.archivedAt) < since) continue;
+    if (options.sagaId && !(await isUnderSaga(acc, t, options.sagaId, cache))) continue;
+    entries.push({
+      id: t.id,
+      title: t.title,
+      ...(t.type ? { type: t.type } : {}),
+      status: restoredStatus(t),
+      archivedAt: t.archivedAt,
+      ...(t.archiveReason ? { archiveReason: t.archiveReason } : {}),
+      parentId: t.parentId ?? null,
+    });
+  }
+  entries.sort((a, b) => b.archivedAt.localeCompare(a.archivedAt));
+  return { tasks: entries, total: entries.length };
+}
+
+/**
+ * Return an archived task to the status it held when it was archived.
+ *
+ * @throws CleoError `NOT_FOUND` when the task is not archived, or `toSaga` does not exist.
+ * @throws CleoError `PARENT_NOT_FOUND` when the original parent is gone and no `toSaga` is given.
+ * @throws CleoError `INVALID_PARENT_TYPE` when `toSaga` is not a saga or cannot hold the task.
+ */
+export async function restoreFromArchive(
+  options: TasksArchiveRestoreParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksArchiveRestoreResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await acc.loadSingleTask(options.taskId);
+  if (!task || task.status !== 'archived' || task.deletedAt) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not in archive: ${options.taskId}`, {
+      fix: task?.deletedAt ? `cleo trash restore ${options.taskId}` : 'cleo archive list',
+    });
+  }
+
+  const originalParent = task.parentId ?? null;
+  const parentId = await resolveRestoreParent(acc, task, originalParent, options.toSaga, 'archive');
+
+  const status = restoredStatus(task);
+  const now = new Date().toISOString();
+  await acc.transaction(async (tx) => {
+    await tx.upsertSingleTask({
+      ...task,
+      status,
+      parentId,
+      preArchiveStatus: null,
+      updatedAt: now,
+    });
+    if (parentId) {
+      const siblings = await tx.getChildren(parentId);
+      await rebuildChildProjectionAc(
+        tx,
+        parentId,
+        siblings.map((child) => ({ id: child.id, title: child.title })),
+        now,
+      );
+    }
+    await tx.appendLog({
+      id: `log-${Math.floor(Date.now() / 1000)}-${(await import('node:crypto')).randomBytes(3).toString('hex')}`,
+      timestamp: now,
+      action: 'task_restored',
+      taskId: task.id,
+      actor: 'system',
+      details: { from: 'archive', status, parentId },
+      before: { status: 'archived' },
+      after: { status, parentId },
+    });
+  });
+
+  return { task: task.id, status, parentId };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrappers
+// ---------------------------------------------------------------------------
+
+/**
+ * List archived tasks, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Optional `sagaId` and `since` filters
+ * @returns EngineResult with `{ tasks, total }`
+ */
+export async function taskArchiveList(
+  projectRoot: string,
+  params: TasksArchiveListParams,
+): Promise<EngineResult<TasksArchiveListResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await listArchived(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to list archive');
+  }
+}
+
+/**
+ * Restore a task from the archive, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID and optional `toSaga`
+ * @returns EngineResult with the restored status and parent
+ */
+export async function taskArchiveRestore(
+  projectRoot: string,
+  params: TasksArchiveRestoreParams,
+): Promise<EngineResult<TasksArchiveRestoreResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    const result = await restoreFromArchive(params, projectRoot, accessor);
+    await publishTaskEvents(projectRoot, [{ event: 'task.created', id: result.task }], accessor);
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to restore task from archive');
+  }
+}
@@ -17,6 +17,7 @@ import type { DataAccessor } from '../store/data-accessor.js';
 import { getTaskAccessor } from '../store/data-accessor.js';
 import { safeAppendLog } from '../store/data-safety-central.js';
 import { removeChildProjectionAc } from './ac-table.js';
+import { publishTaskEvent } from './task-events.js';
 
 /**
  * Truth-grade `archiveReason` values stamped by the bulk-archive path.
@@ -246,6 +247,9 @@ export async function taskArchive(
       projectRoot,
       accessor,
     );
+    if (!opts?.dryRun) {
+      for (const id of result.archived) publishTaskEvent(projectRoot, 'task.archived', { id });
+    }
     return engineSuccess({
       archivedCount: result.archived.length,
       archivedTasks: result.archived.map((id: string) => ({ id })),
@@ -0,0 +1,240 @@
+/**
+ * Transactional multi-step task mutation — `tasks.batch` / `cleo batch`.
+ *
+ * Applies an ordered list of `create` / `update` / `complete` steps inside ONE
+ * `dataAccessor.transaction()`. Every Core mutator is handed the same
+ * accessor, so their own inner transactions nest as SAVEPOINTs (T9814) and a
+ * throw from any step rolls back every write made by the steps before it.
+ * Scripts therefore never observe a half-applied batch — e.g. a
+ * `dependency_cycle` rejection on step 3 leaves steps 1 and 2 unwritten.
+ *
+ * Later steps see the effects of earlier ones (a step may depend on a task
+ * created earlier in the same batch once its ID is known), and the whole set
+ * is validated structurally before the transaction opens.
+ *
+ * `complete` steps run the same strict-mode pre-checks as `tasks.complete`
+ * ({@link checkStrictCompletionGates}). Post-commit side effects of the
+ * single-task path (worktree integration, provenance stamping) are not run.
+ */
+
+import type {
+  TasksBatchOperation,
+  TasksBatchParams,
+  TasksBatchResult,
+  TasksBatchStepResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { addTask } from './add.js';
+import { addBatchSpecToOptions } from './add-batch.js';
+import { checkStrictCompletionGates, completeTask } from './complete.js';
+import { publishTaskEvents, type TaskEventName } from './task-events.js';
+import { type UpdateTaskOptions, updateTask } from './update.js';
+
+/** Step kinds accepted by {@link runTaskBatch}. */
+const BATCH_OPS: ReadonlySet<string> = new Set(['create', 'update', 'complete']);
+
+/** Thrown inside the transaction to roll back a successful dry run. */
+class BatchDryRunRollback extends Error {
+  constructor(readonly results: TasksBatchStepResult[]) {
+    super('tasks.batch dry run — rolled back');
+  }
+}
+
+/**
+ * Reject structurally invalid steps before any write happens.
+ *
+ * @throws CleoError `VALIDATION_ERROR` naming the first bad step index.
+ */
+function validateBatchShape(operations: TasksBatchOperation[]): void {
+  if (!Array.isArray(operations) || operations.length === 0) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'tasks.batch requires at least one operation', {
+      fix: `cleo batch --params '{"operations":[{"op":"update","taskId":"T123","status":"active"}]}'`,
+      details: { field: 'operations' },
+    });
+  }
+  operations.forEach((step, index) => {
+    const op = (step as { op?: unknown } | null)?.op;
+    let problem: string | null = null;
+    if (typeof op !== 'string' || !BATCH_OPS.has(op)) {
+      problem = `unknown op '${String(op)}' (expected create|update|complete)`;
+    } else if (op === 'create' && !(step as { title?: unknown }).title) {
+      problem = 'create requires a title';
+    } else if (op !== 'create' && !(step as { taskId?: unknown }).taskId) {
+      problem = `${op} requires a taskId`;
+    }
+    if (problem) {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `tasks.batch: operation ${index} is invalid: ${problem}`,
+        { details: { field: 'operations', failedIndex: index, op: op ?? null } },
+      );
+    }
+  });
+}
+
+/** Task event a committed step publishes; `update --status done` completes the task. */
+function stepEvent(step: TasksBatchOperation): TaskEventName {
+  if (step.op === 'create') return 'task.created';
+  if (step.op === 'complete' || step.status === 'done') return 'task.completed';
+  return 'task.updated';
+}
+
+/** Apply one step against the shared accessor. */
+async function applyStep(
+  step: TasksBatchOperation,
+  index: number,
+  accessor: DataAccessor,
+  cwd: string | undefined,
+): Promise<TasksBatchStepResult> {
+  switch (step.op) {
+    case 'create': {
+      const { op: _op, ...spec } = step;
+      const result = await addTask(addBatchSpecToOptions(spec), cwd, accessor);
+      return { index, op: 'create', taskId: result.task.id };
+    }
+    case 'update': {
+      // Wire params share Core field names except ADR-057 D2 `parent`.
+      const { op: _op, parent, ...fields } = step;
+      const result = await updateTask(
+        {
+          ...(fields as Omit<UpdateTaskOptions, 'parentId'>),
+          ...(parent !== undefined ? { parentId: parent } : {}),
+        },
+        cwd,
+        accessor,
+      );
+      return { index, op: 'update', taskId: result.task.id, changes: result.changes };
+    }
+    case 'complete': {
+      const { op: _op, ...options } = step;
+      if (cwd) {
+        const rejection = await checkStrictCompletionGates(cwd, step.taskId);
+        if (rejection && !rejection.success) {
+          throw new CleoError(
+            (rejection.error.exitCode as ExitCode | undefined) ?? ExitCode.VALIDATION_ERROR,
+            rejection.error.message,
+            {
+              fix: rejection.error.fix,
+              details: { field: 'taskId', code: rejection.error.code },
+            },
+          );
+        }
+      }
+      const result = await completeTask(options, cwd, accessor);
+      return { index, op: 'complete', taskId: result.task.id };
+    }
+  }
+}
+
+/**
+ * Apply a batch of task mutations atomically.
+ *
+ * @param operations - Ordered steps to apply.
+ * @param accessor - Pre-opened DataAccessor (caller manages lifecycle).
+ * @param cwd - Project root passed through to the Core mutators.
+ * @param options - `dryRun` applies every step then rolls the batch back.
+ * @returns Per-step outcomes; `applied` is 0 on a dry run.
+ * @throws CleoError carrying the failing step's exit code, with
+ *   `details.failedIndex`, `details.op`, `details.code` (the step's LAFS code)
+ *   and `details.cause` (the step's own error details). Nothing is written.
+ */
+export async function runTaskBatch(
+  operations: TasksBatchOperation[],
+  accessor: DataAccessor,
+  cwd?: string,
+  options: { dryRun?: boolean } = {},
+): Promise<TasksBatchResult> {
+  validateBatchShape(operations);
+
+  const results: TasksBatchStepResult[] = [];
+  try {
+    await accessor.transaction(async () => {
+      for (let i = 0; i < operations.length; i++) {
+        const step = operations[i]!;
+        try {
+          results.push(await applyStep(step, i, accessor, cwd));
+        } catch (err) {
+          const message = err instanceof Error ? err.message : String(err);
+          const cleo = err instanceof CleoError ? err : null;
+          throw new CleoError(
+            cleo?.code ?? ExitCode.GENERAL_ERROR,
+            `tasks.batch: operation ${i} (${step.op}) failed: ${message} — no changes were written`,
+            {
+              fix: cleo?.fix,
+              details: {
+                field: 'operations',
+                failedIndex: i,
+                op: step.op,
+                taskId: 'taskId' in step ? step.taskId : null,
+                code: cleo ? cleo.toLAFSError().code : 'E_INTERNAL',
+                reason: message,
+                ...(cleo?.details ? { cause: cleo.details } : {}),
+              },
+              cause: err,
+            },
+          );
+        }
+      }
+      if (options.dryRun) throw new BatchDryRunRollback([...results]);
+    });
+  } catch (err) {
+    if (err instanceof BatchDryRunRollback) {
+      return { applied: 0, results: err.results, dryRun: true };
+    }
+    throw err;
+  }
+
+  return { applied: results.length, results };
+}
+
+/**
+ * Normalized wrapper for {@link runTaskBatch}.
+ * ADR-057 D1 shape: (projectRoot: string, params: TasksBatchParams)
+ *
+ * Failures surface as `E_BATCH_FAILED` (matching `tasks.add-batch`) with the
+ * failing step's exit code and the structured `details` from
+ * {@link runTaskBatch}.
+ *
+ * @param projectRoot - Absolute path to the project root.
+ * @param params - Batch parameters (wire format).
+ * @returns EngineResult wrapping the batch result.
+ */
+export async function tasksBatchOp(
+  projectRoot: string,
+  params: TasksBatchParams,
+): Promise<EngineResult<TasksBatchResult>> {
+  const { getTaskAccessor } = await import('../store/data-accessor.js');
+  const accessor = await getTaskAccessor(projectRoot);
+  try {
+    const result = await runTaskBatch(params.operations, accessor, projectRoot, {
+      dryRun: params.dryRun,
+    });
+    if (!result.dryRun) {
+      await publishTaskEvents(
+        projectRoot,
+        result.results.map((step) => ({
+          event: stepEvent(params.operations[step.index]!),
+          id: step.taskId,
+        })),
+        accessor,
+      );
+    }
+    return engineSuccess(result);
+  } catch (err) {
+    const cleo = err instanceof CleoError ? err : null;
+    return engineError<TasksBatchResult>(
+      'E_BATCH_FAILED',
+      err instanceof Error ? err.message : 'Unknown batch error',
+      {
+        exitCode: cleo?.code,
+        fix: cleo?.fix,
+        details: cleo?.details,
+      },
+    );
+  } finally {
+    await accessor.close();
+  }
+}
@@ -0,0 +1,206 @@
+/**
+ * Blocking on external causes — `cleo tasks block` / `cleo tasks unblock`.
+ *
+ * Dependencies already express "waiting on another task". Blocking covers
+ * everything else (a vendor, a decision, another team): the reason goes in
+ * `blockedBy`, an optional expiry in `blockedUntil`, and the status the task
+ * held before in `blockedPriorStatus`, so unblocking is lossless.
+ *
+ * A task whose `blockedUntil` has passed stays `blocked` until someone
+ * unblocks it, but `orchestrate ready` offers it as a candidate again
+ * (see {@link isBlockHeld}).
+ */
+
+import { randomBytes } from 'node:crypto';
+import type {
+  Task,
+  TaskStatus,
+  TasksBlockParams,
+  TasksBlockResult,
+  TasksUnblockParams,
+  TasksUnblockResult,
+} from '@cleocode/contracts';
+import { ExitCode, TERMINAL_TASK_STATUSES } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { parseRfc3339Date } from './due.js';
+import { publishTaskEvents } from './task-events.js';
+
+/**
+ * Whether a manual block still holds `task` back from the ready set.
+ *
+ * Only tasks blocked with a reason or expiry count — a bare `blocked`
+ * status is left to the dependency check. An expired `blockedUntil`
+ * releases the hold.
+ *
+ * @param task - Task to check.
+ * @param now - Reference time in epoch milliseconds (default `Date.now()`).
+ */
+export function isBlockHeld(task: Task, now = Date.now()): boolean {
+  if (task.status !== 'blocked' || (!task.blockedBy && !task.blockedUntil)) return false;
+  if (!task.blockedUntil) return true;
+  const until = Date.parse(task.blockedUntil);
+  return Number.isNaN(until) || until > now;
+}
+
+/** Build an audit log entry for a block/unblock transition. */
+function blockLogEntry(
+  action: 'task_blocked' | 'task_unblocked',
+  task: Task,
+  after: Record<string, unknown>,
+): Record<string, unknown> {
+  return {
+    id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+    timestamp: new Date().toISOString(),
+    action,
+    taskId: task.id,
+    actor: 'system',
+    details: after,
+    before: {
+      status: task.status,
+      blockedBy: task.blockedBy ?? null,
+      blockedUntil: task.blockedUntil ?? null,
+    },
+    after,
+  };
+}
+
+/**
+ * Block a task on an external cause.
+ *
+ * Blocking an already-blocked task replaces the reason and expiry but keeps
+ * the original prior status, so unblock still restores the pre-block state.
+ *
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when the reason is empty, `until` is
+ *   not RFC 3339, or the task is done, cancelled, or archived.
+ */
+export async function blockTask(
+  options: TasksBlockParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksBlockResult> {
+  const reason = options.reason?.trim();
+  if (!reason) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'A block reason is required', {
+      fix: `cleo tasks block ${options.taskId} --reason "waiting on vendor"`,
+      details: { field: 'reason', expected: 'non-empty string' },
+    });
+  }
+  const until = options.until?.trim() || null;
+  if (until) parseRfc3339Date(until, 'until');
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await acc.loadSingleTask(options.taskId);
+  if (!task) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${options.taskId}`, {
+      fix: `cleo find "${options.taskId}"`,
+    });
+  }
+  if (TERMINAL_TASK_STATUSES.has(task.status)) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Cannot block ${task.status} task ${task.id}`, {
+      fix: `Reopen ${task.id} before blocking it`,
+      details: { field: 'status', expected: 'non-terminal status', actual: task.status },
+    });
+  }
+
+  const priorStatus: TaskStatus =
+    task.status === 'blocked' ? (task.blockedPriorStatus ?? 'pending') : task.status;
+  const after = { status: 'blocked' as const, blockedBy: reason, blockedUntil: until };
+  await acc.transaction(async (tx) => {
+    await tx.updateTaskFields(task.id, { ...after, blockedPriorStatus: priorStatus });
+    await tx.appendLog(blockLogEntry('task_blocked', task, after));
+  });
+
+  return { taskId: task.id, status: 'blocked', reason, until, priorStatus };
+}
+
+/**
+ * Unblock a task, restoring the status it held before `blockTask` and
+ * clearing the reason and expiry. Tasks blocked by other means (no recorded
+ * prior status) return to `pending`.
+ *
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when the task is not blocked.
+ */
+export async function unblockTask(
+  options: TasksUnblockParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksUnblockResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await acc.loadSingleTask(options.taskId);
+  if (!task) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${options.taskId}`, {
+      fix: `cleo find "${options.taskId}"`,
+    });
+  }
+  if (task.status !== 'blocked') {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Task ${task.id} is not blocked`, {
+      fix: `cleo show ${task.id}`,
+      details: { field: 'status', expected: 'blocked', actual: task.status },
+    });
+  }
+
+  const status: TaskStatus = task.blockedPriorStatus ?? 'pending';
+  await acc.transaction(async (tx) => {
+    await tx.updateTaskFields(task.id, {
+      status,
+      blockedBy: null,
+      blockedUntil: null,
+      blockedPriorStatus: null,
+    });
+    await tx.appendLog(blockLogEntry('task_unblocked', task, { status }));
+  });
+
+  return { taskId: task.id, status, clearedReason: task.blockedBy ?? null };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrappers
+// ---------------------------------------------------------------------------
+
+/**
+ * Block a task, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID, reason, and optional `until` date
+ * @returns EngineResult with the blocked task's state
+ */
+export async function taskBlock(
+  projectRoot: string,
+  params: TasksBlockParams,
+): Promise<EngineResult<TasksBlockResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    const result = await blockTask(params, projectRoot, accessor);
+    await publishTaskEvents(projectRoot, [{ event: 'task.updated', id: result.taskId }], accessor);
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to block task');
+  }
+}
+
+/**
+ * Unblock a task, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID
+ * @returns EngineResult with the restored status
+ */
+export async function taskUnblock(
+  projectRoot: string,
+  params: TasksUnblockParams,
+): Promise<EngineResult<TasksUnblockResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    const result = await unblockTask(params, projectRoot, accessor);
+    await publishTaskEvents(projectRoot, [{ event: 'task.updated', id: result.taskId }], accessor);
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to unblock task');
+  }
+}
@@ -0,0 +1,168 @@
+/**
+ * Saga burndown — completed estimate per period over a date range.
+ *
+ * Scope is the saga's current estimated members (see
+ * {@link collectEstimatedMembers}). A member counts as completed at its
+ * `completedAt`; because completing a task re-stamps `completedAt`, a task
+ * that was completed, reopened, and completed again burns down at its latest
+ * completion, and a task reopened and still open counts as remaining.
+ */
+
+import type {
+  TasksBurndownBucket,
+  TasksBurndownParams,
+  TasksBurndownPoint,
+  TasksBurndownResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { parseRfc3339Date } from './due.js';
+import { collectEstimatedMembers, isEstimateDone } from './estimate.js';
+
+/** Accepted `--bucket` values. */
+export const BURNDOWN_BUCKETS = ['day', 'week'] as const;
+
+const DAY_MS = 24 * 60 * 60 * 1000;
+
+/** Start of the UTC day containing `ms`. */
+function startOfDay(ms: number): number {
+  return Math.floor(ms / DAY_MS) * DAY_MS;
+}
+
+/** Start of the ISO week (Monday, UTC) containing `ms`. */
+function startOfWeek(ms: number): number {
+  const day = startOfDay(ms);
+  const weekday = (new Date(day).getUTCDay() + 6) % 7;
+  return day - weekday * DAY_MS;
+}
+
+/** A completion event: estimate burned at a time (`-Infinity` when the date is unknown). */
+interface Burn {
+  atMs: number;
+  estimate: number;
+}
+
+/**
+ * Bucket completion events into a burndown series.
+ *
+ * Every bucket from the one containing `fromMs` through the one containing
+ * `toMs` is emitted, including empty ones, so the series plots directly.
+ * `remaining` is the scope total minus everything completed by the end of
+ * the bucket, including work completed before the range.
+ *
+ * @param burns - Completion events for done members.
+ * @param total - Sum of all member estimates (the scope).
+ */
+export function bucketBurndown(
+  burns: readonly Burn[],
+  total: number,
+  fromMs: number,
+  toMs: number,
+  bucket: TasksBurndownBucket,
+): TasksBurndownPoint[] {
+  const step = bucket === 'week' ? 7 * DAY_MS : DAY_MS;
+  const floor = bucket === 'week' ? startOfWeek : startOfDay;
+  const series: TasksBurndownPoint[] = [];
+  let burned = burns.filter((b) => b.atMs < floor(fromMs)).reduce((n, b) => n + b.estimate, 0);
+
+  for (let start = floor(fromMs); start <= toMs; start += step) {
+    const end = start + step;
+    const completed = burns
+      .filter((b) => b.atMs >= start && b.atMs < end)
+      .reduce((n, b) => n + b.estimate, 0);
+    burned += completed;
+    series.push({
+      date: new Date(start).toISOString().slice(0, 10),
+      completed,
+      remaining: total - burned,
+    });
+  }
+  return series;
+}
+
+/**
+ * Compute the burndown series for a saga.
+ *
+ * @param options - `sagaId`, `from`, optional `to` (defaults to now) and `bucket` (default `day`).
+ * @throws CleoError `VALIDATION_ERROR` for a bad date, bucket, or an empty range.
+ * @throws CleoError `NOT_FOUND` when the saga does not exist.
+ */
+export async function computeBurndown(
+  options: TasksBurndownParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksBurndownResult> {
+  const bucket = options.bucket ?? 'day';
+  if (!(BURNDOWN_BUCKETS as readonly string[]).includes(bucket)) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `Invalid bucket: ${bucket} (must be ${BURNDOWN_BUCKETS.join('|')})`,
+      {
+        fix: `--bucket <${BURNDOWN_BUCKETS.join('|')}>`,
+        details: { field: 'bucket', expected: BURNDOWN_BUCKETS, actual: bucket },
+      },
+    );
+  }
+  const fromMs = parseRfc3339Date(options.from.trim(), 'from');
+  const toMs = options.to ? parseRfc3339Date(options.to.trim(), 'to') : Date.now();
+  if (toMs < fromMs) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'Burndown range ends before it starts', {
+      fix: 'Pass --to on or after --from',
+      details: { field: 'to', expected: `>= ${options.from}`, actual: options.to },
+    });
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const saga = await acc.loadSingleTask(options.sagaId);
+  if (!saga || saga.type !== 'saga') {
+    throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${options.sagaId}`, {
+      fix: 'cleo saga list',
+    });
+  }
+
+  const { estimated } = collectEstimatedMembers(saga.id, await acc.getSubtree(saga.id));
+  const total = estimated.reduce((n, t) => n + (t.estimate as number), 0);
+  const burns: Burn[] = estimated.filter(isEstimateDone).map((t) => {
+    // Legacy done rows with no timestamp count as completed before the range.
+    const atMs = t.completedAt ? Date.parse(t.completedAt) : Number.NaN;
+    return {
+      atMs: Number.isNaN(atMs) ? Number.NEGATIVE_INFINITY : atMs,
+      estimate: t.estimate as number,
+    };
+  });
+
+  return {
+    sagaId: saga.id,
+    bucket,
+    from: new Date(fromMs).toISOString(),
+    to: new Date(toMs).toISOString(),
+    estimateTotal: total,
+    series: bucketBurndown(burns, total, fromMs, toMs, bucket),
+  };
+}
+
+/**
+ * Saga burndown report, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - `sagaId`, `from`, optional `to` and `bucket`
+ * @returns EngineResult with `{ sagaId, bucket, from, to, estimateTotal, series }`
+ */
+export async function taskBurndown(
+  projectRoot: string,
+  params: TasksBurndownParams,
+): Promise<EngineResult<TasksBurndownResult>> {
+  if (!params.sagaId || !params.from) {
+    return engineError('E_INVALID_INPUT', 'sagaId and from are required');
+  }
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await computeBurndown(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to compute burndown');
+  }
+}
@@ -0,0 +1,283 @@
+/**
+ * Task ↔ git commit links — `cleo tasks link-commit`, `cleo tasks commits`,
+ * and `cleo git scan`.
+ *
+ * Linked SHAs live on the task's `commits` array as lowercase hex, kept in
+ * link order. A SHA that is a prefix of one already linked (or the other way
+ * round) counts as the same commit, so linking `abc1234` after the full
+ * 40-character SHA is a no-op.
+ */
+
+import { execFileSync } from 'node:child_process';
+import type {
+  Task,
+  TasksCommitsParams,
+  TasksCommitsResult,
+  TasksCommitsScanParams,
+  TasksCommitsScanResult,
+  TasksLinkCommitParams,
+  TasksLinkCommitResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { resolveOrCwd } from '../paths.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { loadIdPrefixes } from './id-prefix.js';
+
+/** Abbreviated (7+) or full (40) hex commit SHA. */
+const SHA_RE = /^[0-9a-f]{7,40}$/;
+
+/** Task ID mentions in a commit message (`T1234`). */
+const TASK_TOKEN_RE = /\bT\d+\b/g;
+
+/**
+ * Task ID mentions in a commit message, saga-prefixed ones (`AUTH-7`) included
+ * for the given prefixes only, so `UTF-8` or `SHA-256` are never read as IDs.
+ */
+function taskTokenPattern(prefixes: readonly string[]): RegExp {
+  if (prefixes.length === 0) return TASK_TOKEN_RE;
+  return new RegExp(`\\b(?:T\\d+|(?:${prefixes.join('|')})-\\d+)\\b`, 'g');
+}
+
+/** Field / record separators for the `git log` format used by the scanner. */
+const FIELD_SEP = '\x1f';
+const RECORD_SEP = '\x1e';
+
+/**
+ * Normalise a commit SHA to lowercase hex.
+ *
+ * @throws CleoError `VALIDATION_ERROR` when the value is not 7–40 hex characters.
+ */
+export function normalizeCommitSha(sha: string): string {
+  const value = sha.trim().toLowerCase();
+  if (!SHA_RE.test(value)) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid commit SHA: '${sha}'`, {
+      fix: 'Pass 7-40 hex characters, e.g. --sha $(git rev-parse HEAD)',
+      details: { field: 'sha', expected: '7-40 hex characters', actual: sha },
+    });
+  }
+  return value;
+}
+
+/** Whether `sha` names a commit already in `commits` (prefix match either way). */
+function hasCommit(commits: readonly string[], sha: string): boolean {
+  return commits.some((c) => c.startsWith(sha) || sha.startsWith(c));
+}
+
+/** Load a task or throw `NOT_FOUND`. */
+async function requireTask(acc: DataAccessor, taskId: string): Promise<Task> {
+  const task = await acc.loadSingleTask(taskId);
+  if (!task) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${taskId}`, {
+      fix: `cleo find "${taskId}"`,
+    });
+  }
+  return task;
+}
+
+/**
+ * Link a commit to a task.
+ *
+ * @throws CleoError `VALIDATION_ERROR` when the SHA is not hex.
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ */
+export async function linkTaskCommit(
+  options: TasksLinkCommitParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksLinkCommitResult> {
+  const sha = normalizeCommitSha(options.sha ?? '');
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await requireTask(acc, options.taskId);
+
+  const existing = task.commits ?? [];
+  if (hasCommit(existing, sha)) {
+    return { taskId: task.id, sha, added: false, commits: existing };
+  }
+  const commits = [...existing, sha];
+  await acc.updateTaskFields(task.id, { commitsJson: JSON.stringify(commits) });
+  return { taskId: task.id, sha, added: true, commits };
+}
+
+/**
+ * List the commits linked to a task.
+ *
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ */
+export async function listTaskCommits(
+  options: TasksCommitsParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksCommitsResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await requireTask(acc, options.taskId);
+  return { taskId: task.id, commits: task.commits ?? [] };
+}
+
+/** One commit read from `git log`: full SHA and full message. */
+export interface ScannedCommit {
+  sha: string;
+  message: string;
+}
+
+/**
+ * Read commits from `git log` in `cwd`, newest first.
+ *
+ * @param since - Only commits after this ref (`<since>..HEAD`).
+ * @throws CleoError `GENERAL_ERROR` when git fails (not a repository, unknown ref).
+ */
+export function readGitCommits(cwd: string, since?: string): ScannedCommit[] {
+  const range = since ? `${since}..HEAD` : 'HEAD';
+  let out: string;
+  try {
+    out = execFileSync('git', ['log', `--format=%H${FIELD_SEP}%B${RECORD_SEP}`, range], {
+      cwd,
+      encoding: 'utf-8',
+      stdio: ['ignore', 'pipe', 'pipe'],
+      maxBuffer: 64 * 1024 * 1024,
+    });
+  } catch (err: unknown) {
+    throw new CleoError(ExitCode.GENERAL_ERROR, `git log failed for range '${range}'`, {
+      fix: since
+        ? `Check that '${since}' is a valid ref: git rev-parse ${since}`
+        : 'Run cleo git scan inside a git repository with at least one commit',
+      details: { field: 'since', actual: since ?? null, error: String(err) },
+    });
+  }
+  return out
+    .split(RECORD_SEP)
+    .map((record) => record.replace(/^\n/, ''))
+    .filter((record) => record.includes(FIELD_SEP))
+    .map((record) => {
+      const [sha = '', message = ''] = record.split(FIELD_SEP);
+      return { sha: sha.trim().toLowerCase(), message };
+    });
+}
+
+/**
+ * Distinct task IDs mentioned in a commit message, in order of appearance.
+ *
+ * @param prefixes - Saga ID prefixes whose `<PREFIX>-<n>` mentions count too.
+ */
+export function extractTaskIds(message: string, prefixes: readonly string[] = []): string[] {
+  return [...new Set(message.match(taskTokenPattern(prefixes)) ?? [])];
+}
+
+/**
+ * Link commits to the tasks their messages mention.
+ *
+ * `commits` defaults to {@link readGitCommits} over `cwd`. Links are applied
+ * oldest commit first so each task's `commits` array stays chronological.
+ * Mentions of IDs that are not tasks in this project are reported, not
+ * linked.
+ */
+export async function scanTaskCommits(
+  options: TasksCommitsScanParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+  commits?: ScannedCommit[],
+): Promise<TasksCommitsScanResult> {
+  const since = options.since?.trim() || undefined;
+  const scanned = commits ?? readGitCommits(resolveOrCwd(cwd), since);
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+
+  const prefixes = await loadIdPrefixes(acc);
+  const mentions = scanned.map((c) => ({
+    sha: c.sha,
+    taskIds: extractTaskIds(c.message, prefixes),
+  }));
+  const mentioned = [...new Set(mentions.flatMap((m) => m.taskIds))];
+  const tasks = new Map((await acc.loadTasks(mentioned)).map((t) => [t.id, t]));
+
+  const updated = new Map<string, string[]>();
+  const linked: Array<{ taskId: string; sha: string }> = [];
+  const unknown = new Set<string>();
+  for (const { sha, taskIds } of [...mentions].reverse()) {
+    for (const taskId of taskIds) {
+      const task = tasks.get(taskId);
+      if (!task) {
+        unknown.add(taskId);
+        continue;
+      }
+      const current = updated.get(taskId) ?? task.commits ?? [];
+      if (hasCommit(current, sha)) continue;
+      updated.set(taskId, [...current, sha]);
+      linked.push({ taskId, sha });
+    }
+  }
+
+  if (updated.size > 0) {
+    await acc.transaction(async (tx) => {
+      for (const [taskId, list] of updated) {
+        await tx.updateTaskFields(taskId, { commitsJson: JSON.stringify(list) });
+      }
+    });
+  }
+
+  return { scanned: scanned.length, linked, unknownTaskIds: [...unknown].sort() };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrappers
+// ---------------------------------------------------------------------------
+
+/**
+ * Link a commit to a task, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID and commit SHA
+ * @returns EngineResult with the task's linked commits
+ */
+export async function taskLinkCommit(
+  projectRoot: string,
+  params: TasksLinkCommitParams,
+): Promise<EngineResult<TasksLinkCommitResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await linkTaskCommit(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to link commit');
+  }
+}
+
+/**
+ * List a task's linked commits, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID
+ * @returns EngineResult with the linked SHAs
+ */
+export async function taskCommits(
+  projectRoot: string,
+  params: TasksCommitsParams,
+): Promise<EngineResult<TasksCommitsResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await listTaskCommits(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to list task commits');
+  }
+}
+
+/**
+ * Scan git history and link commits to the tasks they mention, wrapped in
+ * EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root (the git work tree)
+ * @param params - Optional `since` ref
+ * @returns EngineResult with the new links
+ */
+export async function taskCommitsScan(
+  projectRoot: string,
+  params: TasksCommitsScanParams,
+): Promise<EngineResult<TasksCommitsScanResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await scanTaskCommits(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to scan git commits');
+  }
+}
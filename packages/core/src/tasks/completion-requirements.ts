@@ -0,0 +1,144 @@
+/**
+ * Completion requirements — what a task must carry before `cleo complete`
+ * accepts it (a linked commit, a note, an estimate, an assignee).
+ *
+ * A saga's `completionRequirements` are the default for the work below it;
+ * a task's own list overrides the saga's, and an explicit empty list turns
+ * the inherited requirements off. Inherited requirements apply to leaf work
+ * (tasks and subtasks) only — an epic closing over its children is not held
+ * to them unless it carries its own list.
+ */
+
+import type { CompletionRequirement, Task } from '@cleocode/contracts';
+import { COMPLETION_REQUIREMENTS, ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+
+/** Task types that never inherit their saga's requirements. */
+const CONTAINER_TYPES: ReadonlySet<string> = new Set(['saga', 'epic']);
+
+/** Requirements that apply to a task, and where they came from. */
+export interface ResolvedCompletionRequirements {
+  /** Requirements to enforce, in declaration order. */
+  requirements: CompletionRequirement[];
+  /** `task` for the task's own list, `saga` for an inherited one, `none` when nothing applies. */
+  source: 'task' | 'saga' | 'none';
+  /** ID of the saga the requirements were inherited from. */
+  sagaId?: string;
+}
+
+/**
+ * Normalise a requirements list for storage.
+ *
+ * @param value - Requirement names, or `null` to inherit the saga's again.
+ * @returns The de-duplicated list (possibly empty), or `null`.
+ * @throws CleoError `INVALID_INPUT` on a name outside {@link COMPLETION_REQUIREMENTS}.
+ */
+export function normalizeCompletionRequirements(
+  value: readonly string[] | null,
+): CompletionRequirement[] | null {
+  if (value === null) return null;
+  const names = value.map((v) => v.trim().toLowerCase()).filter(Boolean);
+  const unknown = names.filter((n) => !(COMPLETION_REQUIREMENTS as readonly string[]).includes(n));
+  if (unknown.length > 0) {
+    throw new CleoError(
+      ExitCode.INVALID_INPUT,
+      `Unknown completion requirement: ${unknown.join(', ')}`,
+      {
+        fix: `Use a comma-separated list of ${COMPLETION_REQUIREMENTS.join(', ')}, e.g. --require commit,note`,
+        details: {
+          field: 'completionRequirements',
+          expected: COMPLETION_REQUIREMENTS,
+          actual: value,
+        },
+      },
+    );
+  }
+  return [...new Set(names as CompletionRequirement[])];
+}
+
+/**
+ * Resolve the requirements a task is completed against: its own list when
+ * set, otherwise the nearest saga ancestor's (for leaf work).
+ *
+ * @param task - Task being completed.
+ * @param accessor - Accessor used to walk the ancestor chain.
+ */
+export async function resolveCompletionRequirements(
+  task: Task,
+  accessor: DataAccessor,
+): Promise<ResolvedCompletionRequirements> {
+  if (task.completionRequirements != null) {
+    return { requirements: [...task.completionRequirements], source: 'task' };
+  }
+  if (!task.parentId || CONTAINER_TYPES.has(task.type ?? '')) {
+    return { requirements: [], source: 'none' };
+  }
+  const ancestors = await accessor.getAncestorChain(task.id);
+  // Root-first: scan from the immediate parent up.
+  for (let i = ancestors.length - 1; i >= 0; i--) {
+    const ancestor = ancestors[i];
+    if (ancestor?.type === 'saga' && ancestor.completionRequirements?.length) {
+      return {
+        requirements: [...ancestor.completionRequirements],
+        source: 'saga',
+        sagaId: ancestor.id,
+      };
+    }
+  }
+  return { requirements: [], source: 'none' };
+}
+
+/** Whether a task satisfies one requirement. */
+function meetsRequirement(task: Task, requirement: CompletionRequirement): boolean {
+  switch (requirement) {
+    case 'commit':
+      return (task.commits?.length ?? 0) > 0;
+    case 'note':
+      return (task.noteHistory?.length ?? 0) > 0 || (task.notes?.length ?? 0) > 0;
+    case 'estimate':
+      return task.estimate != null;
+    case 'assignee':
+      return Boolean(task.assignee);
+  }
+}
+
+/** How to satisfy each requirement, for the error's fix hint. */
+const REQUIREMENT_FIXES: Record<CompletionRequirement, (id: string) => string> = {
+  commit: (id) => `cleo tasks link-commit ${id} <sha>`,
+  note: (id) => `cleo update ${id} --notes "<what was done>"`,
+  estimate: (id) => `cleo update ${id} --estimate <points>`,
+  assignee: (id) => `cleo update ${id} --assignee <name>`,
+};
+
+/**
+ * Reject completion while any resolved requirement is unmet.
+ *
+ * @param task - Task being completed.
+ * @param accessor - Accessor used to find the nearest saga.
+ * @throws CleoError `VALIDATION_ERROR` with `details.error = 'unmet_requirement'`
+ *   and the unmet requirement names in `details.missing`.
+ */
+export async function assertCompletionRequirements(
+  task: Task,
+  accessor: DataAccessor,
+): Promise<void> {
+  const resolved = await resolveCompletionRequirements(task, accessor);
+  const missing = resolved.requirements.filter((r) => !meetsRequirement(task, r));
+  if (missing.length === 0) return;
+  const from = resolved.source === 'saga' ? ` (required by saga ${resolved.sagaId})` : '';
+  throw new CleoError(
+    ExitCode.VALIDATION_ERROR,
+    `Task ${task.id} is missing completion requirements${from}: ${missing.join(', ')}`,
+    {
+      fix: missing.map((r) => REQUIREMENT_FIXES[r](task.id)).join(' && '),
+      details: {
+        field: 'completionRequirements',
+        error: 'unmet_requirement',
+        missing,
+        source: resolved.source,
+        ...(resolved.sagaId ? { sagaId: resolved.sagaId } : {}),
+      },
+    },
+  );
+}
@@ -35,12 +35,14 @@ export interface RelatesEntry {
 
 /**
  * Extract task IDs from text content.
- * Scans for patterns like T1234, T001, T42 (T followed by 3+ digits).
+ * Scans for patterns like T1234, T001, T42 (T followed by 3+ digits) and
+ * saga-prefixed IDs like AUTH-7. Matches are not checked against the task
+ * table (`UTF-8` matches too); filter with {@link validateRelatesRefs}.
  */
 export function extractTaskRefs(text: string, excludeId?: string): string[] {
   if (!text) return [];
 
-  const pattern = /T\d{3,}/g;
+  const pattern = /T\d{3,}|\b[A-Z]{2,10}-\d+\b/g;
   const matches = text.match(pattern);
   if (!matches) return [];
 
@@ -0,0 +1,247 @@
+/**
+ * Project-defined custom fields — `cleo fields add|list`,
+ * `cleo update <id> --set name=value`, and `cleo find --where name=value`.
+ *
+ * The field schema is project-level and lives in the `custom_fields` meta
+ * key of tasks.db (the successor of the legacy `todo.json` top level). Task
+ * values are stored on `task.custom`, keyed by field name: `number` fields
+ * as numbers, `string` and `enum` fields as strings. Values for undeclared
+ * fields, and enum values outside the declared set, are rejected.
+ */
+
+import type {
+  CustomFieldDef,
+  CustomFieldType,
+  TasksFieldsAddParams,
+  TasksFieldsAddResult,
+  TasksFieldsListParams,
+  TasksFieldsListResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+
+/** Meta key holding the project's {@link CustomFieldDef} list. */
+export const CUSTOM_FIELDS_META_KEY = 'custom_fields';
+
+const FIELD_TYPES: readonly CustomFieldType[] = ['string', 'number', 'enum'];
+
+const FIELD_NAME_RE = /^[a-z][a-z0-9_-]*$/;
+
+/** Split point between comma-joined `a=1,b=2` assignments. */
+const ASSIGNMENT_SPLIT_RE = /,(?=\s*[A-Za-z_][\w-]*\s*=)/;
+
+/** Load the project's custom field declarations (empty when none). */
+export async function loadCustomFields(acc: DataAccessor): Promise<CustomFieldDef[]> {
+  const value = await acc.getMetaValue<CustomFieldDef[]>(CUSTOM_FIELDS_META_KEY);
+  return Array.isArray(value) ? value : [];
+}
+
+/**
+ * Declare a custom field.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for a bad name or type, a duplicate
+ *   name, missing enum values, or values given for a non-enum field.
+ */
+export async function addCustomField(
+  options: TasksFieldsAddParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksFieldsAddResult> {
+  const name = options.name?.trim().toLowerCase() ?? '';
+  if (!FIELD_NAME_RE.test(name)) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid custom field name: '${options.name}'`, {
+      fix: 'Use lowercase letters, digits, _ or -, starting with a letter (e.g. --name risk)',
+      details: { field: 'name', expected: FIELD_NAME_RE.source, actual: options.name },
+    });
+  }
+  if (!FIELD_TYPES.includes(options.type)) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid custom field type: '${options.type}'`, {
+      fix: `Use one of: ${FIELD_TYPES.join(', ')}`,
+      details: { field: 'type', expected: FIELD_TYPES, actual: options.type },
+    });
+  }
+
+  const values = [...new Set((options.values ?? []).map((v) => v.trim()).filter(Boolean))];
+  if (options.type === 'enum' && values.length === 0) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Enum field '${name}' needs allowed values`, {
+      fix: `cleo fields add --name ${name} --type enum --values low,med,high`,
+      details: { field: 'values', expected: 'non-empty list' },
+    });
+  }
+  if (options.type !== 'enum' && values.length > 0) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `--values only applies to enum fields, not '${options.type}'`,
+      {
+        fix: `Drop --values, or use --type enum`,
+        details: { field: 'values', expected: 'none', actual: values },
+      },
+    );
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const fields = await loadCustomFields(acc);
+  if (fields.some((f) => f.name === name)) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Custom field already exists: ${name}`, {
+      fix: 'cleo fields list',
+      details: { field: 'name', actual: name },
+    });
+  }
+
+  const field: CustomFieldDef =
+    options.type === 'enum' ? { name, type: 'enum', values } : { name, type: options.type };
+  const next = [...fields, field];
+  await acc.setMetaValue(CUSTOM_FIELDS_META_KEY, next);
+  return { field, fields: next };
+}
+
+/** List the project's custom field declarations. */
+export async function listCustomFields(
+  _options: TasksFieldsListParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksFieldsListResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  return { fields: await loadCustomFields(acc) };
+}
+
+/**
+ * Parse `name=value` assignments into a map. Each entry may itself hold
+ * several comma-joined assignments (`risk=high,cost=3`); enum values that
+ * contain commas are not supported.
+ *
+ * @param flag - Flag name used in error messages (`set` or `where`).
+ * @throws CleoError `INVALID_INPUT` when an entry has no `=` or no name.
+ */
+export function parseCustomAssignments(
+  entries: readonly string[],
+  flag: string,
+): Map<string, string> {
+  const out = new Map<string, string>();
+  for (const entry of entries.flatMap((e) => e.split(ASSIGNMENT_SPLIT_RE))) {
+    const eq = entry.indexOf('=');
+    const name = eq > 0 ? entry.slice(0, eq).trim().toLowerCase() : '';
+    if (!name) {
+      throw new CleoError(ExitCode.INVALID_INPUT, `Invalid --${flag} '${entry}'`, {
+        fix: `Use --${flag} name=value (e.g. --${flag} risk=high)`,
+        details: { field: flag, expected: 'name=value', actual: entry },
+      });
+    }
+    out.set(name, entry.slice(eq + 1).trim());
+  }
+  return out;
+}
+
+/**
+ * Look up a field declaration.
+ *
+ * @throws CleoError `VALIDATION_ERROR` when the field is not declared.
+ */
+function requireCustomField(fields: readonly CustomFieldDef[], name: string): CustomFieldDef {
+  const def = fields.find((f) => f.name === name);
+  if (!def) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Unknown custom field: ${name}`, {
+      fix: `Declare it first: cleo fields add --name ${name} --type string`,
+      details: { field: name, expected: fields.map((f) => f.name), actual: name },
+    });
+  }
+  return def;
+}
+
+/**
+ * Check one raw value against its field declaration and coerce it to the
+ * stored form.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for non-numeric `number` values and
+ *   enum values outside the allowed set.
+ */
+export function coerceCustomValue(def: CustomFieldDef, raw: string): string | number {
+  const { name } = def;
+  if (def.type === 'number') {
+    const n = Number(raw);
+    if (raw === '' || !Number.isFinite(n)) {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `Custom field '${name}' must be a number`, {
+        fix: `Use ${name}=<number>`,
+        details: { field: name, expected: 'number', actual: raw },
+      });
+    }
+    return n;
+  }
+  if (def.type === 'enum' && !def.values?.includes(raw)) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `Invalid value '${raw}' for ${name}; allowed: ${(def.values ?? []).join(', ')}`,
+      {
+        fix: `Use one of: ${(def.values ?? []).join(', ')}`,
+        details: { field: name, expected: def.values ?? [], actual: raw },
+      },
+    );
+  }
+  return raw;
+}
+
+/**
+ * Apply `--set` assignments to a task's current custom values. An empty
+ * value removes the field. The input map is not modified, so a bad entry
+ * leaves the task untouched.
+ *
+ * @returns The new custom map, or `undefined` when no fields remain.
+ */
+export function applyCustomAssignments(
+  fields: readonly CustomFieldDef[],
+  current: Readonly<Record<string, string | number>> | undefined,
+  entries: readonly string[],
+): Record<string, string | number> | undefined {
+  const next: Record<string, string | number> = { ...(current ?? {}) };
+  for (const [name, raw] of parseCustomAssignments(entries, 'set')) {
+    // Clearing still requires a declared field, so typos are not silent.
+    const def = requireCustomField(fields, name);
+    if (raw === '') delete next[name];
+    else next[name] = coerceCustomValue(def, raw);
+  }
+  return Object.keys(next).length > 0 ? next : undefined;
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrappers
+// ---------------------------------------------------------------------------
+
+/**
+ * Declare a custom field, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Field name, type, and enum values
+ * @returns EngineResult with the new declaration and full schema
+ */
+export async function taskFieldsAdd(
+  projectRoot: string,
+  params: TasksFieldsAddParams,
+): Promise<EngineResult<TasksFieldsAddResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await addCustomField(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to add custom field');
+  }
+}
+
+/**
+ * List custom field declarations, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @returns EngineResult with the project's custom fields
+ */
+export async function taskFieldsList(
+  projectRoot: string,
+): Promise<EngineResult<TasksFieldsListResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await listCustomFields({}, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to list custom fields');
+  }
+}
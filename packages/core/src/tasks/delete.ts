@@ -1,5 +1,10 @@
 /**
- * Task deletion (soft delete to archive).
+ * Task deletion (soft delete to the trash).
+ *
+ * A deleted task is archived and stamped with `deletedAt`, which puts it in
+ * the trash (see `trash.ts`) until it is restored or purged. `purge` skips
+ * the trash and removes the rows for good.
+ *
  * @task T4461
  * @epic T4454
  */
@@ -14,22 +19,31 @@ import type { DataAccessor } from '../store/data-accessor.js';
 import { getTaskAccessor } from '../store/data-accessor.js';
 import { removeChildProjectionAc } from './ac-table.js';
 import { taskToRecord } from './engine-converters.js';
+import { publishTaskEvent } from './task-events.js';
 
 /** Options for deleting a task. */
 export interface DeleteTaskOptions {
   taskId: string;
   force?: boolean;
   cascade?: boolean;
+  /** Hard-delete instead of moving to the trash. */
+  purge?: boolean;
 }
 
 /** Result of deleting a task. */
 export interface DeleteTaskResult {
   deletedTask: Task;
   cascadeDeleted?: string[];
+  /** True when the rows were removed rather than trashed. */
+  purged?: boolean;
 }
 
 /**
- * Delete a task (soft delete - moves to archive).
+ * Delete a task (soft delete - moves to the trash).
+ *
+ * With `purge`, the task (and cascaded children) are removed outright; a
+ * task already in the trash can only be purged.
+ *
  * @task T4461
  */
 export async function deleteTask(
@@ -47,6 +61,17 @@ export async function deleteTask(
     });
   }
 
+  if (task.deletedAt) {
+    if (!options.purge) {
+      throw new CleoError(
+        ExitCode.INVALID_INPUT,
+        `Task ${options.taskId} is already in the trash`,
+        { fix: `cleo trash restore ${options.taskId} | cleo delete ${options.taskId} --purge` },
+      );
+    }
+    return purgeTrashedTask(acc, task);
+  }
+
   const cascadeDeleted: string[] = [];
 
   // Check for children using targeted query
@@ -70,9 +95,10 @@ export async function deleteTask(
 
     if (options.cascade) {
       // Use CTE-based subtree query for all descendants
+      // Already-archived descendants stay in the archive rather than the trash.
       const subtree = await acc.getSubtree(options.taskId);
       for (const t of subtree) {
-        if (t.id !== options.taskId) {
+        if (t.id !== options.taskId && t.status !== 'archived') {
           cascadeDeleted.push(t.id);
         }
       }
@@ -121,35 +147,45 @@ export async function deleteTask(
     // Orphan children if force (mutations prepared above)
     if (options.force && children.length > 0 && !options.cascade) {
       for (const child of children) {
-        await tx.upsertSingleTask(child);
+        await tx.upsertSingleTask({ ...child, updatedAt: now });
       }
     }
 
-    // Archive each deleted task.
+    // Archive each deleted task and mark it trashed.
     // T1434 follow-up: T1408 CHECK constraint restricts archive_reason to a
     // 6-value enum (no 'deleted'). Map delete-flow archives to 'cancelled'
-    // — semantically equivalent and within the enum.
+    // — semantically equivalent and within the enum; `deletedAt` is what
+    // distinguishes a trashed row from an ordinary archived one.
     for (const id of idsToDelete) {
       const deletingTask = tasksById.get(id);
       if (deletingTask?.parentId) {
         await removeChildProjectionAc(tx, deletingTask.parentId, id, 'delete', now);
       }
+      if (options.purge) {
+        await tx.removeSingleTask(id);
+        continue;
+      }
       await tx.archiveSingleTask(id, {
         archivedAt: now,
         archiveReason: 'cancelled',
       });
+      await tx.updateTaskFields(id, {
+        deletedAt: now,
+        deletedParentId: deletingTask?.parentId ?? null,
+        updatedAt: now,
+      });
     }
 
     // Clean up dependency references
     for (const dep of depsToUpdate) {
-      await tx.upsertSingleTask(dep);
+      await tx.upsertSingleTask({ ...dep, updatedAt: now });
     }
 
     // Audit log
     await tx.appendLog({
       id: `log-${Math.floor(Date.now() / 1000)}-${(await import('node:crypto')).randomBytes(3).toString('hex')}`,
       timestamp: new Date().toISOString(),
-      action: 'task_deleted',
+      action: options.purge ? 'task_purged' : 'task_deleted',
       taskId: options.taskId,
       actor: 'system',
       details: {
@@ -167,6 +203,40 @@ export async function deleteTask(
   return {
     deletedTask: task,
     ...(cascadeDeleted.length > 0 && { cascadeDeleted }),
+    ...(options.purge && { purged: true }),
+  };
+}
+
+/**
+ * Hard-delete a trashed task together with any trashed descendants.
+ *
+ * Dependency references and AC projections were already cleaned up when the
+ * task was trashed, so only the rows remain to be removed.
+ */
+async function purgeTrashedTask(acc: DataAccessor, task: Task): Promise<DeleteTaskResult> {
+  const subtree = (await acc.getSubtree(task.id)).filter((t) => t.deletedAt);
+  const cascadeDeleted = subtree.map((t) => t.id).filter((id) => id !== task.id);
+
+  await acc.transaction(async (tx) => {
+    for (const t of subtree) {
+      await tx.removeSingleTask(t.id);
+    }
+    await tx.appendLog({
+      id: `log-${Math.floor(Date.now() / 1000)}-${(await import('node:crypto')).randomBytes(3).toString('hex')}`,
+      timestamp: new Date().toISOString(),
+      action: 'task_purged',
+      taskId: task.id,
+      actor: 'system',
+      details: { title: task.title, cascadeDeleted },
+      before: null,
+      after: null,
+    });
+  });
+
+  return {
+    deletedTask: task,
+    ...(cascadeDeleted.length > 0 && { cascadeDeleted }),
+    purged: true,
   };
 }
 
@@ -197,8 +267,13 @@ export async function taskDelete(
       projectRoot,
       accessor,
     );
+    const deletedTask = taskToRecord(result.deletedTask);
+    publishTaskEvent(projectRoot, 'task.deleted', deletedTask);
+    for (const id of result.cascadeDeleted ?? []) {
+      publishTaskEvent(projectRoot, 'task.deleted', { id });
+    }
     return engineSuccess({
-      deletedTask: taskToRecord(result.deletedTask),
+      deletedTask,
       deleted: true,
       cascadeDeleted: result.cascadeDeleted,
     });
@@ -210,3 +285,50 @@ export async function taskDelete(
     return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to delete task');
   }
 }
+
+/**
+ * Permanently delete a task, wrapped in EngineResult.
+ *
+ * Same checks as {@link taskDelete}, but the rows are removed instead of
+ * moved to the trash. Also empties a single task out of the trash.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param taskId - Task identifier to purge
+ * @param force - When true, enables cascade deletion of children
+ * @returns EngineResult with the purged task record and optional cascade info
+ */
+export async function taskPurge(
+  projectRoot: string,
+  taskId: string,
+  force?: boolean,
+): Promise<
+  EngineResult<{
+    deletedTask: TaskRecord;
+    deleted: boolean;
+    purged: true;
+    cascadeDeleted?: string[];
+  }>
+> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    const result = await deleteTask(
+      { taskId, force: force ?? false, cascade: force ?? false, purge: true },
+      projectRoot,
+      accessor,
+    );
+    // Same events as a trash delete: to subscribers the task is gone either way.
+    const deletedTask = taskToRecord(result.deletedTask);
+    publishTaskEvent(projectRoot, 'task.deleted', deletedTask);
+    for (const id of result.cascadeDeleted ?? []) {
+      publishTaskEvent(projectRoot, 'task.deleted', { id });
+    }
+    return engineSuccess({
+      deletedTask,
+      deleted: true,
+      purged: true,
+      cascadeDeleted: result.cascadeDeleted,
+    });
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to purge task');
+  }
+}
@@ -82,6 +82,45 @@ export function wouldCreateCycle(fromId: string, toId: string, tasks: Task[]): b
   return detectCircularDeps(fromId, modified).length > 0;
 }
 
+/**
+ * Find the cycle a proposed `depends` set would close for a task.
+ *
+ * Walks the existing `depends` edges with `taskId`'s own edges replaced by
+ * `proposedDepends`, so the check runs against the graph as it would look
+ * AFTER the mutation. Returns the cycle path starting and ending at
+ * `taskId` (e.g. `['T100', 'T101', 'T100']`), or an empty array when the
+ * resulting graph is acyclic through `taskId`. A self-dependency yields
+ * `[taskId, taskId]`.
+ */
+export function findDependencyCycle(
+  taskId: string,
+  proposedDepends: string[],
+  tasks: Task[],
+): string[] {
+  const edges = new Map(tasks.map((t) => [t.id, t.depends ?? []]));
+  edges.set(taskId, proposedDepends);
+
+  const visited = new Set<string>();
+  const path: string[] = [taskId];
+
+  function walk(id: string): boolean {
+    for (const depId of edges.get(id) ?? []) {
+      if (depId === taskId) {
+        path.push(depId);
+        return true;
+      }
+      if (visited.has(depId)) continue;
+      visited.add(depId);
+      path.push(depId);
+      if (walk(depId)) return true;
+      path.pop();
+    }
+    return false;
+  }
+
+  return walk(taskId) ? path : [];
+}
+
 /**
  * Get tasks that are blocked (have unmet dependencies).
  *
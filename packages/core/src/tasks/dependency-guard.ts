@@ -0,0 +1,117 @@
+/**
+ * Dependency-edge guard — rejects `depends` mutations that would corrupt the
+ * dependency graph before anything is persisted.
+ *
+ * Shared by the create and update paths so both enforce the same contract:
+ *   - every dependency ID is well-formed (`T###`)
+ *   - every NEWLY ADDED dependency resolves to a live task (missing and
+ *     archived targets are rejected with `missing_dependency`)
+ *   - the resulting edge set does not close a cycle through the mutated task
+ *     (direct, transitive, or self — rejected with `dependency_cycle`)
+ *
+ * Without this guard a cyclic edge was written silently and the tasks in the
+ * cycle dropped out of `cleo orchestrate ready` with no diagnostic.
+ *
+ * @epic T4454
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { findDependencyCycle } from './dependency-check.js';
+
+/** Canonical task-ID shapes accepted as a dependency target: `T###` or `PREFIX-#`. */
+const DEPENDENCY_ID_PATTERN = /^(?:T\d{3,}|[A-Z]{2,10}-\d+)$/;
+
+/** Input for {@link assertDependencyEdges}. */
+export interface DependencyEdgeCheck {
+  /**
+   * ID of the task whose edges are changing. `null` on create — a task that
+   * does not exist yet cannot be part of a cycle, so only the target checks run.
+   */
+  taskId: string | null;
+  /** The task's complete `depends` set AFTER the mutation. */
+  depends: string[];
+  /**
+   * Edges introduced by this mutation. Only these are resolved against the
+   * store; pre-existing edges (which may legitimately point at since-archived
+   * tasks) are left alone. Defaults to `depends`.
+   */
+  added?: string[];
+}
+
+/**
+ * Assert that a proposed `depends` set is safe to persist.
+ *
+ * @param acc - Task data accessor used to resolve targets and load the graph.
+ * @param check - The task, its post-mutation edge set, and the newly added edges.
+ * @throws CleoError `VALIDATION_ERROR` for a malformed ID.
+ * @throws CleoError `NOT_FOUND` with `details.error = 'missing_dependency'`
+ *   when an added edge targets a missing or archived task.
+ * @throws CleoError `CIRCULAR_REFERENCE` with `details.error = 'dependency_cycle'`
+ *   and `details.path` (e.g. `['T100', 'T101', 'T100']`) when the edge set
+ *   closes a cycle.
+ */
+export async function assertDependencyEdges(
+  acc: DataAccessor,
+  check: DependencyEdgeCheck,
+): Promise<void> {
+  const added = [...new Set((check.added ?? check.depends).map((d) => d.trim()))];
+
+  for (const depId of added) {
+    if (!DEPENDENCY_ID_PATTERN.test(depId)) {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `Invalid dependency ID format: '${depId}' (must be T### or PREFIX-# format)`,
+        {
+          fix: 'Dependency IDs must match T### or PREFIX-# format (e.g. T123, AUTH-4)',
+          details: { field: 'depends', expected: 'T### or PREFIX-#', actual: depId },
+        },
+      );
+    }
+  }
+
+  const targets = new Map((await acc.loadTasks(added)).map((t) => [t.id, t]));
+  for (const depId of added) {
+    const target = targets.get(depId);
+    if (!target || target.status === 'archived') {
+      const reason = target ? 'archived' : 'not_found';
+      throw new CleoError(
+        ExitCode.NOT_FOUND,
+        reason === 'archived'
+          ? `Dependency task is archived: ${depId}`
+          : `Dependency task not found: ${depId}`,
+        {
+          fix: `cleo find "${depId}"`,
+          details: { field: 'depends', error: 'missing_dependency', actual: depId, reason },
+        },
+      );
+    }
+  }
+
+  if (check.taskId === null || check.depends.length === 0) return;
+
+  const { tasks } = await acc.queryTasks({});
+  const path = findDependencyCycle(
+    check.taskId,
+    check.depends.map((d) => d.trim()),
+    tasks,
+  );
+  if (path.length > 0) throw dependencyCycleError(path);
+}
+
+/**
+ * Build the `dependency_cycle` error for a cycle path such as
+ * `['T100', 'T101', 'T100']`. Shared with planners that hit a cycle already
+ * in the store, so every surface reports cycles the same way.
+ */
+export function dependencyCycleError(path: string[]): CleoError {
+  return new CleoError(
+    ExitCode.CIRCULAR_REFERENCE,
+    `Dependency cycle detected: ${path.join(' -> ')}`,
+    {
+      fix: `Remove one edge of the cycle (e.g. cleo update ${path[0]} --remove-depends ${path[1]})`,
+      details: { field: 'depends', error: 'dependency_cycle', path },
+    },
+  );
+}
@@ -0,0 +1,213 @@
+/**
+ * Transitive dependency trace for one task — `cleo tasks tree <id>`.
+ *
+ * Walks `depends_on` edges away from a task in either direction:
+ *
+ *   - **up** — the blockers: every task that must finish before this one.
+ *   - **down** — the dependents: every task this one unblocks, directly or
+ *     through others.
+ *
+ * Each side comes back as a nested tree. The walk never recurses into a task
+ * that is already on the path from the root (the node is marked `cycle`), and
+ * a task reached a second time by another route is shown once and marked
+ * `repeated` after that, so a dense graph stays linear in its edge count.
+ *
+ * Archived tasks are included, so a finished blocker still shows up as done.
+ */
+
+import type {
+  DependencyTraceDirection,
+  DependencyTraceNode,
+  Task,
+  TasksDepsTraceParams,
+  TasksDepsTraceResult,
+} from '@cleocode/contracts';
+import { ExitCode, TASK_STATUSES } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+
+const DIRECTIONS: readonly DependencyTraceDirection[] = ['up', 'down', 'both'];
+
+/**
+ * Build one side of a task's dependency tree.
+ *
+ * @param rootId - Task the walk starts from (not included in the result).
+ * @param edges - Next task IDs for a task ID: its `depends` going up,
+ *   its dependents going down.
+ * @param byId - Task lookup; IDs it does not know become `missing` nodes.
+ * @param depth - Levels to walk, or `null` for no limit.
+ */
+export function traceDependencies(
+  rootId: string,
+  edges: (id: string) => readonly string[],
+  byId: ReadonlyMap<string, Pick<Task, 'id' | 'title' | 'status'>>,
+  depth: number | null,
+): DependencyTraceNode[] {
+  const path = new Set<string>([rootId]);
+  const expanded = new Set<string>();
+
+  const visit = (id: string, level: number): DependencyTraceNode => {
+    const task = byId.get(id);
+    const node: DependencyTraceNode = {
+      id,
+      title: task?.title ?? '',
+      status: task?.status ?? 'unknown',
+      children: [],
+    };
+    if (!task) {
+      node.missing = true;
+      return node;
+    }
+    const next = edges(id);
+    if (path.has(id)) node.cycle = true;
+    else if (expanded.has(id) && next.length > 0) node.repeated = true;
+    else if (depth !== null && level >= depth && next.length > 0) node.truncated = true;
+    else {
+      expanded.add(id);
+      path.add(id);
+      node.children = next.map((nextId) => visit(nextId, level + 1));
+      path.delete(id);
+    }
+    return node;
+  };
+
+  return edges(rootId).map((id) => visit(id, 1));
+}
+
+/** True when any node in the forest is marked as a cycle. */
+function containsCycle(nodes: readonly DependencyTraceNode[]): boolean {
+  return nodes.some((n) => n.cycle === true || containsCycle(n.children));
+}
+
+/**
+ * Render a trace as an indented tree, one task per line with its status.
+ *
+ * @param root - The traced task.
+ * @param up - Its blockers, or `null` to leave the section out.
+ * @param down - Its dependents, or `null` to leave the section out.
+ */
+export function renderDependencyTrace(
+  root: Pick<Task, 'id' | 'title' | 'status'>,
+  up: readonly DependencyTraceNode[] | null,
+  down: readonly DependencyTraceNode[] | null,
+): string {
+  const lines = [`${root.id} ${root.title} [${root.status}]`];
+  const walk = (nodes: readonly DependencyTraceNode[], indent: string): void => {
+    for (const node of nodes) {
+      const mark = node.missing
+        ? ' (missing)'
+        : node.cycle
+          ? ' (cycle)'
+          : node.repeated
+            ? ' (see above)'
+            : node.truncated
+              ? ' (…)'
+              : '';
+      const label = node.missing ? node.id : `${node.id} ${node.title} [${node.status}]`;
+      lines.push(`${indent}${label}${mark}`);
+      walk(node.children, `${indent}  `);
+    }
+  };
+  const section = (heading: string, nodes: readonly DependencyTraceNode[] | null): void => {
+    if (!nodes) return;
+    lines.push(`  ${heading}${nodes.length === 0 ? ' (none)' : ''}`);
+    walk(nodes, '    ');
+  };
+  section('blocked by', up);
+  section('unblocks', down);
+  return lines.join('\n');
+}
+
+/**
+ * Trace a task's transitive blockers and dependents.
+ *
+ * @param projectRoot - Project root used to open the task store.
+ * @param params - Task ID, optional depth limit, and direction.
+ * @throws CleoError `INVALID_INPUT` for a bad depth or direction.
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ *
+ * @example
+ * ```typescript
+ * const trace = await traceTaskDependencies(projectRoot, { taskId: 'T42', direction: 'up' });
+ * console.log(trace.rendered);
+ * ```
+ */
+export async function traceTaskDependencies(
+  projectRoot: string,
+  params: TasksDepsTraceParams,
+  accessor?: DataAccessor,
+): Promise<TasksDepsTraceResult> {
+  const direction = params.direction ?? 'both';
+  if (!DIRECTIONS.includes(direction)) {
+    throw new CleoError(ExitCode.INVALID_INPUT, `Unknown direction: ${direction}`, {
+      fix: 'Use --direction up, down, or both',
+      details: { field: 'direction', expected: DIRECTIONS, actual: direction },
+    });
+  }
+  const depth = params.depth ?? null;
+  if (depth !== null && (!Number.isInteger(depth) || depth < 1)) {
+    throw new CleoError(ExitCode.INVALID_INPUT, '--depth must be a positive integer', {
+      fix: 'Pass --depth 1 or more, or omit it to walk the whole chain',
+      details: { field: 'depth', actual: depth },
+    });
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(projectRoot));
+  const { tasks } = await acc.queryTasks({ status: [...TASK_STATUSES] });
+  const byId = new Map(tasks.map((t) => [t.id, t]));
+  const root = byId.get(params.taskId);
+  if (!root) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${params.taskId}`, {
+      fix: `cleo find "${params.taskId}"`,
+    });
+  }
+
+  let up: DependencyTraceNode[] | null = null;
+  let down: DependencyTraceNode[] | null = null;
+  if (direction !== 'down') {
+    up = traceDependencies(root.id, (id) => byId.get(id)?.depends ?? [], byId, depth);
+  }
+  if (direction !== 'up') {
+    const dependents = new Map<string, string[]>();
+    for (const task of tasks) {
+      for (const dep of task.depends ?? []) {
+        const list = dependents.get(dep);
+        if (list) list.push(task.id);
+        else dependents.set(dep, [task.id]);
+      }
+    }
+    down = traceDependencies(root.id, (id) => dependents.get(id) ?? [], byId, depth);
+  }
+
+  return {
+    taskId: root.id,
+    title: root.title,
+    status: root.status,
+    direction,
+    depth,
+    up,
+    down,
+    hasCycle: containsCycle(up ?? []) || containsCycle(down ?? []),
+    rendered: renderDependencyTrace(root, up, down),
+  };
+}
+
+/**
+ * `tasks.deps.trace` — the dependency trace as an EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID, optional depth limit, and direction
+ * @returns EngineResult with the nested trees and their text rendering
+ */
+export async function taskDepsTrace(
+  projectRoot: string,
+  params: TasksDepsTraceParams,
+): Promise<EngineResult<TasksDepsTraceResult>> {
+  try {
+    return engineSuccess(await traceTaskDependencies(projectRoot, params));
+  } catch (err) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to trace dependencies');
+  }
+}
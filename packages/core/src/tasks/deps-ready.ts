@@ -10,15 +10,15 @@
  * @epic T4454
  */
 
-/** Completed/cancelled statuses that satisfy dependencies. */
-const SATISFIED_STATUSES = new Set<string>(['done', 'cancelled']);
+/** Completed/cancelled (and since archived) statuses that satisfy dependencies. */
+const SATISFIED_STATUSES = new Set<string>(['done', 'cancelled', 'archived']);
 
 /**
  * Check if all dependencies of a task are satisfied.
  *
  * @param depends - Array of dependency task IDs (may be undefined/empty)
  * @param taskLookup - Map from task ID to a task-like object with at least { status: string }
- * @returns true if all dependencies are done/cancelled, or if no dependencies exist
+ * @returns true if all dependencies are done/cancelled/archived, or if no dependencies exist
  */
 export function depsReady(
   depends: string[] | undefined,
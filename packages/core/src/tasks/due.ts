@@ -0,0 +1,124 @@
+/**
+ * Task due dates — validation and the overdue query.
+ *
+ * `due` holds an RFC 3339 full-date (`2026-07-01`) or date-time
+ * (`2026-07-01T17:00:00Z`) exactly as supplied. A full-date is due at the
+ * start of that day (UTC), matching how `Date.parse` reads it.
+ */
+
+import type { MinimalTaskRecord } from '@cleocode/contracts';
+import { ExitCode, TERMINAL_TASK_STATUSES } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+
+const RFC3339_DATE = /^(\d{4})-(\d{2})-(\d{2})$/;
+const RFC3339_DATE_TIME =
+  /^(\d{4})-(\d{2})-(\d{2})[Tt]([01]\d|2[0-3]):[0-5]\d:[0-5]\d(\.\d+)?([Zz]|[+-]([01]\d|2[0-3]):[0-5]\d)$/;
+
+/**
+ * Parse an RFC 3339 full-date or date-time into epoch milliseconds.
+ *
+ * Rejects calendar-impossible dates (`2026-02-30`) that `Date.parse` would
+ * otherwise roll over into the next month.
+ *
+ * @param value - Date string to parse.
+ * @param field - Field name reported in the error details.
+ * @throws CleoError `VALIDATION_ERROR` when the value is not RFC 3339.
+ */
+export function parseRfc3339Date(value: string, field = 'due'): number {
+  const match = RFC3339_DATE.exec(value) ?? RFC3339_DATE_TIME.exec(value);
+  const ms = match ? Date.parse(value) : Number.NaN;
+  if (match && !Number.isNaN(ms)) {
+    const [year, month, day] = [Number(match[1]), Number(match[2]), Number(match[3])];
+    const calendar = new Date(Date.UTC(year, month - 1, day));
+    if (calendar.getUTCMonth() === month - 1 && calendar.getUTCDate() === day) return ms;
+  }
+  const flag = field === 'asOf' ? 'as-of' : field;
+  throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid ${field} date: '${value}'`, {
+    fix: `Pass an RFC 3339 date, e.g. --${flag} 2026-07-01 or --${flag} 2026-07-01T17:00:00Z`,
+    details: { field, expected: 'RFC 3339 full-date or date-time', actual: value },
+  });
+}
+
+/**
+ * Normalise a `--due` value for storage.
+ *
+ * @param due - Raw value; an empty (or whitespace-only) string clears the date.
+ * @returns The trimmed date, or `null` to clear it.
+ * @throws CleoError `VALIDATION_ERROR` when the value is not RFC 3339.
+ */
+export function normalizeDueDate(due: string): string | null {
+  const trimmed = due.trim();
+  if (trimmed === '') return null;
+  parseRfc3339Date(trimmed);
+  return trimmed;
+}
+
+/** Result of {@link findOverdueTasks} — the `tasks.find` envelope plus `asOf`. */
+export interface OverdueTasksResult {
+  results: MinimalTaskRecord[];
+  total: number;
+  asOf: string;
+}
+
+/**
+ * Find open tasks whose due date is before `asOf` — done, cancelled and
+ * archived tasks are never overdue.
+ *
+ * Results are sorted most overdue first (earliest due date), ties by ID.
+ *
+ * @param options - `asOf` reference date (RFC 3339); defaults to now.
+ * @throws CleoError `VALIDATION_ERROR` when `asOf` is not RFC 3339.
+ */
+export async function findOverdueTasks(
+  options: { asOf?: string } = {},
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<OverdueTasksResult> {
+  const asOfMs = options.asOf ? parseRfc3339Date(options.asOf.trim(), 'asOf') : Date.now();
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const { tasks } = await acc.queryTasks({ excludeStatus: [...TERMINAL_TASK_STATUSES] });
+
+  const overdue = tasks
+    .filter((t) => t.due)
+    .map((t) => ({ task: t, dueMs: Date.parse(t.due!) }))
+    .filter(({ dueMs }) => !Number.isNaN(dueMs) && dueMs < asOfMs)
+    .sort((a, b) => a.dueMs - b.dueMs || a.task.id.localeCompare(b.task.id));
+
+  const results: MinimalTaskRecord[] = overdue.map(({ task: t }) => ({
+    id: t.id,
+    title: t.title,
+    status: t.status,
+    priority: t.priority,
+    parentId: t.parentId,
+    depends: t.depends,
+    type: t.type,
+    size: t.size ?? undefined,
+    ...(t.severity != null ? { severity: t.severity } : {}),
+    due: t.due,
+  }));
+
+  return { results, total: results.length, asOf: new Date(asOfMs).toISOString() };
+}
+
+/**
+ * List overdue tasks, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - `asOf` reference date (RFC 3339); defaults to now
+ * @returns EngineResult with `{ results, total, asOf }`
+ */
+export async function taskOverdue(
+  projectRoot: string,
+  params: { asOf?: string } = {},
+): Promise<EngineResult<OverdueTasksResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await findOverdueTasks(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to list overdue tasks');
+  }
+}
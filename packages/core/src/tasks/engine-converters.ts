@@ -21,6 +21,7 @@ import type {
   TaskRecordRelationCounts,
 } from '@cleocode/contracts';
 import type { IvtrPhase, IvtrPhaseEntry } from '../lifecycle/ivtr-loop.js';
+import { sumTimeEntries } from './time.js';
 
 /**
  * A single lifecycle stage transition entry returned by taskShowWithHistory.
@@ -97,6 +98,14 @@ export function taskToRecord(task: Task): TaskRecord {
     updatedAt: task.updatedAt ?? null,
     completedAt: task.completedAt ?? null,
     cancelledAt: task.cancelledAt ?? null,
+    ...(task.due ? { due: task.due } : {}),
+    ...(task.recurrence ? { recurrence: task.recurrence } : {}),
+    ...(task.recurredTo ? { recurredTo: task.recurredTo } : {}),
+    ...(task.estimate != null ? { estimate: task.estimate } : {}),
+    ...(task.track ? { track: task.track } : {}),
+    ...(task.assignee ? { assignee: task.assignee } : {}),
+    ...(task.deletedAt ? { deletedAt: task.deletedAt } : {}),
+    ...(task.deletedParentId ? { deletedParentId: task.deletedParentId } : {}),
     parentId: task.parentId,
     position: task.position,
     positionVersion: task.positionVersion,
@@ -105,6 +114,17 @@ export function taskToRecord(task: Task): TaskRecord {
     files: task.files,
     acceptance: task.acceptance?.filter((a): a is string => typeof a === 'string'),
     notes: task.notes,
+    ...(task.noteHistory?.length ? { noteHistory: [...task.noteHistory].reverse() } : {}),
+    ...(task.commits?.length ? { commits: task.commits } : {}),
+    ...(task.timeEntries?.length
+      ? { timeEntries: task.timeEntries, timeSpent: sumTimeEntries(task.timeEntries) }
+      : {}),
+    ...(task.custom ? { custom: task.custom } : {}),
+    ...(task.autoCompleteParent ? { autoCompleteParent: true } : {}),
+    ...(task.completionRequirements
+      ? { completionRequirements: task.completionRequirements }
+      : {}),
+    ...(task.idPrefix ? { idPrefix: task.idPrefix } : {}),
     labels: task.labels,
     size: task.size ?? null,
     epicLifecycle: task.epicLifecycle ?? null,
@@ -113,6 +133,8 @@ export function taskToRecord(task: Task): TaskRecord {
     origin: task.origin ?? null,
     cancellationReason: task.cancellationReason,
     blockedBy: task.blockedBy ? [task.blockedBy] : undefined,
+    ...(task.blockedUntil ? { blockedUntil: task.blockedUntil } : {}),
+    ...(task.blockedPriorStatus ? { blockedPriorStatus: task.blockedPriorStatus } : {}),
     ...(relationCounts ? { relationCounts } : {}),
     pipelineStage: task.pipelineStage ?? null,
     // T944/T9072: orthogonal axes (kind → DB col 'role')
@@ -0,0 +1,166 @@
+/**
+ * Task effort estimates — validation and the saga/epic rollup.
+ *
+ * `estimate` is a non-negative number in whatever unit the project uses
+ * (story points or hours). A container (`saga` / `epic`) rolls up the
+ * estimates of its member tasks: done work counts toward `estimateDone`,
+ * everything still open (including `active`) toward `estimateRemaining`.
+ */
+
+import type {
+  Task,
+  TaskEstimateRollup,
+  TasksEstimateRollupParams,
+  TasksEstimateRollupResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineError, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { taskToRecord } from './engine-converters.js';
+
+/** Task types whose own estimate is ignored in favour of their members'. */
+const CONTAINER_TYPES: ReadonlySet<string> = new Set(['saga', 'epic']);
+
+/** Statuses whose estimate counts as done work. */
+const DONE_STATUSES: ReadonlySet<string> = new Set(['done', 'archived']);
+
+/**
+ * Normalise an `--estimate` value for storage.
+ *
+ * @param value - Number, numeric string, or `none` / empty string / `null` to clear.
+ * @returns The estimate, or `null` to clear it.
+ * @throws CleoError `VALIDATION_ERROR` unless the value is a finite number >= 0.
+ */
+export function normalizeEstimate(value: number | string | null): number | null {
+  if (value === null) return null;
+  let estimate = value;
+  if (typeof value === 'string') {
+    const trimmed = value.trim();
+    if (trimmed === '' || trimmed.toLowerCase() === 'none') return null;
+    estimate = Number(trimmed);
+  }
+  if (typeof estimate === 'number' && Number.isFinite(estimate) && estimate >= 0) {
+    return estimate;
+  }
+  throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid estimate: ${JSON.stringify(value)}`, {
+    fix: 'Pass a non-negative number, e.g. --estimate 3 or --estimate 1.5 (none clears it)',
+    details: { field: 'estimate', expected: 'finite number >= 0', actual: value },
+  });
+}
+
+/**
+ * Collect the tasks whose estimates count toward `rootId`.
+ *
+ * Containers always descend. An estimated task counts once and its own
+ * subtasks are skipped, so a parent estimate and its breakdown are never
+ * double-counted; an unestimated task descends into its subtasks and is
+ * reported as unestimated only when it has none. Cancelled and trashed
+ * (`cleo delete`) work is ignored.
+ *
+ * @param rootId - Saga or epic ID.
+ * @param subtree - The root's subtree (as returned by `getSubtree`).
+ * @returns The estimated members, plus the unestimated leaf tasks and their count.
+ */
+export function collectEstimatedMembers(
+  rootId: string,
+  subtree: readonly Task[],
+): { estimated: Task[]; unestimated: Task[]; unestimatedCount: number } {
+  const children = new Map<string, Task[]>();
+  for (const task of subtree) {
+    if (!task.parentId || task.id === rootId) continue;
+    const siblings = children.get(task.parentId);
+    if (siblings) siblings.push(task);
+    else children.set(task.parentId, [task]);
+  }
+
+  const estimated: Task[] = [];
+  const unestimated: Task[] = [];
+  const stack = [...(children.get(rootId) ?? [])];
+  while (stack.length > 0) {
+    const task = stack.pop() as Task;
+    if (task.status === 'cancelled' || task.deletedAt) continue;
+    const kids = children.get(task.id) ?? [];
+    if (CONTAINER_TYPES.has(task.type ?? '')) {
+      stack.push(...kids);
+    } else if (task.estimate != null) {
+      estimated.push(task);
+    } else if (kids.length > 0) {
+      stack.push(...kids);
+    } else {
+      unestimated.push(task);
+    }
+  }
+  return { estimated, unestimated, unestimatedCount: unestimated.length };
+}
+
+/** Whether a task's estimate counts as done work. */
+export function isEstimateDone(task: Pick<Task, 'status'>): boolean {
+  return DONE_STATUSES.has(task.status);
+}
+
+/**
+ * Roll up member estimates below `rootId` (membership per
+ * {@link collectEstimatedMembers}).
+ *
+ * @param rootId - Saga or epic ID.
+ * @param subtree - The root's subtree (as returned by `getSubtree`).
+ */
+export function rollupEstimates(rootId: string, subtree: readonly Task[]): TaskEstimateRollup {
+  const { estimated, unestimatedCount } = collectEstimatedMembers(rootId, subtree);
+  const rollup: TaskEstimateRollup = {
+    estimateTotal: 0,
+    estimateDone: 0,
+    estimateRemaining: 0,
+    unestimatedCount,
+  };
+  for (const task of estimated) {
+    if (isEstimateDone(task)) rollup.estimateDone += task.estimate as number;
+    else rollup.estimateRemaining += task.estimate as number;
+  }
+  rollup.estimateTotal = rollup.estimateDone + rollup.estimateRemaining;
+  return rollup;
+}
+
+/**
+ * Show a saga or epic with its estimate rollup, wrapped in EngineResult.
+ * Sagas also carry their progress rollup (`computeSagaProgress`).
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - `taskId`, and optionally the `type` the task must have
+ * @returns EngineResult with `{ task, estimate }`
+ */
+export async function taskEstimateRollup(
+  projectRoot: string,
+  params: TasksEstimateRollupParams,
+  accessor?: DataAccessor,
+): Promise<EngineResult<TasksEstimateRollupResult>> {
+  try {
+    const acc = accessor ?? (await getTaskAccessor(projectRoot));
+    const task = await acc.loadSingleTask(params.taskId);
+    if (!task) {
+      return engineError('E_NOT_FOUND', `Task not found: ${params.taskId}`);
+    }
+    if (params.type && task.type !== params.type) {
+      return engineError(
+        'E_INVALID_INPUT',
+        `${params.taskId} is a ${task.type ?? 'task'}, not a ${params.type}`,
+      );
+    }
+    const subtree = await acc.getSubtree(task.id);
+    // Imported lazily: sagas/critical-path imports this module.
+    const progress =
+      task.type === 'saga'
+        ? (await import('../sagas/progress.js')).computeSagaProgress(task.id, subtree)
+        : {};
+    return engineSuccess({
+      task: taskToRecord(task),
+      estimate: rollupEstimates(task.id, subtree),
+      ...progress,
+    });
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to roll up estimates');
+  }
+}
@@ -11,6 +11,7 @@ import type {
   TaskQueryFilters,
   TaskRecord,
   TaskStatus,
+  TaskType,
 } from '@cleocode/contracts';
 import { ExitCode } from '@cleocode/contracts';
 import { type EngineResult, engineSuccess } from '../engine-result.js';
@@ -21,7 +22,10 @@ import { taskListItemNext } from '../mvi-helpers.js';
 import { resolveSagaMemberIds } from '../sagas/storage.js';
 import type { DataAccessor } from '../store/data-accessor.js';
 import { getTaskAccessor } from '../store/data-accessor.js';
+import { loadCustomFields } from './custom-fields.js';
 import { taskToRecord } from './engine-converters.js';
+import { compareByPriority, type TaskSortKey, validateTaskSort } from './sort.js';
+import { compileWhere, parseWhere } from './where.js';
 
 /** Minimal task info for search results. */
 export interface FindResult {
@@ -42,18 +46,62 @@ export interface FindResult {
    * @task T9905
    */
   severity?: string | null;
+  /** Why the task is blocked (`cleo tasks block --reason`). */
+  blockedBy?: string[];
+  /** When a manual block lapses (`cleo tasks block --until`). */
+  blockedUntil?: string;
   score: number;
   /** Progressive disclosure directives for follow-up operations. */
   _next?: NextDirectives;
 }
 
+/** Block reason and expiry for a find row, omitted when the task is not blocked. */
+function blockFields(t: Task): Pick<FindResult, 'blockedBy' | 'blockedUntil'> {
+  return {
+    ...(t.blockedBy ? { blockedBy: [t.blockedBy] } : {}),
+    ...(t.blockedUntil ? { blockedUntil: t.blockedUntil } : {}),
+  };
+}
+
+/**
+ * Task text a `find` query can be matched against. `id` is kept for the
+ * original `find --in id`; `all` covers title, description and notes.
+ */
+export type FindField = 'title' | 'description' | 'notes' | 'id' | 'all';
+
+/** Valid values for {@link FindTasksOptions.field}. */
+export const FIND_FIELDS: readonly FindField[] = ['title', 'description', 'notes', 'id', 'all'];
+
+/** Longest pattern accepted by `find --regex`. */
+export const MAX_FIND_REGEX_LENGTH = 256;
+
+/**
+ * Per-field cap on the text a `--regex` pattern is run against. JS regexes
+ * cannot be interrupted, so bounding the input bounds worst-case
+ * backtracking on long descriptions and note logs.
+ */
+export const MAX_FIND_REGEX_INPUT = 4096;
+
 /** Options for finding tasks. */
 export interface FindTasksOptions {
   query?: string;
   id?: string;
   exact?: boolean;
   status?: TaskStatus;
-  field?: string;
+  /**
+   * Which task text the query is matched against. When unset, fuzzy search
+   * keeps its historical title + description scoring and `--regex` matches
+   * the title only.
+   */
+  field?: FindField;
+  /**
+   * Treat `query` as a case-insensitive regular expression instead of a
+   * fuzzy string. The pattern is compiled once; an invalid pattern throws
+   * `INVALID_INPUT` with `details.error = 'bad_regex'`.
+   */
+  regex?: boolean;
+  /** Filter by hierarchy type (saga | epic | task | subtask). Composes via AND. */
+  type?: TaskType;
   includeArchive?: boolean;
   limit?: number;
   offset?: number;
@@ -106,6 +154,19 @@ export interface FindTasksOptions {
    * @saga T9862
    */
   parent?: string;
+  /**
+   * Result ordering. `priority` orders critical → high → medium → low with
+   * an ID tiebreaker, replacing the default relevance order. Applied before
+   * pagination so `--limit` keeps the highest-priority matches.
+   */
+  sort?: TaskSortKey;
+  /**
+   * `--where` expressions over task and custom fields (see `where.ts`), e.g.
+   * `priority >= high and (label:saga or label:wave-1) and status != done`.
+   * All must match. Syntax errors throw `INVALID_INPUT` with the token
+   * position; unknown fields and impossible values throw `VALIDATION_ERROR`.
+   */
+  where?: string[];
 }
 
 /** Result of finding tasks. */
@@ -113,7 +174,7 @@ export interface FindTasksResult {
   results: FindResult[];
   total: number;
   query: string;
-  searchType: 'fuzzy' | 'id' | 'exact';
+  searchType: 'fuzzy' | 'id' | 'exact' | 'regex';
 }
 
 /**
@@ -137,6 +198,46 @@ export function isUrgentTask(task: {
   return p === 'critical' || p === 'high' || s === 'P0' || s === 'P1';
 }
 
+/** The text of `field` on a task; notes (legacy and authored) are joined one per line. */
+function fieldText(task: Task, field: Exclude<FindField, 'all'>): string {
+  if (field === 'title') return task.title;
+  if (field === 'description') return task.description ?? '';
+  if (field === 'id') return task.id;
+  return [...(task.notes ?? []), ...(task.noteHistory ?? []).map((n) => n.text)].join('\n');
+}
+
+/**
+ * Compile a `find --regex` pattern.
+ *
+ * @throws CleoError `INVALID_INPUT` (`details.error = 'bad_regex'`) when the
+ *   pattern is too long or does not compile.
+ */
+export function compileFindRegex(pattern: string): RegExp {
+  if (pattern.length > MAX_FIND_REGEX_LENGTH) {
+    throw new CleoError(
+      ExitCode.INVALID_INPUT,
+      `Regex is longer than ${MAX_FIND_REGEX_LENGTH} characters`,
+      {
+        fix: 'Shorten the pattern or search with a plain fuzzy query',
+        details: {
+          field: 'query',
+          error: 'bad_regex',
+          detail: `pattern length ${pattern.length} exceeds ${MAX_FIND_REGEX_LENGTH}`,
+        },
+      },
+    );
+  }
+  try {
+    return new RegExp(pattern, 'i');
+  } catch (err: unknown) {
+    const detail = err instanceof Error ? err.message : String(err);
+    throw new CleoError(ExitCode.INVALID_INPUT, `Invalid regex: ${detail}`, {
+      fix: 'Check the pattern syntax, or drop --regex for a fuzzy search',
+      details: { field: 'query', error: 'bad_regex', detail },
+    });
+  }
+}
+
 /**
  * Calculate fuzzy match score between query and text.
  * Higher score = better match. 0 = no match.
@@ -267,8 +368,11 @@ export function extractInlineFilters(options: FindTasksOptions): FindTasksOption
         // other inline filters above).
         if (!next.label) next.label = value;
         break;
+      case 'type':
+        if (!next.type) next.type = value as TaskType;
+        break;
       default:
-        // priority/type aren't in FindTasksOptions today — pass through.
+        // priority isn't in FindTasksOptions today — pass through.
         remaining.push(tok);
         break;
     }
@@ -279,11 +383,12 @@ export function extractInlineFilters(options: FindTasksOptions): FindTasksOption
 }
 
 /**
- * Search tasks by fuzzy matching, ID prefix, exact title, or filter-only.
+ * Search tasks by fuzzy matching, regex, ID prefix, exact title, or filter-only.
  * Returns minimal fields only (context-efficient).
  *
  * Accepts any of:
- *   - positional `query` for fuzzy title/description search
+ *   - positional `query` for fuzzy title/description search (`field`
+ *     narrows or widens what is matched; `regex` compiles it as a pattern)
  *   - `id` prefix
  *   - `status` / `kind` filter (any of these alone is sufficient — no
  *     query required, returns all matches)
@@ -298,7 +403,8 @@ export async function findTasks(
   cwd?: string,
   accessor?: DataAccessor,
 ): Promise<FindTasksResult> {
-  const options = extractInlineFilters(rawOptions);
+  // A regex is taken verbatim — `key:value` lifting would rewrite its whitespace.
+  const options = rawOptions.regex ? { ...rawOptions } : extractInlineFilters(rawOptions);
 
   // T10108: an empty-string or whitespace-only `query` is the same as no
   // query — without this, `fuzzyScore('', '<any title>')` returns 80 for
@@ -310,13 +416,19 @@ export async function findTasks(
   }
 
   const hasFilter = Boolean(
-    options.status || options.kind || options.urgent || options.label || options.parent,
+    options.status ||
+      options.kind ||
+      options.urgent ||
+      options.label ||
+      options.parent ||
+      options.type ||
+      options.where?.length,
   );
 
   if (options.query == null && !options.id && !hasFilter) {
     throw new CleoError(
       ExitCode.INVALID_INPUT,
-      'Search query, --id, or at least one filter (--status, --kind, --urgent, --label, --parent) is required',
+      'Search query, --id, or at least one filter (--status, --kind, --type, --urgent, --label, --parent, --where) is required',
       {
         fix: 'cleo find "<query>"  OR  cleo find --label bug  OR  cleo find --urgent  OR  cleo find --status pending  OR  cleo find --parent T123  OR  cleo find --id T123',
         details: { field: 'query' },
@@ -324,8 +436,34 @@ export async function findTasks(
     );
   }
 
+  if (options.sort !== undefined) validateTaskSort(options.sort);
+
+  if (options.field !== undefined && !FIND_FIELDS.includes(options.field)) {
+    throw new CleoError(ExitCode.INVALID_INPUT, `Invalid --field: ${options.field}`, {
+      fix: `Use one of: ${FIND_FIELDS.join(', ')}`,
+      details: { field: 'field', expected: FIND_FIELDS, actual: options.field },
+    });
+  }
+
+  // Compile before touching the store so a bad pattern fails fast.
+  const pattern =
+    options.regex && options.query != null && !options.id && !options.exact
+      ? compileFindRegex(options.query)
+      : null;
+
+  // Parse `--where` up front so a syntax error fails before the store opens.
+  const whereTrees = options.where?.map((expression) => parseWhere(expression)) ?? [];
+
   const acc = accessor ?? (await getTaskAccessor(cwd));
 
+  // Resolve `--where` fields against the task fields and the project's custom fields.
+  let matchesWhere: ((task: Task) => boolean) | null = null;
+  if (whereTrees.length > 0) {
+    const customFields = await loadCustomFields(acc);
+    const predicates = whereTrees.map((tree) => compileWhere(tree, customFields));
+    matchesWhere = (task) => predicates.every((p) => p(task));
+  }
+
   // T10108: Saga-aware --parent routing.
   // When --parent targets a Saga, resolve members through the canonical
   // Saga member helper. Falls back to the default parentId-based query when the
@@ -347,6 +485,9 @@ export async function findTasks(
   if (options.label) {
     filters.label = options.label;
   }
+  if (options.type) {
+    filters.type = options.type;
+  }
   // T10108: skip the raw parentId filter when routing through the Saga helper;
   // the member-set intersection below restricts the result in-memory instead.
   if (options.parent && sagaMemberIds === null) {
@@ -372,7 +513,8 @@ export async function findTasks(
   if (options.includeArchive) {
     const archive = await acc.loadArchive();
     if (archive?.archivedTasks) {
-      let archivedTasks = archive.archivedTasks as Task[];
+      // Trashed tasks are archived rows too, but only `trash list` shows them.
+      let archivedTasks = (archive.archivedTasks as Task[]).filter((t) => !t.deletedAt);
       if (options.status) {
         archivedTasks = archivedTasks.filter((t) => t.status === options.status);
       }
@@ -383,6 +525,9 @@ export async function findTasks(
           (t.labels ?? []).includes(options.label as string),
         );
       }
+      if (options.type) {
+        archivedTasks = archivedTasks.filter((t) => t.type === options.type);
+      }
       allTasks = [...allTasks, ...archivedTasks];
     }
   }
@@ -398,6 +543,10 @@ export async function findTasks(
     allTasks = allTasks.filter((t) => isUrgentTask(t));
   }
 
+  if (matchesWhere) {
+    allTasks = allTasks.filter(matchesWhere);
+  }
+
   let results: FindResult[];
   let searchType: FindTasksResult['searchType'];
   let queryStr: string;
@@ -419,6 +568,7 @@ export async function findTasks(
         depends: t.depends ?? [],
         size: t.size ?? undefined,
         severity: t.severity ?? undefined,
+        ...blockFields(t),
         score:
           t.id.toUpperCase() === idQuery ? 100 : t.id.toUpperCase().startsWith(idQuery) ? 80 : 50,
       }));
@@ -438,8 +588,34 @@ export async function findTasks(
         depends: t.depends ?? [],
         size: t.size ?? undefined,
         severity: t.severity ?? undefined,
+        ...blockFields(t),
         score: 100,
       }));
+  } else if (pattern) {
+    // Regex search — score 100 on a title hit, 70 when only another field hits.
+    searchType = 'regex';
+    queryStr = options.query!;
+    const fields: Exclude<FindField, 'all'>[] =
+      options.field === 'all' ? ['title', 'description', 'notes'] : [options.field ?? 'title'];
+    results = [];
+    for (const t of allTasks) {
+      const hit = fields.find((f) => pattern.test(fieldText(t, f).slice(0, MAX_FIND_REGEX_INPUT)));
+      if (!hit) continue;
+      results.push({
+        id: t.id,
+        title: t.title,
+        status: t.status,
+        priority: t.priority,
+        type: t.type,
+        parentId: t.parentId,
+        depends: t.depends ?? [],
+        size: t.size ?? undefined,
+        severity: t.severity ?? undefined,
+        ...blockFields(t),
+        score: hit === 'title' ? 100 : 70,
+      });
+    }
+    results.sort((a, b) => b.score - a.score);
   } else if (options.query == null) {
     // Filter-only mode — return every task the status/kind filter already
     // matched. All-equal score=50 so pagination is stable. T1187-followup.
@@ -455,6 +631,7 @@ export async function findTasks(
       depends: t.depends ?? [],
       size: t.size ?? undefined,
       severity: t.severity ?? undefined,
+      ...blockFields(t),
       score: 50,
     }));
   } else {
@@ -464,9 +641,17 @@ export async function findTasks(
     const scored: FindResult[] = [];
 
     for (const t of allTasks) {
-      const titleScore = fuzzyScore(queryStr, t.title);
-      const descScore = t.description ? fuzzyScore(queryStr, t.description) * 0.7 : 0;
-      const score = Math.max(titleScore, descScore);
+      let score: number;
+      if (options.field === undefined || options.field === 'all') {
+        const titleScore = fuzzyScore(queryStr, t.title);
+        const descScore = t.description ? fuzzyScore(queryStr, t.description) * 0.7 : 0;
+        const notes = options.field === 'all' ? fieldText(t, 'notes') : '';
+        const notesScore = notes ? fuzzyScore(queryStr, notes) * 0.7 : 0;
+        score = Math.max(titleScore, descScore, notesScore);
+      } else {
+        const text = fieldText(t, options.field);
+        score = text ? fuzzyScore(queryStr, text) : 0;
+      }
 
       if (score > 0) {
         scored.push({
@@ -479,6 +664,7 @@ export async function findTasks(
           depends: t.depends ?? [],
           size: t.size ?? undefined,
           severity: t.severity ?? undefined,
+          ...blockFields(t),
           score: Math.round(score),
         });
       }
@@ -487,10 +673,14 @@ export async function findTasks(
     results = scored.sort((a, b) => b.score - a.score);
   }
 
+  if (options.sort === 'priority') {
+    results = [...results].sort(compareByPriority);
+  }
+
   const total = results.length;
 
-  // Apply pagination
-  const limit = options.limit ?? 20;
+  // Apply pagination; `limit: 0` returns every match, as in coreTaskList.
+  const limit = options.limit === 0 ? Number.POSITIVE_INFINITY : (options.limit ?? 20);
   const offset = options.offset ?? 0;
   results = results.slice(offset, offset + limit);
 
@@ -543,6 +733,16 @@ export async function taskFind(
     label?: string;
     /** Filter by parent task ID — see {@link FindTasksOptions.parent}. @task T10108 */
     parent?: string;
+    /** Result ordering — see {@link FindTasksOptions.sort}. */
+    sort?: string;
+    /** Field to match — see {@link FindTasksOptions.field}. */
+    field?: string;
+    /** Treat the query as a regex — see {@link FindTasksOptions.regex}. */
+    regex?: boolean;
+    /** Filter by hierarchy type — see {@link FindTasksOptions.type}. */
+    type?: string;
+    /** `--where` expressions — see {@link FindTasksOptions.where}. */
+    where?: string[];
   },
 ): Promise<EngineResult<{ results: (MinimalTaskRecord | TaskRecord)[]; total: number }>> {
   try {
@@ -560,6 +760,11 @@ export async function taskFind(
         urgent: options?.urgent,
         label: options?.label,
         parent: options?.parent,
+        sort: options?.sort as TaskSortKey | undefined,
+        field: options?.field as FindField | undefined,
+        regex: options?.regex,
+        type: options?.type as TaskType | undefined,
+        where: options?.where,
       },
       projectRoot,
       accessor,
@@ -587,6 +792,8 @@ export async function taskFind(
       // `cleo find --urgent` see the second urgency axis without a follow-up
       // `cleo show` per row.
       ...(r.severity != null ? { severity: r.severity } : {}),
+      ...(r.blockedBy ? { blockedBy: r.blockedBy } : {}),
+      ...(r.blockedUntil ? { blockedUntil: r.blockedUntil } : {}),
     }));
 
     return engineSuccess({ results, total: findResult.total });
@@ -0,0 +1,210 @@
+/**
+ * Dependency-graph export — renders the task `depends` graph as Graphviz DOT
+ * or Mermaid so it can be piped into `dot -Tsvg` or pasted into Markdown.
+ *
+ * Scope is the whole project by default, or the full subtree of a single
+ * saga / epic. Edges are drawn dependency → dependent and only edges whose
+ * both endpoints are in scope are emitted. Nodes are labelled
+ * `ID: short title` and styled by status (done / active / blocked / pending).
+ *
+ * Used by `cleo graph export` and the `tasks.graph` query (MCP `cleo_tasks_graph`),
+ * which return the same payload.
+ */
+
+import type { Task, TasksGraphParams, TasksGraphResult } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { isSagaShape } from '../sagas/enforcement.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import type { TreeEdge, TreeNode } from './tree-render.js';
+
+/** Output formats supported by {@link exportDependencyGraph}. */
+export type DependencyGraphFormat = 'dot' | 'mermaid';
+
+/** Options for {@link exportDependencyGraph}. */
+export interface DependencyGraphExportOptions {
+  /** Output format. Defaults to `'dot'`. */
+  format?: DependencyGraphFormat;
+  /** Restrict the graph to this saga and everything beneath it. */
+  sagaId?: string;
+  /** Restrict the graph to this epic and everything beneath it. */
+  epicId?: string;
+}
+
+/** Result of {@link exportDependencyGraph}. */
+export interface DependencyGraphExport {
+  format: DependencyGraphFormat;
+  /** Root of the scoped subtree, or `null` for the whole project. */
+  scopeId: string | null;
+  nodes: TreeNode[];
+  edges: TreeEdge[];
+  /** Rendered DOT / Mermaid source. */
+  rendered: string;
+}
+
+/** Maximum title length rendered inside a node label. */
+const LABEL_TITLE_MAX = 40;
+
+/** Visual style per status bucket: fill colour, border colour, DOT shape. */
+const STATUS_STYLES: Record<string, { fill: string; stroke: string; shape: string }> = {
+  done: { fill: '#c8e6c9', stroke: '#2e7d32', shape: 'box' },
+  active: { fill: '#fff59d', stroke: '#f9a825', shape: 'box' },
+  blocked: { fill: '#ffcdd2', stroke: '#c62828', shape: 'octagon' },
+  cancelled: { fill: '#eeeeee', stroke: '#9e9e9e', shape: 'box' },
+  pending: { fill: '#ffffff', stroke: '#616161', shape: 'box' },
+};
+
+/** Map a task status onto one of the {@link STATUS_STYLES} buckets. */
+function styleBucket(status: string): string {
+  return status in STATUS_STYLES ? status : 'pending';
+}
+
+/** Truncate a title for use inside a node label. */
+function shortTitle(title: string): string {
+  return title.length > LABEL_TITLE_MAX ? `${title.slice(0, LABEL_TITLE_MAX - 1)}…` : title;
+}
+
+/**
+ * Build scoped nodes and dep → dependent edges from a task list.
+ *
+ * Archived tasks are dropped; `depends` entries pointing outside the set are
+ * trimmed so renderers never reference an undeclared node.
+ *
+ * @param tasks - Tasks in scope.
+ */
+export function buildDependencyGraph(tasks: Task[]): { nodes: TreeNode[]; edges: TreeEdge[] } {
+  const live = tasks.filter((t) => t.status !== 'archived');
+  const ids = new Set(live.map((t) => t.id));
+  const nodes = live.map((t) => ({
+    id: t.id,
+    title: t.title,
+    status: t.status,
+    depends: (t.depends ?? []).filter((d) => ids.has(d)),
+  }));
+  const edges = nodes.flatMap((n) => n.depends.map((d) => ({ from: d, to: n.id })));
+  return { nodes, edges };
+}
+
+/**
+ * Render a dependency graph as a Graphviz `digraph`.
+ *
+ * @param nodes - Graph nodes.
+ * @param edges - Graph edges (dependency → dependent).
+ */
+export function renderDependencyGraphDot(nodes: TreeNode[], edges: TreeEdge[]): string {
+  const lines: string[] = ['digraph dependencies {', '  rankdir=LR;', '  node [style=filled];'];
+  for (const n of nodes) {
+    const style = STATUS_STYLES[styleBucket(n.status)];
+    const label = `${n.id}: ${shortTitle(n.title)}\\n(${n.status})`.replace(/"/g, '\\"');
+    lines.push(
+      `  ${n.id} [label="${label}", shape=${style.shape}, fillcolor="${style.fill}", color="${style.stroke}"];`,
+    );
+  }
+  for (const e of edges) {
+    lines.push(`  ${e.from} -> ${e.to};`);
+  }
+  lines.push('}');
+  return lines.join('\n');
+}
+
+/**
+ * Render a dependency graph as a Mermaid `graph LR` block with one
+ * `classDef` per status bucket.
+ *
+ * @param nodes - Graph nodes.
+ * @param edges - Graph edges (dependency → dependent).
+ */
+export function renderDependencyGraphMermaid(nodes: TreeNode[], edges: TreeEdge[]): string {
+  const lines: string[] = ['graph LR'];
+  for (const n of nodes) {
+    // Escape quotes and brackets in titles for Mermaid safety
+    const safeTitle = shortTitle(n.title).replace(/"/g, "'").replace(/[[\]]/g, '');
+    const label = `${n.id}: ${safeTitle} (${n.status})`;
+    lines.push(
+      styleBucket(n.status) === 'blocked' ? `  ${n.id}{{"${label}"}}` : `  ${n.id}["${label}"]`,
+    );
+  }
+  for (const e of edges) {
+    lines.push(`  ${e.from} --> ${e.to}`);
+  }
+  const used = new Set(nodes.map((n) => styleBucket(n.status)));
+  for (const [bucket, style] of Object.entries(STATUS_STYLES)) {
+    if (!used.has(bucket)) continue;
+    lines.push(`  classDef ${bucket} fill:${style.fill},stroke:${style.stroke};`);
+    const members = nodes.filter((n) => styleBucket(n.status) === bucket).map((n) => n.id);
+    lines.push(`  class ${members.join(',')} ${bucket};`);
+  }
+  return lines.join('\n');
+}
+
+/**
+ * Export the project's dependency graph, optionally scoped to a saga or epic.
+ *
+ * @param projectRoot - Project root used to open the task store.
+ * @param options - Format and scope.
+ * @throws CleoError `VALIDATION_ERROR` when both `sagaId` and `epicId` are set,
+ *   or when `sagaId` does not name a saga.
+ * @throws CleoError `NOT_FOUND` when the scope root does not exist.
+ */
+export async function exportDependencyGraph(
+  projectRoot: string,
+  options: DependencyGraphExportOptions = {},
+): Promise<DependencyGraphExport> {
+  const format = options.format ?? 'dot';
+  if (options.sagaId && options.epicId) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'Specify at most one of --saga or --epic', {
+      fix: 'cleo graph export --saga <id>  OR  cleo graph export --epic <id>',
+    });
+  }
+
+  const accessor = await getTaskAccessor(projectRoot);
+  const scopeId = options.sagaId ?? options.epicId ?? null;
+  let tasks: Task[];
+  if (scopeId) {
+    const root = await accessor.loadSingleTask(scopeId);
+    if (!root) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${scopeId}`, {
+        fix: `cleo find "${scopeId}"`,
+      });
+    }
+    if (options.sagaId && !isSagaShape(root)) {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `Task ${scopeId} is not a saga`, {
+        fix: `cleo graph export --epic ${scopeId}`,
+        details: { field: 'sagaId', actual: root.type ?? null },
+      });
+    }
+    // Saga membership is parent_id containment (T10637), so a saga's subtree
+    // covers its epics and everything beneath them.
+    tasks = await accessor.getSubtree(scopeId);
+  } else {
+    tasks = (await accessor.queryTasks({})).tasks;
+  }
+
+  const { nodes, edges } = buildDependencyGraph(tasks);
+  const rendered =
+    format === 'mermaid'
+      ? renderDependencyGraphMermaid(nodes, edges)
+      : renderDependencyGraphDot(nodes, edges);
+
+  return { format, scopeId, nodes, edges, rendered };
+}
+
+/**
+ * `tasks.graph` — the dependency graph as an EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Optional saga / epic scope and render format
+ * @returns EngineResult with the same payload as `cleo graph export --json`
+ */
+export async function taskGraph(
+  projectRoot: string,
+  params: TasksGraphParams = {},
+): Promise<EngineResult<TasksGraphResult>> {
+  try {
+    return engineSuccess(await exportDependencyGraph(projectRoot, params));
+  } catch (err) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to build dependency graph');
+  }
+}
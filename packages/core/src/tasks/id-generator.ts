@@ -3,8 +3,13 @@
  *
  * Generates unique task IDs in the T#### format used by CLEO.
  * Ensures uniqueness across active and archived tasks.
+ *
+ * Tasks under a saga with an ID prefix are numbered `<PREFIX>-<n>` instead
+ * (see `allocatePrefixedTaskId`); the helpers here accept both forms.
  */
 
+import { PREFIXED_TASK_ID_PATTERN } from '@cleocode/contracts';
+
 /**
  * Task ID pattern: T followed by 3+ digits
  */
@@ -43,11 +48,18 @@ export function generateNextIdFromSet(existingIds: Set<string>): string {
   return `T${padded}`;
 }
 
+/**
+ * Whether `id` is a saga-prefixed task ID (`AUTH-1`), as opposed to a global `T<n>`.
+ */
+export function isPrefixedTaskId(id: string): boolean {
+  return PREFIXED_TASK_ID_PATTERN.test(id);
+}
+
 /**
  * Validate that a task ID matches the expected format
  */
 export function isValidTaskId(id: string): boolean {
-  return TASK_ID_PATTERN.test(id);
+  return TASK_ID_PATTERN.test(id) || isPrefixedTaskId(id);
 }
 
 /**
@@ -55,12 +67,15 @@ export function isValidTaskId(id: string): boolean {
  *
  * Accepts various loose formats (lowercase prefix, bare digits,
  * underscore-suffixed descriptors) and returns the canonical form,
- * or null if the input cannot be parsed as a task ID.
+ * or null if the input cannot be parsed as a task ID. Saga-prefixed IDs
+ * (`auth-7`) are uppercased (`AUTH-7`).
  */
 export function normalizeTaskId(input: unknown): string | null {
   if (typeof input !== 'string') return null;
   const trimmed = input.trim();
   if (trimmed === '') return null;
+  const prefixed = trimmed.toUpperCase();
+  if (isPrefixedTaskId(prefixed)) return prefixed;
   const match = trimmed.match(/^[Tt]?(\d+)(?:_.*)?$/);
   if (!match) return null;
   return `T${match[1]}`;
@@ -0,0 +1,102 @@
+/**
+ * Saga ID prefixes — `cleo saga create --prefix AUTH` numbers the saga's
+ * tasks `AUTH-1`, `AUTH-2`, … instead of the global `T<n>`.
+ *
+ * The prefix lives on the saga row (`idPrefix`, unique across sagas) and
+ * each prefix has its own counter (see `allocatePrefixedTaskId`). A task
+ * takes the prefix of the nearest saga above it at creation time; its ID
+ * never changes afterwards, so reparenting leaves existing IDs alone.
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { ExitCode, TASK_ID_PREFIX_PATTERN, TASK_STATUSES } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import { allocateNextTaskId, allocatePrefixedTaskId } from '../sequence/index.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+
+/**
+ * Normalise an ID prefix for storage.
+ *
+ * @param value - Prefix as typed; case-insensitive, a trailing `-` is dropped.
+ * @returns The uppercase prefix.
+ * @throws CleoError `INVALID_INPUT` unless it is 2–10 letters.
+ */
+export function normalizeIdPrefix(value: string): string {
+  const prefix = value.trim().replace(/-$/, '').toUpperCase();
+  if (!TASK_ID_PREFIX_PATTERN.test(prefix)) {
+    throw new CleoError(ExitCode.INVALID_INPUT, `Invalid ID prefix: '${value}'`, {
+      fix: 'Use 2-10 letters, e.g. --prefix AUTH',
+      details: { field: 'idPrefix', expected: TASK_ID_PREFIX_PATTERN.source, actual: value },
+    });
+  }
+  return prefix;
+}
+
+/**
+ * Reject a prefix another saga already uses — archived and trashed sagas
+ * included, since their rows still hold the prefix — so an ID handed out
+ * under one saga can never be claimed by another.
+ *
+ * @throws CleoError `ALREADY_EXISTS` naming the saga that owns the prefix.
+ */
+export async function assertIdPrefixAvailable(
+  prefix: string,
+  accessor: DataAccessor,
+): Promise<void> {
+  const [live, trashed] = await Promise.all([
+    accessor.queryTasks({ type: 'saga', status: [...TASK_STATUSES] }),
+    accessor.queryTasks({ type: 'saga', trashed: true }),
+  ]);
+  const owner = [...live.tasks, ...trashed.tasks].find((t) => t.idPrefix === prefix);
+  if (owner) {
+    const where = owner.deletedAt ? ' (in the trash)' : '';
+    throw new CleoError(
+      ExitCode.ALREADY_EXISTS,
+      `ID prefix ${prefix} is already used by saga ${owner.id}${where}`,
+      {
+        fix: owner.deletedAt
+          ? `Pick a different --prefix, or purge the saga first: cleo delete ${owner.id} --purge`
+          : 'Pick a different --prefix',
+        details: { field: 'idPrefix', actual: prefix, sagaId: owner.id },
+      },
+    );
+  }
+}
+
+/**
+ * ID prefix a new task under `parent` is numbered with: the prefix of the
+ * nearest saga at or above the parent, or `null` for a global `T<n>` ID.
+ */
+export async function resolveIdPrefix(
+  parent: Task | null,
+  accessor: DataAccessor,
+): Promise<string | null> {
+  if (!parent) return null;
+  if (parent.type === 'saga') return parent.idPrefix ?? null;
+  const ancestors = await accessor.getAncestorChain(parent.id);
+  // Root-first: scan from the parent's own parent up.
+  for (let i = ancestors.length - 1; i >= 0; i--) {
+    const ancestor = ancestors[i];
+    if (ancestor?.type === 'saga') return ancestor.idPrefix ?? null;
+  }
+  return null;
+}
+
+/**
+ * Allocate the ID of a new task under `parent`: the next `<PREFIX>-<n>` below
+ * a prefixed saga (see {@link resolveIdPrefix}), otherwise the next `T<n>`.
+ */
+export async function allocateTaskIdUnder(
+  parent: Task | null,
+  accessor: DataAccessor,
+  cwd?: string,
+): Promise<string> {
+  const prefix = await resolveIdPrefix(parent, accessor);
+  return prefix ? allocatePrefixedTaskId(prefix, cwd) : allocateNextTaskId(cwd);
+}
+
+/** Prefixes of every saga that has one, for scanning text for prefixed IDs. */
+export async function loadIdPrefixes(accessor: DataAccessor): Promise<string[]> {
+  const { tasks } = await accessor.queryTasks({ type: 'saga', status: [...TASK_STATUSES] });
+  return tasks.flatMap((t) => (t.idPrefix ? [t.idPrefix] : []));
+}
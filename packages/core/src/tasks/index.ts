@@ -53,6 +53,7 @@ export {
   type AddBatchOptions,
   type AddBatchResult,
   type AddBatchTaskSpec,
+  addBatchSpecToOptions,
   addBatchTasks,
   tasksAddBatchOp,
 } from './add-batch.js';
@@ -71,9 +72,34 @@ export {
   archiveTasks,
   taskArchive,
 } from './archive.js';
+// Archive browsing and restore (`cleo archive list` / `cleo archive restore`)
+export {
+  listArchived,
+  restoreFromArchive,
+  taskArchiveList,
+  taskArchiveRestore,
+} from './archive-restore.js';
+export { runTaskBatch, tasksBatchOp } from './batch.js';
+// Manual blocking (`tasks.block` / `tasks.unblock`)
+export { blockTask, isBlockHeld, taskBlock, taskUnblock, unblockTask } from './block.js';
+export { BURNDOWN_BUCKETS, bucketBurndown, computeBurndown, taskBurndown } from './burndown.js';
+// Commit links (`tasks.link-commit` / `tasks.commits` / `tasks.commits.scan`)
+export {
+  extractTaskIds,
+  linkTaskCommit,
+  listTaskCommits,
+  normalizeCommitSha,
+  readGitCommits,
+  type ScannedCommit,
+  scanTaskCommits,
+  taskCommits,
+  taskCommitsScan,
+  taskLinkCommit,
+} from './commits.js';
 export {
   type CompleteTaskOptions,
   type CompleteTaskResult,
+  checkStrictCompletionGates,
   completeTask,
   completeTaskStrict,
   taskComplete,
@@ -90,8 +116,26 @@ export {
   buildRollupEvidence,
   isCoordinationParent,
 } from './coordination-parent.js';
+// Project custom fields (`tasks.fields.add` / `tasks.fields.list`, update --set, find --where)
+export {
+  addCustomField,
+  applyCustomAssignments,
+  CUSTOM_FIELDS_META_KEY,
+  coerceCustomValue,
+  listCustomFields,
+  loadCustomFields,
+  parseCustomAssignments,
+  taskFieldsAdd,
+  taskFieldsList,
+} from './custom-fields.js';
 // Wave 4: Complex mutations + strict completion (T1568 / ADR-057 / ADR-058)
-export { type DeleteTaskOptions, type DeleteTaskResult, deleteTask, taskDelete } from './delete.js';
+export {
+  type DeleteTaskOptions,
+  type DeleteTaskResult,
+  deleteTask,
+  taskDelete,
+  taskPurge,
+} from './delete.js';
 // Dep-graph validator (T1857 — orphan / cross-epic gap / stale-dep detection)
 export {
   type DepGraphIssue as CoreDepGraphIssue,
@@ -111,6 +155,7 @@ export {
   type DependencyError,
   type DependencyWarning,
   detectCircularDeps,
+  findDependencyCycle,
   getBlockedTasks,
   getDependentIds,
   getDependents,
@@ -123,6 +168,32 @@ export {
   validateDependencyRefs,
   wouldCreateCycle,
 } from './dependency-check.js';
+export {
+  assertDependencyEdges,
+  type DependencyEdgeCheck,
+  dependencyCycleError,
+} from './dependency-guard.js';
+export {
+  findOverdueTasks,
+  normalizeDueDate,
+  type OverdueTasksResult,
+  parseRfc3339Date,
+  taskOverdue,
+} from './due.js';
+export {
+  collectEstimatedMembers,
+  isEstimateDone,
+  normalizeEstimate,
+  rollupEstimates,
+  taskEstimateRollup,
+} from './estimate.js';
+export {
+  allocateTaskIdUnder,
+  assertIdPrefixAvailable,
+  loadIdPrefixes,
+  normalizeIdPrefix,
+  resolveIdPrefix,
+} from './id-prefix.js';
 // Engine-layer converter types and functions (T1568 / ADR-057 / ADR-058)
 export {
   type IvtrHistoryEntry,
@@ -150,6 +221,9 @@ export {
   validateAtom,
 } from './evidence.js';
 export {
+  compileFindRegex,
+  FIND_FIELDS,
+  type FindField,
   type FindResult,
   type FindTasksOptions,
   type FindTasksResult,
@@ -170,6 +244,22 @@ export {
   type SignedGateAuditRecord,
   verifyAuditHistory,
 } from './gate-audit.js';
+export {
+  buildDependencyGraph,
+  type DependencyGraphExport,
+  type DependencyGraphExportOptions,
+  type DependencyGraphFormat,
+  exportDependencyGraph,
+  renderDependencyGraphDot,
+  renderDependencyGraphMermaid,
+  taskGraph,
+} from './graph-export.js';
+export {
+  renderDependencyTrace,
+  taskDepsTrace,
+  traceDependencies,
+  traceTaskDependencies,
+} from './dependency-trace.js';
 // Pre-dispatch inference for cleo add (T1490)
 export {
   type InferAddParamsInput,
@@ -178,12 +268,44 @@ export {
   inferTaskAddParams,
   parseAcceptanceCriteria,
 } from './infer-add-params.js';
-export { taskLabelList, taskLabelShow } from './labels.js';
+export {
+  mergeLabels,
+  renameLabel,
+  taskLabelList,
+  taskLabelMerge,
+  taskLabelRename,
+  taskLabelShow,
+} from './labels.js';
+// Structural lint of task data (`cleo lint`)
+export {
+  type LintTaskDataOptions,
+  lintTaskData,
+  lintTaskRecords,
+  type TaskLintFinding,
+  type TaskLintResult,
+} from './lint.js';
 export { type ListTasksOptions, type ListTasksResult, listTasks, taskList } from './list.js';
+// Markdown checklist import (`tasks.import.markdown`)
+export {
+  importMarkdownChecklist,
+  type MarkdownChecklistItem,
+  parseMarkdownChecklist,
+  taskImportMarkdown,
+} from './markdown-import.js';
+// Duplicate folding (`tasks.merge`)
+export { coreTaskMerge, taskMerge } from './merge.js';
+export { coreTaskMove, taskMove } from './move.js';
+// Authored note history (`tasks.note`)
+export { addTaskNote, taskNote } from './note.js';
 // Task Core operation signatures for OpsFromCore inference (T1445)
 export type { tasksCoreOps } from './ops.js';
 export { taskPlan } from './plan.js';
+export { advanceDue, normalizeRecurrence, recurrenceIntervalMs } from './recurrence.js';
+// Contiguous ID compaction (`tasks.renumber`)
+export { renumberTasks, taskRenumber } from './renumber.js';
 export { addTaskWithSessionScope, resolveParentFromSession } from './session-scope.js';
+// Bulk status transition by filter (`tasks.set-status`)
+export { setTaskStatus, taskSetStatus } from './set-status.js';
 // System-wide severity attestation primitive (T9071 / ADR-054 draft)
 export {
   type AppendSeverityAttestationOptions,
@@ -204,8 +326,35 @@ export {
   taskShowOperation,
   taskShowWithHistory,
 } from './show.js';
+export {
+  compareByPriority,
+  compareTaskIds,
+  PRIORITY_RANK,
+  TASK_SORT_KEYS,
+  type TaskSortKey,
+  validateTaskSort,
+} from './sort.js';
+// Task breakdown into children (`tasks.split`)
+export { splitTask, taskSplit } from './split.js';
+// Stale detection (`tasks.stale` / `tasks.stale.reset`)
+export { listStaleTasks, resetStaleTasks, taskStale, taskStaleReset } from './staleness.js';
 // Sync sub-domain (T1568 / ADR-057 / ADR-058) — Wave 3
 export { taskSyncLinks, taskSyncLinksRemove, taskSyncReconcile } from './sync-ops.js';
+// Task lifecycle event bus
+export {
+  onTaskEvent,
+  publishTaskEvent,
+  TASK_EVENT_NAMES,
+  type TaskEvent,
+  type TaskEventListener,
+  type TaskEventName,
+} from './task-events.js';
+export {
+  buildTaskIndex,
+  enableTaskIndexCache,
+  loadTaskIndex,
+  type TaskIndex,
+} from './task-index.js';
 export {
   taskAnalyze,
   taskBatchValidate,
@@ -240,6 +389,16 @@ export {
   taskUnarchive,
   taskUnclaim,
 } from './task-ops.js';
+// Time tracking (`tasks.time.start` / `tasks.time.stop` / `tasks.time`)
+export {
+  getTaskTime,
+  startTaskTimer,
+  stopTaskTimer,
+  sumTimeEntries,
+  taskTime,
+  taskTimeStart,
+  taskTimeStop,
+} from './time.js';
 // Tool result cache + cross-process semaphore (T1534 / ADR-061)
 export {
   cacheEntryPath,
@@ -272,4 +431,45 @@ export {
   resolveMaxConcurrent,
   semaphoreDir,
 } from './tool-semaphore.js';
+// Trash — soft-deleted tasks
+export {
+  emptyTrash,
+  listTrash,
+  restoreFromTrash,
+  taskTrashEmpty,
+  taskTrashList,
+  taskTrashRestore,
+} from './trash.js';
 export { taskUpdate, type UpdateTaskOptions, type UpdateTaskResult, updateTask } from './update.js';
+// Live task change feed (`cleo watch`)
+export {
+  DEFAULT_WATCH_DEBOUNCE_MS,
+  diffTaskSnapshots,
+  loadTaskSnapshot,
+  type TaskSnapshot,
+  type TaskWatchEvent,
+  type WatchTasksOptions,
+  watchTasks,
+} from './watch.js';
+// Webhook emitter for task lifecycle events (`cleo webhook test`)
+export {
+  DEFAULT_WEBHOOK_TIMEOUT_MS,
+  deliverWebhook,
+  enqueueWebhook,
+  flushWebhooks,
+  installWebhookEmitter,
+  sendWebhookTest,
+  WEBHOOK_QUEUE_LIMIT,
+  type WebhookBody,
+  type WebhookDelivery,
+  webhookTest,
+} from './webhook.js';
+// `cleo find --where` expression language
+export {
+  compileWhere,
+  parseWhere,
+  type TaskWherePredicate,
+  WHERE_FIELDS,
+  type WhereNode,
+  type WhereOperator,
+} from './where.js';
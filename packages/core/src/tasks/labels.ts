@@ -4,12 +4,18 @@
  * @epic T4454
  */
 
+import type {
+  TasksLabelMergeParams,
+  TasksLabelMergeResult,
+  TasksLabelRenameParams,
+} from '@cleocode/contracts';
 import { ExitCode } from '@cleocode/contracts';
 import { type EngineResult, engineSuccess } from '../engine-result.js';
 import { CleoError } from '../errors.js';
 import { cleoErrorToEngineResult } from '../errors-to-engine.js';
 import type { DataAccessor } from '../store/data-accessor.js';
 import { getTaskAccessor } from '../store/data-accessor.js';
+import { validateLabels } from './add.js';
 
 interface LabelInfo {
   label: string;
@@ -78,6 +84,92 @@ export async function getLabelStats(
   };
 }
 
+/**
+ * Fold `sources` into `dest` on every task bearing any of them.
+ *
+ * Each source is replaced in place by `dest`, keeping label order, and the
+ * result is de-duplicated so a task that had both a source and `dest` keeps
+ * a single `dest`. Archived and trashed tasks are included. All rewrites
+ * happen in one transaction.
+ *
+ * @throws CleoError `VALIDATION_ERROR` when no source is given or a label is malformed.
+ */
+export async function mergeLabels(
+  sources: string[],
+  dest: string,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksLabelMergeResult> {
+  const target = dest.trim();
+  const from = [...new Set(sources.map((l) => l.trim()))].filter((l) => l !== target);
+  if (sources.length === 0 || !target) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      'At least one source label and a destination are required',
+      {
+        fix: 'cleo labels merge <src...> <dest>',
+        details: { field: sources.length === 0 ? 'sources' : 'dest' },
+      },
+    );
+  }
+  validateLabels([...from, target]);
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  // Archived and trashed tasks are rewritten too, so a restore brings back `dest`.
+  const [live, archived, trashed] = await Promise.all([
+    acc.queryTasks({}),
+    acc.queryTasks({ status: 'archived' }),
+    acc.queryTasks({ trashed: true }),
+  ]);
+  const tasks = [...live.tasks, ...archived.tasks, ...trashed.tasks];
+  const merged = tasks.some((t) => (t.labels ?? []).includes(target));
+  const fromSet = new Set(from);
+  const touched = tasks.filter((t) => (t.labels ?? []).some((l) => fromSet.has(l)));
+
+  if (touched.length > 0) {
+    const now = new Date().toISOString();
+    await acc.transaction(async (tx) => {
+      for (const task of touched) {
+        const labels = [...new Set((task.labels ?? []).map((l) => (fromSet.has(l) ? target : l)))];
+        await tx.upsertSingleTask({ ...task, labels, updatedAt: now });
+      }
+      await tx.appendLog({
+        id: `log-${Math.floor(Date.now() / 1000)}-${(await import('node:crypto')).randomBytes(3).toString('hex')}`,
+        timestamp: now,
+        action: 'labels_merged',
+        taskId: touched[0]!.id,
+        actor: 'system',
+        details: { sources: from, dest: target, taskIds: touched.map((t) => t.id) },
+        before: { labels: from },
+        after: { labels: [target] },
+      });
+    });
+  }
+
+  return {
+    sources: from,
+    dest: target,
+    merged,
+    tasksTouched: touched.length,
+    taskIds: touched.map((t) => t.id),
+  };
+}
+
+/**
+ * Rename a label on every task bearing it.
+ *
+ * When `newLabel` is already in use the two are merged rather than rejected
+ * — see {@link mergeLabels}.
+ */
+export async function renameLabel(
+  oldLabel: string,
+  newLabel: string,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksLabelMergeResult> {
+  return mergeLabels([oldLabel], newLabel, cwd, accessor);
+}
+
 // ---------------------------------------------------------------------------
 // EngineResult-returning wrappers (T1568 / ADR-057 / ADR-058)
 // ---------------------------------------------------------------------------
@@ -128,3 +220,42 @@ export async function taskLabelShow(
     return { success: false, error: { code, message: e?.message ?? 'Failed to list labels' } };
   }
 }
+
+/**
+ * Rename a label across all tasks, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - `from` and `to` labels
+ * @returns EngineResult with the number of tasks touched
+ */
+export async function taskLabelRename(
+  projectRoot: string,
+  params: TasksLabelRenameParams,
+): Promise<EngineResult<TasksLabelMergeResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await renameLabel(params.from, params.to, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to rename label');
+  }
+}
+
+/**
+ * Merge several labels into one across all tasks, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - `sources` labels and the `dest` label
+ * @returns EngineResult with the number of tasks touched
+ */
+export async function taskLabelMerge(
+  projectRoot: string,
+  params: TasksLabelMergeParams,
+): Promise<EngineResult<TasksLabelMergeResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    const result = await mergeLabels(params.sources ?? [], params.dest, projectRoot, accessor);
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to merge labels');
+  }
+}
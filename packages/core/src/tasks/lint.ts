@@ -0,0 +1,219 @@
+/**
+ * Structural lint for task data — the checks behind `cleo lint`.
+ *
+ * Unlike {@link coreTaskLint}, which mixes in style warnings, every finding
+ * here is an invariant violation: a duplicate or missing ID, a reference that
+ * does not resolve, a status outside the enum, a date that does not parse, a
+ * blank label, or a schema version the running binary does not write. All
+ * violations are collected, so one run reports everything that needs fixing.
+ *
+ * The input is either the live task store or a snapshot JSON file (as written
+ * by `cleo snapshot export`), so a committed file can be gated in CI.
+ */
+
+import { readFile } from 'node:fs/promises';
+import { ExitCode, TASK_STATUSES } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import { SNAPSHOT_FORMAT_VERSION } from '../snapshot/index.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+import { getSchemaVersion, SQLITE_SCHEMA_VERSION } from '../store/sqlite.js';
+
+/** Date fields checked by the `valid-date` rule. */
+const DATE_FIELDS = [
+  'createdAt',
+  'updatedAt',
+  'completedAt',
+  'cancelledAt',
+  'due',
+  'blockedUntil',
+] as const;
+
+/** One invariant violation. */
+export interface TaskLintFinding {
+  /** Offending task, or null for file-level findings. */
+  taskId: string | null;
+  /** Rule identifier, e.g. `valid-parent`. */
+  rule: string;
+  /** Offending field, when the finding is about one. */
+  field?: string;
+  message: string;
+}
+
+/** Result of {@link lintTaskData}. */
+export interface TaskLintResult {
+  /** `store` for the task database, otherwise the linted file path. */
+  source: string;
+  /** Schema version recorded by the source, or null when missing. */
+  schemaVersion: string | null;
+  /** Schema version this binary writes. */
+  expectedSchemaVersion: string;
+  taskCount: number;
+  /** Findings in task order, then by rule. */
+  findings: TaskLintFinding[];
+}
+
+/** Options for {@link lintTaskData}. */
+export interface LintTaskDataOptions {
+  /** Lint this snapshot file instead of the task store. */
+  file?: string;
+}
+
+/** Whether `value` is an ISO-like date string that parses. */
+function isParseableDate(value: unknown): boolean {
+  return typeof value === 'string' && value.trim() !== '' && !Number.isNaN(Date.parse(value));
+}
+
+/**
+ * Check task records against the structural invariants. Records are taken as
+ * untyped so that malformed JSON is reported rather than trusted.
+ *
+ * @param records - Task records, as stored or as read from a file.
+ */
+export function lintTaskRecords(records: readonly unknown[]): TaskLintFinding[] {
+  const findings: TaskLintFinding[] = [];
+  const tasks = records.map(
+    (r) => (r && typeof r === 'object' ? r : {}) as Record<string, unknown>,
+  );
+  const ids = new Set<string>();
+  const seen = new Set<string>();
+
+  for (const task of tasks) {
+    if (typeof task.id === 'string' && task.id !== '') ids.add(task.id);
+  }
+
+  tasks.forEach((task, index) => {
+    const taskId = typeof task.id === 'string' && task.id !== '' ? task.id : null;
+    const push = (rule: string, message: string, field?: string): void => {
+      findings.push({ taskId, rule, ...(field && { field }), message });
+    };
+
+    if (taskId === null) {
+      push('required-id', `Task at index ${index} has no id`, 'id');
+    } else if (seen.has(taskId)) {
+      push('unique-id', `Duplicate task ID: ${taskId}`, 'id');
+    } else {
+      seen.add(taskId);
+    }
+
+    if (!(TASK_STATUSES as readonly unknown[]).includes(task.status)) {
+      push(
+        'valid-status',
+        `Invalid status: ${String(task.status)} (expected one of ${TASK_STATUSES.join(', ')})`,
+        'status',
+      );
+    }
+
+    if (task.parentId != null && !ids.has(task.parentId as string)) {
+      push('valid-parent', `Parent task '${String(task.parentId)}' does not exist`, 'parentId');
+    }
+
+    if (task.depends != null && !Array.isArray(task.depends)) {
+      push('valid-dependency', 'depends must be a list of task IDs', 'depends');
+    }
+    for (const depId of Array.isArray(task.depends) ? task.depends : []) {
+      if (!ids.has(depId as string)) {
+        push('valid-dependency', `Dependency '${String(depId)}' does not exist`, 'depends');
+      }
+    }
+
+    for (const field of DATE_FIELDS) {
+      const value = task[field];
+      if (field !== 'createdAt' && value == null) continue;
+      if (!isParseableDate(value)) {
+        push('valid-date', `${field} is not a valid date: ${JSON.stringify(value)}`, field);
+      }
+    }
+
+    if (task.labels != null && !Array.isArray(task.labels)) {
+      push('valid-label', 'labels must be a list of strings', 'labels');
+    }
+    for (const label of Array.isArray(task.labels) ? task.labels : []) {
+      if (typeof label !== 'string' || label.trim() === '') {
+        push('valid-label', `Label must be a non-empty string: ${JSON.stringify(label)}`, 'labels');
+      }
+    }
+  });
+
+  return findings;
+}
+
+/**
+ * Lint the task store, or a snapshot file, for structural invariants.
+ *
+ * The store's schema version is compared with {@link SQLITE_SCHEMA_VERSION};
+ * a file's `_meta.version` with the snapshot format version.
+ *
+ * @throws CleoError `NOT_FOUND` when `options.file` cannot be read;
+ *   `VALIDATION_ERROR` when it is not JSON or has no task list.
+ */
+export async function lintTaskData(
+  options: LintTaskDataOptions = {},
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TaskLintResult> {
+  if (options.file) return lintTaskFile(options.file);
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const { tasks } = await acc.queryTasks({ status: [...TASK_STATUSES] });
+  const schemaVersion = await getSchemaVersion(cwd);
+  const findings = lintTaskRecords(tasks);
+  if (schemaVersion !== SQLITE_SCHEMA_VERSION) findings.unshift(schemaFinding(schemaVersion));
+  return {
+    source: 'store',
+    schemaVersion,
+    expectedSchemaVersion: SQLITE_SCHEMA_VERSION,
+    taskCount: tasks.length,
+    findings,
+  };
+}
+
+/** File-level finding for a schema version mismatch. */
+function schemaFinding(actual: string | null): TaskLintFinding {
+  return {
+    taskId: null,
+    rule: 'schema-version',
+    field: 'schemaVersion',
+    message:
+      actual === null
+        ? 'Schema version is missing'
+        : `Schema version ${actual} does not match this binary`,
+  };
+}
+
+async function lintTaskFile(file: string): Promise<TaskLintResult> {
+  let content: string;
+  try {
+    content = await readFile(file, 'utf-8');
+  } catch {
+    throw new CleoError(ExitCode.NOT_FOUND, `Cannot read ${file}`, {
+      fix: 'Pass a snapshot file written by: cleo snapshot export',
+    });
+  }
+  let parsed: { _meta?: { version?: unknown }; tasks?: unknown };
+  try {
+    parsed = JSON.parse(content) as typeof parsed;
+  } catch (err) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `${file} is not valid JSON: ${err instanceof Error ? err.message : String(err)}`,
+    );
+  }
+  if (!Array.isArray(parsed?.tasks)) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `${file} has no tasks array`, {
+      fix: 'Pass a snapshot file written by: cleo snapshot export',
+      details: { field: 'tasks' },
+    });
+  }
+
+  const version = parsed._meta?.version;
+  const schemaVersion = typeof version === 'string' ? version : null;
+  const findings = lintTaskRecords(parsed.tasks);
+  if (schemaVersion !== SNAPSHOT_FORMAT_VERSION) findings.unshift(schemaFinding(schemaVersion));
+  return {
+    source: file,
+    schemaVersion,
+    expectedSchemaVersion: SNAPSHOT_FORMAT_VERSION,
+    taskCount: parsed.tasks.length,
+    findings,
+  };
+}
@@ -20,6 +20,7 @@ import { resolveSagaMemberIds } from '../sagas/storage.js';
 import type { TaskQueryFilters } from '../store/data-accessor.js';
 import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
 import { tasksToRecords } from './engine-converters.js';
+import { compareByPriority, validateTaskSort } from './sort.js';
 
 // Re-export saga constants for backwards-compat (T10123).
 // Test fixtures and external consumers historically imported these from
@@ -76,7 +77,8 @@ export interface ListTasksOptions {
   excludeArchived?: boolean;
   /**
    * When `true`, order results by priority (critical → high → medium → low)
-   * instead of the default position-based order.
+   * instead of the default position-based order, ties broken by task ID.
+   * Also backs `cleo list --sort priority`.
    *
    * @remarks
    * T948: preserves the historic priority-first ordering of Studio's
@@ -175,7 +177,11 @@ export async function listTasks(
     const memberSet = new Set(sagaMemberIds);
     const sagaFiltered = queryResult.tasks
       .filter((t) => memberSet.has(t.id))
-      .sort((a, b) => (memberOrder.get(a.id) ?? 0) - (memberOrder.get(b.id) ?? 0));
+      .sort(
+        options.sortByPriority
+          ? compareByPriority
+          : (a, b) => (memberOrder.get(a.id) ?? 0) - (memberOrder.get(b.id) ?? 0),
+      );
     filtered = sagaFiltered;
     filteredCount = sagaFiltered.length;
   } else {
@@ -247,6 +253,8 @@ export async function taskList(
     limit?: number;
     offset?: number;
     compact?: boolean;
+    /** Result ordering; `priority` → critical first, ties by ID. */
+    sort?: string;
   },
 ): Promise<
   EngineResult<{
@@ -257,6 +265,7 @@ export async function taskList(
   }>
 > {
   try {
+    if (params?.sort !== undefined) validateTaskSort(params.sort);
     const accessor = await getTaskAccessor(projectRoot);
     const result = await listTasks(
       {
@@ -269,6 +278,7 @@ export async function taskList(
         children: params?.children,
         limit: params?.limit,
         offset: params?.offset,
+        sortByPriority: params?.sort === 'priority',
       },
       projectRoot,
       accessor,
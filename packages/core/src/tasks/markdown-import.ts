@@ -0,0 +1,302 @@
+/**
+ * Markdown checklist import — `cleo import markdown <file> [--saga <id>]`.
+ *
+ * Every `- [ ]` / `- [x]` item becomes a task (unchecked → `pending`,
+ * checked → `done`). Indentation nests items: an item's parent is the
+ * nearest item above it with less indentation. The closest heading above a
+ * block becomes a grouping label on each of its items, and trailing
+ * `@label` / `#priority` tokens are lifted off the title.
+ *
+ * Hierarchy types follow the parent: items under `--saga` are epics, their
+ * children tasks, then subtasks. Without `--saga` top-level items are tasks
+ * and their children subtasks. Like `coreTaskImport`, this is a data
+ * movement path: rows are written directly, so creation-time acceptance and
+ * session gates do not apply. All rows go in one transaction.
+ */
+
+import { randomBytes } from 'node:crypto';
+import { readFileSync } from 'node:fs';
+import { resolve } from 'node:path';
+import type {
+  Task,
+  TaskPriority,
+  TasksImportMarkdownItem,
+  TasksImportMarkdownParams,
+  TasksImportMarkdownResult,
+  TaskType,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { resolveOrCwd } from '../paths.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { VALID_PRIORITIES, validateLabels } from './add.js';
+import { allocateTaskIdUnder } from './id-prefix.js';
+import { resolveDefaultPipelineStage } from './pipeline-stage.js';
+
+const CHECKLIST_RE = /^(\s*)[-*+]\s+\[([ xX])\]\s+(.*)$/;
+const HEADING_RE = /^#{1,6}\s+(.+?)\s*#*\s*$/;
+
+/** Child type for each parent type; a subtask cannot hold children. */
+const CHILD_TYPE: Partial<Record<TaskType, TaskType>> = {
+  saga: 'epic',
+  epic: 'task',
+  task: 'subtask',
+};
+
+/** One checklist item parsed from Markdown, before any task is written. */
+export interface MarkdownChecklistItem {
+  /** 1-based source line number. */
+  line: number;
+  title: string;
+  checked: boolean;
+  /** Index of the enclosing item in the parsed list, or `null` at top level. */
+  parentIndex: number | null;
+  priority?: TaskPriority;
+  labels: string[];
+}
+
+/** Slugify a heading into a label (`## Phase 1: Setup` → `phase-1-setup`). */
+function headingLabel(heading: string): string | null {
+  const slug = heading
+    .toLowerCase()
+    .replace(/[^a-z0-9.]+/g, '-')
+    .replace(/^[^a-z]+/, '')
+    .replace(/-+$/, '');
+  return slug || null;
+}
+
+/** Width of leading whitespace, counting a tab as four columns. */
+function indentWidth(ws: string): number {
+  return ws.replace(/\t/g, '    ').length;
+}
+
+/**
+ * Parse checklist items out of Markdown text.
+ *
+ * Trailing `@label` tokens become labels and a trailing `#low|medium|high|critical`
+ * token sets the priority; any other trailing `#token` (e.g. `#42`) stays in
+ * the title. A heading ends the nesting of the block above it.
+ *
+ * @throws CleoError `VALIDATION_ERROR` when an item has an invalid label or
+ *   no title once tokens are removed.
+ */
+export function parseMarkdownChecklist(text: string): MarkdownChecklistItem[] {
+  const items: MarkdownChecklistItem[] = [];
+  let heading: string | null = null;
+  let stack: Array<{ indent: number; index: number }> = [];
+
+  text.split(/\r?\n/).forEach((raw, i) => {
+    const headingMatch = HEADING_RE.exec(raw);
+    if (headingMatch) {
+      heading = headingLabel(headingMatch[1] ?? '');
+      stack = [];
+      return;
+    }
+    const match = CHECKLIST_RE.exec(raw);
+    if (!match) return;
+
+    const line = i + 1;
+    const indent = indentWidth(match[1] ?? '');
+    const words = (match[3] ?? '').trim().split(/\s+/);
+    const labels: string[] = [];
+    let priority: TaskPriority | undefined;
+    while (words.length > 1) {
+      const token = words[words.length - 1] ?? '';
+      if (token.startsWith('@') && token.length > 1) {
+        labels.unshift(token.slice(1).toLowerCase());
+      } else if (
+        token.startsWith('#') &&
+        !priority &&
+        VALID_PRIORITIES.includes(token.slice(1).toLowerCase() as TaskPriority)
+      ) {
+        priority = token.slice(1).toLowerCase() as TaskPriority;
+      } else {
+        break;
+      }
+      words.pop();
+    }
+    const title = words.join(' ');
+    if (!title) {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `Line ${line}: checklist item has no title`, {
+        fix: 'Give every "- [ ]" item some text',
+        details: { field: 'title', actual: raw, line },
+      });
+    }
+    if (heading && !labels.includes(heading)) labels.unshift(heading);
+    try {
+      validateLabels(labels);
+    } catch (err) {
+      if (err instanceof CleoError) {
+        throw new CleoError(ExitCode.VALIDATION_ERROR, `Line ${line}: ${err.message}`, {
+          fix: err.fix,
+          details: { field: 'labels', actual: labels, line },
+        });
+      }
+      throw err;
+    }
+
+    while (stack.length > 0 && (stack[stack.length - 1]?.indent ?? 0) >= indent) stack.pop();
+    const parentIndex = stack[stack.length - 1]?.index ?? null;
+    stack.push({ indent, index: items.length });
+    items.push({ line, title, checked: match[2] !== ' ', parentIndex, priority, labels });
+  });
+
+  return items;
+}
+
+/**
+ * Import a Markdown checklist file as tasks.
+ *
+ * @throws CleoError `NOT_FOUND` when the file or saga does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when `--saga` is not a saga, the file
+ *   has no checklist items, or nesting goes deeper than the type ladder.
+ */
+export async function importMarkdownChecklist(
+  options: TasksImportMarkdownParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksImportMarkdownResult> {
+  const root = resolveOrCwd(cwd);
+  const path = resolve(root, options.file ?? '');
+  let text: string;
+  try {
+    text = readFileSync(path, 'utf-8');
+  } catch {
+    throw new CleoError(ExitCode.NOT_FOUND, `Markdown file not found: ${options.file}`, {
+      fix: 'Pass a path relative to the project root, e.g. cleo import markdown docs/plan.md',
+      details: { field: 'file', actual: options.file },
+    });
+  }
+
+  const parsed = parseMarkdownChecklist(text);
+  if (parsed.length === 0) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `No checklist items in ${options.file}`, {
+      fix: 'Items must look like "- [ ] Title" or "- [x] Title"',
+      details: { field: 'file', expected: '- [ ] / - [x] items', actual: options.file },
+    });
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  let saga: Task | null = null;
+  if (options.saga) {
+    saga = await acc.loadSingleTask(options.saga);
+    if (!saga) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${options.saga}`, {
+        fix: 'cleo saga list',
+      });
+    }
+    if (saga.type !== 'saga') {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `${saga.id} is a ${saga.type}, not a saga`, {
+        fix: 'Pass a saga ID to --saga, or omit it',
+        details: { field: 'saga', expected: 'saga', actual: saga.type },
+      });
+    }
+  }
+
+  // Resolve every item's type before writing so a too-deep block fails cleanly.
+  const types: TaskType[] = [];
+  for (const item of parsed) {
+    const parentType: TaskType | undefined =
+      item.parentIndex !== null ? types[item.parentIndex] : saga ? 'saga' : undefined;
+    const type = parentType ? CHILD_TYPE[parentType] : 'task';
+    if (!type) {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `Line ${item.line} is nested too deeply: a subtask cannot have children`,
+        {
+          fix: 'Outdent the item, or import under --saga to gain a level',
+          details: { field: 'line', actual: item.line },
+        },
+      );
+    }
+    types.push(type);
+  }
+
+  const items: TasksImportMarkdownItem[] = parsed.map((item, i) => ({
+    line: item.line,
+    title: item.title,
+    taskId: null,
+    parentLine: item.parentIndex !== null ? (parsed[item.parentIndex]?.line ?? null) : null,
+    parentId: item.parentIndex === null ? (saga?.id ?? null) : null,
+    type: types[i] ?? 'task',
+    status: item.checked ? 'done' : 'pending',
+    priority: item.priority ?? 'medium',
+    labels: item.labels,
+  }));
+
+  if (options.dryRun) {
+    return { file: options.file, dryRun: true, created: 0, items };
+  }
+
+  const created: Task[] = [];
+  await acc.transaction(async (tx) => {
+    for (const [i, item] of items.entries()) {
+      const parentIndex = parsed[i]?.parentIndex ?? null;
+      const parent = parentIndex !== null ? created[parentIndex] : saga;
+      const parentId = parent?.id ?? null;
+      const now = new Date().toISOString();
+      const task: Task = {
+        id: await allocateTaskIdUnder(parent ?? null, acc, cwd),
+        title: item.title,
+        description: '',
+        status: item.status,
+        priority: item.priority,
+        type: item.type,
+        parentId,
+        position: await acc.getNextPosition(parentId),
+        positionVersion: 0,
+        size: 'medium',
+        pipelineStage: resolveDefaultPipelineStage({
+          taskType: item.type,
+          parentTask: parent ? { pipelineStage: parent.pipelineStage, type: parent.type } : null,
+        }),
+        createdAt: now,
+        updatedAt: now,
+        ...(item.labels.length ? { labels: item.labels } : {}),
+        ...(item.status === 'done' ? { completedAt: now } : {}),
+      };
+      await tx.upsertSingleTask(task);
+      await tx.appendLog({
+        id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+        timestamp: now,
+        action: 'task_created',
+        taskId: task.id,
+        actor: 'system',
+        details: { title: task.title, status: task.status, source: options.file, line: item.line },
+        before: null,
+        after: { title: task.title, status: task.status, priority: task.priority },
+      });
+      created.push(task);
+      item.taskId = task.id;
+      item.parentId = parentId;
+    }
+  });
+
+  return { file: options.file, dryRun: false, created: created.length, items };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrapper
+// ---------------------------------------------------------------------------
+
+/**
+ * Import a Markdown checklist, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - File path, optional saga, and dry-run flag
+ * @returns EngineResult with the source line → task mapping
+ */
+export async function taskImportMarkdown(
+  projectRoot: string,
+  params: TasksImportMarkdownParams,
+): Promise<EngineResult<TasksImportMarkdownResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await importMarkdownChecklist(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to import Markdown checklist');
+  }
+}
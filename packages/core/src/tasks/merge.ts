@@ -0,0 +1,214 @@
+/**
+ * Fold duplicate tasks into one — `cleo tasks merge <keep-id> <dup-id...>`.
+ *
+ * The kept task takes the union of the duplicates' labels and dependencies,
+ * their notes and note history (history re-sorted by timestamp), and their
+ * subtasks. Every `depends_on` edge that pointed at a duplicate is
+ * redirected to the kept task. An edge between the kept task and a
+ * duplicate (or between two duplicates) would become a self-loop, so it is
+ * dropped and reported instead. The duplicates are then moved to the trash,
+ * where `cleo trash restore` can still bring them back.
+ *
+ * Subtasks move through {@link coreTaskReparent}, so the type matrix, depth,
+ * and sibling limits apply as they do for `cleo reparent`. The reparents,
+ * the rewrites, and the trash moves share one transaction: a step that fails
+ * part-way (e.g. a sibling limit on the second duplicate's subtasks) rolls
+ * the whole merge back.
+ */
+
+import { randomBytes } from 'node:crypto';
+import type {
+  Task,
+  TaskNoteEntry,
+  TasksMergeDroppedEdge,
+  TasksMergeParams,
+  TasksMergeResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { deleteTask } from './delete.js';
+import { findDependencyCycle } from './dependency-check.js';
+import { dependencyCycleError } from './dependency-guard.js';
+import { taskToRecord } from './engine-converters.js';
+import { publishTaskEvent } from './task-events.js';
+import { coreTaskReparent } from './task-reparent.js';
+
+/** Append `items` to `into`, skipping values already present. */
+function union(into: readonly string[], items: readonly string[]): string[] {
+  const out = [...into];
+  for (const item of items) if (!out.includes(item)) out.push(item);
+  return out;
+}
+
+/**
+ * Merge `duplicates` into `taskId`.
+ *
+ * @throws CleoError `INVALID_INPUT` when no duplicates are given or the kept
+ *   task is listed among them.
+ * @throws CleoError `NOT_FOUND` when the kept task or a duplicate does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when a task is already in the trash or
+ *   the kept task lies inside a duplicate's subtree.
+ * @throws CleoError `CIRCULAR_REFERENCE` when the redirected edges would form
+ *   a dependency cycle through the kept task.
+ */
+export async function coreTaskMerge(
+  projectRoot: string,
+  params: TasksMergeParams,
+): Promise<TasksMergeResult> {
+  const dupIds = [...new Set(params.duplicates ?? [])];
+  if (dupIds.length === 0 || dupIds.includes(params.taskId)) {
+    throw new CleoError(
+      ExitCode.INVALID_INPUT,
+      dupIds.length === 0
+        ? 'Pass at least one duplicate task ID'
+        : `${params.taskId} cannot be merged into itself`,
+      {
+        fix: `cleo tasks merge ${params.taskId} <dup-id...>`,
+        details: { field: 'duplicates', actual: params.duplicates },
+      },
+    );
+  }
+
+  const accessor = await getTaskAccessor(projectRoot);
+  const keep = await accessor.loadSingleTask(params.taskId);
+  if (!keep) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${params.taskId}`, {
+      fix: `cleo find "${params.taskId}"`,
+    });
+  }
+  const dups = await accessor.loadTasks(dupIds);
+  const missing = dupIds.filter((id) => !dups.some((t) => t.id === id));
+  if (missing.length > 0) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${missing.join(', ')}`, {
+      fix: `cleo find "${missing[0]}"`,
+    });
+  }
+  const trashed = [keep, ...dups].filter((t) => t.deletedAt).map((t) => t.id);
+  if (trashed.length > 0) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Already in the trash: ${trashed.join(', ')}`, {
+      fix: `cleo trash restore ${trashed[0]}`,
+    });
+  }
+  for (const dup of dups) {
+    if ((await accessor.getSubtree(dup.id)).some((t) => t.id === keep.id)) {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `${keep.id} is inside the subtree of duplicate ${dup.id}`,
+        { fix: `Keep ${dup.id} instead: cleo tasks merge ${dup.id} ${keep.id}` },
+      );
+    }
+  }
+
+  // Plan the dependency rewrite and refuse any cycle before touching rows.
+  const dupSet = new Set(dupIds);
+  const merged = new Set([keep.id, ...dupIds]);
+  const droppedEdges: TasksMergeDroppedEdge[] = [];
+  let depends: string[] = [];
+  for (const source of [keep, ...dups]) {
+    for (const dep of source.depends ?? []) {
+      if (merged.has(dep)) droppedEdges.push({ from: source.id, to: dep });
+      else depends = union(depends, [dep]);
+    }
+  }
+  const dependents = new Map<string, Task>();
+  for (const id of dupIds) {
+    for (const t of await accessor.getDependents(id)) {
+      if (!merged.has(t.id)) dependents.set(t.id, t);
+    }
+  }
+  const redirect = (deps: readonly string[]): string[] =>
+    union([], deps.map((d) => (dupSet.has(d) ? keep.id : d)));
+
+  const { tasks: all } = await accessor.queryTasks({});
+  const projected = all
+    .filter((t) => !dupSet.has(t.id))
+    .map((t) => (dependents.has(t.id) ? { ...t, depends: redirect(t.depends ?? []) } : t));
+  const cycle = findDependencyCycle(keep.id, depends, projected);
+  if (cycle.length > 0) throw dependencyCycleError(cycle);
+
+  const reparented: string[] = [];
+  await accessor.transaction(async (tx) => {
+    for (const dup of dups) {
+      for (const child of await accessor.getChildren(dup.id)) {
+        await coreTaskReparent(projectRoot, child.id, keep.id);
+        if (!dupSet.has(child.id)) reparented.push(child.id);
+      }
+    }
+
+    const fresh = (await accessor.loadSingleTask(keep.id)) ?? keep;
+    const sources = [fresh, ...(await accessor.loadTasks(dupIds))];
+    const noteHistory: TaskNoteEntry[] = sources
+      .flatMap((t) => t.noteHistory ?? [])
+      .sort((a, b) => a.at.localeCompare(b.at));
+    const now = new Date().toISOString();
+
+    await tx.upsertSingleTask({
+      ...fresh,
+      labels: sources.reduce<string[]>((acc, t) => union(acc, t.labels ?? []), []),
+      notes: sources.flatMap((t) => t.notes ?? []),
+      noteHistory,
+      depends,
+      updatedAt: now,
+    });
+    for (const dependent of dependents.values()) {
+      await tx.upsertSingleTask({
+        ...dependent,
+        depends: redirect(dependent.depends ?? []),
+        updatedAt: now,
+      });
+    }
+    // The duplicates' edges now live on the kept task.
+    for (const dup of sources.slice(1)) {
+      if ((dup.depends ?? []).length > 0) {
+        await tx.upsertSingleTask({ ...dup, depends: [], updatedAt: now });
+      }
+    }
+    await tx.appendLog({
+      id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+      timestamp: now,
+      action: 'task_merged',
+      taskId: keep.id,
+      actor: 'system',
+      details: { merged: dupIds, redirected: [...dependents.keys()], reparented, droppedEdges },
+      before: { labels: keep.labels ?? [], depends: keep.depends ?? [] },
+      after: { merged: dupIds },
+    });
+
+    for (const id of dupIds) {
+      await deleteTask({ taskId: id }, projectRoot, accessor);
+    }
+  });
+
+  const task = (await accessor.loadSingleTask(keep.id)) ?? keep;
+  return {
+    task: taskToRecord(task),
+    merged: dupIds,
+    redirected: [...dependents.keys()],
+    reparented,
+    droppedEdges,
+  };
+}
+
+/**
+ * Merge duplicate tasks into one, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Kept task ID and the duplicate IDs
+ * @returns EngineResult with the merged task
+ */
+export async function taskMerge(
+  projectRoot: string,
+  params: TasksMergeParams,
+): Promise<EngineResult<TasksMergeResult>> {
+  try {
+    const result = await coreTaskMerge(projectRoot, params);
+    publishTaskEvent(projectRoot, 'task.updated', result.task);
+    for (const id of result.merged) publishTaskEvent(projectRoot, 'task.deleted', { id });
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to merge tasks');
+  }
+}
@@ -0,0 +1,167 @@
+/**
+ * Move a task (or its whole subtree) to a different saga or epic.
+ *
+ * `tasks.move` is the mis-filing fix: it keeps the task's ID and history,
+ * validates the target, and refuses to silently turn in-saga dependency
+ * edges into cross-saga ones. The parent rewrite itself goes through
+ * {@link coreTaskReparent}, so the type matrix, depth, and sibling limits
+ * apply exactly as they do for `cleo reparent`.
+ */
+
+import type {
+  Task,
+  TasksMoveCrossSagaDependency,
+  TasksMoveParams,
+  TasksMoveResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { publishTaskEvents } from './task-events.js';
+import { coreTaskReparent } from './task-reparent.js';
+
+/** Find the saga containing `taskId` (the task itself when it is a saga). */
+function sagaOf(taskId: string, byId: ReadonlyMap<string, Task>): string | null {
+  const seen = new Set<string>();
+  let current = byId.get(taskId);
+  while (current && !seen.has(current.id)) {
+    if (current.type === 'saga') return current.id;
+    seen.add(current.id);
+    current = current.parentId ? byId.get(current.parentId) : undefined;
+  }
+  return null;
+}
+
+/**
+ * Move a task under a saga or epic.
+ *
+ * Without `recursive`, only the task moves: its direct children are
+ * re-attached to the task's old parent. With `recursive`, the whole subtree
+ * moves. A dependency edge between a moved task and an unmoved one that is
+ * in the same saga today but would cross sagas after the move is rejected
+ * unless `force` is set.
+ *
+ * @throws CleoError `NOT_FOUND` when the task or target does not exist.
+ * @throws CleoError `INVALID_PARENT_TYPE` when the target is not the requested type.
+ * @throws CleoError `DEPENDENCY_ERROR` for cross-saga dependencies without `force`.
+ */
+export async function coreTaskMove(
+  projectRoot: string,
+  params: TasksMoveParams,
+): Promise<TasksMoveResult> {
+  const targetId = params.toSaga ?? params.toEpic;
+  if (!targetId || (params.toSaga && params.toEpic)) {
+    throw new CleoError(ExitCode.INVALID_INPUT, 'Pass exactly one of --to-saga or --to-epic', {
+      fix: `cleo tasks move ${params.taskId} --to-saga <id> | --to-epic <id>`,
+    });
+  }
+  const targetType = params.toSaga ? 'saga' : 'epic';
+
+  const accessor = await getTaskAccessor(projectRoot);
+  const task = await accessor.loadSingleTask(params.taskId);
+  if (!task) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${params.taskId}`, {
+      fix: `cleo find "${params.taskId}"`,
+    });
+  }
+  const target = await accessor.loadSingleTask(targetId);
+  if (!target) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Target ${targetType} not found: ${targetId}`, {
+      fix: targetType === 'saga' ? 'cleo saga list' : 'cleo list --type epic',
+    });
+  }
+  if (target.type !== targetType) {
+    throw new CleoError(
+      ExitCode.INVALID_PARENT_TYPE,
+      `${targetId} is a ${target.type ?? 'task'}, not a ${targetType}`,
+      {
+        fix: `Use --to-${target.type === 'saga' ? 'saga' : 'epic'} ${targetId}`,
+        details: { field: `to-${targetType}`, expected: targetType, actual: target.type },
+      },
+    );
+  }
+
+  const { tasks: all } = await accessor.queryTasks({});
+  const byId = new Map(all.map((t) => [t.id, t]));
+  const children = all.filter((t) => t.parentId === task.id);
+  const moved = params.recursive
+    ? (await accessor.getSubtree(task.id)).map((t) => t.id)
+    : [task.id];
+  const movedSet = new Set(moved);
+
+  const targetSaga = sagaOf(target.id, byId);
+  const crossSaga: TasksMoveCrossSagaDependency[] = [];
+  const check = (movedId: string, otherId: string, from: string, to: string): void => {
+    if (movedSet.has(otherId)) return;
+    const otherSaga = sagaOf(otherId, byId);
+    if (otherSaga === sagaOf(movedId, byId) && otherSaga !== targetSaga) {
+      crossSaga.push({ from, to, saga: otherSaga });
+    }
+  };
+  for (const t of all) {
+    for (const dep of t.depends ?? []) {
+      if (movedSet.has(t.id)) check(t.id, dep, t.id, dep);
+      else if (movedSet.has(dep)) check(dep, t.id, t.id, dep);
+    }
+  }
+  if (crossSaga.length > 0 && !params.force) {
+    throw new CleoError(
+      ExitCode.DEPENDENCY_ERROR,
+      `Move would leave ${crossSaga.length} dependency edge(s) crossing saga boundaries`,
+      {
+        fix: 'Move the dependencies too (--recursive), remove them, or re-run with --force',
+        details: { crossSagaDependencies: crossSaga },
+      },
+    );
+  }
+
+  // Move first (the subtree travels with it), then hand the direct children
+  // back to the old parent. Every reparent runs in one transaction, so a
+  // child the old parent rejects (e.g. the sibling limit) rolls the whole
+  // move back instead of leaving only some children re-attached.
+  const fromParent = task.parentId ?? null;
+  const detached: string[] = [];
+  await accessor.transaction(async () => {
+    await coreTaskReparent(projectRoot, task.id, target.id);
+    if (!params.recursive) {
+      for (const child of children) {
+        await coreTaskReparent(projectRoot, child.id, fromParent);
+        detached.push(child.id);
+      }
+    }
+  });
+
+  return {
+    moved,
+    fromParent,
+    toParent: target.id,
+    detached,
+    crossSagaDependencies: crossSaga,
+  };
+}
+
+/**
+ * Move a task under a saga or epic, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID, exactly one of `toSaga` / `toEpic`, and `recursive` / `force`
+ * @returns EngineResult with the moved task IDs
+ */
+export async function taskMove(
+  projectRoot: string,
+  params: TasksMoveParams,
+): Promise<EngineResult<TasksMoveResult>> {
+  try {
+    const result = await coreTaskMove(projectRoot, params);
+    await publishTaskEvents(
+      projectRoot,
+      [...result.moved, ...result.detached].map((id) => ({ event: 'task.updated' as const, id })),
+      await getTaskAccessor(projectRoot),
+    );
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to move task');
+  }
+}
@@ -0,0 +1,93 @@
+/**
+ * Authored note history — `cleo tasks note <id> --text ... [--author ...]`.
+ *
+ * Each call appends one `{ at, author, text }` entry to `noteHistory`.
+ * Existing entries are never edited or removed, so when several agents
+ * touch a task the history keeps the sequence of observations rather than
+ * just the latest description.
+ */
+
+import { randomBytes } from 'node:crypto';
+import type { TaskNoteEntry, TasksNoteParams, TasksNoteResult } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+
+/**
+ * Append a note to a task's history.
+ *
+ * The new entry is added after the existing ones; nothing already in the
+ * history is rewritten.
+ *
+ * @throws CleoError `VALIDATION_ERROR` when the text is empty.
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ */
+export async function addTaskNote(
+  options: TasksNoteParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksNoteResult> {
+  const text = options.text?.trim();
+  if (!text) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'Note text is required', {
+      fix: `cleo tasks note ${options.taskId} --text "what you observed"`,
+      details: { field: 'text', expected: 'non-empty string' },
+    });
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await acc.loadSingleTask(options.taskId);
+  if (!task) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${options.taskId}`, {
+      fix: `cleo find "${options.taskId}"`,
+    });
+  }
+
+  const note: TaskNoteEntry = {
+    at: new Date().toISOString(),
+    author: options.author?.trim() || process.env['CLEO_AGENT_ID'] || 'cleo',
+    text,
+  };
+  const history = [...(task.noteHistory ?? []), note];
+  await acc.transaction(async (tx) => {
+    await tx.updateTaskFields(task.id, { noteHistoryJson: JSON.stringify(history) });
+    await tx.appendLog({
+      id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+      timestamp: note.at,
+      action: 'task_noted',
+      taskId: task.id,
+      actor: note.author,
+      details: { author: note.author, length: text.length },
+      before: null,
+      after: { noteCount: history.length },
+    });
+  });
+
+  return { taskId: task.id, note, count: history.length };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrapper
+// ---------------------------------------------------------------------------
+
+/**
+ * Append a task note, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID, note text, and optional author
+ * @returns EngineResult with the appended entry
+ */
+export async function taskNote(
+  projectRoot: string,
+  params: TasksNoteParams,
+): Promise<EngineResult<TasksNoteResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await addTaskNote(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to add task note');
+  }
+}
@@ -193,6 +193,14 @@ export async function tasksAddOp(
     kind?: TaskKind;
     scope?: TaskScope;
     severity?: TaskSeverity;
+    /** Due date (RFC 3339 full-date or date-time). */
+    due?: string;
+    /** Recurrence rule shorthand (`7d`, `@weekly`) or JSON `{ every }`. */
+    recurrence?: string;
+    /** Effort estimate (story points or hours). */
+    estimate?: number;
+    /** Orchestration track. */
+    track?: string;
     /**
      * Bypass the BRAIN duplicate-detection rejection guard (T1633).
      * Audited to `.cleo/audit/duplicate-bypass.jsonl`.
@@ -219,6 +227,10 @@ export async function tasksAddOp(
       kind: params.kind,
       scope: params.scope,
       severity: params.severity,
+      due: params.due,
+      recurrence: params.recurrence,
+      estimate: params.estimate,
+      track: params.track,
       forceDuplicate: params.forceDuplicate,
     },
     projectRoot,
@@ -287,6 +299,14 @@ export async function tasksUpdateOp(
     scope?: TaskScope;
     /** Severity level — valid for any role (T9073). Orthogonal to priority. */
     severity?: TaskSeverity;
+    /** Due date (RFC 3339); an empty string clears it. */
+    due?: string;
+    /** Recurrence rule shorthand; `none` stops the chain. */
+    recurrence?: string;
+    /** Effort estimate; `null` clears it. */
+    estimate?: number | null;
+    /** Orchestration track; `null` clears it. */
+    track?: string | null;
     /** Clear the blockedBy free-text reason. @task T9241 */
     clearBlockedBy?: boolean;
   },
@@ -317,6 +337,10 @@ export async function tasksUpdateOp(
       kind: params.kind,
       scope: params.scope,
       severity: params.severity,
+      due: params.due,
+      recurrence: params.recurrence,
+      estimate: params.estimate,
+      track: params.track,
       clearBlockedBy: params.clearBlockedBy,
     },
     projectRoot,
@@ -453,10 +477,22 @@ export declare const tasksCoreOps: {
   readonly history: TaskCoreOperation<'history'>;
   readonly current: TaskCoreOperation<'current'>;
   readonly 'label.list': TaskCoreOperation<'label.list'>;
+  readonly 'trash.list': TaskCoreOperation<'trash.list'>;
+  readonly 'archive.list': TaskCoreOperation<'archive.list'>;
+  readonly overdue: TaskCoreOperation<'overdue'>;
+  readonly stale: TaskCoreOperation<'stale'>;
+  readonly commits: TaskCoreOperation<'commits'>;
+  readonly time: TaskCoreOperation<'time'>;
+  readonly 'fields.list': TaskCoreOperation<'fields.list'>;
+  readonly 'estimate.rollup': TaskCoreOperation<'estimate.rollup'>;
+  readonly burndown: TaskCoreOperation<'burndown'>;
+  readonly graph: TaskCoreOperation<'graph'>;
+  readonly 'deps.trace': TaskCoreOperation<'deps.trace'>;
   readonly 'sync.links': TaskCoreOperation<'sync.links'>;
   // Mutate ops
   readonly add: TaskCoreOperation<'add'>;
   readonly 'add-batch': TaskCoreOperation<'add-batch'>;
+  readonly batch: TaskCoreOperation<'batch'>;
   readonly update: TaskCoreOperation<'update'>;
   readonly complete: TaskCoreOperation<'complete'>;
   readonly cancel: TaskCoreOperation<'cancel'>;
@@ -464,6 +500,28 @@ export declare const tasksCoreOps: {
   readonly archive: TaskCoreOperation<'archive'>;
   readonly restore: TaskCoreOperation<'restore'>;
   readonly reparent: TaskCoreOperation<'reparent'>;
+  readonly move: TaskCoreOperation<'move'>;
+  readonly merge: TaskCoreOperation<'merge'>;
+  readonly split: TaskCoreOperation<'split'>;
+  readonly 'trash.restore': TaskCoreOperation<'trash.restore'>;
+  readonly 'trash.empty': TaskCoreOperation<'trash.empty'>;
+  readonly 'archive.restore': TaskCoreOperation<'archive.restore'>;
+  readonly 'stale.reset': TaskCoreOperation<'stale.reset'>;
+  readonly block: TaskCoreOperation<'block'>;
+  readonly unblock: TaskCoreOperation<'unblock'>;
+  readonly note: TaskCoreOperation<'note'>;
+  readonly 'link-commit': TaskCoreOperation<'link-commit'>;
+  readonly 'commits.scan': TaskCoreOperation<'commits.scan'>;
+  readonly 'fields.add': TaskCoreOperation<'fields.add'>;
+  readonly 'import.markdown': TaskCoreOperation<'import.markdown'>;
+  readonly renumber: TaskCoreOperation<'renumber'>;
+  readonly undo: TaskCoreOperation<'undo'>;
+  readonly redo: TaskCoreOperation<'redo'>;
+  readonly 'set-status': TaskCoreOperation<'set-status'>;
+  readonly 'time.start': TaskCoreOperation<'time.start'>;
+  readonly 'time.stop': TaskCoreOperation<'time.stop'>;
+  readonly 'label.rename': TaskCoreOperation<'label.rename'>;
+  readonly 'label.merge': TaskCoreOperation<'label.merge'>;
   readonly reorder: TaskCoreOperation<'reorder'>;
   // T11786 (epic T11556) — bulk task mutate ops Studio's Kanban binds to.
   readonly 'reorder-rank': TaskCoreOperation<'reorder-rank'>;
@@ -162,6 +162,13 @@ function calculateEpicCompletion(
 export async function coreTaskPlan(projectRoot: string): Promise<PlanResult> {
   const allTasks = await loadAllTasks(projectRoot);
   const taskMap = new Map(allTasks.map((t) => [t.id, t]));
+  // Dependencies resolve project-wide, so a blocker that has since been
+  // archived (in this saga or another) still counts as met.
+  const archive = await (await getTaskAccessor(projectRoot)).loadArchive();
+  const depLookup = new Map<string, TaskRecord>(taskMap);
+  for (const t of archive?.archivedTasks ?? []) {
+    if (!t.deletedAt && !depLookup.has(t.id)) depLookup.set(t.id, t);
+  }
   const currentPhase = await getCurrentPhase(projectRoot);
 
   // ========================================================================
@@ -187,7 +194,7 @@ export async function coreTaskPlan(projectRoot: string): Promise<PlanResult> {
   const pendingTasks = allTasks.filter((t) => t.status === 'pending');
 
   for (const task of pendingTasks) {
-    if (depsReady(task.depends, taskMap)) {
+    if (depsReady(task.depends, depLookup)) {
       const leverage = calculateLeverage(task.id, taskMap);
       const epicId = findEpicId(task, taskMap);
 
@@ -0,0 +1,108 @@
+/**
+ * Recurring tasks — rule parsing and due-date advancement.
+ *
+ * A task carrying `recurrence: { every }` regenerates when completed: the
+ * next occurrence is a fresh copy (new ID, `pending`, `due` advanced by the
+ * interval) and the completed task records it in `recurredTo`. The copy is
+ * created by `completeTask`.
+ */
+
+import type { TaskRecurrence } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+
+const MINUTE_MS = 60_000;
+const DAY_MS = 24 * 60 * MINUTE_MS;
+
+const UNIT_MS: Readonly<Record<string, number>> = {
+  m: MINUTE_MS,
+  h: 60 * MINUTE_MS,
+  d: DAY_MS,
+  w: 7 * DAY_MS,
+};
+
+/** Cron-style shorthands accepted for `every`. */
+const EVERY_ALIASES: Readonly<Record<string, string>> = {
+  '@hourly': '1h',
+  '@daily': '1d',
+  '@weekly': '1w',
+};
+
+const INTERVAL = /^(-?\d+)\s*([mhdw])$/;
+const FULL_DATE = /^\d{4}-\d{2}-\d{2}$/;
+
+/**
+ * Resolve an `every` value to milliseconds.
+ *
+ * @returns The interval, or `null` when the value is malformed or not
+ *   strictly positive (a zero/negative interval would regenerate forever).
+ */
+export function recurrenceIntervalMs(every: string): number | null {
+  const raw = every.trim().toLowerCase();
+  const match = INTERVAL.exec(EVERY_ALIASES[raw] ?? raw);
+  if (!match) return null;
+  const ms = Number(match[1]) * (UNIT_MS[match[2] as string] as number);
+  return ms > 0 ? ms : null;
+}
+
+/**
+ * Normalise a `--recurrence` value for storage.
+ *
+ * Accepts a rule object, its JSON form (`{"every":"7d"}`), or the bare
+ * interval (`7d`, `@weekly`). `none` or an empty string clears the rule.
+ *
+ * @returns The rule, or `null` to stop the chain.
+ * @throws CleoError `VALIDATION_ERROR` for a malformed or non-positive interval.
+ */
+export function normalizeRecurrence(value: string | TaskRecurrence): TaskRecurrence | null {
+  let every: unknown;
+  if (typeof value === 'string') {
+    const trimmed = value.trim();
+    if (trimmed === '' || trimmed.toLowerCase() === 'none') return null;
+    if (trimmed.startsWith('{')) {
+      try {
+        every = (JSON.parse(trimmed) as { every?: unknown }).every;
+      } catch {
+        every = undefined;
+      }
+    } else {
+      every = trimmed;
+    }
+  } else {
+    every = value.every;
+  }
+
+  if (typeof every === 'string' && recurrenceIntervalMs(every) !== null) {
+    return { every: every.trim().toLowerCase() };
+  }
+  throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid recurrence: ${JSON.stringify(value)}`, {
+    fix: "Use a positive interval: --recurrence 7d | --recurrence '@weekly' | --recurrence none",
+    details: {
+      field: 'recurrence',
+      expected: '<n>m|h|d|w (n > 0), @hourly, @daily, @weekly, or none',
+      actual: value,
+    },
+  });
+}
+
+/**
+ * Advance a due date to the next occurrence after `from` (the completion time).
+ *
+ * The due date moves forward in whole intervals, so the schedule keeps its
+ * day and time: completing a weekly task late skips the occurrences it
+ * missed instead of creating one that is already overdue. A full-date stays
+ * a full-date when the interval is whole days; anything else becomes an
+ * ISO-8601 instant. With no due date the next occurrence is due one interval
+ * after `from`.
+ */
+export function advanceDue(due: string | null | undefined, intervalMs: number, from: Date): string {
+  const baseMs = due ? Date.parse(due) : Number.NaN;
+  const steps = Number.isNaN(baseMs)
+    ? 1
+    : Math.max(1, Math.floor((from.getTime() - baseMs) / intervalMs) + 1);
+  const next = new Date((Number.isNaN(baseMs) ? from.getTime() : baseMs) + steps * intervalMs);
+  if (due && FULL_DATE.test(due) && intervalMs % DAY_MS === 0) {
+    return next.toISOString().slice(0, 10);
+  }
+  return next.toISOString();
+}
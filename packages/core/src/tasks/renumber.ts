@@ -0,0 +1,286 @@
+/**
+ * Task ID compaction — `cleo tasks renumber [--saga <id>] [--start 1] [--dry-run]`.
+ *
+ * Tasks are renumbered in ascending order of their current number, so
+ * relative order is kept: with `--start 1`, `T1 T4 T5 T9` becomes
+ * `T001 T002 T003 T004`. With `--saga` only that saga's subtree moves, and
+ * its new IDs must not be taken by tasks outside it.
+ *
+ * Every reference in the project database is rewritten in the same SQLite
+ * transaction: each column with a foreign key onto `tasks_tasks.id` (parent
+ * links, dependencies, relations, acceptance targets, sessions, commit and
+ * release provenance, ...) is found by schema introspection, plus the
+ * non-FK columns in {@link EXTRA_ID_COLUMNS} and labels that are task IDs.
+ * Foreign keys are deferred to the commit, so a broken edge aborts the whole
+ * renumber. IDs are moved through a temporary name first so that a
+ * permutation never collides with its own not-yet-moved rows. The ID
+ * sequence never moves back, so an ID freed by a purge or by the compaction
+ * itself is not handed out again; it only moves up when a task lands past it.
+ * Prefixed IDs (`AUTH-7`) keep their IDs and their per-prefix counters.
+ *
+ * Mentions of task IDs in free text (titles, notes, commit messages) and in
+ * other databases are not rewritten; use the returned map for those. The
+ * dispatch layer runs this under the project mutate lock.
+ */
+
+import type { TasksRenumberParams, TasksRenumberResult } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { getDb, getNativeDb } from '../store/sqlite.js';
+
+type NativeDb = NonNullable<ReturnType<typeof getNativeDb>>;
+
+const TASK_ID_RE = /^T(\d+)$/;
+
+/** Prefix for the intermediate ID of a task mid-move; never a valid task ID. */
+const TEMP_ID_PREFIX = '~renumber~';
+
+/** Task-ID columns that carry no foreign key, by table. */
+const EXTRA_ID_COLUMNS: Readonly<Record<string, readonly string[]>> = {
+  tasks_tasks: ['recurred_to', 'deleted_parent_id'],
+  tasks_task_labels: ['task_id', 'label'],
+  tasks_audit_log: ['task_id'],
+};
+
+/** Numeric part of a `T<n>` ID. */
+function idNumber(id: string): number {
+  return Number(TASK_ID_RE.exec(id)?.[1] ?? Number.NaN);
+}
+
+/** Format a task number the way the ID allocator does. */
+function formatTaskId(n: number): string {
+  return `T${String(n).padStart(3, '0')}`;
+}
+
+/** Quote an SQL identifier. */
+function ident(name: string): string {
+  return `"${name.replace(/"/g, '""')}"`;
+}
+
+/**
+ * Find every column holding task IDs: foreign keys onto `tasks_tasks.id`
+ * plus the existing {@link EXTRA_ID_COLUMNS}, grouped by table.
+ */
+function taskIdColumns(db: NativeDb): Map<string, string[]> {
+  const out = new Map<string, string[]>();
+  const add = (table: string, column: string): void => {
+    const columns = out.get(table) ?? [];
+    if (!columns.includes(column)) columns.push(column);
+    out.set(table, columns);
+  };
+  add('tasks_tasks', 'id');
+  const tables = db
+    .prepare(`SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'`)
+    .all() as Array<{ name: string }>;
+  for (const { name } of tables) {
+    const fks = db.prepare(`PRAGMA foreign_key_list(${ident(name)})`).all() as Array<{
+      table: string;
+      from: string;
+      to: string | null;
+    }>;
+    for (const fk of fks) {
+      if (fk.table === 'tasks_tasks' && (fk.to ?? 'id') === 'id') add(name, fk.from);
+    }
+    const extra = EXTRA_ID_COLUMNS[name];
+    if (extra) {
+      const existing = new Set(
+        (db.prepare(`PRAGMA table_info(${ident(name)})`).all() as Array<{ name: string }>).map(
+          (c) => c.name,
+        ),
+      );
+      for (const column of extra) if (existing.has(column)) add(name, column);
+    }
+  }
+  return out;
+}
+
+/**
+ * Rewrite every task-ID column through the `_cleo_renumber` map. All of a
+ * table's columns move in one statement, so row-level triggers that relate
+ * two columns (e.g. acceptance `task_id` / `target_task_id`) see a
+ * consistent row.
+ */
+function rewriteColumns(db: NativeDb, columns: Map<string, string[]>): void {
+  for (const [table, cols] of columns) {
+    const t = ident(table);
+    const set = cols
+      .map((c) => {
+        const col = `${t}.${ident(c)}`;
+        const mapped = `(SELECT new FROM _cleo_renumber WHERE old = ${col})`;
+        return `${ident(c)} = COALESCE(${mapped}, ${col})`;
+      })
+      .join(', ');
+    const where = cols
+      .map((c) => `${t}.${ident(c)} IN (SELECT old FROM _cleo_renumber)`)
+      .join(' OR ');
+    db.prepare(`UPDATE ${t} SET ${set} WHERE ${where}`).run();
+  }
+}
+
+/** Rewrite labels that name a moved task in the `labels_json` arrays. */
+function rewriteLabelJson(db: NativeDb, moves: ReadonlyMap<string, string>): void {
+  const rows = db
+    .prepare(`SELECT id, labels_json FROM tasks_tasks WHERE labels_json LIKE '%"T%'`)
+    .all() as Array<{ id: string; labels_json: string | null }>;
+  const update = db.prepare('UPDATE tasks_tasks SET labels_json = ? WHERE id = ?');
+  for (const row of rows) {
+    const labels = JSON.parse(row.labels_json ?? '[]') as string[];
+    if (!labels.some((l) => moves.has(l))) continue;
+    update.run(JSON.stringify(labels.map((l) => moves.get(l) ?? l)), row.id);
+  }
+}
+
+/**
+ * Reassign contiguous task IDs.
+ *
+ * @throws CleoError `INVALID_INPUT` when `start` is not a positive integer.
+ * @throws CleoError `NOT_FOUND` when `saga` does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when `saga` is not a saga, or a new ID
+ *   is held by a task outside the renumbered set.
+ */
+export async function renumberTasks(
+  options: TasksRenumberParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksRenumberResult> {
+  const start = options.start ?? 1;
+  if (!Number.isInteger(start) || start < 1) {
+    throw new CleoError(ExitCode.INVALID_INPUT, `--start must be a positive integer`, {
+      fix: 'cleo tasks renumber --start 1',
+      details: { field: 'start', expected: 'integer >= 1', actual: options.start },
+    });
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  let scope: string[] | null = null;
+  if (options.saga) {
+    const saga = await acc.loadSingleTask(options.saga);
+    if (!saga) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${options.saga}`, {
+        fix: 'cleo saga list',
+      });
+    }
+    if (saga.type !== 'saga') {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `${saga.id} is a ${saga.type}, not a saga`, {
+        fix: 'Pass a saga ID to --saga, or omit it to renumber every task',
+        details: { field: 'saga', expected: 'saga', actual: saga.type },
+      });
+    }
+    scope = (await acc.getSubtree(saga.id)).map((t) => t.id);
+  }
+
+  await getDb(cwd);
+  const db = getNativeDb();
+  if (!db) {
+    throw new CleoError(ExitCode.FILE_ERROR, 'Native database not available for renumbering', {
+      fix: 'cleo doctor --fix',
+      details: { operation: 'tasks.renumber' },
+    });
+  }
+  const allIds = (db.prepare('SELECT id FROM tasks_tasks').all() as Array<{ id: string }>).map(
+    (r) => r.id,
+  );
+  const inScope = new Set(scope ?? allIds);
+  const ordered = [...inScope]
+    .filter((id) => TASK_ID_RE.test(id))
+    .sort((a, b) => idNumber(a) - idNumber(b) || a.localeCompare(b));
+
+  const idMap: Record<string, string> = {};
+  ordered.forEach((id, i) => {
+    idMap[id] = formatTaskId(start + i);
+  });
+  const newIds = new Set(Object.values(idMap));
+  const taken = allIds.filter((id) => !inScope.has(id) && newIds.has(id)).sort();
+  if (taken.length > 0) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `New IDs are already used outside the renumbered set: ${taken.join(', ')}`,
+      {
+        fix: 'Pick a --start past the IDs in use, or renumber without --saga',
+        details: { field: 'start', actual: start, conflicts: taken },
+      },
+    );
+  }
+
+  const moves = new Map(Object.entries(idMap).filter(([from, to]) => from !== to));
+  const result: TasksRenumberResult = {
+    dryRun: options.dryRun === true,
+    saga: options.saga ?? null,
+    start,
+    idMap,
+    changed: moves.size,
+  };
+  if (options.dryRun || moves.size === 0) return result;
+
+  const columns = taskIdColumns(db);
+  db.exec('BEGIN IMMEDIATE');
+  try {
+    // Checked at COMMIT: any edge left pointing at an old ID aborts the lot.
+    db.exec('PRAGMA defer_foreign_keys = ON');
+    db.exec('CREATE TEMP TABLE IF NOT EXISTS _cleo_renumber (old TEXT PRIMARY KEY, new TEXT)');
+    const insert = db.prepare('INSERT INTO _cleo_renumber (old, new) VALUES (?, ?)');
+
+    db.exec('DELETE FROM _cleo_renumber');
+    for (const [from, to] of moves) insert.run(from, `${TEMP_ID_PREFIX}${to}`);
+    rewriteColumns(db, columns);
+
+    db.exec('DELETE FROM _cleo_renumber');
+    for (const to of moves.values()) insert.run(`${TEMP_ID_PREFIX}${to}`, to);
+    rewriteColumns(db, columns);
+    db.exec('DROP TABLE _cleo_renumber');
+
+    rewriteLabelJson(db, moves);
+
+    const stored = db
+      .prepare(
+        `SELECT json_extract(value, '$.counter') AS counter
+         FROM schema_meta WHERE key = 'task_id_sequence'`,
+      )
+      .get() as { counter: number | null } | undefined;
+    const max = allIds.reduce(
+      (m, id) => Math.max(m, idNumber(moves.get(id) ?? id) || 0),
+      Number(stored?.counter) || 0,
+    );
+    db.prepare(
+      `UPDATE schema_meta
+       SET value = json_set(value, '$.counter', ?, '$.lastId', ?, '$.checksum', ?)
+       WHERE key = 'task_id_sequence'`,
+    ).run(max, formatTaskId(max), `renumber-${Date.now()}`);
+    db.exec('COMMIT');
+  } catch (err) {
+    try {
+      db.exec('ROLLBACK');
+    } catch {
+      // ignore — surface the original error
+    }
+    throw err;
+  }
+  return result;
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrapper
+// ---------------------------------------------------------------------------
+
+/**
+ * Renumber task IDs, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Optional saga scope, start number, and dry-run flag
+ * @returns EngineResult with the old → new ID mapping
+ */
+export async function taskRenumber(
+  projectRoot: string,
+  params: TasksRenumberParams,
+): Promise<EngineResult<TasksRenumberResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await renumberTasks(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to renumber tasks');
+  }
+}
@@ -36,6 +36,7 @@ import { getActiveSession } from '../store/session-store.js';
 import { addTask } from './add.js';
 import { taskToRecord } from './engine-converters.js';
 import { findTasks } from './find.js';
+import { publishTaskEvent } from './task-events.js';
 
 /**
  * Resolve the parent task ID through 3 mechanisms in priority order (T090):
@@ -132,6 +133,16 @@ export async function addTaskWithSessionScope(
     kind?: string;
     scope?: string;
     severity?: string;
+    /** Due date (RFC 3339 full-date or date-time). */
+    due?: string;
+    /** Recurrence rule shorthand (`7d`, `@weekly`) or JSON `{ every }`. */
+    recurrence?: string;
+    /** Effort estimate (story points or hours). */
+    estimate?: number;
+    /** Orchestration track. */
+    track?: string;
+    /** Saga only: ID prefix for tasks created under it. */
+    idPrefix?: string;
     /**
      * Bypass the BRAIN duplicate-detection rejection guard (T1633).
      * Audited to `.cleo/audit/duplicate-bypass.jsonl`.
@@ -187,14 +198,23 @@ export async function addTaskWithSessionScope(
         kind: params.kind as TaskKind | undefined,
         scope: params.scope as TaskScope | undefined,
         severity: params.severity as TaskSeverity | undefined,
+        due: params.due,
+        recurrence: params.recurrence,
+        estimate: params.estimate,
+        track: params.track,
+        idPrefix: params.idPrefix,
         forceDuplicate: params.forceDuplicate,
       },
       projectRoot,
       accessor,
     );
 
+    const task = taskToRecord(result.task);
+    if (!params.dryRun && !result.duplicate) {
+      publishTaskEvent(projectRoot, 'task.created', task);
+    }
     return engineSuccess({
-      task: taskToRecord(result.task),
+      task,
       duplicate: result.duplicate ?? false,
       dryRun: params.dryRun,
       ...(result.warnings?.length && { warnings: result.warnings }),
@@ -0,0 +1,302 @@
+/**
+ * Bulk status transition — `cleo tasks set-status <status> --where '<expr>'`.
+ *
+ * Every task matching the filters (`--where` expressions, `--label`,
+ * `--saga`; all must match) moves to the target status in ONE transaction.
+ * A filter is required, so a bare call can never sweep the whole project.
+ *
+ * Moving to `done` or `cancelled` first drops tasks with open children or
+ * open dependencies, which are skipped and reported rather than failing the
+ * batch. Children and dependencies that are themselves part of the batch
+ * count as closed, so a whole wave with its parent closes together.
+ * `--force` skips those two guards only.
+ *
+ * A move to `done` then completes each task through `completeTask` (after
+ * the strict-mode gates, as `tasks.batch` does), children and dependencies
+ * first, so evidence/verification/AC gates, completion requirements,
+ * recurrence, parent auto-completion and dependent unblocking all apply. A
+ * task a gate rejects is skipped with the gate's message. Reopening a done
+ * or cancelled task clears its `completedAt` / `cancelledAt`.
+ * The dispatch layer runs this under the project mutate lock.
+ */
+
+import { randomBytes } from 'node:crypto';
+import type {
+  Task,
+  TaskFieldUpdates,
+  TasksSetStatusParams,
+  TasksSetStatusResult,
+  TasksSetStatusSkip,
+} from '@cleocode/contracts';
+import { ExitCode, TASK_STATUSES, TERMINAL_TASK_STATUSES } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { checkStrictCompletionGates, completeTask } from './complete.js';
+import { loadCustomFields } from './custom-fields.js';
+import { publishTaskEvents } from './task-events.js';
+import { compileWhere, parseWhere } from './where.js';
+
+/** Whether `task` sits below `sagaId`. */
+function isBelow(task: Task, sagaId: string, byId: ReadonlyMap<string, Task>): boolean {
+  const seen = new Set<string>();
+  let current = task.parentId ? byId.get(task.parentId) : undefined;
+  while (current && !seen.has(current.id)) {
+    if (current.id === sagaId) return true;
+    seen.add(current.id);
+    current = current.parentId ? byId.get(current.parentId) : undefined;
+  }
+  return false;
+}
+
+/**
+ * Why `task` may not close, given the tasks closing alongside it, or null.
+ */
+function closeBlocker(
+  task: Task,
+  closing: ReadonlySet<string>,
+  byId: ReadonlyMap<string, Task>,
+  children: ReadonlyMap<string, Task[]>,
+): string | null {
+  const openChildren = (children.get(task.id) ?? [])
+    .filter((c) => !TERMINAL_TASK_STATUSES.has(c.status) && !closing.has(c.id))
+    .map((c) => c.id);
+  if (openChildren.length > 0) return `open children: ${openChildren.join(', ')}`;
+  const openDeps = (task.depends ?? []).filter((id) => {
+    const dep = byId.get(id);
+    return dep !== undefined && !TERMINAL_TASK_STATUSES.has(dep.status) && !closing.has(id);
+  });
+  if (openDeps.length > 0) return `open dependencies: ${openDeps.join(', ')}`;
+  return null;
+}
+
+/** `tasks` reordered so children and dependencies in the set come first. */
+function closingOrder(tasks: readonly Task[]): Task[] {
+  const inSet = new Map(tasks.map((t) => [t.id, t]));
+  const childrenOf = new Map<string, Task[]>();
+  for (const t of tasks) {
+    if (!t.parentId || !inSet.has(t.parentId)) continue;
+    const list = childrenOf.get(t.parentId) ?? [];
+    list.push(t);
+    childrenOf.set(t.parentId, list);
+  }
+  const ordered: Task[] = [];
+  const seen = new Set<string>();
+  const visit = (task: Task): void => {
+    if (seen.has(task.id)) return;
+    seen.add(task.id);
+    for (const child of childrenOf.get(task.id) ?? []) visit(child);
+    for (const id of task.depends ?? []) {
+      const dep = inSet.get(id);
+      if (dep) visit(dep);
+    }
+    ordered.push(task);
+  };
+  for (const t of tasks) visit(t);
+  return ordered;
+}
+
+/**
+ * Complete one task the way `cleo complete` would.
+ *
+ * @returns The rejection message when a gate refuses the task, else null.
+ */
+async function closeAsDone(
+  task: Task,
+  force: boolean,
+  acc: DataAccessor,
+  cwd: string | undefined,
+): Promise<string | null> {
+  if (cwd) {
+    const rejection = await checkStrictCompletionGates(cwd, task.id);
+    if (rejection && !rejection.success) return rejection.error.message;
+  }
+  const override = force ? 'cleo tasks set-status --force' : undefined;
+  try {
+    await completeTask(
+      { taskId: task.id, overrideReason: override, waiveDependsReason: override },
+      cwd,
+      acc,
+    );
+    return null;
+  } catch (err) {
+    if (err instanceof CleoError) return err.message;
+    throw err;
+  }
+}
+
+/**
+ * Move every task matching the filters to `params.status`.
+ *
+ * @returns The transitioned IDs and the matching tasks that were skipped.
+ * @throws CleoError `VALIDATION_ERROR` for an invalid or `archived` status,
+ *   no filter, a malformed `--where`, or a `saga` that is not a saga.
+ * @throws CleoError `NOT_FOUND` when `saga` does not exist.
+ */
+export async function setTaskStatus(
+  params: TasksSetStatusParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksSetStatusResult> {
+  const status = params.status;
+  if (!(TASK_STATUSES as readonly string[]).includes(status) || status === 'archived') {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid status: ${String(status)}`, {
+      fix:
+        status === 'archived'
+          ? 'Archive with: cleo archive'
+          : `Use one of: ${TASK_STATUSES.filter((s) => s !== 'archived').join(', ')}`,
+      details: { field: 'status', actual: status },
+    });
+  }
+  if (!params.where?.length && !params.label && !params.saga) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, 'set-status needs a filter', {
+      fix: "Pass --where '<expression>', --label <name>, or --saga <id>",
+    });
+  }
+
+  const whereTrees = params.where?.map((expression) => parseWhere(expression)) ?? [];
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const { tasks: all } = await acc.queryTasks({});
+  const live = all.filter((t) => t.status !== 'archived');
+  const byId = new Map(live.map((t) => [t.id, t]));
+
+  if (params.saga) {
+    const saga = byId.get(params.saga);
+    if (!saga) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${params.saga}`, {
+        fix: 'cleo saga list',
+      });
+    }
+    if (saga.type !== 'saga') {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `${saga.id} is a ${saga.type ?? 'task'}, not a saga`,
+        { details: { field: 'saga', expected: 'saga', actual: saga.type } },
+      );
+    }
+  }
+  const customFields = whereTrees.length > 0 ? await loadCustomFields(acc) : [];
+  const predicates = whereTrees.map((tree) => compileWhere(tree, customFields));
+
+  const matches = live
+    .filter(
+      (t) =>
+        (!params.saga || isBelow(t, params.saga, byId)) &&
+        (!params.label || (t.labels ?? []).includes(params.label)) &&
+        predicates.every((p) => p(t)),
+    )
+    .sort((a, b) => a.id.localeCompare(b.id, undefined, { numeric: true }));
+
+  const skipped: TasksSetStatusSkip[] = [];
+  let moving = matches.filter((t) => {
+    if (t.status !== status) return true;
+    skipped.push({ taskId: t.id, reason: `already ${status}` });
+    return false;
+  });
+
+  if ((status === 'done' || status === 'cancelled') && !params.force) {
+    const children = new Map<string, Task[]>();
+    for (const t of live) {
+      if (!t.parentId) continue;
+      const list = children.get(t.parentId) ?? [];
+      list.push(t);
+      children.set(t.parentId, list);
+    }
+    const reasons = new Map<string, string>();
+    // Dropping a task can in turn block a parent or dependent that was counting
+    // on it closing, so repeat until no task is dropped.
+    for (let changed = true; changed; ) {
+      changed = false;
+      const closing = new Set(moving.map((t) => t.id));
+      moving = moving.filter((t) => {
+        const reason = closeBlocker(t, closing, byId, children);
+        if (reason === null) return true;
+        reasons.set(t.id, reason);
+        changed = true;
+        return false;
+      });
+    }
+    for (const [taskId, reason] of reasons) skipped.push({ taskId, reason });
+    skipped.sort((a, b) => a.taskId.localeCompare(b.taskId, undefined, { numeric: true }));
+  }
+
+  if (params.dryRun || moving.length === 0) {
+    return {
+      status,
+      dryRun: params.dryRun === true,
+      transitioned: moving.map((t) => t.id),
+      skipped,
+    };
+  }
+
+  const transitioned: string[] = [];
+  const now = new Date().toISOString();
+  await acc.transaction(async (tx) => {
+    if (status === 'done') {
+      for (const task of closingOrder(moving)) {
+        // A parent in the batch may already have rolled up from its children.
+        const current = await acc.loadSingleTask(task.id);
+        const reason =
+          current?.status === 'done'
+            ? null
+            : await closeAsDone(task, params.force === true, acc, cwd);
+        if (reason === null) transitioned.push(task.id);
+        else skipped.push({ taskId: task.id, reason });
+      }
+      return;
+    }
+    for (const task of moving) {
+      const fields: TaskFieldUpdates = { status, updatedAt: now };
+      if (status === 'cancelled') {
+        fields.cancelledAt = now;
+        fields.pipelineStage = 'cancelled';
+      }
+      if (task.status === 'done') fields.completedAt = null;
+      if (task.status === 'cancelled') {
+        fields.cancelledAt = null;
+        fields.cancellationReason = null;
+      }
+      await tx.updateTaskFields(task.id, fields);
+      await tx.appendLog({
+        id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+        timestamp: now,
+        action: 'task_status_set',
+        taskId: task.id,
+        actor: 'system',
+        details: { status, force: params.force === true },
+        before: { status: task.status },
+        after: { status },
+      });
+      transitioned.push(task.id);
+    }
+  });
+
+  const byIdOrder = (a: string, b: string): number =>
+    a.localeCompare(b, undefined, { numeric: true });
+  transitioned.sort(byIdOrder);
+  skipped.sort((a, b) => byIdOrder(a.taskId, b.taskId));
+  return { status, dryRun: false, transitioned, skipped };
+}
+
+/**
+ * EngineResult wrapper for `tasks.set-status`.
+ */
+export async function taskSetStatus(
+  projectRoot: string,
+  params: TasksSetStatusParams,
+): Promise<EngineResult<TasksSetStatusResult>> {
+  try {
+    const result = await setTaskStatus(params, projectRoot);
+    const event = result.status === 'done' ? 'task.completed' : 'task.updated';
+    await publishTaskEvents(
+      projectRoot,
+      result.transitioned.map((id) => ({ event, id })),
+      await getTaskAccessor(projectRoot),
+    );
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to set task status');
+  }
+}
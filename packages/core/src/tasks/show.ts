@@ -33,6 +33,7 @@ import {
   taskToRecord,
   toHistoryEntry,
 } from './engine-converters.js';
+import { isPrefixedTaskId } from './id-generator.js';
 
 /**
  * Hydrated acceptance criterion row surfaced by `cleo show --verbose`.
@@ -80,7 +81,8 @@ export interface TaskDetail extends Task {
 }
 
 /**
- * Canonical task ID format — uppercase `T` followed by one or more digits.
+ * Canonical task ID format — uppercase `T` followed by one or more digits,
+ * or a saga-prefixed `<PREFIX>-<n>` like `AUTH-7` ({@link isPrefixedTaskId}).
  *
  * Dispatch-layer sanitization (`sanitizeTaskId`) normalises loose inputs like
  * `t1234` or bare digits before they reach core, so this defensive check
@@ -112,9 +114,9 @@ export async function showTask(
     });
   }
 
-  if (!CANONICAL_TASK_ID_PATTERN.test(taskId)) {
+  if (!CANONICAL_TASK_ID_PATTERN.test(taskId) && !isPrefixedTaskId(taskId)) {
     throw new CleoError(ExitCode.INVALID_INPUT, `Invalid task ID format: ${taskId}`, {
-      fix: 'Use format T followed by digits (e.g., T1234)',
+      fix: 'Use format T followed by digits (e.g., T1234), or a saga prefix ID (e.g., AUTH-7)',
       details: { field: 'taskId', value: taskId, pattern: '^T\\d+$' },
     });
   }
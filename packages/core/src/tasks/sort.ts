@@ -0,0 +1,58 @@
+/**
+ * Shared `--sort` ordering for `cleo list`, `cleo find`, and
+ * `cleo orchestrate ready`.
+ *
+ * `priority` orders critical → high → medium → low, then by task ID in
+ * natural order (`T9` before `T10`) so ties are stable across runs.
+ */
+
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+
+/** Accepted `--sort` values. */
+export const TASK_SORT_KEYS = ['priority'] as const;
+
+/** A `--sort` value. */
+export type TaskSortKey = (typeof TASK_SORT_KEYS)[number];
+
+/** Priority rank — lower sorts first. Unknown priorities sort last. */
+export const PRIORITY_RANK: Readonly<Record<string, number>> = {
+  critical: 0,
+  high: 1,
+  medium: 2,
+  low: 3,
+};
+
+/** Compare task IDs in natural order: shorter IDs first, then lexically. */
+export function compareTaskIds(a: string, b: string): number {
+  if (a.length !== b.length) return a.length - b.length;
+  return a < b ? -1 : a > b ? 1 : 0;
+}
+
+/** Compare two tasks by priority, then by ID. */
+export function compareByPriority(
+  a: { id: string; priority?: string | null },
+  b: { id: string; priority?: string | null },
+): number {
+  const ra = PRIORITY_RANK[a.priority ?? ''] ?? 4;
+  const rb = PRIORITY_RANK[b.priority ?? ''] ?? 4;
+  return ra - rb || compareTaskIds(a.id, b.id);
+}
+
+/**
+ * Validate a `--sort` value.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for an unknown sort key.
+ */
+export function validateTaskSort(sort: string): asserts sort is TaskSortKey {
+  if (!(TASK_SORT_KEYS as readonly string[]).includes(sort)) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `Invalid sort: ${sort} (must be ${TASK_SORT_KEYS.join('|')})`,
+      {
+        fix: `--sort <${TASK_SORT_KEYS.join('|')}>`,
+        details: { field: 'sort', expected: TASK_SORT_KEYS, actual: sort },
+      },
+    );
+  }
+}
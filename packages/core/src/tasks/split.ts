@@ -0,0 +1,237 @@
+/**
+ * Break a task into subtasks — `cleo tasks split <id> --into "A" --into "B"`.
+ *
+ * The task keeps its ID and place in the tree and becomes a container for
+ * the new children (one level down the type ladder: saga → epic → task →
+ * subtask). Its dependency edges move with the work:
+ *
+ *   - what the parent depended on, every child now depends on;
+ *   - what depended on the parent now depends on every child.
+ *
+ * The parent's estimate is handed to the children — divided evenly unless
+ * `estimates` gives one per child — and cleared on the parent, so the
+ * estimate rollup does not count it twice.
+ *
+ * The parent's status is left as it is. It closes through the completion
+ * rollup in `completeTask` once its last open child completes: epics and
+ * sagas always roll up, and a split task is opted in with
+ * `autoCompleteParent`, since it may still list its own files.
+ *
+ * Like `cleo import markdown`, the children are written directly in one
+ * transaction, so creation-time acceptance and session gates do not apply.
+ */
+
+import { randomBytes } from 'node:crypto';
+import type {
+  Task,
+  TaskType,
+  TasksSplitParams,
+  TasksSplitResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { normalizeEstimate } from './estimate.js';
+import { allocateTaskIdUnder } from './id-prefix.js';
+import { resolveDefaultPipelineStage } from './pipeline-stage.js';
+import { publishTaskEvents } from './task-events.js';
+
+/** Child type for each splittable parent type. */
+const CHILD_TYPE: Partial<Record<TaskType, TaskType>> = {
+  saga: 'epic',
+  epic: 'task',
+  task: 'subtask',
+};
+
+/** Statuses a task can no longer be split from. */
+const CLOSED_STATUSES: ReadonlySet<string> = new Set(['done', 'cancelled', 'archived']);
+
+/** Divide `total` into `n` parts to two decimals; the last part takes the rounding. */
+function divideEvenly(total: number, n: number): number[] {
+  const share = Math.floor((total / n) * 100) / 100;
+  const parts = Array.from({ length: n }, () => share);
+  parts[n - 1] = Math.round((total - share * (n - 1)) * 100) / 100;
+  return parts;
+}
+
+/**
+ * Split a task into child tasks.
+ *
+ * @throws CleoError `INVALID_INPUT` when no titles are given, a title is
+ *   empty, or the estimate count does not match the title count.
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when the task is closed or a subtask.
+ */
+export async function splitTask(
+  options: TasksSplitParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksSplitResult> {
+  const titles = (options.into ?? []).map((t) => t.trim());
+  if (titles.length === 0 || titles.some((t) => t === '')) {
+    throw new CleoError(ExitCode.INVALID_INPUT, 'Pass a non-empty --into title for each child', {
+      fix: `cleo tasks split ${options.taskId} --into "First part" --into "Second part"`,
+      details: { field: 'into', actual: options.into },
+    });
+  }
+  const given = options.estimates?.map((e) => normalizeEstimate(e));
+  if (given && given.length > 0 && given.length !== titles.length) {
+    throw new CleoError(
+      ExitCode.INVALID_INPUT,
+      `Got ${given.length} --estimate value(s) for ${titles.length} --into title(s)`,
+      {
+        fix: 'Pass one --estimate per --into, or none to divide the parent estimate evenly',
+        details: { field: 'estimates', expected: titles.length, actual: given.length },
+      },
+    );
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const parent = await acc.loadSingleTask(options.taskId);
+  if (!parent) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${options.taskId}`, {
+      fix: `cleo find "${options.taskId}"`,
+    });
+  }
+  if (CLOSED_STATUSES.has(parent.status) || parent.deletedAt) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `${parent.id} is ${parent.status}`, {
+      fix: `Reopen it first: cleo update ${parent.id} --status pending`,
+      details: { field: 'status', expected: 'open task', actual: parent.status },
+    });
+  }
+  const childType = CHILD_TYPE[parent.type ?? 'task'];
+  if (!childType) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `${parent.id} is a subtask and cannot have children`,
+      {
+        fix: `Split its parent instead, or promote it: cleo promote ${parent.id}`,
+        details: { field: 'type', expected: 'saga | epic | task', actual: parent.type },
+      },
+    );
+  }
+
+  const estimates: Array<number | null> =
+    given && given.length > 0
+      ? given
+      : parent.estimate != null
+        ? divideEvenly(parent.estimate, titles.length)
+        : titles.map(() => null);
+  const depends = parent.depends ?? [];
+  const dependents = (await acc.getDependents(parent.id)).filter((t) => t.id !== parent.id);
+
+  const created: Task[] = [];
+  const rollsUp = parent.type !== 'epic' && parent.type !== 'saga';
+  await acc.transaction(async (tx) => {
+    const now = new Date().toISOString();
+    for (const [i, title] of titles.entries()) {
+      const estimate = estimates[i] ?? null;
+      const child: Task = {
+        id: await allocateTaskIdUnder(parent, acc, cwd),
+        title,
+        description: '',
+        status: 'pending',
+        priority: parent.priority,
+        type: childType,
+        parentId: parent.id,
+        position: await acc.getNextPosition(parent.id),
+        positionVersion: 0,
+        size: 'medium',
+        pipelineStage: resolveDefaultPipelineStage({
+          taskType: childType,
+          parentTask: { pipelineStage: parent.pipelineStage, type: parent.type },
+        }),
+        createdAt: now,
+        updatedAt: now,
+        ...(parent.labels?.length ? { labels: parent.labels } : {}),
+        ...(depends.length > 0 ? { depends } : {}),
+        ...(estimate !== null ? { estimate } : {}),
+      };
+      await tx.upsertSingleTask(child);
+      await tx.appendLog({
+        id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+        timestamp: now,
+        action: 'task_created',
+        taskId: child.id,
+        actor: 'system',
+        details: { title: child.title, status: child.status, splitFrom: parent.id },
+        before: null,
+        after: { title: child.title, status: child.status, priority: child.priority },
+      });
+      created.push(child);
+    }
+
+    const childIds = created.map((c) => c.id);
+    for (const dependent of dependents) {
+      const next: string[] = [];
+      for (const dep of dependent.depends ?? []) {
+        for (const id of dep === parent.id ? childIds : [dep]) {
+          if (!next.includes(id)) next.push(id);
+        }
+      }
+      await tx.upsertSingleTask({ ...dependent, depends: next, updatedAt: now });
+    }
+
+    await tx.upsertSingleTask({
+      ...parent,
+      depends: [],
+      estimate: null,
+      ...(rollsUp ? { autoCompleteParent: true } : {}),
+      updatedAt: now,
+    });
+    await tx.appendLog({
+      id: `log-${Math.floor(Date.now() / 1000)}-${randomBytes(3).toString('hex')}`,
+      timestamp: now,
+      action: 'task_split',
+      taskId: parent.id,
+      actor: 'system',
+      details: { children: childIds, depends, redirected: dependents.map((t) => t.id) },
+      before: { estimate: parent.estimate ?? null, depends },
+      after: { estimate: null, depends: [] },
+    });
+  });
+
+  return {
+    taskId: parent.id,
+    children: created.map((c) => c.id),
+    status: parent.status,
+    estimates,
+    depends,
+    redirected: dependents.map((t) => t.id),
+  };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrapper
+// ---------------------------------------------------------------------------
+
+/**
+ * Split a task into child tasks, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID, child titles, and optional per-child estimates
+ * @returns EngineResult with the new child IDs
+ */
+export async function taskSplit(
+  projectRoot: string,
+  params: TasksSplitParams,
+): Promise<EngineResult<TasksSplitResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    const result = await splitTask(params, projectRoot, accessor);
+    await publishTaskEvents(
+      projectRoot,
+      [
+        ...result.children.map((id) => ({ event: 'task.created' as const, id })),
+        { event: 'task.updated', id: result.taskId },
+      ],
+      accessor,
+    );
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to split task');
+  }
+}
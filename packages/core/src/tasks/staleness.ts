@@ -6,7 +6,21 @@
  * @task T4529
  */
 
-import type { Task } from '@cleocode/contracts';
+import type {
+  Task,
+  TaskStatus,
+  TasksStaleEntry,
+  TasksStaleParams,
+  TasksStaleResetResult,
+  TasksStaleResult,
+} from '@cleocode/contracts';
+import { ExitCode, TASK_STATUSES, TERMINAL_TASK_STATUSES } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { recurrenceIntervalMs } from './recurrence.js';
 
 /** Staleness thresholds in days. */
 export interface StalenessThresholds {
@@ -134,3 +148,156 @@ export function getStalenessSummary(
 
   return summary;
 }
+
+// ---------------------------------------------------------------------------
+// `cleo stale` — tasks stuck in one status
+// ---------------------------------------------------------------------------
+
+const DEFAULT_STALE_STATUS: TaskStatus = 'active';
+const DEFAULT_STALE_OLDER_THAN = '7d';
+const DAY_MS = 1000 * 60 * 60 * 24;
+
+/**
+ * Resolve the status a stale scan looks at. `in_progress` is accepted as
+ * the conventional name for `active`.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for an unknown or terminal status.
+ */
+function resolveStaleStatus(status: string | undefined): TaskStatus {
+  const raw = status?.trim().toLowerCase() || DEFAULT_STALE_STATUS;
+  const resolved = raw === 'in_progress' || raw === 'in-progress' ? 'active' : raw;
+  if (
+    !(TASK_STATUSES as readonly string[]).includes(resolved) ||
+    TERMINAL_TASK_STATUSES.has(resolved as TaskStatus)
+  ) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid --status for stale: ${status}`, {
+      fix: 'Use a non-terminal status: pending, active (in_progress), blocked, or proposed',
+      details: { field: 'status', expected: 'non-terminal task status', actual: status },
+    });
+  }
+  return resolved as TaskStatus;
+}
+
+/**
+ * Find tasks still in `status` whose last update is older than `olderThan`,
+ * stalest first (ties by ID).
+ *
+ * @param options - `status` (default `active`) and `olderThan` (default `7d`).
+ * @throws CleoError `VALIDATION_ERROR` for a terminal status or malformed `olderThan`.
+ */
+export async function listStaleTasks(
+  options: TasksStaleParams = {},
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksStaleResult> {
+  const status = resolveStaleStatus(options.status);
+  const olderThan = options.olderThan?.trim() || DEFAULT_STALE_OLDER_THAN;
+  const ms = recurrenceIntervalMs(olderThan);
+  if (ms === null) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid --older-than: ${olderThan}`, {
+      fix: 'Use a duration like 7d, 12h, or 2w',
+      details: { field: 'olderThan', expected: '<n>[mhdw]', actual: olderThan },
+    });
+  }
+
+  const now = Date.now();
+  const cutoff = now - ms;
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const { tasks } = await acc.queryTasks({ status });
+
+  const stale: TasksStaleEntry[] = tasks
+    .map((t) => ({ task: t, updatedAt: t.updatedAt || t.createdAt }))
+    .filter(({ updatedAt }) => Date.parse(updatedAt) < cutoff)
+    .sort((a, b) => a.updatedAt.localeCompare(b.updatedAt) || a.task.id.localeCompare(b.task.id))
+    .map(({ task: t, updatedAt }) => ({
+      id: t.id,
+      title: t.title,
+      status: t.status,
+      assignee: t.assignee ?? null,
+      updatedAt,
+      staleDays: Math.floor((now - Date.parse(updatedAt)) / DAY_MS),
+    }));
+
+  return {
+    tasks: stale,
+    total: stale.length,
+    status,
+    olderThan,
+    cutoff: new Date(cutoff).toISOString(),
+  };
+}
+
+/**
+ * Reset stale tasks (per {@link listStaleTasks}) to `pending` and clear
+ * their assignee in one transaction, so abandoned work can be picked up again.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for a terminal status or malformed `olderThan`.
+ */
+export async function resetStaleTasks(
+  options: TasksStaleParams = {},
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksStaleResetResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const result = await listStaleTasks(options, cwd, acc);
+  const reset = result.tasks.map((t) => t.id);
+
+  if (reset.length > 0) {
+    const now = new Date().toISOString();
+    await acc.transaction(async (tx) => {
+      for (const id of reset) {
+        await tx.updateTaskFields(id, { status: 'pending', assignee: null, updatedAt: now });
+      }
+      await tx.appendLog({
+        id: `log-${Math.floor(Date.now() / 1000)}-${(await import('node:crypto')).randomBytes(3).toString('hex')}`,
+        timestamp: now,
+        action: 'stale_reset',
+        taskId: reset[0] as string,
+        actor: 'system',
+        details: { reset, status: result.status, olderThan: result.olderThan },
+        before: { status: result.status },
+        after: { status: 'pending', assignee: null },
+      });
+    });
+  }
+
+  return { ...result, reset };
+}
+
+/**
+ * List stale tasks, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Optional `status` and `olderThan`
+ * @returns EngineResult with the stale tasks, stalest first
+ */
+export async function taskStale(
+  projectRoot: string,
+  params: TasksStaleParams = {},
+): Promise<EngineResult<TasksStaleResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await listStaleTasks(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to list stale tasks');
+  }
+}
+
+/**
+ * Reset stale tasks to pending, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Optional `status` and `olderThan`
+ * @returns EngineResult with the stale tasks and the reset IDs
+ */
+export async function taskStaleReset(
+  projectRoot: string,
+  params: TasksStaleParams = {},
+): Promise<EngineResult<TasksStaleResetResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await resetStaleTasks(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to reset stale tasks');
+  }
+}
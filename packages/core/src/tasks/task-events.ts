@@ -0,0 +1,118 @@
+/**
+ * In-process bus for task lifecycle events.
+ *
+ * The engine wrappers publish one typed event per task after a successful
+ * write: {@link addTaskWithSessionScope}, `taskUpdate`, `taskComplete`,
+ * `taskDelete` / `taskPurge`, `taskArchive`, `taskBlock` / `taskUnblock`,
+ * `taskMerge`, `taskSplit`, `taskSetStatus`, `taskMove`, `taskTrashRestore`,
+ * `taskArchiveRestore` and `tasksBatchOp`. A restore publishes `task.created`
+ * because subscribers last saw the task deleted or archived. Subscribers —
+ * the webhook emitter today — observe the task engine without the mutators
+ * knowing about them.
+ *
+ * Publishing is synchronous and never throws: a listener error is logged and
+ * swallowed so a misbehaving subscriber cannot fail a write that has already
+ * landed. Listeners that do I/O must hand the work off (queue it) rather than
+ * block the publisher.
+ */
+
+import type { TaskRecord } from '@cleocode/contracts';
+import { getLogger } from '../logger.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { taskToRecord } from './engine-converters.js';
+
+/** Task lifecycle events published by the engine wrappers. */
+export const TASK_EVENT_NAMES = [
+  'task.created',
+  'task.updated',
+  'task.completed',
+  'task.deleted',
+  'task.archived',
+] as const;
+
+/** Name of a task lifecycle event. */
+export type TaskEventName = (typeof TASK_EVENT_NAMES)[number];
+
+/** A task lifecycle event as delivered to listeners. */
+export interface TaskEvent {
+  event: TaskEventName;
+  /**
+   * Task state after the write. Archive events carry only the ID — the
+   * archived row is no longer readable through the live accessor.
+   */
+  task: TaskRecord | { id: string };
+  /** ISO 8601 time the event was published. */
+  at: string;
+  /** Project the task belongs to. */
+  projectRoot: string;
+}
+
+/** Callback registered with {@link onTaskEvent}. */
+export type TaskEventListener = (event: TaskEvent) => void;
+
+const listeners = new Set<TaskEventListener>();
+
+/**
+ * Subscribe to task lifecycle events.
+ *
+ * @param listener - Called synchronously for every published event.
+ * @returns A function that removes the listener.
+ */
+export function onTaskEvent(listener: TaskEventListener): () => void {
+  listeners.add(listener);
+  return () => {
+    listeners.delete(listener);
+  };
+}
+
+/**
+ * Publish a task lifecycle event to every listener.
+ *
+ * @param projectRoot - Project the task belongs to.
+ * @param event - Event name.
+ * @param task - Task state after the write.
+ */
+export function publishTaskEvent(
+  projectRoot: string,
+  event: TaskEventName,
+  task: TaskRecord | { id: string },
+): void {
+  if (listeners.size === 0) return;
+  const payload: TaskEvent = { event, task, at: new Date().toISOString(), projectRoot };
+  for (const listener of listeners) {
+    try {
+      listener(payload);
+    } catch (err) {
+      getLogger('task-events').warn({ err, event, taskId: task.id }, 'task event listener failed');
+    }
+  }
+}
+
+/**
+ * Publish events for tasks a write touched, known by ID only.
+ *
+ * Each task is re-read once through `accessor` so the event carries its state
+ * after the write; a task the live accessor no longer returns is published by
+ * ID. Nothing is read when no listener is subscribed, and a failed read is
+ * logged rather than thrown — the write itself has already landed.
+ *
+ * @param projectRoot - Project the tasks belong to.
+ * @param events - Event name and task ID pairs, in publish order.
+ * @param accessor - Accessor the write went through.
+ */
+export async function publishTaskEvents(
+  projectRoot: string,
+  events: ReadonlyArray<{ event: TaskEventName; id: string }>,
+  accessor: DataAccessor,
+): Promise<void> {
+  if (listeners.size === 0 || events.length === 0) return;
+  try {
+    const ids = [...new Set(events.map((e) => e.id))];
+    const byId = new Map((await accessor.loadTasks(ids)).map((t) => [t.id, taskToRecord(t)]));
+    for (const { event, id } of events) {
+      publishTaskEvent(projectRoot, event, byId.get(id) ?? { id });
+    }
+  } catch (err) {
+    getLogger('task-events').warn({ err }, 'failed to load tasks for task events');
+  }
+}
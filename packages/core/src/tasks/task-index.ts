@@ -0,0 +1,114 @@
+/**
+ * In-memory task index — ID, parent, and dependency lookups built once per
+ * command run instead of a scan per question.
+ *
+ * Readiness needs, for every candidate, its dependencies' completion and its
+ * open children. Answering that from the flat task list costs a pass over
+ * the list per lookup; {@link buildTaskIndex} answers every lookup in O(1)
+ * after one O(n + e) build, where e is the number of dependency edges.
+ * `cleo find` does not use it: fuzzy scoring reads every row once and makes
+ * no per-row lookups, so building the index would only add a second pass.
+ *
+ * Short-lived CLI processes build the index once and throw it away. A
+ * resident process (the MCP server) can turn on {@link enableTaskIndexCache}
+ * to keep it between calls; the cached index is reused until the project
+ * database or its WAL changes on disk (mtime or size), so a write from any
+ * process invalidates it.
+ */
+
+import { statSync } from 'node:fs';
+import type { Task } from '@cleocode/contracts';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getDbPath } from '../store/sqlite.js';
+
+/** Lookups over the live task set. Treat every member as read-only. */
+export interface TaskIndex {
+  /** Live tasks: everything except archived and trashed rows. */
+  tasks: readonly Task[];
+  /** Live tasks by ID. */
+  byId: ReadonlyMap<string, Task>;
+  /** Live tasks by parent ID, in task order. */
+  children: ReadonlyMap<string, readonly Task[]>;
+  /** IDs of the live tasks that depend on each task, by the task they depend on. */
+  dependents: ReadonlyMap<string, readonly string[]>;
+  /** Tasks whose dependents are unblocked: done, or archived and not trashed. */
+  completedIds: ReadonlySet<string>;
+}
+
+/**
+ * Index a task set in one pass over its tasks and dependency edges.
+ *
+ * @param tasks - Live tasks, as returned by `queryTasks({})`.
+ * @param archived - Archived tasks; the non-trashed ones count as completed.
+ */
+export function buildTaskIndex(tasks: readonly Task[], archived: readonly Task[] = []): TaskIndex {
+  const byId = new Map<string, Task>();
+  const children = new Map<string, Task[]>();
+  const dependents = new Map<string, string[]>();
+  const completedIds = new Set<string>();
+  for (const task of tasks) {
+    byId.set(task.id, task);
+    if (task.status === 'done') completedIds.add(task.id);
+    if (task.parentId) {
+      const siblings = children.get(task.parentId);
+      if (siblings) siblings.push(task);
+      else children.set(task.parentId, [task]);
+    }
+    for (const dep of task.depends ?? []) {
+      const list = dependents.get(dep);
+      if (list) list.push(task.id);
+      else dependents.set(dep, [task.id]);
+    }
+  }
+  for (const task of archived) {
+    if (!task.deletedAt) completedIds.add(task.id);
+  }
+  return { tasks, byId, children, dependents, completedIds };
+}
+
+/** Cached index per database path, with the file signature it was built at. */
+const cache = new Map<string, { signature: string; index: TaskIndex }>();
+let cacheEnabled = false;
+
+/**
+ * Keep indexes between calls in this process. Meant for resident servers;
+ * a one-shot CLI run gains nothing from it.
+ *
+ * @param enabled - `false` turns caching off and drops cached indexes.
+ */
+export function enableTaskIndexCache(enabled = true): void {
+  cacheEnabled = enabled;
+  if (!enabled) cache.clear();
+}
+
+/** mtime and size of the database and its WAL, or null when the database is not there. */
+function databaseSignature(dbPath: string): string | null {
+  try {
+    const db = statSync(dbPath, { throwIfNoEntry: false });
+    if (!db) return null;
+    const wal = statSync(`${dbPath}-wal`, { throwIfNoEntry: false });
+    return `${db.mtimeMs}:${db.size}|${wal ? `${wal.mtimeMs}:${wal.size}` : '-'}`;
+  } catch {
+    return null;
+  }
+}
+
+/**
+ * Load the live and archived tasks and index them, reusing the cached index
+ * when caching is on and the database has not changed since it was built.
+ *
+ * @param accessor - Task accessor to read through.
+ * @param cwd - Project root, used to locate the database for the cache key.
+ */
+export async function loadTaskIndex(accessor: DataAccessor, cwd?: string): Promise<TaskIndex> {
+  const dbPath = cacheEnabled ? getDbPath(cwd) : null;
+  const signature = dbPath ? databaseSignature(dbPath) : null;
+  const cached = dbPath ? cache.get(dbPath) : undefined;
+  if (cached && signature !== null && cached.signature === signature) return cached.index;
+
+  const { tasks } = await accessor.queryTasks({});
+  const archived = (await accessor.loadArchive())?.archivedTasks ?? [];
+  const index = buildTaskIndex(tasks, archived);
+  if (dbPath && signature !== null) cache.set(dbPath, { signature, index });
+  return index;
+}
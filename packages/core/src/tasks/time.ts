@@ -0,0 +1,192 @@
+/**
+ * Task time tracking — `cleo tasks start`, `cleo tasks stop`, and
+ * `cleo tasks time`.
+ *
+ * Intervals live on the task's `timeEntries` array, oldest first. `start`
+ * appends an open `{ start }` entry and `stop` closes it, so a task has at
+ * most one open interval. Both run under the project mutate lock: two agents
+ * starting the same task at once cannot both see it idle and open
+ * overlapping intervals.
+ */
+
+import type {
+  Task,
+  TaskTimeEntry,
+  TasksTimeParams,
+  TasksTimeResult,
+  TasksTimeStartParams,
+  TasksTimeStartResult,
+  TasksTimeStopParams,
+  TasksTimeStopResult,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { getProjectRoot } from '../paths.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { withMutateLock } from '../store/mutate-lock.js';
+
+/** Whole seconds between two ISO timestamps, never negative. */
+function secondsBetween(start: string, stop: string): number {
+  const ms = Date.parse(stop) - Date.parse(start);
+  return Number.isFinite(ms) && ms > 0 ? Math.floor(ms / 1000) : 0;
+}
+
+/**
+ * Summed duration of `entries` in seconds. An open interval counts up to
+ * `now`.
+ */
+export function sumTimeEntries(
+  entries: readonly TaskTimeEntry[],
+  now: string = new Date().toISOString(),
+): number {
+  return entries.reduce((sum, e) => sum + secondsBetween(e.start, e.stop ?? now), 0);
+}
+
+/** Load a task or throw `NOT_FOUND`. */
+async function requireTask(acc: DataAccessor, taskId: string): Promise<Task> {
+  const task = await acc.loadSingleTask(taskId);
+  if (!task) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not found: ${taskId}`, {
+      fix: `cleo find "${taskId}"`,
+    });
+  }
+  return task;
+}
+
+/**
+ * Open a time interval on a task.
+ *
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when an interval is already open.
+ */
+export async function startTaskTimer(
+  options: TasksTimeStartParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksTimeStartResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  return withMutateLock(getProjectRoot(cwd), async () => {
+    const task = await requireTask(acc, options.taskId);
+    const entries = task.timeEntries ?? [];
+    const open = entries.find((e) => !e.stop);
+    if (open) {
+      throw new CleoError(
+        ExitCode.VALIDATION_ERROR,
+        `Timer already running on ${task.id} (since ${open.start})`,
+        {
+          fix: `cleo tasks stop ${task.id}`,
+          details: { field: 'timeEntries', actual: open.start },
+        },
+      );
+    }
+    const start = new Date().toISOString();
+    await acc.updateTaskFields(task.id, {
+      timeEntriesJson: JSON.stringify([...entries, { start }]),
+    });
+    return { taskId: task.id, start };
+  });
+}
+
+/**
+ * Close the open time interval on a task.
+ *
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ * @throws CleoError `VALIDATION_ERROR` when no interval is open.
+ */
+export async function stopTaskTimer(
+  options: TasksTimeStopParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksTimeStopResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  return withMutateLock(getProjectRoot(cwd), async () => {
+    const task = await requireTask(acc, options.taskId);
+    const entries = task.timeEntries ?? [];
+    const index = entries.findIndex((e) => !e.stop);
+    if (index === -1) {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `No timer running on ${task.id}`, {
+        fix: `cleo tasks start ${task.id}`,
+        details: { field: 'timeEntries' },
+      });
+    }
+    const now = new Date().toISOString();
+    // Clamp to the start so a clock step backwards cannot record a negative span.
+    const start = entries[index]!.start;
+    const entry: TaskTimeEntry = { start, stop: now < start ? start : now };
+    const updated = entries.map((e, i) => (i === index ? entry : e));
+    await acc.updateTaskFields(task.id, { timeEntriesJson: JSON.stringify(updated) });
+    return {
+      taskId: task.id,
+      entry,
+      duration: secondsBetween(entry.start, entry.stop!),
+      timeSpent: sumTimeEntries(updated, now),
+    };
+  });
+}
+
+/**
+ * Tracked intervals on a task and their total.
+ *
+ * @throws CleoError `NOT_FOUND` when the task does not exist.
+ */
+export async function getTaskTime(
+  options: TasksTimeParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksTimeResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await requireTask(acc, options.taskId);
+  const intervals = task.timeEntries ?? [];
+  return {
+    taskId: task.id,
+    timeSpent: sumTimeEntries(intervals),
+    estimate: task.estimate ?? null,
+    running: intervals.some((e) => !e.stop),
+    intervals,
+  };
+}
+
+/**
+ * EngineResult wrapper for `tasks.time.start`.
+ */
+export async function taskTimeStart(
+  projectRoot: string,
+  params: TasksTimeStartParams,
+): Promise<EngineResult<TasksTimeStartResult>> {
+  try {
+    return engineSuccess(await startTaskTimer(params, projectRoot));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to start task timer');
+  }
+}
+
+/**
+ * EngineResult wrapper for `tasks.time.stop`.
+ */
+export async function taskTimeStop(
+  projectRoot: string,
+  params: TasksTimeStopParams,
+): Promise<EngineResult<TasksTimeStopResult>> {
+  try {
+    return engineSuccess(await stopTaskTimer(params, projectRoot));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to stop task timer');
+  }
+}
+
+/**
+ * EngineResult wrapper for `tasks.time`.
+ */
+export async function taskTime(
+  projectRoot: string,
+  params: TasksTimeParams,
+): Promise<EngineResult<TasksTimeResult>> {
+  try {
+    return engineSuccess(await getTaskTime(params, projectRoot));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to read task time');
+  }
+}
@@ -0,0 +1,292 @@
+/**
+ * Task trash — list, restore, and empty soft-deleted tasks.
+ *
+ * `cleo delete` archives a task and stamps `deletedAt` (see `delete.ts`).
+ * Trashed rows never surface through an ordinary `queryTasks` call, so they
+ * drop out of `list`, `find`, and `orchestrate ready` until restored.
+ * Dependency edges removed at delete time are not restored.
+ */
+
+import type {
+  Task,
+  TaskStatus,
+  TasksTrashEmptyParams,
+  TasksTrashEmptyResult,
+  TasksTrashListResult,
+  TasksTrashRestoreParams,
+  TasksTrashRestoreResult,
+} from '@cleocode/contracts';
+import { ExitCode, isAllowedWorkGraphParentType } from '@cleocode/contracts';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { rebuildChildProjectionAc } from './ac-table.js';
+import { recurrenceIntervalMs } from './recurrence.js';
+import { publishTaskEvents } from './task-events.js';
+
+/** A task row that is present and neither archived nor trashed. */
+function isLive(task: Task | null): task is Task {
+  return task !== null && !task.deletedAt && task.status !== 'archived';
+}
+
+/**
+ * Status a trashed or archived task returns to. The archive step overwrites
+ * `status` but records it in `preArchiveStatus`; rows archived before that
+ * column existed fall back to the lifecycle timestamps that survive it.
+ */
+export function restoredStatus(task: Task): TaskStatus {
+  if (task.preArchiveStatus && task.preArchiveStatus !== 'archived') return task.preArchiveStatus;
+  if (task.completedAt) return 'done';
+  if (task.cancelledAt) return 'cancelled';
+  return 'pending';
+}
+
+/**
+ * Resolve the parent a restored task goes back under: `toSaga` when given,
+ * otherwise the original parent, which must still be live.
+ *
+ * @param source - The command the task is restored through, used in fix hints.
+ * @throws CleoError `NOT_FOUND` when `toSaga` does not exist.
+ * @throws CleoError `PARENT_NOT_FOUND` when the original parent is gone and no `toSaga` is given.
+ * @throws CleoError `INVALID_PARENT_TYPE` when `toSaga` is not a saga or cannot hold the task.
+ */
+export async function resolveRestoreParent(
+  acc: DataAccessor,
+  task: Task,
+  originalParent: string | null,
+  toSaga: string | undefined,
+  source: 'trash' | 'archive',
+): Promise<string | null> {
+  if (toSaga) {
+    const saga = await acc.loadSingleTask(toSaga);
+    if (!isLive(saga)) {
+      throw new CleoError(ExitCode.NOT_FOUND, `Saga not found: ${toSaga}`, {
+        fix: 'cleo saga list',
+      });
+    }
+    const taskType = task.type ?? 'task';
+    if (saga.type !== 'saga' || !isAllowedWorkGraphParentType(taskType, 'saga')) {
+      throw new CleoError(
+        ExitCode.INVALID_PARENT_TYPE,
+        saga.type !== 'saga'
+          ? `${saga.id} is a ${saga.type ?? 'task'}, not a saga`
+          : `A ${taskType} cannot be placed directly under a saga`,
+        { details: { field: 'toSaga', expected: 'saga', actual: saga.type } },
+      );
+    }
+    return saga.id;
+  }
+  if (originalParent) {
+    const parent = await acc.loadSingleTask(originalParent);
+    if (!isLive(parent)) {
+      const parentSource = parent?.deletedAt ? 'trash' : parent ? 'archive' : null;
+      throw new CleoError(
+        ExitCode.PARENT_NOT_FOUND,
+        `Cannot restore ${task.id}: its parent ${originalParent} no longer exists`,
+        {
+          fix: parentSource
+            ? `cleo ${parentSource} restore ${originalParent} first, or pass --to-saga <sagaId>`
+            : `cleo ${source} restore ${task.id} --to-saga <sagaId>`,
+          details: { field: 'parentId', actual: originalParent },
+        },
+      );
+    }
+  }
+  return originalParent;
+}
+
+/** List trashed tasks, most recently deleted first. */
+export async function listTrash(
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksTrashListResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const { tasks } = await acc.queryTasks({ trashed: true });
+  const entries = tasks
+    .map((t) => ({
+      id: t.id,
+      title: t.title,
+      ...(t.type ? { type: t.type } : {}),
+      deletedAt: t.deletedAt as string,
+      parentId: t.deletedParentId ?? t.parentId ?? null,
+    }))
+    .sort((a, b) => b.deletedAt.localeCompare(a.deletedAt));
+  return { tasks: entries, total: entries.length };
+}
+
+/**
+ * Restore a task from the trash, along with children deleted in the same
+ * `cleo delete --cascade`.
+ *
+ * @throws CleoError `NOT_FOUND` when the task is not in the trash, or `toSaga` does not exist.
+ * @throws CleoError `PARENT_NOT_FOUND` when the original parent is gone and no `toSaga` is given.
+ * @throws CleoError `INVALID_PARENT_TYPE` when `toSaga` is not a saga or cannot hold the task.
+ */
+export async function restoreFromTrash(
+  options: TasksTrashRestoreParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksTrashRestoreResult> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const task = await acc.loadSingleTask(options.taskId);
+  if (!task?.deletedAt) {
+    throw new CleoError(ExitCode.NOT_FOUND, `Task not in trash: ${options.taskId}`, {
+      fix: 'cleo trash list',
+    });
+  }
+
+  const originalParent = task.deletedParentId ?? task.parentId ?? null;
+  const parentId = await resolveRestoreParent(acc, task, originalParent, options.toSaga, 'trash');
+
+  const batch = (await acc.getSubtree(task.id)).filter((t) => t.deletedAt === task.deletedAt);
+  const now = new Date().toISOString();
+  await acc.transaction(async (tx) => {
+    for (const t of batch) {
+      await tx.upsertSingleTask({
+        ...t,
+        status: restoredStatus(t),
+        parentId: t.id === task.id ? parentId : t.parentId,
+        deletedAt: null,
+        deletedParentId: null,
+        preArchiveStatus: null,
+        updatedAt: now,
+      });
+    }
+    if (parentId) {
+      const siblings = await tx.getChildren(parentId);
+      await rebuildChildProjectionAc(
+        tx,
+        parentId,
+        siblings.map((child) => ({ id: child.id, title: child.title })),
+        now,
+      );
+    }
+    await tx.appendLog({
+      id: `log-${Math.floor(Date.now() / 1000)}-${(await import('node:crypto')).randomBytes(3).toString('hex')}`,
+      timestamp: now,
+      action: 'task_restored',
+      taskId: task.id,
+      actor: 'system',
+      details: { restored: batch.map((t) => t.id), parentId },
+      before: { deletedAt: task.deletedAt },
+      after: { parentId },
+    });
+  });
+
+  return { task: task.id, restored: batch.map((t) => t.id), parentId };
+}
+
+/**
+ * Permanently remove trashed tasks.
+ *
+ * @param options - `olderThan` limits the purge to tasks deleted longer ago (e.g. `30d`).
+ * @throws CleoError `VALIDATION_ERROR` for a malformed `olderThan`.
+ */
+export async function emptyTrash(
+  options: TasksTrashEmptyParams,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TasksTrashEmptyResult> {
+  let cutoff = Number.POSITIVE_INFINITY;
+  if (options.olderThan) {
+    const ms = recurrenceIntervalMs(options.olderThan);
+    if (ms === null) {
+      throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid --older-than: ${options.olderThan}`, {
+        fix: 'Use a duration like 30d, 12h, or 2w',
+        details: { field: 'olderThan', expected: '<n>[mhdw]', actual: options.olderThan },
+      });
+    }
+    cutoff = Date.now() - ms;
+  }
+
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const { tasks } = await acc.queryTasks({ trashed: true });
+  const purged = tasks.filter((t) => Date.parse(t.deletedAt as string) < cutoff).map((t) => t.id);
+
+  if (purged.length > 0) {
+    await acc.transaction(async (tx) => {
+      for (const id of purged) {
+        await tx.removeSingleTask(id);
+      }
+      await tx.appendLog({
+        id: `log-${Math.floor(Date.now() / 1000)}-${(await import('node:crypto')).randomBytes(3).toString('hex')}`,
+        timestamp: new Date().toISOString(),
+        action: 'trash_emptied',
+        taskId: purged[0] as string,
+        actor: 'system',
+        details: { purged, olderThan: options.olderThan ?? null },
+        before: null,
+        after: null,
+      });
+    });
+  }
+
+  return { purged, count: purged.length };
+}
+
+// ---------------------------------------------------------------------------
+// EngineResult-returning wrappers
+// ---------------------------------------------------------------------------
+
+/**
+ * List trashed tasks, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @returns EngineResult with `{ tasks, total }`
+ */
+export async function taskTrashList(
+  projectRoot: string,
+): Promise<EngineResult<TasksTrashListResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await listTrash(projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to list trash');
+  }
+}
+
+/**
+ * Restore a task from the trash, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Task ID and optional `toSaga`
+ * @returns EngineResult with the restored task IDs
+ */
+export async function taskTrashRestore(
+  projectRoot: string,
+  params: TasksTrashRestoreParams,
+): Promise<EngineResult<TasksTrashRestoreResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    const result = await restoreFromTrash(params, projectRoot, accessor);
+    await publishTaskEvents(
+      projectRoot,
+      result.restored.map((id) => ({ event: 'task.created' as const, id })),
+      accessor,
+    );
+    return engineSuccess(result);
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to restore task from trash');
+  }
+}
+
+/**
+ * Empty the trash, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Optional `olderThan` duration
+ * @returns EngineResult with the purged task IDs
+ */
+export async function taskTrashEmpty(
+  projectRoot: string,
+  params: TasksTrashEmptyParams,
+): Promise<EngineResult<TasksTrashEmptyResult>> {
+  try {
+    const accessor = await getTaskAccessor(projectRoot);
+    return engineSuccess(await emptyTrash(params, projectRoot, accessor));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to empty trash');
+  }
+}
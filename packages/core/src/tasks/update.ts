@@ -36,6 +36,10 @@ import {
 } from './add.js';
 import { assertNoActiveChildrenForTerminal } from './child-disposition.js';
 import { completeTask } from './complete.js';
+import { normalizeCompletionRequirements } from './completion-requirements.js';
+import { applyCustomAssignments, loadCustomFields } from './custom-fields.js';
+import { assertDependencyEdges } from './dependency-guard.js';
+import { normalizeDueDate } from './due.js';
 import { createAcceptanceEnforcement } from './enforcement.js';
 import { taskToRecord } from './engine-converters.js';
 import {
@@ -43,8 +47,11 @@ import {
   validateChildStageCeiling,
   validateEpicStageAdvancement,
 } from './epic-enforcement.js';
+import { normalizeEstimate } from './estimate.js';
 import { resolveHierarchyPolicy } from './hierarchy-policy.js';
 import { validatePipelineTransition } from './pipeline-stage.js';
+import { normalizeRecurrence } from './recurrence.js';
+import { publishTaskEvent } from './task-events.js';
 
 const NON_STATUS_DONE_FIELDS: Array<keyof Omit<UpdateTaskOptions, 'taskId' | 'status'>> = [
   'title',
@@ -68,10 +75,18 @@ const NON_STATUS_DONE_FIELDS: Array<keyof Omit<UpdateTaskOptions, 'taskId' | 'st
   'clearBlockedBy',
   'parentId',
   'noAutoComplete',
+  'autoCompleteParent',
   'pipelineStage',
   'kind',
   'scope',
   'severity',
+  'due',
+  'recurrence',
+  'estimate',
+  'track',
+  'assignee',
+  'completionRequirements',
+  'set',
   'relates',
   'addRelates',
   'removeRelates',
@@ -120,6 +135,8 @@ export interface UpdateTaskOptions {
   clearBlockedBy?: boolean;
   parentId?: string | null;
   noAutoComplete?: boolean;
+  /** Complete the task once its last open child completes. */
+  autoCompleteParent?: boolean;
   /** RCASD-IVTR+C pipeline stage transition target. Must be >= current stage. @task T060 */
   pipelineStage?: string;
   /**
@@ -139,6 +156,24 @@ export interface UpdateTaskOptions {
    * @task T9073
    */
   severity?: TaskSeverity;
+  /** Due date (RFC 3339 full-date or date-time). An empty string clears it. */
+  due?: string;
+  /** Recurrence rule or shorthand (`7d`, `@weekly`); `none` stops the chain. */
+  recurrence?: string;
+  /** Effort estimate (story points or hours); `null` clears it. */
+  estimate?: number | null;
+  /** Orchestration track; `null` or an empty string clears it. */
+  track?: string | null;
+  /** Agent or person to pin the task to; `null` or an empty string clears it. */
+  assignee?: string | null;
+  /**
+   * What `cleo complete` requires of the task (`commit`, `note`, `estimate`, `assignee`).
+   * On a saga the list is the default for its work; `[]` requires nothing and
+   * `null` inherits the saga's list again.
+   */
+  completionRequirements?: string[] | null;
+  /** Custom field assignments (`name=value`); an empty value clears the field. */
+  set?: string[];
   /**
    * Operator-supplied justification required to override the
    * acceptance-criteria immutability guard once a task has entered the
@@ -239,6 +274,22 @@ export async function updateTask(
     projectRoot: cwd,
   });
 
+  // Validate the due date, recurrence, estimate, requirements, and custom fields up
+  // front so a bad value never reaches a write.
+  const due = options.due !== undefined ? normalizeDueDate(options.due) : undefined;
+  const recurrence =
+    options.recurrence !== undefined ? normalizeRecurrence(options.recurrence) : undefined;
+  const estimate =
+    options.estimate !== undefined ? normalizeEstimate(options.estimate) : undefined;
+  const completionRequirements =
+    options.completionRequirements !== undefined
+      ? normalizeCompletionRequirements(options.completionRequirements)
+      : undefined;
+  const custom =
+    options.set !== undefined
+      ? applyCustomAssignments(await loadCustomFields(acc), task.custom, options.set)
+      : undefined;
+
   // Update fields
   if (options.title !== undefined) {
     validateTitle(options.title);
@@ -357,6 +408,8 @@ export async function updateTask(
     changes.push('labels');
   }
 
+  const originalDepends = new Set(task.depends ?? []);
+
   if (options.depends !== undefined) {
     task.depends = options.depends;
     changes.push('depends');
@@ -375,6 +428,15 @@ export async function updateTask(
     changes.push('depends');
   }
 
+  // Reject cyclic / dangling edges before anything is persisted.
+  if (changes.includes('depends') && task.depends) {
+    await assertDependencyEdges(acc, {
+      taskId: task.id,
+      depends: task.depends,
+      added: task.depends.filter((d) => !originalDepends.has(d)),
+    });
+  }
+
   if (options.notes !== undefined) {
     const timestampedNote = `${new Date()
       .toISOString()
@@ -425,6 +487,11 @@ export async function updateTask(
     changes.push('noAutoComplete');
   }
 
+  if (options.autoCompleteParent !== undefined) {
+    task.autoCompleteParent = options.autoCompleteParent;
+    changes.push('autoCompleteParent');
+  }
+
   // T944/T9072: orthogonal axes
   if (options.kind !== undefined) {
     task.kind = options.kind;
@@ -442,6 +509,41 @@ export async function updateTask(
     changes.push('severity');
   }
 
+  if (due !== undefined) {
+    task.due = due;
+    changes.push('due');
+  }
+
+  if (recurrence !== undefined) {
+    task.recurrence = recurrence;
+    changes.push('recurrence');
+  }
+
+  if (estimate !== undefined) {
+    task.estimate = estimate;
+    changes.push('estimate');
+  }
+
+  if (options.track !== undefined) {
+    task.track = options.track?.trim() || null;
+    changes.push('track');
+  }
+
+  if (options.assignee !== undefined) {
+    task.assignee = options.assignee?.trim() || null;
+    changes.push('assignee');
+  }
+
+  if (completionRequirements !== undefined) {
+    task.completionRequirements = completionRequirements;
+    changes.push('completionRequirements');
+  }
+
+  if (options.set !== undefined) {
+    task.custom = custom;
+    changes.push('custom');
+  }
+
   // T9327: relates mutations
   if (options.relates !== undefined) {
     task.relates = options.relates.map((r) => ({
@@ -751,6 +853,20 @@ export async function taskUpdate(
     kind?: string;
     scope?: string;
     severity?: string;
+    /** Due date (RFC 3339); an empty string clears it. */
+    due?: string;
+    /** Recurrence rule shorthand; `none` stops the chain. */
+    recurrence?: string;
+    /** Effort estimate; `null` clears it. */
+    estimate?: number | null;
+    /** Orchestration track; `null` or an empty string clears it. */
+    track?: string | null;
+    /** Assignee; `null` or an empty string clears it. */
+    assignee?: string | null;
+    /** Requirements checked at completion; `null` inherits the saga's. */
+    completionRequirements?: string[] | null;
+    /** Custom field assignments (`name=value`). */
+    set?: string[];
     reason?: string;
     /** Set the blockedBy free-text reason. @task T9241 (gh#1106) */
     blockedBy?: string;
@@ -761,6 +877,8 @@ export async function taskUpdate(
     addRelates?: Array<{ taskId: string; type: string; reason?: string }>;
     /** @task T9327 */
     removeRelates?: string[];
+    /** Complete the task once its last open child completes. */
+    autoCompleteParent?: boolean;
   },
 ): Promise<EngineResult<{ task: TaskRecord; changes?: string[] }>> {
   try {
@@ -790,17 +908,29 @@ export async function taskUpdate(
         kind: updates.kind as TaskKind | undefined,
         scope: updates.scope as TaskScope | undefined,
         severity: updates.severity as TaskSeverity | undefined,
+        due: updates.due,
+        recurrence: updates.recurrence,
+        estimate: updates.estimate,
+        track: updates.track,
+        assignee: updates.assignee,
+        completionRequirements: updates.completionRequirements,
+        set: updates.set,
         reason: updates.reason,
         relates: updates.relates,
         addRelates: updates.addRelates,
         removeRelates: updates.removeRelates,
         blockedBy: updates.blockedBy,
         clearBlockedBy: updates.clearBlockedBy,
+        autoCompleteParent: updates.autoCompleteParent,
       },
       projectRoot,
       accessor,
     );
-    return engineSuccess({ task: taskToRecord(result.task), changes: result.changes });
+    const task = taskToRecord(result.task);
+    // `--status done` is routed through completeTask; report it as a completion.
+    const completed = updates.status === 'done' && task.status === 'done';
+    publishTaskEvent(projectRoot, completed ? 'task.completed' : 'task.updated', task);
+    return engineSuccess({ task, changes: result.changes });
   } catch (err: unknown) {
     // T9940 (generalizing T9838-D): surface real CleoError LAFS codes via
     // the shared `cleoErrorToEngineResult` helper. Non-CleoErrors (DB
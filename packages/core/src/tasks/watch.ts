@@ -0,0 +1,203 @@
+/**
+ * Task watch — a live feed of task changes for `cleo watch`.
+ *
+ * The task store is the project SQLite database, so the watcher observes the
+ * directory that holds it (database, WAL, and journal files) rather than a
+ * single inode: writes from other processes land in the WAL, and a database
+ * that is atomically replaced keeps being seen. Bursts of writes are
+ * debounced into one reload; each reload is diffed against the previous
+ * snapshot and the delta is emitted as {@link TaskWatchEvent}s.
+ */
+
+import { type FSWatcher, watch } from 'node:fs';
+import { basename, dirname } from 'node:path';
+import type { Task, TaskStatus } from '@cleocode/contracts';
+import { TASK_STATUSES } from '@cleocode/contracts';
+import type { DataAccessor } from '../store/data-accessor.js';
+import { getTaskAccessor } from '../store/data-accessor.js';
+import { getDbPath } from '../store/sqlite.js';
+
+/** Default quiet period before a burst of writes is reloaded. */
+export const DEFAULT_WATCH_DEBOUNCE_MS = 200;
+
+/** Fields whose changes are bookkeeping, not news. */
+const IGNORED_FIELDS: ReadonlySet<string> = new Set(['updatedAt', 'preArchiveStatus']);
+
+/** One change between two task snapshots. */
+export interface TaskWatchEvent {
+  /** `task.status` for a status transition, `task.updated` for any other field change. */
+  event: 'task.created' | 'task.status' | 'task.updated' | 'task.removed';
+  taskId: string;
+  title: string;
+  /** Previous status (`task.status`). */
+  from?: TaskStatus;
+  /** New status (`task.status`). */
+  to?: TaskStatus;
+  /** Changed fields (`task.updated`), sorted. */
+  fields?: string[];
+  /** When the change was observed. */
+  at: string;
+}
+
+/** Task snapshot keyed by ID. */
+export type TaskSnapshot = Map<string, Task>;
+
+/** Options for {@link watchTasks}. */
+export interface WatchTasksOptions {
+  /** Only report tasks at or below this saga. */
+  sagaId?: string;
+  /** Quiet period before a burst of writes is reloaded (ms). @defaultValue 200 */
+  debounceMs?: number;
+  /** Receives each change, in snapshot order. */
+  onEvent: (event: TaskWatchEvent) => void;
+  /** Receives reload failures; the watcher keeps running. */
+  onError?: (err: unknown) => void;
+}
+
+/** Whether `task` is `rootId` or sits below it in `snapshot`. */
+function isWithin(task: Task, rootId: string, snapshot: TaskSnapshot): boolean {
+  const seen = new Set<string>();
+  let current: Task | undefined = task;
+  while (current && !seen.has(current.id)) {
+    if (current.id === rootId) return true;
+    seen.add(current.id);
+    current = current.parentId ? snapshot.get(current.parentId) : undefined;
+  }
+  return false;
+}
+
+/**
+ * Load every task, archived included, as a snapshot. Trashed tasks are left
+ * out, so a delete reads as a removal.
+ *
+ * @param sagaId - Only keep tasks at or below this saga.
+ */
+export async function loadTaskSnapshot(
+  sagaId?: string,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<TaskSnapshot> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const { tasks } = await acc.queryTasks({ status: [...TASK_STATUSES] });
+  const all: TaskSnapshot = new Map(tasks.map((t) => [t.id, t]));
+  if (!sagaId) return all;
+  return new Map([...all].filter(([, t]) => isWithin(t, sagaId, all)));
+}
+
+/** Changed fields between two versions of a task, sorted. */
+function changedFields(prev: Task, next: Task): string[] {
+  const keys = new Set([...Object.keys(prev), ...Object.keys(next)]);
+  const fields: string[] = [];
+  for (const key of keys) {
+    if (key === 'status' || IGNORED_FIELDS.has(key)) continue;
+    const a = (prev as unknown as Record<string, unknown>)[key] ?? null;
+    const b = (next as unknown as Record<string, unknown>)[key] ?? null;
+    if (JSON.stringify(a) !== JSON.stringify(b)) fields.push(key);
+  }
+  return fields.sort();
+}
+
+/**
+ * Diff two snapshots into watch events: creations, status transitions, other
+ * field edits, and removals. A task whose status and fields both changed
+ * yields a `task.status` and a `task.updated` event.
+ */
+export function diffTaskSnapshots(
+  prev: TaskSnapshot,
+  next: TaskSnapshot,
+  at: string = new Date().toISOString(),
+): TaskWatchEvent[] {
+  const events: TaskWatchEvent[] = [];
+  for (const [id, task] of next) {
+    const before = prev.get(id);
+    if (!before) {
+      events.push({ event: 'task.created', taskId: id, title: task.title, to: task.status, at });
+      continue;
+    }
+    if (before.status !== task.status) {
+      events.push({
+        event: 'task.status',
+        taskId: id,
+        title: task.title,
+        from: before.status,
+        to: task.status,
+        at,
+      });
+    }
+    const fields = changedFields(before, task);
+    if (fields.length > 0) {
+      events.push({ event: 'task.updated', taskId: id, title: task.title, fields, at });
+    }
+  }
+  for (const [id, task] of prev) {
+    if (!next.has(id)) {
+      events.push({ event: 'task.removed', taskId: id, title: task.title, from: task.status, at });
+    }
+  }
+  return events;
+}
+
+/**
+ * Watch the task store and report each change until the returned function
+ * is called.
+ *
+ * @returns A function that stops watching.
+ */
+export async function watchTasks(
+  options: WatchTasksOptions,
+  cwd?: string,
+  accessor?: DataAccessor,
+): Promise<() => void> {
+  const acc = accessor ?? (await getTaskAccessor(cwd));
+  const dbPath = getDbPath(cwd);
+  const dbName = basename(dbPath);
+  const debounceMs = options.debounceMs ?? DEFAULT_WATCH_DEBOUNCE_MS;
+
+  let snapshot = await loadTaskSnapshot(options.sagaId, cwd, acc);
+  let timer: ReturnType<typeof setTimeout> | null = null;
+  let reloading: Promise<void> | null = null;
+  let pending = false;
+  let closed = false;
+
+  const reload = async (): Promise<void> => {
+    try {
+      const next = await loadTaskSnapshot(options.sagaId, cwd, acc);
+      const events = diffTaskSnapshots(snapshot, next);
+      snapshot = next;
+      for (const event of events) options.onEvent(event);
+    } catch (err: unknown) {
+      options.onError?.(err);
+    }
+  };
+
+  // Reloads never overlap: a change during a reload schedules one more.
+  const run = (): void => {
+    timer = null;
+    if (closed) return;
+    if (reloading) {
+      pending = true;
+      return;
+    }
+    reloading = reload().finally(() => {
+      reloading = null;
+      if (pending && !closed) {
+        pending = false;
+        run();
+      }
+    });
+  };
+
+  const watcher: FSWatcher = watch(dirname(dbPath), { persistent: true }, (_type, filename) => {
+    if (closed || (filename && !filename.toString().startsWith(dbName))) return;
+    if (timer !== null) clearTimeout(timer);
+    timer = setTimeout(run, debounceMs);
+  });
+  watcher.on('error', (err) => options.onError?.(err));
+
+  return () => {
+    if (closed) return;
+    closed = true;
+    if (timer !== null) clearTimeout(timer);
+    watcher.close();
+  };
+}
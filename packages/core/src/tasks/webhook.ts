@@ -0,0 +1,340 @@
+/**
+ * Webhook emitter — POSTs task lifecycle events to `webhook.url`.
+ *
+ * {@link installWebhookEmitter} subscribes to the task event bus
+ * (`task-events.ts`); every event is queued and delivered as
+ *
+ *   `{ "event": "task.completed", "task": { ...TaskRecord }, "at": "<ISO 8601>" }`
+ *
+ * to the receiver configured with `cleo config set webhook.url <url>`.
+ *
+ * Delivery is best-effort and never on the command's critical path:
+ *   - the publisher only appends to an in-memory queue of at most
+ *     {@link WEBHOOK_QUEUE_LIMIT} events (oldest dropped first);
+ *   - a single background worker drains it, one request at a time, so events
+ *     reach the receiver in publish order;
+ *   - each attempt times out after `webhook.timeoutMs`
+ *     ({@link DEFAULT_WEBHOOK_TIMEOUT_MS}); network errors and 5xx / 429
+ *     responses are retried twice with a short backoff;
+ *   - {@link flushWebhooks} (run by `shutdownCliRuntime`) gives the queue a
+ *     bounded grace period at exit, then aborts whatever is left.
+ *
+ * {@link webhookTest} backs `cleo webhook test`: it sends one synthetic event
+ * directly (bypassing the queue) and reports the receiver's answer.
+ */
+
+import type {
+  AdminWebhookTestParams,
+  AdminWebhookTestResult,
+  TaskRecord,
+} from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { loadConfig } from '../config.js';
+import { type EngineResult, engineSuccess } from '../engine-result.js';
+import { CleoError } from '../errors.js';
+import { cleoErrorToEngineResult } from '../errors-to-engine.js';
+import { getLogger } from '../logger.js';
+import { onTaskEvent } from './task-events.js';
+
+/** Most events held for delivery; older events are dropped past this. */
+export const WEBHOOK_QUEUE_LIMIT = 100;
+
+/** Default per-attempt request timeout (2s). */
+export const DEFAULT_WEBHOOK_TIMEOUT_MS = 2_000;
+
+/** Default grace period {@link flushWebhooks} gives the queue at exit. */
+export const DEFAULT_WEBHOOK_FLUSH_MS = 1_500;
+
+/** Backoff before each retry; its length is the retry count. */
+const RETRY_DELAYS_MS = [250, 1_000];
+
+/** Event document POSTed to the receiver. */
+export interface WebhookBody {
+  event: string;
+  task: Partial<TaskRecord> & { id: string };
+  at: string;
+  /** Set on synthetic events sent by `cleo webhook test`. */
+  test?: true;
+}
+
+/** Outcome of delivering one event. */
+export interface WebhookDelivery {
+  /** HTTP status of the last attempt; `null` when no response arrived. */
+  status: number | null;
+  attempts: number;
+  /** Why the last attempt failed; absent on a 2xx response. */
+  error?: string;
+}
+
+/** Options for {@link deliverWebhook}. */
+export interface WebhookDeliveryOptions {
+  timeoutMs?: number;
+  /** Aborts the in-flight attempt and any remaining retries. */
+  signal?: AbortSignal;
+}
+
+interface PendingEvent {
+  projectRoot: string;
+  body: WebhookBody;
+}
+
+const log = getLogger('webhook');
+const queue: PendingEvent[] = [];
+let worker: Promise<void> | null = null;
+let stop = new AbortController();
+let unsubscribe: (() => void) | null = null;
+
+/** Receiver and timeout from the project's config, or `null` when unset. */
+async function resolveReceiver(
+  projectRoot: string,
+): Promise<{ url: string; timeoutMs: number } | null> {
+  const config = await loadConfig(projectRoot);
+  const url = config.webhook?.url?.trim();
+  if (!url) return null;
+  return { url, timeoutMs: config.webhook?.timeoutMs ?? DEFAULT_WEBHOOK_TIMEOUT_MS };
+}
+
+/** Wait `ms`, returning early when `signal` aborts. */
+function pause(ms: number, signal: AbortSignal): Promise<void> {
+  return new Promise((resolve) => {
+    if (signal.aborted) return resolve();
+    const done = (): void => {
+      clearTimeout(timer);
+      signal.removeEventListener('abort', done);
+      resolve();
+    };
+    const timer = setTimeout(done, ms);
+    signal.addEventListener('abort', done, { once: true });
+  });
+}
+
+/**
+ * POST one event, retrying network errors and 5xx / 429 responses.
+ *
+ * Never throws; a failed delivery is reported through `error`.
+ *
+ * @param url - Receiver URL.
+ * @param body - Event document.
+ * @param options - Per-attempt timeout and an abort signal.
+ */
+export async function deliverWebhook(
+  url: string,
+  body: WebhookBody,
+  options: WebhookDeliveryOptions = {},
+): Promise<WebhookDelivery> {
+  const signal = options.signal ?? stop.signal;
+  const timeoutMs = options.timeoutMs ?? DEFAULT_WEBHOOK_TIMEOUT_MS;
+  const payload = JSON.stringify(body);
+  for (let attempt = 1; ; attempt++) {
+    let status: number | null = null;
+    let error = 'no response';
+    try {
+      const response = await fetch(url, {
+        method: 'POST',
+        headers: { 'content-type': 'application/json', 'user-agent': 'cleo-webhook' },
+        body: payload,
+        signal: AbortSignal.any([signal, AbortSignal.timeout(timeoutMs)]),
+      });
+      // Release the connection; the receiver's body is not used.
+      await response.body?.cancel().catch(() => {});
+      status = response.status;
+      if (response.ok) return { status, attempts: attempt };
+      error = `HTTP ${status}`;
+      if (status !== 429 && status < 500) return { status, attempts: attempt, error };
+    } catch (err) {
+      error = err instanceof Error ? err.message : String(err);
+    }
+    if (attempt > RETRY_DELAYS_MS.length || signal.aborted) {
+      return { status, attempts: attempt, error };
+    }
+    await pause(RETRY_DELAYS_MS[attempt - 1] ?? 0, signal);
+  }
+}
+
+/** Deliver queued events in order until the queue is empty or stopped. */
+async function drain(signal: AbortSignal): Promise<void> {
+  while (queue.length > 0 && !signal.aborted) {
+    const next = queue.shift();
+    if (!next) break;
+    try {
+      const receiver = await resolveReceiver(next.projectRoot);
+      if (!receiver) continue;
+      const result = await deliverWebhook(receiver.url, next.body, {
+        timeoutMs: receiver.timeoutMs,
+        signal,
+      });
+      if (result.error) {
+        log.warn(
+          { url: receiver.url, event: next.body.event, ...result },
+          'webhook delivery failed',
+        );
+      }
+    } catch (err) {
+      log.warn({ err, event: next.body.event }, 'webhook delivery failed');
+    }
+  }
+}
+
+function startWorker(): void {
+  if (worker) return;
+  worker = drain(stop.signal).finally(() => {
+    worker = null;
+    // An event queued while the loop was exiting.
+    if (queue.length > 0 && !stop.signal.aborted) startWorker();
+  });
+}
+
+/**
+ * Queue an event for delivery to the project's receiver.
+ *
+ * Returns immediately. When the queue is full the oldest event is dropped.
+ *
+ * @param projectRoot - Project whose `webhook.url` receives the event.
+ * @param body - Event document.
+ */
+export function enqueueWebhook(projectRoot: string, body: WebhookBody): void {
+  queue.push({ projectRoot, body });
+  if (queue.length > WEBHOOK_QUEUE_LIMIT) {
+    const dropped = queue.shift();
+    log.warn({ event: dropped?.body.event }, 'webhook queue full; dropped oldest event');
+  }
+  startWorker();
+}
+
+/** Number of events waiting for delivery (excluding the one in flight). */
+export function pendingWebhookCount(): number {
+  return queue.length;
+}
+
+/**
+ * Subscribe the webhook emitter to task lifecycle events. Idempotent.
+ */
+export function installWebhookEmitter(): void {
+  if (unsubscribe) return;
+  unsubscribe = onTaskEvent((e) => {
+    enqueueWebhook(e.projectRoot, { event: e.event, task: e.task, at: e.at });
+  });
+}
+
+/**
+ * Give queued deliveries up to `graceMs` to finish, then abort the rest.
+ *
+ * Safe to call when nothing is queued or the emitter was never installed.
+ *
+ * @param graceMs - Longest time to wait for the queue to drain.
+ */
+export async function flushWebhooks(graceMs = DEFAULT_WEBHOOK_FLUSH_MS): Promise<void> {
+  if (worker) {
+    let timer: ReturnType<typeof setTimeout> | undefined;
+    await Promise.race([worker, new Promise<void>((r) => (timer = setTimeout(r, graceMs)))]);
+    clearTimeout(timer);
+  }
+  if (!worker && queue.length === 0) return;
+  if (queue.length > 0) {
+    log.warn({ dropped: queue.length }, 'webhook events dropped at exit');
+  }
+  queue.length = 0;
+  stop.abort();
+  await worker;
+  stop = new AbortController();
+}
+
+/**
+ * Unsubscribe the emitter and discard queued events (for testing).
+ */
+export function resetWebhookEmitter(): void {
+  unsubscribe?.();
+  unsubscribe = null;
+  queue.length = 0;
+  stop.abort();
+  stop = new AbortController();
+  worker = null;
+}
+
+/**
+ * Send one synthetic event to the receiver and wait for its answer.
+ *
+ * @param projectRoot - Project whose config supplies the receiver.
+ * @param params - Receiver override and event name.
+ * @returns The delivery outcome.
+ * @throws CleoError when no receiver is configured, the URL is invalid, or
+ *   the receiver does not answer with a 2xx status.
+ */
+export async function sendWebhookTest(
+  projectRoot: string,
+  params: AdminWebhookTestParams = {},
+): Promise<AdminWebhookTestResult> {
+  const configured = await resolveReceiver(projectRoot);
+  const url = params.url?.trim() || configured?.url;
+  if (!url) {
+    throw new CleoError(ExitCode.CONFIG_ERROR, 'No webhook receiver configured', {
+      fix: 'cleo config set webhook.url <url>',
+    });
+  }
+  let parsed: URL | null = null;
+  try {
+    parsed = new URL(url);
+  } catch {
+    // Reported below.
+  }
+  if (!parsed || (parsed.protocol !== 'http:' && parsed.protocol !== 'https:')) {
+    throw new CleoError(ExitCode.INVALID_INPUT, `Invalid webhook URL: ${url}`, {
+      fix: 'Use an http:// or https:// URL',
+      details: { field: 'url', expected: 'http(s) URL', actual: url },
+    });
+  }
+
+  const event = params.event?.trim() || 'task.completed';
+  const started = Date.now();
+  const result = await deliverWebhook(
+    url,
+    {
+      event,
+      task: { id: 'T000', title: 'cleo webhook test', status: 'done' },
+      at: new Date().toISOString(),
+      test: true,
+    },
+    { timeoutMs: configured?.timeoutMs, signal: new AbortController().signal },
+  );
+  if (result.error || result.status === null) {
+    throw new CleoError(
+      ExitCode.GENERAL_ERROR,
+      `Webhook receiver ${url} did not accept the event: ${result.error ?? 'no response'}`,
+      {
+        fix: 'Check that the receiver is reachable and answers with a 2xx status',
+        details: {
+          field: 'url',
+          expected: '2xx response',
+          actual: result.status ?? result.error,
+          attempts: result.attempts,
+        },
+      },
+    );
+  }
+  return {
+    url,
+    event,
+    delivered: true,
+    status: result.status,
+    attempts: result.attempts,
+    durationMs: Date.now() - started,
+  };
+}
+
+/**
+ * Send a synthetic webhook event, wrapped in EngineResult.
+ *
+ * @param projectRoot - Absolute path to the project root
+ * @param params - Receiver override and event name
+ * @returns EngineResult with the delivery outcome
+ */
+export async function webhookTest(
+  projectRoot: string,
+  params: AdminWebhookTestParams = {},
+): Promise<EngineResult<AdminWebhookTestResult>> {
+  try {
+    return engineSuccess(await sendWebhookTest(projectRoot, params));
+  } catch (err: unknown) {
+    return cleoErrorToEngineResult(err, 'E_INTERNAL', 'Failed to send webhook test event');
+  }
+}
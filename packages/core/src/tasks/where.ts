@@ -0,0 +1,443 @@
+/**
+ * `cleo find --where` expressions — a small boolean query language over
+ * task fields.
+ *
+ *   cleo find --where 'priority >= high and (label:saga or label:wave-1) and status != done'
+ *
+ * Grammar (keywords are case-insensitive; `,` is an alias for `and`):
+ *
+ *   expr       := and ('or' and)*
+ *   and        := unary (('and' | ',') unary)*
+ *   unary      := ('not' | '!') unary | primary
+ *   primary    := '(' expr ')' | field op value
+ *   op         := '=' | '==' | '!=' | '<' | '<=' | '>' | '>=' | ':' | '~'
+ *   value      := word | 'single-quoted' | "double-quoted"
+ *
+ * Quote values that contain spaces or operator characters
+ * (`due < '2026-07-01T00:00:00Z'`).
+ *
+ * `:` is equality (`label:saga` — for list fields, "contains"); `~` is a
+ * case-insensitive substring match. `priority`, `severity` and `size` compare
+ * by rank (`critical > high > medium > low`, `P0 > P1 > P2 > P3`,
+ * `large > medium > small`); dates compare chronologically. Any other name is
+ * a project custom field (`cleo fields`), also reachable as `custom.<name>`,
+ * so the original `--where risk=high` form keeps working.
+ *
+ * A task with no value for a field fails every comparison except `!=`.
+ * Syntax errors throw `INVALID_INPUT` with the 1-based position of the
+ * offending token; values a field can never hold throw `VALIDATION_ERROR`.
+ */
+
+import type { CustomFieldDef, Task } from '@cleocode/contracts';
+import { ExitCode, TASK_STATUSES } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import { normalizePriority } from './add.js';
+import { coerceCustomValue } from './custom-fields.js';
+
+/** Comparison operators. */
+export type WhereOperator = '=' | '!=' | '<' | '<=' | '>' | '>=' | '~';
+
+/** Parsed `--where` expression. Positions are 0-based offsets into the source. */
+export type WhereNode =
+  | { kind: 'and' | 'or'; left: WhereNode; right: WhereNode }
+  | { kind: 'not'; operand: WhereNode }
+  | {
+      kind: 'compare';
+      field: string;
+      op: WhereOperator;
+      value: string;
+      fieldPos: number;
+      valuePos: number;
+    };
+
+/** Predicate compiled from a {@link WhereNode}. */
+export type TaskWherePredicate = (task: Task) => boolean;
+
+const EXAMPLE = "--where 'priority >= high and (label:saga or label:wave-1) and status != done'";
+
+interface Token {
+  type: 'word' | 'string' | 'op' | '(' | ')' | ',' | 'end';
+  text: string;
+  pos: number;
+}
+
+const OPERATOR_RE = /^(?:==|!=|<=|>=|[=<>:~!])/;
+const WORD_RE = /^[^\s()=!<>:~,'"]+/;
+
+function syntaxError(source: string, token: Token, expected: string): CleoError {
+  const found = token.type === 'end' ? 'end of expression' : `'${token.text}'`;
+  return new CleoError(
+    ExitCode.INVALID_INPUT,
+    `Invalid --where at position ${token.pos + 1}: expected ${expected}, found ${found}`,
+    {
+      fix: `Check the expression near position ${token.pos + 1} (e.g. ${EXAMPLE})`,
+      details: {
+        field: 'where',
+        expected,
+        actual: token.type === 'end' ? null : token.text,
+        position: token.pos + 1,
+        expression: source,
+      },
+    },
+  );
+}
+
+function tokenize(source: string): Token[] {
+  const tokens: Token[] = [];
+  let i = 0;
+  while (i < source.length) {
+    const ch = source[i] as string;
+    if (/\s/.test(ch)) {
+      i++;
+      continue;
+    }
+    if (ch === '(' || ch === ')' || ch === ',') {
+      tokens.push({ type: ch, text: ch, pos: i++ });
+      continue;
+    }
+    if (ch === "'" || ch === '"') {
+      const close = source.indexOf(ch, i + 1);
+      if (close < 0) {
+        throw syntaxError(source, { type: 'end', text: '', pos: source.length }, `closing ${ch}`);
+      }
+      tokens.push({ type: 'string', text: source.slice(i + 1, close), pos: i });
+      i = close + 1;
+      continue;
+    }
+    const rest = source.slice(i);
+    const op = OPERATOR_RE.exec(rest)?.[0];
+    const word = op ? undefined : WORD_RE.exec(rest)?.[0];
+    const text = op ?? word ?? ch;
+    tokens.push({ type: op ? 'op' : 'word', text, pos: i });
+    i += text.length;
+  }
+  tokens.push({ type: 'end', text: '', pos: source.length });
+  return tokens;
+}
+
+function isKeyword(token: Token, keyword: string): boolean {
+  return token.type === 'word' && token.text.toLowerCase() === keyword;
+}
+
+/**
+ * Parse a `--where` expression.
+ *
+ * @throws CleoError `INVALID_INPUT` with `details.position` on a syntax error.
+ */
+export function parseWhere(source: string): WhereNode {
+  const tokens = tokenize(source);
+  let at = 0;
+  const peek = (): Token => tokens[at] as Token;
+  const next = (): Token => tokens[at++] as Token;
+
+  const parseOr = (): WhereNode => {
+    let left = parseAnd();
+    while (isKeyword(peek(), 'or')) {
+      next();
+      left = { kind: 'or', left, right: parseAnd() };
+    }
+    return left;
+  };
+
+  const parseAnd = (): WhereNode => {
+    let left = parseUnary();
+    while (isKeyword(peek(), 'and') || peek().type === ',') {
+      next();
+      left = { kind: 'and', left, right: parseUnary() };
+    }
+    return left;
+  };
+
+  const parseUnary = (): WhereNode => {
+    const token = peek();
+    if (isKeyword(token, 'not') || (token.type === 'op' && token.text === '!')) {
+      next();
+      return { kind: 'not', operand: parseUnary() };
+    }
+    return parsePrimary();
+  };
+
+  const parsePrimary = (): WhereNode => {
+    const token = next();
+    if (token.type === '(') {
+      const inner = parseOr();
+      const close = next();
+      if (close.type !== ')') throw syntaxError(source, close, "')'");
+      return inner;
+    }
+    if (token.type !== 'word' || ['and', 'or', 'not'].includes(token.text.toLowerCase())) {
+      throw syntaxError(source, token, "a field name or '('");
+    }
+    const opToken = next();
+    if (opToken.type !== 'op' || opToken.text === '!') {
+      throw syntaxError(source, opToken, 'a comparison operator (= != < <= > >= : ~)');
+    }
+    const valueToken = next();
+    if (valueToken.type !== 'word' && valueToken.type !== 'string') {
+      throw syntaxError(source, valueToken, 'a value');
+    }
+    const op = opToken.text === '==' || opToken.text === ':' ? '=' : opToken.text;
+    return {
+      kind: 'compare',
+      field: token.text.toLowerCase(),
+      op: op as WhereOperator,
+      value: valueToken.text,
+      fieldPos: token.pos,
+      valuePos: valueToken.pos,
+    };
+  };
+
+  const root = parseOr();
+  if (peek().type !== 'end') throw syntaxError(source, peek(), "'and', 'or' or end of expression");
+  return root;
+}
+
+// ---------------------------------------------------------------------------
+// Field resolution
+// ---------------------------------------------------------------------------
+
+type FieldValue = string | number | readonly string[] | null | undefined;
+
+interface FieldSpec {
+  /** How values compare. */
+  kind: 'text' | 'rank' | 'number' | 'date' | 'list' | 'custom';
+  get: (task: Task) => FieldValue;
+  /** Rank order, lowest first (rank fields) or accepted values (text fields). */
+  values?: readonly string[];
+}
+
+const PRIORITY_ORDER = ['low', 'medium', 'high', 'critical'] as const;
+
+const TASK_FIELDS: Record<string, FieldSpec> = {
+  id: { kind: 'text', get: (t) => t.id },
+  title: { kind: 'text', get: (t) => t.title },
+  description: { kind: 'text', get: (t) => t.description },
+  status: { kind: 'text', get: (t) => t.status, values: TASK_STATUSES },
+  type: { kind: 'text', get: (t) => t.type, values: ['saga', 'epic', 'task', 'subtask'] },
+  kind: { kind: 'text', get: (t) => t.kind },
+  scope: { kind: 'text', get: (t) => t.scope },
+  parent: { kind: 'text', get: (t) => t.parentId },
+  assignee: { kind: 'text', get: (t) => t.assignee },
+  stage: { kind: 'text', get: (t) => t.pipelineStage },
+  priority: { kind: 'rank', get: (t) => t.priority, values: PRIORITY_ORDER },
+  severity: { kind: 'rank', get: (t) => t.severity, values: ['P3', 'P2', 'P1', 'P0'] },
+  size: { kind: 'rank', get: (t) => t.size, values: ['small', 'medium', 'large'] },
+  estimate: { kind: 'number', get: (t) => t.estimate },
+  due: { kind: 'date', get: (t) => t.due },
+  created: { kind: 'date', get: (t) => t.createdAt },
+  updated: { kind: 'date', get: (t) => t.updatedAt },
+  completed: { kind: 'date', get: (t) => t.completedAt },
+  label: { kind: 'list', get: (t) => t.labels },
+  depends: { kind: 'list', get: (t) => t.depends },
+};
+
+const FIELD_ALIASES: Record<string, string> = {
+  labels: 'label',
+  parentid: 'parent',
+  pipelinestage: 'stage',
+  createdat: 'created',
+  updatedat: 'updated',
+  completedat: 'completed',
+  deps: 'depends',
+};
+
+/** Built-in `--where` field names (aliases excluded). */
+export const WHERE_FIELDS: readonly string[] = Object.keys(TASK_FIELDS);
+
+function resolveField(
+  node: Extract<WhereNode, { kind: 'compare' }>,
+  customFields: readonly CustomFieldDef[],
+): { spec: FieldSpec; def?: CustomFieldDef } {
+  const name = FIELD_ALIASES[node.field] ?? node.field;
+  const custom = name.startsWith('custom.') ? name.slice('custom.'.length) : null;
+  const builtin = custom === null ? TASK_FIELDS[name] : undefined;
+  if (builtin) return { spec: builtin };
+  const def = customFields.find((f) => f.name === (custom ?? name));
+  if (!def) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `Unknown --where field '${node.field}' at position ${node.fieldPos + 1}`,
+      {
+        fix: `Use a task field (${WHERE_FIELDS.join(', ')}) or declare it: cleo fields add --name ${custom ?? name} --type string`,
+        details: {
+          field: 'where',
+          expected: [...WHERE_FIELDS, ...customFields.map((f) => f.name)],
+          actual: node.field,
+          position: node.fieldPos + 1,
+        },
+      },
+    );
+  }
+  return { spec: { kind: 'custom', get: (t) => t.custom?.[def.name] }, def };
+}
+
+function badOperator(node: Extract<WhereNode, { kind: 'compare' }>, allowed: string): CleoError {
+  return new CleoError(
+    ExitCode.INVALID_INPUT,
+    `Operator '${node.op}' does not apply to '${node.field}' (position ${node.fieldPos + 1})`,
+    {
+      fix: `Use ${allowed} with ${node.field}`,
+      details: {
+        field: 'where',
+        expected: allowed,
+        actual: node.op,
+        position: node.fieldPos + 1,
+      },
+    },
+  );
+}
+
+function badValue(
+  node: Extract<WhereNode, { kind: 'compare' }>,
+  expected: unknown,
+): CleoError {
+  return new CleoError(
+    ExitCode.VALIDATION_ERROR,
+    `Invalid value '${node.value}' for ${node.field} at position ${node.valuePos + 1}`,
+    {
+      fix: Array.isArray(expected)
+        ? `Use one of: ${expected.join(', ')}`
+        : `Use a ${String(expected)}`,
+      details: { field: 'where', expected, actual: node.value, position: node.valuePos + 1 },
+    },
+  );
+}
+
+/** Canonical priority for a value (accepts aliases and 1-9), or `null`. */
+function priorityOf(value: string): string | null {
+  try {
+    return normalizePriority(value);
+  } catch {
+    return null;
+  }
+}
+
+/** `cmp` sign → operator outcome. */
+function ordered(op: WhereOperator, cmp: number): boolean {
+  switch (op) {
+    case '=':
+      return cmp === 0;
+    case '!=':
+      return cmp !== 0;
+    case '<':
+      return cmp < 0;
+    case '<=':
+      return cmp <= 0;
+    case '>':
+      return cmp > 0;
+    case '>=':
+      return cmp >= 0;
+    default:
+      return false;
+  }
+}
+
+/** Build the predicate for one comparison, validating its value up front. */
+function compileCompare(
+  node: Extract<WhereNode, { kind: 'compare' }>,
+  customFields: readonly CustomFieldDef[],
+): TaskWherePredicate {
+  const { spec, def } = resolveField(node, customFields);
+  const { op } = node;
+  const missing = op === '!=';
+
+  if (spec.kind === 'rank') {
+    const order = spec.values ?? [];
+    const wanted =
+      spec === TASK_FIELDS['priority']
+        ? priorityOf(node.value)
+        : order.find((v) => v.toLowerCase() === node.value.toLowerCase());
+    if (!wanted) throw badValue(node, order);
+    if (op === '~') throw badOperator(node, '= != < <= > >=');
+    const target = order.indexOf(wanted);
+    return (t) => {
+      const rank = order.indexOf(String(spec.get(t) ?? ''));
+      return rank < 0 ? missing : ordered(op, rank - target);
+    };
+  }
+
+  if (spec.kind === 'number' || spec.kind === 'date' || def?.type === 'number') {
+    const parse = spec.kind === 'date' ? Date.parse : Number;
+    const target = def ? coerceCustomValue(def, node.value) : parse(node.value);
+    if (typeof target !== 'number' || !Number.isFinite(target)) {
+      throw badValue(node, spec.kind === 'date' ? 'RFC 3339 date' : 'number');
+    }
+    if (op === '~') throw badOperator(node, '= != < <= > >=');
+    return (t) => {
+      const raw = spec.get(t);
+      const value = raw == null || raw === '' ? Number.NaN : parse(String(raw));
+      return Number.isFinite(value) ? ordered(op, value - target) : missing;
+    };
+  }
+
+  if (spec.kind === 'list') {
+    if (op !== '=' && op !== '!=' && op !== '~') throw badOperator(node, '= : != ~');
+    const needle = node.value.toLowerCase();
+    return (t) => {
+      const items = (spec.get(t) as readonly string[] | undefined) ?? [];
+      if (op === '~') return items.some((v) => v.toLowerCase().includes(needle));
+      return items.includes(node.value) === (op === '=');
+    };
+  }
+
+  if (def) {
+    // String and enum custom fields: exact values, ordered by declaration for enums.
+    const target = op === '~' ? node.value : (coerceCustomValue(def, node.value) as string);
+    const order = def.type === 'enum' ? (def.values ?? []) : null;
+    if (op !== '=' && op !== '!=' && op !== '~' && !order) {
+      throw badOperator(node, '= != ~');
+    }
+    return (t) => {
+      const value = spec.get(t);
+      if (value == null) return missing;
+      const text = String(value);
+      if (op === '~') return text.toLowerCase().includes(target.toLowerCase());
+      if (order) return ordered(op, order.indexOf(text) - order.indexOf(target));
+      return ordered(op, text === target ? 0 : 1);
+    };
+  }
+
+  // Plain text fields compare case-insensitively.
+  if (op !== '=' && op !== '!=' && op !== '~') throw badOperator(node, '= : != ~');
+  const needle = node.value.toLowerCase();
+  if (spec.values && op !== '~' && !spec.values.includes(needle)) {
+    throw badValue(node, spec.values);
+  }
+  return (t) => {
+    const value = spec.get(t);
+    if (value == null || value === '') return missing;
+    const text = String(value).toLowerCase();
+    if (op === '~') return text.includes(needle);
+    return (text === needle) === (op === '=');
+  };
+}
+
+/**
+ * Compile a parsed expression into a task predicate.
+ *
+ * @throws CleoError `VALIDATION_ERROR` for unknown fields or values a field
+ *   can never hold; `INVALID_INPUT` for an operator the field does not support.
+ */
+export function compileWhere(
+  node: WhereNode,
+  customFields: readonly CustomFieldDef[] = [],
+): TaskWherePredicate {
+  switch (node.kind) {
+    case 'and': {
+      const left = compileWhere(node.left, customFields);
+      const right = compileWhere(node.right, customFields);
+      return (t) => left(t) && right(t);
+    }
+    case 'or': {
+      const left = compileWhere(node.left, customFields);
+      const right = compileWhere(node.right, customFields);
+      return (t) => left(t) || right(t);
+    }
+    case 'not': {
+      const operand = compileWhere(node.operand, customFields);
+      return (t) => !operand(t);
+    }
+    default:
+      return compileCompare(node, customFields);
+  }
+}
@@ -0,0 +1,265 @@
+/**
+ * Workspace — a per-user list of project roots whose tasks can be read
+ * together (`cleo workspace`).
+ *
+ * Persists to `~/.cleo/workspace.json` (operator-managed, plain JSON):
+ *
+ * ```json
+ * {
+ *   "version": 1,
+ *   "projects": [
+ *     { "name": "api", "root": "/home/me/src/api", "addedAt": "2026-10-14T..." }
+ *   ]
+ * }
+ * ```
+ *
+ * A project is registered by any path inside it; the root is the nearest
+ * ancestor that passes `validateProjectRoot`, the same check project
+ * resolution uses. Its `name` namespaces task IDs in aggregated reads
+ * (`api:T42`), using the `project:taskId` form of NEXUS queries.
+ *
+ * Only reads aggregate. Mutations still go to one project through the
+ * normal commands run inside it.
+ */
+
+import { existsSync, mkdirSync, readFileSync, renameSync, writeFileSync } from 'node:fs';
+import { homedir } from 'node:os';
+import { basename, dirname, join, resolve } from 'node:path';
+import type { MinimalTaskRecord, TaskStatus } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import { validateProjectRoot } from '../paths.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+import { loadCustomFields } from './custom-fields.js';
+import { compileWhere, parseWhere } from './where.js';
+
+/** One registered project. */
+export interface WorkspaceProject {
+  /** Namespace for the project's task IDs (`<name>:T42`). */
+  name: string;
+  /** Absolute project root. */
+  root: string;
+  /** ISO 8601 timestamp the project was added. */
+  addedAt: string;
+}
+
+/** On-disk shape of `~/.cleo/workspace.json`. */
+export interface WorkspaceIndex {
+  /** Schema version — currently `1`. */
+  version: 1;
+  /** Registered projects, by name. */
+  projects: WorkspaceProject[];
+}
+
+/** A task row from an aggregated read, tagged with its source project. */
+export interface WorkspaceTaskRecord extends MinimalTaskRecord {
+  /** Namespaced ID, `<project>:<taskId>`. */
+  id: string;
+  /** Source project name. */
+  project: string;
+  /** Task ID within the source project. */
+  taskId: string;
+}
+
+/** Result of {@link queryWorkspaceTasks}. */
+export interface WorkspaceTasksResult {
+  results: WorkspaceTaskRecord[];
+  total: number;
+  /** Projects read, by name. */
+  projects: string[];
+  /** Projects that are gone or whose task store could not be opened, with the reason. */
+  skipped: Array<{ project: string; root: string; reason: string }>;
+}
+
+/** Project names share the NEXUS `project:taskId` prefix syntax. */
+const NAME_RE = /^[a-z0-9_-]+$/;
+
+/**
+ * Path of the workspace index. Like the federation index it sits at
+ * `~/.cleo`, not the XDG `getCleoHome()`, so it is easy to hand-edit.
+ */
+export function getWorkspacePath(): string {
+  return join(homedir(), '.cleo', 'workspace.json'); // path-drift-allowed: operator-managed file deliberately at ~/.cleo, like federation.json
+}
+
+/**
+ * Read the workspace index; an empty one when the file does not exist.
+ *
+ * @param path - Index file (defaults to {@link getWorkspacePath}).
+ * @throws CleoError `CONFIG_ERROR` when the file is not a version-1 index.
+ */
+export function readWorkspace(path = getWorkspacePath()): WorkspaceIndex {
+  if (!existsSync(path)) return { version: 1, projects: [] };
+  let parsed: { version?: unknown; projects?: unknown };
+  try {
+    parsed = JSON.parse(readFileSync(path, 'utf-8')) as typeof parsed;
+  } catch (err) {
+    throw new CleoError(ExitCode.CONFIG_ERROR, `Workspace index ${path} is not valid JSON`, {
+      fix: `Fix or remove ${path}`,
+      details: { field: 'workspace', actual: err instanceof Error ? err.message : String(err) },
+    });
+  }
+  if (parsed.version !== 1 || !Array.isArray(parsed.projects)) {
+    throw new CleoError(ExitCode.CONFIG_ERROR, `Workspace index ${path} is not a v1 index`, {
+      fix: `Fix or remove ${path}`,
+      details: { field: 'version', expected: 1, actual: parsed.version },
+    });
+  }
+  return { version: 1, projects: parsed.projects as WorkspaceProject[] };
+}
+
+/** Write the index atomically (tmp file, then rename). */
+function writeWorkspace(index: WorkspaceIndex, path: string): void {
+  mkdirSync(dirname(path), { recursive: true });
+  const tmp = `${path}.tmp-${process.pid}-${Date.now()}`;
+  writeFileSync(tmp, `${JSON.stringify(index, null, 2)}\n`, 'utf-8');
+  renameSync(tmp, path);
+}
+
+/** Nearest ancestor of `start` that is an initialized CLEO project, or null. */
+function findProjectRoot(start: string): string | null {
+  for (let current = resolve(start); ; current = dirname(current)) {
+    if (current !== homedir() && validateProjectRoot(current)) return current;
+    if (dirname(current) === current) return null;
+  }
+}
+
+/** Default project name: the root's directory name, lowercased to the name syntax. */
+function defaultName(root: string): string {
+  const name = basename(root)
+    .toLowerCase()
+    .replace(/[^a-z0-9_-]+/g, '-')
+    .replace(/^-+|-+$/g, '');
+  return name || 'project';
+}
+
+/**
+ * Register the project containing `path`. Adding a root that is already
+ * registered renames it when `name` is given and is otherwise a no-op.
+ *
+ * @param path - Any path inside the project.
+ * @param options - `name` to namespace its tasks with (default: root directory name).
+ * @param indexPath - Index file (defaults to {@link getWorkspacePath}).
+ * @throws CleoError `NOT_FOUND` when `path` is not inside a CLEO project,
+ *   `VALIDATION_ERROR` for a malformed name or one another project uses.
+ */
+export function addWorkspaceProject(
+  path: string,
+  options: { name?: string } = {},
+  indexPath = getWorkspacePath(),
+): { project: WorkspaceProject; added: boolean } {
+  const root = findProjectRoot(path);
+  if (!root) {
+    throw new CleoError(ExitCode.NOT_FOUND, `No CLEO project found at ${resolve(path)}`, {
+      fix: `cd ${resolve(path)} && cleo init`,
+      details: { field: 'path', actual: path },
+    });
+  }
+  const index = readWorkspace(indexPath);
+  const existing = index.projects.find((p) => p.root === root);
+  const name = options.name ?? existing?.name ?? defaultName(root);
+  if (!NAME_RE.test(name)) {
+    throw new CleoError(ExitCode.VALIDATION_ERROR, `Invalid workspace project name: '${name}'`, {
+      fix: 'Use lowercase letters, digits, - and _, e.g. --name my-app',
+      details: { field: 'name', expected: NAME_RE.source, actual: name },
+    });
+  }
+  const clash = index.projects.find((p) => p.name === name && p.root !== root);
+  if (clash) {
+    throw new CleoError(
+      ExitCode.VALIDATION_ERROR,
+      `Workspace project name '${name}' is already used by ${clash.root}`,
+      {
+        fix: `cleo workspace add ${path} --name <other-name>`,
+        details: { field: 'name', actual: name },
+      },
+    );
+  }
+
+  const project = { name, root, addedAt: existing?.addedAt ?? new Date().toISOString() };
+  const projects = [...index.projects.filter((p) => p.root !== root), project].sort((a, b) =>
+    a.name.localeCompare(b.name),
+  );
+  writeWorkspace({ version: 1, projects }, indexPath);
+  return { project, added: !existing };
+}
+
+/**
+ * Unregister a project by name. Its task data is untouched.
+ *
+ * @param name - Workspace project name.
+ * @param indexPath - Index file (defaults to {@link getWorkspacePath}).
+ * @returns `true` when a project was removed.
+ */
+export function removeWorkspaceProject(name: string, indexPath = getWorkspacePath()): boolean {
+  const index = readWorkspace(indexPath);
+  const projects = index.projects.filter((p) => p.name !== name);
+  if (projects.length === index.projects.length) return false;
+  writeWorkspace({ version: 1, projects }, indexPath);
+  return true;
+}
+
+/**
+ * Read tasks from every registered project, tagging each with its source.
+ *
+ * `where` uses the `cleo find --where` language and is resolved against each
+ * project's own custom fields. Projects are read one at a time; one that is
+ * gone or whose store cannot be opened is reported under `skipped` instead of
+ * failing the whole read.
+ *
+ * @param options - `where` expressions (all must match) and `status` filter.
+ * @param indexPath - Index file (defaults to {@link getWorkspacePath}).
+ * @throws CleoError `INVALID_INPUT` / `VALIDATION_ERROR` for a bad `where`.
+ */
+export async function queryWorkspaceTasks(
+  options: { where?: string[]; status?: TaskStatus } = {},
+  indexPath = getWorkspacePath(),
+): Promise<WorkspaceTasksResult> {
+  const trees = options.where?.map((expression) => parseWhere(expression)) ?? [];
+  const { projects } = readWorkspace(indexPath);
+  const results: WorkspaceTaskRecord[] = [];
+  const read: string[] = [];
+  const skipped: WorkspaceTasksResult['skipped'] = [];
+
+  for (const project of projects) {
+    if (!validateProjectRoot(project.root)) {
+      skipped.push({ project: project.name, root: project.root, reason: 'not a CLEO project' });
+      continue;
+    }
+    let acc: DataAccessor;
+    try {
+      acc = await getTaskAccessor(project.root);
+    } catch (err) {
+      const reason = err instanceof Error ? err.message : String(err);
+      skipped.push({ project: project.name, root: project.root, reason });
+      continue;
+    }
+    try {
+      const customFields = trees.length > 0 ? await loadCustomFields(acc) : [];
+      const predicates = trees.map((tree) => compileWhere(tree, customFields));
+      const { tasks } = await acc.queryTasks(options.status ? { status: options.status } : {});
+      for (const t of tasks) {
+        if (!predicates.every((p) => p(t))) continue;
+        results.push({
+          id: `${project.name}:${t.id}`,
+          project: project.name,
+          taskId: t.id,
+          title: t.title,
+          status: t.status,
+          priority: t.priority,
+          parentId: t.parentId,
+          depends: t.depends,
+          type: t.type,
+          size: t.size ?? undefined,
+          ...(t.severity != null ? { severity: t.severity } : {}),
+          ...(t.due ? { due: t.due } : {}),
+        });
+      }
+      read.push(project.name);
+    } finally {
+      await acc.close();
+    }
+  }
+
+  return { results, total: results.length, projects: read, skipped };
+}
@@ -0,0 +1,77 @@
+/**
+ * Tests for the task-store checksum seal behind `cleo verify`.
+ */
+
+import { afterEach, beforeEach, describe, expect, it } from 'vitest';
+import { createTestDb, seedTasks, type TestDbEnv } from '../../store/__tests__/test-db-helper.js';
+import { resetDbState } from '../../store/sqlite.js';
+import { refreshTaskChecksum, verifyTaskChecksum } from '../task-checksum.js';
+
+describe('verifyTaskChecksum', () => {
+  let env: TestDbEnv;
+  const now = new Date().toISOString();
+
+  beforeEach(async () => {
+    env = await createTestDb();
+    process.env['CLEO_DIR'] = env.cleoDir;
+    await seedTasks(env.accessor, [
+      {
+        id: 'T001',
+        title: 'Epic',
+        type: 'epic',
+        status: 'pending',
+        priority: 'medium',
+        createdAt: now,
+      },
+      {
+        id: 'T002',
+        title: 'Child',
+        parentId: 'T001',
+        status: 'pending',
+        priority: 'medium',
+        createdAt: now,
+      },
+    ]);
+  });
+
+  afterEach(async () => {
+    delete process.env['CLEO_DIR'];
+    resetDbState();
+    await env.cleanup();
+  });
+
+  it('reports an unsealed store as not ok', async () => {
+    const report = await verifyTaskChecksum(env.tempDir);
+    expect(report).toMatchObject({ ok: false, stored: null, taskCount: 2, repaired: false });
+    expect(report.computed).toMatch(/^[0-9a-f]{16}$/);
+  });
+
+  it('--repair seals the store so the next verify passes', async () => {
+    const repaired = await verifyTaskChecksum(env.tempDir, { repair: true });
+    expect(repaired).toMatchObject({ ok: true, repaired: true });
+    expect(repaired.stored).toBe(repaired.computed);
+
+    await expect(verifyTaskChecksum(env.tempDir)).resolves.toMatchObject({ ok: true });
+  });
+
+  it('detects writes made without refreshing the seal', async () => {
+    await verifyTaskChecksum(env.tempDir, { repair: true });
+    const task = await env.accessor.loadSingleTask('T002');
+    await env.accessor.upsertSingleTask({ ...task!, title: 'Edited out of band' });
+
+    const report = await verifyTaskChecksum(env.tempDir);
+    expect(report.ok).toBe(false);
+    expect(report.stored).not.toBe(report.computed);
+  });
+
+  it('refreshTaskChecksum keeps a sealed store current and ignores unsealed ones', async () => {
+    await expect(refreshTaskChecksum(env.tempDir)).resolves.toBe(false);
+
+    await verifyTaskChecksum(env.tempDir, { repair: true });
+    const task = await env.accessor.loadSingleTask('T002');
+    await env.accessor.upsertSingleTask({ ...task!, title: 'Edited through cleo' });
+
+    await expect(refreshTaskChecksum(env.tempDir)).resolves.toBe(true);
+    await expect(verifyTaskChecksum(env.tempDir)).resolves.toMatchObject({ ok: true });
+  });
+});
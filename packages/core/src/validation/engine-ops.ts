@@ -34,6 +34,7 @@ import {
   validateAtom,
 } from '../tasks/evidence.js';
 import { appendForceBypassLine, appendGateAuditLine } from '../tasks/gate-audit.js';
+import { isValidTaskId } from '../tasks/id-generator.js';
 import {
   hasCallsiteCoverageLabel,
   hasEngineMigrationLabel,
@@ -345,9 +346,8 @@ export async function validateGateVerify(
     const agentId = agent ?? 'unknown';
     const sessionId = params.sessionId ?? null;
 
-    // Validate task ID format
-    const idPattern = /^T\d{3,}$/;
-    if (!idPattern.test(taskId)) {
+    // Validate task ID format (`T###` or a saga-prefixed `AUTH-7`)
+    if (!isValidTaskId(taskId)) {
       return engineError('E_INVALID_INPUT', `Invalid task ID format: ${taskId}`);
     }
 
@@ -36,6 +36,9 @@ import {
   type WorkflowGateTracker,
 } from './operation-verification-gates.js';
 
+/** Task ID: global `T<n>` or saga-prefixed `<PREFIX>-<n>` (e.g. `AUTH-1`). */
+const TASK_ID_RE = /^(?:T[0-9]+|[A-Z]{2,10}-[0-9]+)$/;
+
 /**
  * Layer 1: Schema Validation
  *
@@ -48,7 +51,7 @@ export async function validateLayer1Schema(context: OperationContext): Promise<L
   // Task ID validation (if present)
   if (context.params?.taskId) {
     const taskId = context.params.taskId as string;
-    if (!taskId.match(/^T[0-9]+$/)) {
+    if (!TASK_ID_RE.test(taskId)) {
       violations.push({
         layer: GateLayer.SCHEMA,
         severity: ErrorSeverity.ERROR,
@@ -56,8 +59,8 @@ export async function validateLayer1Schema(context: OperationContext): Promise<L
         message: `Invalid task ID format: ${taskId}`,
         field: 'taskId',
         value: taskId,
-        constraint: 'Must match pattern ^T[0-9]+$',
-        fix: 'Use format T followed by digits (e.g., T1234)',
+        constraint: `Must match pattern ${TASK_ID_RE.source}`,
+        fix: 'Use format T followed by digits (e.g., T1234), or a saga prefix ID (e.g., AUTH-1)',
       });
     }
   }
@@ -375,7 +378,7 @@ export async function validateLayer3Referential(context: OperationContext): Prom
   // Parent task validation
   if (context.params?.parent) {
     const parent = context.params.parent as string;
-    if (!parent.match(/^T[0-9]+$/)) {
+    if (!TASK_ID_RE.test(parent)) {
       violations.push({
         layer: GateLayer.REFERENTIAL,
         severity: ErrorSeverity.ERROR,
@@ -393,7 +396,7 @@ export async function validateLayer3Referential(context: OperationContext): Prom
   if (context.params?.depends) {
     const depends = context.params.depends as string[];
     for (const depId of depends) {
-      if (!depId.match(/^T[0-9]+$/)) {
+      if (!TASK_ID_RE.test(depId)) {
         violations.push({
           layer: GateLayer.REFERENTIAL,
           severity: ErrorSeverity.ERROR,
@@ -427,7 +430,7 @@ export async function validateLayer3Referential(context: OperationContext): Prom
   // Task start validation
   if (context.domain === 'tasks' && context.operation === 'start') {
     const taskId = context.params?.taskId as string | undefined;
-    if (taskId && !taskId.match(/^T[0-9]+$/)) {
+    if (taskId && !TASK_ID_RE.test(taskId)) {
       violations.push({
         layer: GateLayer.REFERENTIAL,
         severity: ErrorSeverity.ERROR,
@@ -436,7 +439,7 @@ export async function validateLayer3Referential(context: OperationContext): Prom
         field: 'taskId',
         value: taskId,
         constraint: 'Must be valid task ID',
-        fix: 'Use valid task ID format: T####',
+        fix: 'Use valid task ID format: T#### or PREFIX-#',
       });
     }
   }
@@ -647,7 +650,7 @@ export async function validateLayer4Protocol(
  * Validation rule definitions for reuse
  */
 export const GATE_VALIDATION_RULES = {
-  TASK_ID_PATTERN: /^T[0-9]+$/,
+  TASK_ID_PATTERN: TASK_ID_RE,
   MANIFEST_ID_PATTERN: /^T\d{3,}-[a-z0-9-]+$/,
   DATE_FORMAT_PATTERN: /^\d{4}-\d{2}-\d{2}$/,
   TITLE_MIN_LENGTH: 5,
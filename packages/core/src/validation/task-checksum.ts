@@ -0,0 +1,153 @@
+/**
+ * Task-store checksum seal — detect and repair out-of-band drift.
+ *
+ * A 16-char {@link computeChecksum} over the full task set is stored in the
+ * `file_meta.checksum` metadata key. The checksum is canonical (tasks by ID,
+ * sorted keys), so row order in the store never changes it.
+ * {@link verifyTaskChecksum} recomputes it and compares; a mismatch means the
+ * task rows were changed outside cleo (hand edits through `sqlite3`, a
+ * half-applied restore, another tool) since the seal was last written. The
+ * same pass checks that task IDs are unique and that every `depends` entry
+ * points at an existing task.
+ *
+ * The seal is opt-in: it is written by `cleo verify --repair` and then kept
+ * current by {@link refreshTaskChecksum}, which the dispatch layer calls
+ * after every successful mutate while still holding the mutate lock.
+ *
+ * Used by `cleo verify` (no task ID).
+ */
+
+import type { Task } from '@cleocode/contracts';
+import { ExitCode } from '@cleocode/contracts';
+import { CleoError } from '../errors.js';
+import { type DataAccessor, getTaskAccessor } from '../store/data-accessor.js';
+import { computeChecksum } from '../store/json.js';
+import { withMutateLock } from '../store/mutate-lock.js';
+
+/** A `depends` entry whose target task does not exist. */
+export interface DanglingDependency {
+  taskId: string;
+  dependsOn: string;
+}
+
+/** Result of {@link verifyTaskChecksum}. */
+export interface TaskChecksumReport {
+  /** True when the seal matches and no structural problems were found. */
+  ok: boolean;
+  /** Checksum recorded in `file_meta`, or `null` when never sealed. */
+  stored: string | null;
+  /** Checksum of the task set as it is now. */
+  computed: string;
+  taskCount: number;
+  duplicateIds: string[];
+  danglingDepends: DanglingDependency[];
+  /** True when `--repair` rewrote the stored checksum. */
+  repaired: boolean;
+}
+
+/** Options for {@link verifyTaskChecksum}. */
+export interface VerifyTaskChecksumOptions {
+  /** Rewrite the stored checksum once the task set passes the ID check. */
+  repair?: boolean;
+}
+
+/** Find IDs that occur more than once. */
+function findDuplicateIds(tasks: Task[]): string[] {
+  const seen = new Set<string>();
+  const dupes = new Set<string>();
+  for (const t of tasks) {
+    if (seen.has(t.id)) dupes.add(t.id);
+    seen.add(t.id);
+  }
+  return [...dupes];
+}
+
+/** Find `depends` entries that reference a missing task. */
+function findDanglingDepends(tasks: Task[]): DanglingDependency[] {
+  const ids = new Set(tasks.map((t) => t.id));
+  return tasks.flatMap((t) =>
+    (t.depends ?? []).filter((d) => !ids.has(d)).map((d) => ({ taskId: t.id, dependsOn: d })),
+  );
+}
+
+/** Store `checksum` in `file_meta`, preserving the other meta keys. */
+async function writeStoredChecksum(accessor: DataAccessor, checksum: string): Promise<void> {
+  const meta = (await accessor.getMetaValue<Record<string, unknown>>('file_meta')) ?? {};
+  await accessor.setMetaValue('file_meta', { ...meta, checksum });
+}
+
+/**
+ * Recompute the task checksum and compare it with the stored seal.
+ *
+ * Loading the task set parses every JSON column, so a corrupt row surfaces as
+ * a thrown error before any comparison. With `repair`, the seal is rewritten
+ * under the project mutate lock — but only when task IDs are unique.
+ *
+ * @param projectRoot - Project root used to open the task store.
+ * @param options - `repair` rewrites the stored checksum.
+ * @throws CleoError `ID_COLLISION` when `repair` is requested and task IDs
+ *   are not unique (the seal is left untouched).
+ */
+export async function verifyTaskChecksum(
+  projectRoot: string,
+  options: VerifyTaskChecksumOptions = {},
+): Promise<TaskChecksumReport> {
+  const inspect = async (): Promise<TaskChecksumReport> => {
+    const accessor = await getTaskAccessor(projectRoot);
+    const { tasks } = await accessor.queryTasks({});
+    const meta = await accessor.getMetaValue<{ checksum?: string }>('file_meta');
+    const computed = computeChecksum(tasks);
+    const duplicateIds = findDuplicateIds(tasks);
+    const danglingDepends = findDanglingDepends(tasks);
+    let stored = meta?.checksum ?? null;
+    let repaired = false;
+
+    if (options.repair) {
+      if (duplicateIds.length > 0) {
+        throw new CleoError(
+          ExitCode.ID_COLLISION,
+          `Refusing to reseal: duplicate task IDs ${duplicateIds.join(', ')}`,
+          {
+            fix: 'Resolve the duplicate IDs, then re-run cleo verify --repair',
+            details: { field: 'id', duplicateIds },
+          },
+        );
+      }
+      await writeStoredChecksum(accessor, computed);
+      stored = computed;
+      repaired = true;
+    }
+
+    return {
+      ok: stored === computed && duplicateIds.length === 0 && danglingDepends.length === 0,
+      stored,
+      computed,
+      taskCount: tasks.length,
+      duplicateIds,
+      danglingDepends,
+      repaired,
+    };
+  };
+
+  return options.repair ? withMutateLock(projectRoot, inspect) : inspect();
+}
+
+/**
+ * Bring an existing checksum seal up to date after a cleo-managed write.
+ *
+ * Does nothing for projects that were never sealed. Callers must already
+ * hold the project mutate lock.
+ *
+ * @param projectRoot - Project root used to open the task store.
+ * @returns True when the stored checksum was rewritten.
+ */
+export async function refreshTaskChecksum(projectRoot: string): Promise<boolean> {
+  const accessor = await getTaskAccessor(projectRoot);
+  const meta = await accessor.getMetaValue<{ checksum?: string }>('file_meta');
+  if (!meta?.checksum) return false;
+  const { tasks } = await accessor.queryTasks({});
+  const computed = computeChecksum(tasks);
+  if (computed === meta.checksum) return false;
+  await writeStoredChecksum(accessor, computed);
+  return true;
+}
@@ -143,6 +143,7 @@ export {
   orchestrateAnalyze,
   orchestrateBootstrap,
   orchestrateCheck,
+  orchestrateClaim,
   orchestrateContext,
   orchestrateCriticalPath,
   orchestrateHandoff,
@@ -152,11 +153,13 @@ export {
   orchestratePlan,
   orchestrateReady,
   orchestrateReport,
+  orchestrateSequence,
   orchestrateSkillInject,
   orchestrateSpawn,
   orchestrateSpawnExecute,
   orchestrateStartup,
   orchestrateStatus,
+  orchestrateTracks,
   orchestrateUnblockOpportunities,
   orchestrateValidate,
   orchestrateWaves,
@@ -228,14 +231,20 @@ export {
   systemHooksMatrix,
   taskAnalyze,
   taskArchive,
+  taskArchiveList,
+  taskArchiveRestore,
   // T11786 (epic T11556) — first-class assignee set/clear (distinct from claim).
   taskAssignee,
   taskBatchValidate,
+  taskBlock,
   taskBlockers,
   // T11786 (epic T11556) — atomic multi-task status/stage move.
   taskBulkMove,
+  taskBurndown,
   taskCancel,
   taskClaim,
+  taskCommits,
+  taskCommitsScan,
   taskComplete,
   taskComplexityEstimate,
   taskCurrentGet,
@@ -244,37 +253,57 @@ export {
   taskDeps,
   taskDepsCycles,
   taskDepsOverview,
+  taskDepsTrace,
   taskDepsTree,
   taskDepsValidate,
+  taskEstimateRollup,
   taskExists,
   taskExport,
+  taskFieldsAdd,
+  taskFieldsList,
   taskFind,
+  taskGraph,
   taskHistory,
   taskImpact,
   taskImport,
+  taskImportMarkdown,
   taskLabelList,
+  taskLabelMerge,
+  taskLabelRename,
   taskLabelShow,
   taskLint,
+  taskLinkCommit,
   taskList,
+  taskMerge,
+  taskMove,
   taskNext,
+  taskNote,
+  taskOverdue,
   taskPlan,
+  taskPurge,
   taskPromote,
+  taskRedo,
   taskRelates,
   taskRelatesAdd,
   taskRelatesAddBatch,
   taskRelatesFind,
   taskRelatesRemove,
+  taskRenumber,
   taskReopen,
   taskReorder,
   // T11786 (epic T11556) — explicit within-column re-rank from an ID order.
   taskReorderRank,
   taskReparent,
   taskRestore,
+  taskSetStatus,
   taskShow,
   taskShowIvtrHistory,
   taskShowOperation,
   taskShowWithHistory,
   taskSlice,
+  taskSplit,
+  taskStale,
+  taskStaleReset,
   taskStart,
   taskStats,
   taskStop,
@@ -282,9 +311,18 @@ export {
   taskSyncLinksRemove,
   taskSyncReconcile,
   tasksAddBatchOp,
+  tasksBatchOp,
+  taskTime,
+  taskTimeStart,
+  taskTimeStop,
+  taskTrashEmpty,
+  taskTrashList,
+  taskTrashRestore,
   taskTree,
   taskUnarchive,
+  taskUnblock,
   taskUnclaim,
+  taskUndo,
   taskUpdate,
   taskWorkHistory,
   // T9937 — validate-changelog verb (Saga T9862)
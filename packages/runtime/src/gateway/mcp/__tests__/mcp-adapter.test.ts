@@ -26,6 +26,7 @@ import { describe, expect, it, vi } from 'vitest';
 import type { GatewayHandler } from '../../index.js';
 
 vi.mock('@cleocode/core', () => ({
+  enableTaskIndexCache: vi.fn(),
   getLogger: () => ({ debug: vi.fn(), info: vi.fn(), warn: vi.fn(), error: vi.fn() }),
 }));
 
@@ -40,6 +41,13 @@ const LEGACY_TOOL_NAMES = [
   'cleo_sentient_propose_enable',
 ] as const;
 
+/** Tools promoted onto the MCP surface after the legacy set, one registry edit each. */
+const PROMOTED_TOOL_NAMES = [
+  'cleo_tasks_batch',
+  'cleo_tasks_graph',
+  'cleo_orchestrate_sequence',
+] as const;
+
 describe('R3-T4 MCP tools/list — default-deny mcpExposed generation', () => {
   it('exposes ONLY operations that opt in via mcpExposed: true', () => {
     for (const op of exposedOperations()) {
@@ -47,11 +55,25 @@ describe('R3-T4 MCP tools/list — default-deny mcpExposed generation', () => {
     }
   });
 
-  it('generated tool SET is identical to the legacy standalone adapter (no behavior change)', () => {
+  it('generated tool SET is the legacy standalone adapter set plus explicitly promoted tools', () => {
     const names = buildToolsList()
       .map((t) => t.name)
       .sort();
-    expect(names).toEqual([...LEGACY_TOOL_NAMES].sort());
+    expect(names).toEqual([...LEGACY_TOOL_NAMES, ...PROMOTED_TOOL_NAMES].sort());
+  });
+
+  it('tasks.batch surfaces its `operations` param in the inputSchema', () => {
+    const tool = buildToolsList().find((t) => t.name === 'cleo_tasks_batch');
+    expect(tool?.inputSchema.properties.operations).toBeDefined();
+  });
+
+  it('tasks.graph and orchestrate.sequence surface their scope params', () => {
+    const tools = buildToolsList();
+    const graph = tools.find((t) => t.name === 'cleo_tasks_graph');
+    const plan = tools.find((t) => t.name === 'cleo_orchestrate_sequence');
+    expect(graph?.inputSchema.properties.sagaId).toBeDefined();
+    expect(graph?.inputSchema.properties.format?.enum).toEqual(['dot', 'mermaid']);
+    expect(plan?.inputSchema.properties.sagaId).toBeDefined();
   });
 
   it('each tool carries a description + an object inputSchema', () => {
@@ -32,7 +32,7 @@
 import { randomUUID } from 'node:crypto';
 import * as readline from 'node:readline';
 import type { DispatchRequest, DispatchResponse } from '@cleocode/contracts/gateway';
-import { getLogger } from '@cleocode/core';
+import { enableTaskIndexCache, getLogger } from '@cleocode/core';
 import type { GatewayHandler } from '../index.js';
 import { toolNameToOperationKey } from './tool-naming.js';
 import { buildToolsList, exposedOperations } from './tools-list.js';
@@ -244,6 +244,8 @@ export function startMcpServer(handler: GatewayHandler, opts?: McpServerOptions)
   const output = opts?.output ?? process.stdout;
   const exitOnClose = opts?.exitOnClose ?? true;
   const log = getLogger('gateway-mcp');
+  // The server stays resident, so reuse the task index until the database changes.
+  enableTaskIndexCache();
 
   const rl = readline.createInterface({ input, terminal: false });
 